@@ -330,6 +330,10 @@ func (a *Auth) doMgmLogin(client *mgm.GrpcClient, ctx context.Context, pubSSHKey
 // registerPeer checks whether setupKey was provided via cmd line and if not then it prompts user to enter a key.
 // Otherwise tries to register with the provided setupKey via command line.
 func (a *Auth) registerPeer(client *mgm.GrpcClient, ctx context.Context, setupKey string, jwtToken string, pubSSHKey []byte) (*mgmProto.LoginResponse, error) {
+	if setupKey == "" && jwtToken == "" && a.config.WorkloadIdentityProvider != "" {
+		return a.registerPeerWithWorkloadIdentity(client, ctx, pubSSHKey)
+	}
+
 	validSetupKey, err := uuid.Parse(setupKey)
 	if err != nil && jwtToken == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid setup-key or no sso information provided, err: %v", err)
@@ -349,6 +353,28 @@ func (a *Auth) registerPeer(client *mgm.GrpcClient, ctx context.Context, setupKe
 	return loginResp, nil
 }
 
+// registerPeerWithWorkloadIdentity registers the peer with the workload identity token of the machine, e.g. of a
+// CI job or a Kubernetes service account, instead of a setup key.
+func (a *Auth) registerPeerWithWorkloadIdentity(client *mgm.GrpcClient, ctx context.Context, pubSSHKey []byte) (*mgmProto.LoginResponse, error) {
+	token, err := getWorkloadIdentityToken(ctx, a.config.WorkloadIdentityTokenFile, a.config.WorkloadIdentityAudience)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid workload identity: %v", err)
+	}
+
+	log.Debugf("sending peer registration request with workload identity provider %s to Management Service", a.config.WorkloadIdentityProvider)
+	info := system.GetInfo(ctx)
+	a.setSystemInfoFlags(info)
+	loginResp, err := client.RegisterWithWorkloadIdentity(a.config.WorkloadIdentityProvider, token, info, pubSSHKey, a.config.DNSLabels)
+	if err != nil {
+		log.Errorf("failed registering peer with workload identity %v", err)
+		return nil, err
+	}
+
+	log.Infof("peer has been successfully registered on Management Service with workload identity")
+
+	return loginResp, nil
+}
+
 // setSystemInfoFlags sets all configuration flags on the provided system info
 func (a *Auth) setSystemInfoFlags(info *system.Info) {
 	info.SetFlags(
@@ -369,6 +395,7 @@ func (a *Auth) setSystemInfoFlags(info *system.Info) {
 		a.config.EnableSSHRemotePortForwarding,
 		a.config.DisableSSHAuth,
 	)
+	info.Labels = a.config.Labels
 }
 
 // reconnect closes the current connection and creates a new one
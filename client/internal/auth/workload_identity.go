@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// workloadIdentityTokenEnv holds a workload identity token, e.g. a GitLab CI id_token
+	workloadIdentityTokenEnv = "NB_WORKLOAD_IDENTITY_TOKEN"
+
+	// GitHub Actions exposes these to jobs with the id-token: write permission
+	githubTokenRequestURLEnv   = "ACTIONS_ID_TOKEN_REQUEST_URL"
+	githubTokenRequestTokenEnv = "ACTIONS_ID_TOKEN_REQUEST_TOKEN"
+
+	workloadIdentityRequestTimeout = 10 * time.Second
+)
+
+// getWorkloadIdentityToken returns the workload identity token of the peer. It is read, in order, from the
+// configured token file, e.g. a projected Kubernetes service account token, the NB_WORKLOAD_IDENTITY_TOKEN
+// environment variable and the GitHub Actions token endpoint.
+func getWorkloadIdentityToken(ctx context.Context, tokenFile, audience string) (string, error) {
+	if tokenFile != "" {
+		// projected tokens are rotated by the kubelet, so the file is read on every registration
+		token, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return "", fmt.Errorf("read workload identity token file: %w", err)
+		}
+		return strings.TrimSpace(string(token)), nil
+	}
+
+	if token := os.Getenv(workloadIdentityTokenEnv); token != "" {
+		return token, nil
+	}
+
+	if os.Getenv(githubTokenRequestURLEnv) != "" {
+		return requestGitHubActionsToken(ctx, audience)
+	}
+
+	return "", fmt.Errorf("no workload identity token found, set a token file or %s", workloadIdentityTokenEnv)
+}
+
+// requestGitHubActionsToken requests an OIDC token for the audience from the GitHub Actions token endpoint.
+func requestGitHubActionsToken(ctx context.Context, audience string) (string, error) {
+	requestURL, err := url.Parse(os.Getenv(githubTokenRequestURLEnv))
+	if err != nil {
+		return "", fmt.Errorf("parse GitHub Actions token request URL: %w", err)
+	}
+	if audience != "" {
+		query := requestURL.Query()
+		query.Set("audience", audience)
+		requestURL.RawQuery = query.Encode()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, workloadIdentityRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("create GitHub Actions token request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+os.Getenv(githubTokenRequestTokenEnv))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request GitHub Actions token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("request GitHub Actions token: %s", resp.Status)
+	}
+
+	var tokenResp struct {
+		Value string `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("decode GitHub Actions token response: %w", err)
+	}
+	if tokenResp.Value == "" {
+		return "", fmt.Errorf("GitHub Actions token response has no token")
+	}
+
+	return tokenResp.Value, nil
+}
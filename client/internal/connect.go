@@ -680,6 +680,8 @@ func createEngineConfig(key wgtypes.Key, config *profilemanager.Config, peerConf
 		DisableIPv6:         config.DisableIPv6,
 		SyncMessageVersion:  config.SyncMessageVersion,
 
+		Labels: config.Labels,
+
 		LazyConnection: lazyconn.ParseState(config.LazyConnection),
 
 		MTU:     selectMTU(config.MTU, peerConfig.Mtu),
@@ -762,6 +764,7 @@ func loginToManagement(ctx context.Context, client mgm.Client, pubSSHKey []byte,
 		config.EnableSSHRemotePortForwarding,
 		config.DisableSSHAuth,
 	)
+	sysInfo.Labels = config.Labels
 	return client.Login(sysInfo, pubSSHKey, config.DNSLabels)
 }
 
@@ -154,6 +154,9 @@ type EngineConfig struct {
 	DisableIPv6         bool
 	SyncMessageVersion  *int
 
+	// Labels are the key/value labels reported to the management service with the system info
+	Labels map[string]string
+
 	// LazyConnection is the MDM-sourced lazy-connection override; StateUnset defers to
 	// the env var and management feature flag.
 	LazyConnection lazyconn.State
@@ -1261,6 +1264,7 @@ func (e *Engine) applyInfoFlags(info *system.Info) {
 		e.config.EnableSSHRemotePortForwarding,
 		e.config.DisableSSHAuth,
 	)
+	info.Labels = e.config.Labels
 }
 
 // overlayAddresses returns our own WireGuard overlay address (v4 and v6) so it
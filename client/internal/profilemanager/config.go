@@ -144,6 +144,17 @@ type Config struct {
 
 	DNSLabels domain.List
 
+	// Labels are key/value labels reported to the management service. They are informational, policies
+	// and routes select peers only by the labels set for the peer in the management service.
+	Labels map[string]string
+
+	// WorkloadIdentityProvider is the ID of the workload identity provider the peer registers with when neither a
+	// setup key nor SSO is used. The token is read from WorkloadIdentityTokenFile, the NB_WORKLOAD_IDENTITY_TOKEN
+	// environment variable or, in GitHub Actions, requested for WorkloadIdentityAudience.
+	WorkloadIdentityProvider  string
+	WorkloadIdentityTokenFile string
+	WorkloadIdentityAudience  string
+
 	// SSHKey is a private SSH key in a PEM format
 	SSHKey string
 
@@ -674,7 +674,8 @@ func (s *Server) Login(callerCtx context.Context, msg *proto.LoginRequest) (*pro
 		return &proto.LoginResponse{}, nil
 	}
 
-	if msg.SetupKey == "" {
+	// peers with a workload identity provider register with their workload token instead of SSO
+	if msg.SetupKey == "" && config.WorkloadIdentityProvider == "" {
 		hint := ""
 		if msg.Hint != nil {
 			hint = *msg.Hint
@@ -725,7 +726,7 @@ func (s *Server) Login(callerCtx context.Context, msg *proto.LoginRequest) (*pro
 		}, nil
 	}
 
-	// Setup-key path: we are about to dial Management with the key, so the
+	// Setup-key and workload identity path: we are about to dial Management with the key, so the
 	// Connecting paint is meaningful here — unlike the SSO branch above,
 	// which returns NeedsLogin and parks on the browser leg.
 	state.Set(internal.StatusConnecting)
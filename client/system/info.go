@@ -81,6 +81,9 @@ type Info struct {
 	DisableSSHAuth                bool
 
 	SyncMessageVersion *int
+
+	// Labels are the key/value labels from the local config
+	Labels map[string]string
 }
 
 func (i *Info) SetFlags(
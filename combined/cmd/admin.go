@@ -10,6 +10,8 @@ import (
 
 	"github.com/netbirdio/netbird/formatter/hook"
 	admincmd "github.com/netbirdio/netbird/management/cmd/admin"
+	configcmd "github.com/netbirdio/netbird/management/cmd/config"
+	storecmd "github.com/netbirdio/netbird/management/cmd/store"
 	tokencmd "github.com/netbirdio/netbird/management/cmd/token"
 	nbconfig "github.com/netbirdio/netbird/management/internals/server/config"
 	"github.com/netbirdio/netbird/management/server/activity"
@@ -34,6 +36,16 @@ func newLegacyTokenCommand() *cobra.Command {
 	return cmd
 }
 
+// newConfigCommands creates the config-as-code command tree with the combined resource opener.
+func newConfigCommands() *cobra.Command {
+	return configcmd.NewCommands(withConfigResources)
+}
+
+// newStoreCommands creates the store maintenance command tree with the combined config opener.
+func newStoreCommands() *cobra.Command {
+	return storecmd.NewCommands(withStoreEnvironment)
+}
+
 // withAdminResources loads the combined YAML config, initializes stores, and calls fn.
 func withAdminResources(cmd *cobra.Command, fn func(ctx context.Context, resources admincmd.Resources) error) error {
 	return withAdminConfig(cmd, func(ctx context.Context, cfg *CombinedConfig) error {
@@ -70,6 +82,58 @@ func withAdminResources(cmd *cobra.Command, fn func(ctx context.Context, resourc
 	})
 }
 
+// withConfigResources loads the combined YAML config, opens the management store and,
+// when it is available, the activity event store, and calls fn.
+func withConfigResources(cmd *cobra.Command, exclusive bool, fn func(ctx context.Context, resources configcmd.Resources) error) error {
+	return withAdminConfig(cmd, func(ctx context.Context, cfg *CombinedConfig) error {
+		mgmtConfig, err := adminManagementConfig(cfg)
+		if err != nil {
+			return err
+		}
+
+		if exclusive {
+			if err := store.EnsureStoreNotInUse(ctx, types.Engine(cfg.Management.Store.Engine), cfg.Management.DataDir); err != nil {
+				return err
+			}
+		}
+
+		managementStore, err := openAdminStore(ctx, cfg)
+		if err != nil {
+			return err
+		}
+		defer admincmd.CloseStore(ctx, managementStore)
+
+		eventStore, esErr := openAdminEventStore(ctx, cfg, mgmtConfig)
+		if esErr != nil {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Warning: audit events will not be recorded: %v\n", esErr)
+		}
+		if eventStore != nil {
+			defer func() {
+				if err := eventStore.Close(ctx); err != nil {
+					log.Debugf("close activity event store: %v", err)
+				}
+			}()
+		}
+
+		return fn(ctx, configcmd.Resources{Store: managementStore, EventStore: eventStore})
+	})
+}
+
+// withStoreEnvironment loads the combined config, exports the store settings to the environment
+// and calls fn with the data directory and encryption key the store commands open databases with.
+func withStoreEnvironment(cmd *cobra.Command, fn func(ctx context.Context, env storecmd.Environment) error) error {
+	return withAdminConfig(cmd, func(ctx context.Context, cfg *CombinedConfig) error {
+		mgmtConfig, err := adminManagementConfig(cfg)
+		if err != nil {
+			return err
+		}
+		if err := applyActivityStoreEnv(cfg.Server.ActivityStore); err != nil {
+			return fmt.Errorf("configure activity event store: %w", err)
+		}
+		return fn(ctx, storecmd.Environment{Datadir: mgmtConfig.Datadir, EncryptionKey: mgmtConfig.DataStoreEncryptionKey})
+	})
+}
+
 // withAdminStoreOnly opens only the management store for admin subcommands that do not
 // need embedded IdP storage.
 func withAdminStoreOnly(cmd *cobra.Command, fn func(ctx context.Context, s store.Store) error) error {
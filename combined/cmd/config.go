@@ -78,6 +78,14 @@ type ServerConfig struct {
 	PerAccountSupportedSyncMessageVersions map[string]int `yaml:"perAccountSupportedSyncMessageVersions,omitempty"`
 
 	AgentNetwork AgentNetworkConfig `yaml:"agentNetwork"`
+	UpdateBus    UpdateBusConfig    `yaml:"updateBus"`
+}
+
+// UpdateBusConfig contains the settings of the bus connecting management instances that serve the same store
+type UpdateBusConfig struct {
+	Engine     string `yaml:"engine"`     // "postgres", empty runs a single instance
+	DSN        string `yaml:"dsn"`        // Defaults to the postgres store DSN
+	InstanceID string `yaml:"instanceId"` // Random when empty
 }
 
 // AgentNetworkConfig contains agent-network (LLM gateway) configuration.
@@ -132,6 +140,7 @@ type ManagementConfig struct {
 	DisableAnonymousMetrics bool               `yaml:"disableAnonymousMetrics"`
 	DisableGeoliteUpdate    bool               `yaml:"disableGeoliteUpdate"`
 	DisableDefaultPolicy    bool               `yaml:"disableDefaultPolicy"`
+	ActivityRetentionDays   int                `yaml:"activityRetentionDays"`
 	Auth                    AuthConfig         `yaml:"auth"`
 	Stuns                   []HostConfig       `yaml:"stuns"`
 	Relays                  RelaysConfig       `yaml:"relays"`
@@ -199,6 +208,14 @@ type ReverseProxyConfig struct {
 	TrustedPeers                  []string `yaml:"trustedPeers"`
 	AccessLogRetentionDays        int      `yaml:"accessLogRetentionDays"`
 	AccessLogCleanupIntervalHours int      `yaml:"accessLogCleanupIntervalHours"`
+	// ClusterAccessLogs selects the access log sinks of shared proxy clusters, keyed by cluster address.
+	ClusterAccessLogs map[string]ClusterAccessLogConfig `yaml:"clusterAccessLogs"`
+}
+
+// ClusterAccessLogConfig contains the access log settings of a proxy cluster
+type ClusterAccessLogConfig struct {
+	Sinks          []string `yaml:"sinks"`
+	ManagementMode string   `yaml:"managementMode"`
 }
 
 // DefaultConfig returns a CombinedConfig with default values
@@ -685,6 +702,15 @@ func (c *CombinedConfig) ToManagementConfig() (*nbconfig.Config, error) {
 		AccessLogRetentionDays:        mgmt.ReverseProxy.AccessLogRetentionDays,
 		AccessLogCleanupIntervalHours: mgmt.ReverseProxy.AccessLogCleanupIntervalHours,
 	}
+	for address, accessLog := range mgmt.ReverseProxy.ClusterAccessLogs {
+		if reverseProxy.ClusterAccessLogs == nil {
+			reverseProxy.ClusterAccessLogs = make(map[string]nbconfig.ClusterAccessLog)
+		}
+		reverseProxy.ClusterAccessLogs[address] = nbconfig.ClusterAccessLog{
+			Sinks:          accessLog.Sinks,
+			ManagementMode: accessLog.ManagementMode,
+		}
+	}
 	for _, p := range mgmt.ReverseProxy.TrustedHTTPProxies {
 		if prefix, err := netip.ParsePrefix(p); err == nil {
 			reverseProxy.TrustedHTTPProxies = append(reverseProxy.TrustedHTTPProxies, prefix)
@@ -727,12 +753,18 @@ func (c *CombinedConfig) ToManagementConfig() (*nbconfig.Config, error) {
 		StoreConfig:                        storeConfig,
 		ReverseProxy:                       reverseProxy,
 		DisableDefaultPolicy:               mgmt.DisableDefaultPolicy,
+		ActivityEventRetentionDays:         mgmt.ActivityRetentionDays,
 		EmbeddedIdP:                        embeddedIdP,
 		HighestSupportedSyncMessageVersion: c.Server.SupportedSyncMessageVersions,
 		PerAccountHighestSupportedSyncMessageVersion: c.Server.PerAccountSupportedSyncMessageVersions,
 		AgentNetwork: nbconfig.AgentNetwork{
 			PricingDefaultsFile: c.Server.AgentNetwork.PricingDefaultsFile,
 		},
+		UpdateBus: nbconfig.UpdateBus{
+			Engine:     c.Server.UpdateBus.Engine,
+			DSN:        c.Server.UpdateBus.DSN,
+			InstanceID: c.Server.UpdateBus.InstanceID,
+		},
 	}, nil
 }
 
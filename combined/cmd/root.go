@@ -69,6 +69,8 @@ func init() {
 
 	rootCmd.AddCommand(newAdminCommands())
 	rootCmd.AddCommand(newLegacyTokenCommand())
+	rootCmd.AddCommand(newConfigCommands())
+	rootCmd.AddCommand(newStoreCommands())
 }
 
 func RootCmd() *cobra.Command {
@@ -1,6 +1,9 @@
 package main
 
 import (
+	// embedded zoneinfo, so policy schedule timezones resolve on images without tzdata
+	_ "time/tzdata"
+
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/combined/cmd"
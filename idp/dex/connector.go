@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/dexidp/dex/connector/ldap"
 	"github.com/dexidp/dex/storage"
 )
 
@@ -17,7 +18,7 @@ type ConnectorConfig struct {
 	ID string
 	// Name is a human-readable name for the connector
 	Name string
-	// Type is the connector type (oidc, google, microsoft)
+	// Type is the connector type (oidc, google, microsoft, ldap, saml)
 	Type string
 	// Issuer is the OIDC issuer URL (for OIDC-based connectors)
 	Issuer string
@@ -25,8 +26,73 @@ type ConnectorConfig struct {
 	ClientID string
 	// ClientSecret is the OAuth2 client secret
 	ClientSecret string
-	// RedirectURI is the OAuth2 redirect URI
+	// RedirectURI is the OAuth2 redirect URI, or the assertion consumer service URL of SAML connectors
 	RedirectURI string
+	// LDAP holds the directory settings of ldap connectors
+	LDAP *LDAPConnectorConfig
+	// SAML holds the settings of saml connectors
+	SAML *SAMLConnectorConfig
+}
+
+// LDAPConnectorConfig holds the settings of an LDAP or Active Directory connector.
+// It is serialized with the field names of the Dex ldap connector config.
+type LDAPConnectorConfig struct {
+	// Host is the host and optional port of the LDAP server
+	Host               string `json:"host"`
+	InsecureNoSSL      bool   `json:"insecureNoSSL"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify"`
+	StartTLS           bool   `json:"startTLS"`
+	// RootCAData is the PEM encoded CA bundle used to verify the server certificate
+	RootCAData []byte `json:"rootCAData,omitempty"`
+	// BindDN and BindPW are the credentials of the service account used to search the directory
+	BindDN      string          `json:"bindDN"`
+	BindPW      string          `json:"bindPW"`
+	UserSearch  LDAPUserSearch  `json:"userSearch"`
+	GroupSearch LDAPGroupSearch `json:"groupSearch"`
+}
+
+// LDAPUserSearch configures how users are looked up when they sign in.
+type LDAPUserSearch struct {
+	BaseDN string `json:"baseDN"`
+	Filter string `json:"filter,omitempty"`
+	// Username lists the attributes matched against the username entered on the login page
+	Username  ldap.UsernameAttributes `json:"username"`
+	IDAttr    string                  `json:"idAttr,omitempty"`
+	EmailAttr string                  `json:"emailAttr,omitempty"`
+	NameAttr  string                  `json:"nameAttr,omitempty"`
+}
+
+// LDAPGroupSearch configures how the groups of a user are looked up. The group names are issued in the
+// groups claim of the tokens.
+type LDAPGroupSearch struct {
+	BaseDN       string            `json:"baseDN,omitempty"`
+	Filter       string            `json:"filter,omitempty"`
+	UserMatchers []LDAPUserMatcher `json:"userMatchers,omitempty"`
+	NameAttr     string            `json:"nameAttr,omitempty"`
+}
+
+// LDAPUserMatcher matches a user attribute against a group attribute, e.g. the user DN against member.
+type LDAPUserMatcher struct {
+	UserAttr  string `json:"userAttr"`
+	GroupAttr string `json:"groupAttr"`
+}
+
+// SAMLConnectorConfig holds the settings of a SAML 2.0 connector.
+// It is serialized with the field names of the Dex saml connector config.
+type SAMLConnectorConfig struct {
+	// SSOURL is the URL of the identity provider the authentication requests are sent to
+	SSOURL string `json:"ssoURL"`
+	// CAData is the PEM encoded certificate used to verify the assertion signatures
+	CAData                          []byte `json:"caData,omitempty"`
+	InsecureSkipSignatureValidation bool   `json:"insecureSkipSignatureValidation,omitempty"`
+	EntityIssuer                    string `json:"entityIssuer,omitempty"`
+	SSOIssuer                       string `json:"ssoIssuer,omitempty"`
+	RedirectURI                     string `json:"redirectURI,omitempty"`
+	UsernameAttr                    string `json:"usernameAttr"`
+	EmailAttr                       string `json:"emailAttr"`
+	GroupsAttr                      string `json:"groupsAttr,omitempty"`
+	GroupsDelim                     string `json:"groupsDelim,omitempty"`
+	NameIDPolicyFormat              string `json:"nameIDPolicyFormat,omitempty"`
 }
 
 // CreateConnector creates a new connector in Dex storage.
@@ -127,11 +193,27 @@ func (p *Provider) UpdateConnector(ctx context.Context, cfg *ConnectorConfig) er
 // overlayConnectorConfig writes only the user-mutable fields onto the existing
 // stored config, preserving every other field (scopes, claimMapping, userIDKey,
 // insecure flags, etc.). Empty fields on cfg leave the existing value alone.
+// LDAP and SAML settings replace the stored ones, except for an empty LDAP
+// bind password which keeps the stored password.
 func overlayConnectorConfig(oldConfig []byte, cfg *ConnectorConfig) ([]byte, error) {
 	var m map[string]any
 	if err := decodeConnectorConfig(oldConfig, &m); err != nil {
 		return nil, err
 	}
+	if cfg.LDAP != nil {
+		ldapConfig := *cfg.LDAP
+		if ldapConfig.BindPW == "" {
+			ldapConfig.BindPW, _ = m["bindPW"].(string)
+		}
+		if err := overlayStruct(m, &ldapConfig); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.SAML != nil {
+		if err := overlayStruct(m, cfg.SAML); err != nil {
+			return nil, err
+		}
+	}
 	if cfg.Issuer != "" {
 		m["issuer"] = cfg.Issuer
 	}
@@ -147,6 +229,22 @@ func overlayConnectorConfig(oldConfig []byte, cfg *ConnectorConfig) ([]byte, err
 	return encodeConnectorConfig(m)
 }
 
+// overlayStruct writes the JSON fields of v onto m.
+func overlayStruct(m map[string]any, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var fields map[string]any
+	if err := decodeConnectorConfig(data, &fields); err != nil {
+		return err
+	}
+	for key, value := range fields {
+		m[key] = value
+	}
+	return nil
+}
+
 // DeleteConnector removes a connector from Dex storage.
 func (p *Provider) DeleteConnector(ctx context.Context, id string) error {
 	// Prevent deletion of the local connector
@@ -193,6 +291,12 @@ func (p *Provider) buildStorageConnector(cfg *ConnectorConfig) (storage.Connecto
 	case "microsoft":
 		dexType = "microsoft"
 		configData, err = buildOAuth2ConnectorConfig(cfg, redirectURI)
+	case "ldap":
+		dexType = "ldap"
+		configData, err = buildLDAPConnectorConfig(cfg)
+	case "saml":
+		dexType = "saml"
+		configData, err = buildSAMLConnectorConfig(cfg, redirectURI)
 	default:
 		return storage.Connector{}, fmt.Errorf("unsupported connector type: %s", cfg.Type)
 	}
@@ -255,6 +359,25 @@ func buildOAuth2ConnectorConfig(cfg *ConnectorConfig, redirectURI string) ([]byt
 	})
 }
 
+// buildLDAPConnectorConfig creates config for LDAP connectors
+func buildLDAPConnectorConfig(cfg *ConnectorConfig) ([]byte, error) {
+	if cfg.LDAP == nil {
+		return nil, errors.New("ldap connector settings are required")
+	}
+	return json.Marshal(cfg.LDAP)
+}
+
+// buildSAMLConnectorConfig creates config for SAML connectors. Dex serves the
+// assertion consumer service on the same callback as the OAuth2 connectors.
+func buildSAMLConnectorConfig(cfg *ConnectorConfig, redirectURI string) ([]byte, error) {
+	if cfg.SAML == nil {
+		return nil, errors.New("saml connector settings are required")
+	}
+	samlConfig := *cfg.SAML
+	samlConfig.RedirectURI = redirectURI
+	return json.Marshal(samlConfig)
+}
+
 // parseStorageConnector converts a storage.Connector back to ConnectorConfig.
 // It infers the original identity provider type from the Dex connector type and ID.
 func (p *Provider) parseStorageConnector(conn storage.Connector) (*ConnectorConfig, error) {
@@ -287,6 +410,19 @@ func (p *Provider) parseStorageConnector(conn storage.Connector) (*ConnectorConf
 		cfg.Issuer = v
 	}
 
+	switch conn.Type {
+	case "ldap":
+		cfg.LDAP = &LDAPConnectorConfig{}
+		if err := decodeConnectorConfig(conn.Config, cfg.LDAP); err != nil {
+			return nil, fmt.Errorf("failed to parse ldap connector config: %w", err)
+		}
+	case "saml":
+		cfg.SAML = &SAMLConnectorConfig{}
+		if err := decodeConnectorConfig(conn.Config, cfg.SAML); err != nil {
+			return nil, fmt.Errorf("failed to parse saml connector config: %w", err)
+		}
+	}
+
 	// Infer the original identity provider type from Dex connector type and ID
 	cfg.Type = inferIdentityProviderType(conn.Type, conn.ID, configMap)
 
@@ -3,11 +3,13 @@ package dex
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"log/slog"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/dexidp/dex/server"
 	"github.com/dexidp/dex/storage"
 	"github.com/dexidp/dex/storage/sql"
 	"github.com/stretchr/testify/assert"
@@ -203,3 +205,127 @@ func TestUpdateConnector_AllowsSameTypeUpdate(t *testing.T) {
 	require.NoError(t, json.Unmarshal(conn.Config, &m))
 	assert.Equal(t, "https://login.microsoftonline.com/new/v2.0", m["issuer"])
 }
+
+func testLDAPConnectorConfig() *ConnectorConfig {
+	return &ConnectorConfig{
+		ID:   "ldap-test",
+		Name: "Active Directory",
+		Type: "ldap",
+		LDAP: &LDAPConnectorConfig{
+			Host:   "ldap.example.com:636",
+			BindDN: "cn=netbird,dc=example,dc=com",
+			BindPW: "bind-secret",
+			UserSearch: LDAPUserSearch{
+				BaseDN:    "ou=users,dc=example,dc=com",
+				Username:  []string{"sAMAccountName"},
+				IDAttr:    "objectGUID",
+				EmailAttr: "mail",
+				NameAttr:  "cn",
+			},
+			GroupSearch: LDAPGroupSearch{
+				BaseDN:       "ou=groups,dc=example,dc=com",
+				UserMatchers: []LDAPUserMatcher{{UserAttr: "DN", GroupAttr: "member"}},
+				NameAttr:     "cn",
+			},
+		},
+	}
+}
+
+// openStoredConnector opens a stored connector the way the Dex server does, which validates its config.
+func openStoredConnector(t *testing.T, conn storage.Connector) {
+	t.Helper()
+
+	newConfig, ok := server.ConnectorsConfig[conn.Type]
+	require.True(t, ok, "dex has no %s connector", conn.Type)
+	connConfig := newConfig()
+	require.NoError(t, json.Unmarshal(conn.Config, connConfig))
+	_, err := connConfig.Open(conn.ID, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+}
+
+func TestCreateConnector_LDAP(t *testing.T) {
+	ctx := context.Background()
+	p, cleanup := newTestProvider(t)
+	defer cleanup()
+
+	_, err := p.CreateConnector(ctx, testLDAPConnectorConfig())
+	require.NoError(t, err)
+
+	conn, err := p.storage.GetConnector(ctx, "ldap-test")
+	require.NoError(t, err)
+	assert.Equal(t, "ldap", conn.Type)
+	openStoredConnector(t, conn)
+
+	parsed, err := p.GetConnector(ctx, "ldap-test")
+	require.NoError(t, err)
+	assert.Equal(t, "ldap", parsed.Type)
+	require.NotNil(t, parsed.LDAP)
+	assert.Equal(t, testLDAPConnectorConfig().LDAP, parsed.LDAP)
+
+	_, err = p.CreateConnector(ctx, &ConnectorConfig{ID: "ldap-empty", Type: "ldap"})
+	assert.Error(t, err, "ldap connectors require their settings")
+}
+
+func TestUpdateConnector_LDAPKeepsBindPassword(t *testing.T) {
+	ctx := context.Background()
+	p, cleanup := newTestProvider(t)
+	defer cleanup()
+
+	_, err := p.CreateConnector(ctx, testLDAPConnectorConfig())
+	require.NoError(t, err)
+
+	update := testLDAPConnectorConfig()
+	update.LDAP.BindPW = ""
+	update.LDAP.Host = "ldap2.example.com:636"
+	require.NoError(t, p.UpdateConnector(ctx, update))
+
+	parsed, err := p.GetConnector(ctx, "ldap-test")
+	require.NoError(t, err)
+	assert.Equal(t, "ldap2.example.com:636", parsed.LDAP.Host)
+	assert.Equal(t, "bind-secret", parsed.LDAP.BindPW, "an empty bind password must keep the stored one")
+}
+
+func TestParseStorageConnector_LDAPSingleUsernameAttribute(t *testing.T) {
+	p := &Provider{}
+	// static connectors from config files commonly set username as a single attribute
+	parsed, err := p.parseStorageConnector(storage.Connector{
+		ID:     "ldap",
+		Type:   "ldap",
+		Config: []byte(`{"host":"ldap:389","userSearch":{"baseDN":"dc=example,dc=com","username":"uid"}}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"uid"}, []string(parsed.LDAP.UserSearch.Username))
+}
+
+func TestCreateConnector_SAML(t *testing.T) {
+	ctx := context.Background()
+	p, cleanup := newTestProvider(t)
+	defer cleanup()
+	p.config = &Config{Issuer: "https://netbird.example.com/oauth2"}
+
+	_, err := p.CreateConnector(ctx, &ConnectorConfig{
+		ID:   "saml-test",
+		Name: "SAML",
+		Type: "saml",
+		SAML: &SAMLConnectorConfig{
+			SSOURL:                          "https://idp.example.com/saml/sso",
+			InsecureSkipSignatureValidation: true,
+			UsernameAttr:                    "name",
+			EmailAttr:                       "email",
+			GroupsAttr:                      "groups",
+		},
+	})
+	require.NoError(t, err)
+
+	conn, err := p.storage.GetConnector(ctx, "saml-test")
+	require.NoError(t, err)
+	assert.Equal(t, "saml", conn.Type)
+	openStoredConnector(t, conn)
+
+	parsed, err := p.GetConnector(ctx, "saml-test")
+	require.NoError(t, err)
+	assert.Equal(t, "saml", parsed.Type)
+	assert.Equal(t, "https://netbird.example.com/oauth2/callback", parsed.RedirectURI)
+	require.NotNil(t, parsed.SAML)
+	assert.Equal(t, "groups", parsed.SAML.GroupsAttr)
+}
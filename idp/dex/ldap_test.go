@@ -0,0 +1,166 @@
+package dex
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dexidp/dex/connector"
+	"github.com/dexidp/dex/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const openLDAPTestData = `dn: ou=users,dc=example,dc=org
+objectClass: organizationalUnit
+ou: users
+
+dn: ou=groups,dc=example,dc=org
+objectClass: organizationalUnit
+ou: groups
+
+dn: uid=alice,ou=users,dc=example,dc=org
+objectClass: inetOrgPerson
+uid: alice
+cn: Alice Doe
+sn: Doe
+mail: alice@example.org
+userPassword: alice-password
+
+dn: uid=bob,ou=users,dc=example,dc=org
+objectClass: inetOrgPerson
+uid: bob
+cn: Bob Doe
+sn: Doe
+mail: bob@example.org
+userPassword: bob-password
+
+dn: cn=engineering,ou=groups,dc=example,dc=org
+objectClass: groupOfNames
+cn: engineering
+member: uid=alice,ou=users,dc=example,dc=org
+
+dn: cn=devops,ou=groups,dc=example,dc=org
+objectClass: groupOfNames
+cn: devops
+member: uid=alice,ou=users,dc=example,dc=org
+member: uid=bob,ou=users,dc=example,dc=org
+`
+
+// startOpenLDAP starts an OpenLDAP container standing in for a directory like Active Directory and returns its address.
+func startOpenLDAP(t *testing.T) string {
+	t.Helper()
+
+	ctx := context.Background()
+	c, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "osixia/openldap:1.5.0",
+			ExposedPorts: []string{"389/tcp"},
+			Env: map[string]string{
+				"LDAP_ORGANISATION":   "NetBird",
+				"LDAP_DOMAIN":         "example.org",
+				"LDAP_ADMIN_PASSWORD": "admin",
+				"LDAP_TLS":            "false",
+			},
+			Files: []testcontainers.ContainerFile{{
+				Reader:            strings.NewReader(openLDAPTestData),
+				ContainerFilePath: "/container/service/slapd/assets/config/bootstrap/ldif/custom/50-netbird.ldif",
+				FileMode:          0o644,
+			}},
+			Cmd:        []string{"--copy-service"},
+			WaitingFor: wait.ForListeningPort("389/tcp").WithStartupTimeout(2 * time.Minute),
+		},
+		Started: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := c.Terminate(ctx); err != nil {
+			t.Log(err)
+		}
+	})
+
+	host, err := c.Host(ctx)
+	require.NoError(t, err)
+	port, err := c.MappedPort(ctx, "389")
+	require.NoError(t, err)
+
+	return net.JoinHostPort(host, port.Port())
+}
+
+func TestLDAPConnector_OpenLDAP(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("Skipping test on non-Linux due to docker dependency")
+	}
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+
+	ctx := context.Background()
+	p, cleanup := newTestProvider(t)
+	defer cleanup()
+
+	_, err := p.CreateConnector(ctx, &ConnectorConfig{
+		ID:   "ldap-openldap",
+		Name: "OpenLDAP",
+		Type: "ldap",
+		LDAP: &LDAPConnectorConfig{
+			Host:          startOpenLDAP(t),
+			InsecureNoSSL: true,
+			BindDN:        "cn=admin,dc=example,dc=org",
+			BindPW:        "admin",
+			UserSearch: LDAPUserSearch{
+				BaseDN:    "ou=users,dc=example,dc=org",
+				Filter:    "(objectClass=inetOrgPerson)",
+				Username:  []string{"uid", "mail"},
+				IDAttr:    "uid",
+				EmailAttr: "mail",
+				NameAttr:  "cn",
+			},
+			GroupSearch: LDAPGroupSearch{
+				BaseDN:       "ou=groups,dc=example,dc=org",
+				Filter:       "(objectClass=groupOfNames)",
+				UserMatchers: []LDAPUserMatcher{{UserAttr: "DN", GroupAttr: "member"}},
+				NameAttr:     "cn",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	stored, err := p.storage.GetConnector(ctx, "ldap-openldap")
+	require.NoError(t, err)
+
+	connConfig := server.ConnectorsConfig[stored.Type]()
+	require.NoError(t, json.Unmarshal(stored.Config, connConfig))
+	conn, err := connConfig.Open(stored.ID, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	passwordConn, ok := conn.(connector.PasswordConnector)
+	require.True(t, ok, "ldap connector must accept passwords")
+
+	// the directory finishes loading the bootstrap data after the port starts listening
+	var identity connector.Identity
+	require.Eventually(t, func() bool {
+		var valid bool
+		identity, valid, err = passwordConn.Login(ctx, connector.Scopes{Groups: true}, "alice", "alice-password")
+		return err == nil && valid
+	}, time.Minute, time.Second)
+
+	assert.Equal(t, "alice", identity.UserID)
+	assert.Equal(t, "alice@example.org", identity.Email)
+	assert.Equal(t, "Alice Doe", identity.Username)
+	assert.ElementsMatch(t, []string{"engineering", "devops"}, identity.Groups)
+
+	identity, valid, err := passwordConn.Login(ctx, connector.Scopes{Groups: true}, "bob@example.org", "bob-password")
+	require.NoError(t, err)
+	require.True(t, valid)
+	assert.Equal(t, []string{"devops"}, identity.Groups)
+
+	_, valid, err = passwordConn.Login(ctx, connector.Scopes{Groups: true}, "alice", "wrong-password")
+	require.NoError(t, err)
+	assert.False(t, valid)
+}
@@ -12,6 +12,8 @@ import (
 
 	"github.com/netbirdio/netbird/formatter/hook"
 	admincmd "github.com/netbirdio/netbird/management/cmd/admin"
+	configcmd "github.com/netbirdio/netbird/management/cmd/config"
+	storecmd "github.com/netbirdio/netbird/management/cmd/store"
 	tokencmd "github.com/netbirdio/netbird/management/cmd/token"
 	nbconfig "github.com/netbirdio/netbird/management/internals/server/config"
 	"github.com/netbirdio/netbird/management/server/activity"
@@ -40,6 +42,22 @@ func newLegacyTokenCommand() *cobra.Command {
 	return cmd
 }
 
+// newConfigCommands creates the config-as-code command tree with the management resource opener.
+func newConfigCommands() *cobra.Command {
+	cmd := configcmd.NewCommands(withConfigResources)
+	cmd.PersistentFlags().StringVar(&adminDatadir, "datadir", "", "Override the data directory from config (used for store.db and events.db)")
+	cmd.PersistentFlags().StringVar(&nbconfig.MgmtConfigPath, "config", defaultMgmtConfig, "Netbird config file location")
+	return cmd
+}
+
+// newStoreCommands creates the store maintenance command tree with the management config opener.
+func newStoreCommands() *cobra.Command {
+	cmd := storecmd.NewCommands(withStoreEnvironment)
+	cmd.PersistentFlags().StringVar(&adminDatadir, "datadir", "", "Override the data directory from config (used for store.db and events.db)")
+	cmd.PersistentFlags().StringVar(&nbconfig.MgmtConfigPath, "config", defaultMgmtConfig, "Netbird config file location")
+	return cmd
+}
+
 // withAdminResources initializes logging, loads config, opens the management store
 // and embedded IdP storage, and calls fn.
 func withAdminResources(cmd *cobra.Command, fn func(ctx context.Context, resources admincmd.Resources) error) error {
@@ -72,6 +90,46 @@ func withAdminResources(cmd *cobra.Command, fn func(ctx context.Context, resourc
 	})
 }
 
+// withConfigResources initializes logging, loads config, opens the management store and,
+// when it is available, the activity event store, and calls fn.
+func withConfigResources(cmd *cobra.Command, exclusive bool, fn func(ctx context.Context, resources configcmd.Resources) error) error {
+	return withAdminConfig(cmd, false, func(ctx context.Context, config *nbconfig.Config, datadir string) error {
+		if exclusive {
+			if err := store.EnsureStoreNotInUse(ctx, config.StoreConfig.Engine, datadir); err != nil {
+				return err
+			}
+		}
+
+		managementStore, err := openAdminStore(ctx, config, datadir)
+		if err != nil {
+			return err
+		}
+		defer admincmd.CloseStore(ctx, managementStore)
+
+		eventStore, esErr := openAdminEventStore(ctx, config, datadir)
+		if esErr != nil {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Warning: audit events will not be recorded: %v\n", esErr)
+		}
+		if eventStore != nil {
+			defer func() {
+				if err := eventStore.Close(ctx); err != nil {
+					log.Debugf("close activity event store: %v", err)
+				}
+			}()
+		}
+
+		return fn(ctx, configcmd.Resources{Store: managementStore, EventStore: eventStore})
+	})
+}
+
+// withStoreEnvironment initializes logging, loads config and calls fn with the data directory
+// and encryption key the store commands open databases with.
+func withStoreEnvironment(cmd *cobra.Command, fn func(ctx context.Context, env storecmd.Environment) error) error {
+	return withAdminConfig(cmd, false, func(ctx context.Context, config *nbconfig.Config, datadir string) error {
+		return fn(ctx, storecmd.Environment{Datadir: datadir, EncryptionKey: config.DataStoreEncryptionKey})
+	})
+}
+
 // withAdminStoreOnly opens only the management store for admin subcommands that do not
 // need embedded IdP storage.
 func withAdminStoreOnly(cmd *cobra.Command, fn func(ctx context.Context, s store.Store) error) error {
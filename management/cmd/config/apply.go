@@ -0,0 +1,705 @@
+package configcmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/formatter/hook"
+	"github.com/netbirdio/netbird/management/internals/modules/zones"
+	"github.com/netbirdio/netbird/management/internals/modules/zones/records"
+	"github.com/netbirdio/netbird/management/server/activity"
+	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
+	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
+	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// ApplyResult is the outcome of applying a document
+type ApplyResult struct {
+	Changes []Change
+	// SetupKeys are the setup keys created by the apply with their plain text key
+	SetupKeys []CreatedSetupKey
+}
+
+// CreatedSetupKey is a setup key created by an apply, the plain text key is not stored and can't be shown again
+type CreatedSetupKey struct {
+	Name string
+	Key  string
+}
+
+// Apply changes the account to match the document in a single transaction. Activity events are recorded
+// after the transaction is committed, when an event store is given.
+func Apply(ctx context.Context, s store.Store, eventStore activity.Store, accountID string, doc *Document) (*ApplyResult, error) {
+	var (
+		result = &ApplyResult{}
+		events []*activity.Event
+	)
+
+	err := s.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		plan, err := NewPlan(ctx, transaction, accountID, doc)
+		if err != nil {
+			return err
+		}
+
+		a := &applier{ctx: ctx, transaction: transaction, plan: plan, st: plan.state, m: plan.models}
+		if err := a.apply(); err != nil {
+			return err
+		}
+		if !a.changed {
+			return nil
+		}
+
+		if err := transaction.IncrementNetworkSerial(ctx, accountID); err != nil {
+			return fmt.Errorf("increment network serial: %w", err)
+		}
+
+		result.Changes = plan.Changes
+		result.SetupKeys = a.setupKeys
+		events = a.events
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if eventStore != nil {
+		for _, event := range events {
+			if _, err := eventStore.Save(ctx, event); err != nil {
+				log.WithContext(ctx).Warnf("failed to save activity event %s for %s: %v", event.Activity.StringCode(), event.TargetID, err)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// applier writes the models of a plan to the store
+type applier struct {
+	ctx         context.Context
+	transaction store.Store
+	plan        *Plan
+	st          *state
+	m           *models
+
+	changed     bool
+	savedGroups map[string]struct{}
+	events      []*activity.Event
+	setupKeys   []CreatedSetupKey
+}
+
+func (a *applier) apply() error {
+	a.savedGroups = make(map[string]struct{})
+
+	steps := []func() error{
+		a.saveGroups,
+		a.savePostureChecks,
+		a.saveNetworks,
+		a.syncGroupResources,
+		a.savePolicies,
+		a.saveRoutes,
+		a.saveNameserverGroups,
+		a.saveZones,
+		a.saveSetupKeys,
+		// objects are deleted in reverse order, after everything referencing them is updated
+		a.deleteSetupKeys,
+		a.deleteZones,
+		a.deleteNameserverGroups,
+		a.deleteRoutes,
+		a.deletePolicies,
+		a.deleteNetworks,
+		a.deletePostureChecks,
+		a.deleteGroups,
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *applier) event(activityType activity.Activity, targetID string, meta map[string]any) {
+	a.changed = true
+	a.events = append(a.events, &activity.Event{
+		Timestamp:   time.Now().UTC(),
+		Activity:    activityType,
+		InitiatorID: string(hook.SystemSource),
+		TargetID:    targetID,
+		AccountID:   a.st.accountID,
+		Meta:        meta,
+	})
+}
+
+// deletions returns the names of the objects of a kind the plan deletes
+func (a *applier) deletions(kind string) []string {
+	var names []string
+	for _, change := range a.plan.Changes {
+		if change.Kind == kind && change.Action == ActionDelete {
+			names = append(names, change.Name)
+		}
+	}
+	return names
+}
+
+func (a *applier) saveGroups() error {
+	for _, groupConfig := range a.plan.desired.Groups {
+		action, ok := a.plan.action(kindGroup, groupConfig.Name)
+		if !ok {
+			continue
+		}
+		group := a.m.groups[groupConfig.Name]
+
+		var currentPeers []string
+		if action == ActionCreate {
+			if err := a.transaction.CreateGroup(a.ctx, group); err != nil {
+				return fmt.Errorf("create group %s: %w", group.Name, err)
+			}
+			a.event(activity.GroupCreated, group.ID, group.EventMeta())
+		} else {
+			if err := a.transaction.UpdateGroup(a.ctx, group); err != nil {
+				return fmt.Errorf("update group %s: %w", group.Name, err)
+			}
+			currentPeers = a.st.managedGroup(group.Name).Peers
+			a.event(activity.GroupUpdated, group.ID, group.EventMeta())
+		}
+		a.savedGroups[group.ID] = struct{}{}
+
+		for _, peerID := range group.Peers {
+			if slices.Contains(currentPeers, peerID) {
+				continue
+			}
+			if err := a.transaction.AddPeerToGroup(a.ctx, a.st.accountID, peerID, group.ID); err != nil {
+				return fmt.Errorf("add peer %s to group %s: %w", peerID, group.Name, err)
+			}
+		}
+		for _, peerID := range currentPeers {
+			if slices.Contains(group.Peers, peerID) {
+				continue
+			}
+			if err := a.transaction.RemovePeerFromGroup(a.ctx, peerID, group.ID); err != nil {
+				return fmt.Errorf("remove peer %s from group %s: %w", peerID, group.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (a *applier) savePostureChecks() error {
+	for _, checksConfig := range a.plan.desired.PostureChecks {
+		action, ok := a.plan.action(kindPostureCheck, checksConfig.Name)
+		if !ok {
+			continue
+		}
+		checks := a.m.postureChecks[checksConfig.Name]
+		if err := a.transaction.SavePostureChecks(a.ctx, checks); err != nil {
+			return fmt.Errorf("save posture check %s: %w", checks.Name, err)
+		}
+		a.event(eventFor(action, activity.PostureCheckCreated, activity.PostureCheckUpdated), checks.ID, checks.EventMeta())
+	}
+	return nil
+}
+
+func (a *applier) saveNetworks() error {
+	for _, networkConfig := range a.plan.desired.Networks {
+		action, ok := a.plan.action(kindNetwork, networkConfig.Name)
+		if !ok {
+			continue
+		}
+		network := a.m.networks[networkConfig.Name]
+
+		idx := slices.IndexFunc(a.st.networks, func(n *networkTypes.Network) bool { return n.ID == network.ID })
+		if action == ActionCreate || a.st.networks[idx].Description != network.Description {
+			if err := a.transaction.SaveNetwork(a.ctx, network); err != nil {
+				return fmt.Errorf("save network %s: %w", network.Name, err)
+			}
+			a.event(eventFor(action, activity.NetworkCreated, activity.NetworkUpdated), network.ID, network.EventMeta())
+		}
+
+		if err := a.saveNetworkResources(network, networkConfig); err != nil {
+			return err
+		}
+		if err := a.saveNetworkRouters(network, networkConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *applier) saveNetworkResources(network *networkTypes.Network, networkConfig NetworkConfig) error {
+	var resourceIDs []string
+	for _, resourceConfig := range networkConfig.Resources {
+		resource := a.m.resources[resourceConfig.Name]
+		resourceIDs = append(resourceIDs, resource.ID)
+
+		idx := slices.IndexFunc(a.st.resources, func(r *resourceTypes.NetworkResource) bool { return r.ID == resource.ID })
+		if idx >= 0 && sameResource(a.st.resources[idx], resource) {
+			continue
+		}
+		if err := a.transaction.SaveNetworkResource(a.ctx, resource); err != nil {
+			return fmt.Errorf("save network resource %s: %w", resource.Name, err)
+		}
+		action := ActionUpdate
+		if idx < 0 {
+			action = ActionCreate
+		}
+		a.event(eventFor(action, activity.NetworkResourceCreated, activity.NetworkResourceUpdated), resource.ID, resource.EventMeta(network))
+	}
+
+	for _, resource := range a.st.resources {
+		if resource.NetworkID != network.ID || slices.Contains(resourceIDs, resource.ID) {
+			continue
+		}
+		if err := a.transaction.DeleteNetworkResource(a.ctx, a.st.accountID, resource.ID); err != nil {
+			return fmt.Errorf("delete network resource %s: %w", resource.Name, err)
+		}
+		a.event(activity.NetworkResourceDeleted, resource.ID, resource.EventMeta(network))
+	}
+	return nil
+}
+
+func sameResource(current, desired *resourceTypes.NetworkResource) bool {
+	return current.Name == desired.Name && current.Description == desired.Description && current.Type == desired.Type &&
+		current.Domain == desired.Domain && current.Prefix == desired.Prefix && current.Enabled == desired.Enabled
+}
+
+func (a *applier) saveNetworkRouters(network *networkTypes.Network, networkConfig NetworkConfig) error {
+	var routerIDs []string
+	for _, router := range a.m.routers[networkConfig.Name] {
+		routerIDs = append(routerIDs, router.ID)
+
+		idx := slices.IndexFunc(a.st.routers, func(r *routerTypes.NetworkRouter) bool { return r.ID == router.ID })
+		switch {
+		case idx < 0:
+			if err := a.transaction.CreateNetworkRouter(a.ctx, router); err != nil {
+				return fmt.Errorf("create router of network %s: %w", network.Name, err)
+			}
+			a.event(activity.NetworkRouterCreated, router.ID, router.EventMeta(network))
+		case !sameRouter(a.st.routers[idx], router):
+			if err := a.transaction.UpdateNetworkRouter(a.ctx, router); err != nil {
+				return fmt.Errorf("update router of network %s: %w", network.Name, err)
+			}
+			a.event(activity.NetworkRouterUpdated, router.ID, router.EventMeta(network))
+		}
+	}
+
+	for _, router := range a.st.routers {
+		if router.NetworkID != network.ID || slices.Contains(routerIDs, router.ID) {
+			continue
+		}
+		if err := a.transaction.DeleteNetworkRouter(a.ctx, a.st.accountID, router.ID); err != nil {
+			return fmt.Errorf("delete router of network %s: %w", network.Name, err)
+		}
+		a.event(activity.NetworkRouterDeleted, router.ID, router.EventMeta(network))
+	}
+	return nil
+}
+
+func sameRouter(current, desired *routerTypes.NetworkRouter) bool {
+	return current.Peer == desired.Peer && slices.Equal(sortedSet(current.PeerGroups), sortedSet(desired.PeerGroups)) &&
+		current.Masquerade == desired.Masquerade && current.Metric == desired.Metric && current.Enabled == desired.Enabled
+}
+
+// syncGroupResources assigns the network resources to their groups. The document defines all network
+// resources, so the resources of groups owned by the system are changed as well.
+func (a *applier) syncGroupResources() error {
+	deletedGroups := a.deletions(kindGroup)
+
+	for _, group := range a.st.groups {
+		if _, ok := a.savedGroups[group.ID]; ok || (isManagedGroup(group) && slices.Contains(deletedGroups, group.Name)) {
+			continue
+		}
+
+		desired := a.m.groupResources(group.ID)
+		if sameResourceIDs(group.Resources, desired) {
+			continue
+		}
+
+		updated := group.Copy()
+		updated.Resources = desired
+		if err := a.transaction.UpdateGroup(a.ctx, updated); err != nil {
+			return fmt.Errorf("update resources of group %s: %w", group.Name, err)
+		}
+		a.event(activity.GroupUpdated, group.ID, group.EventMeta())
+	}
+	return nil
+}
+
+func sameResourceIDs(current, desired []types.Resource) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+	for _, resource := range current {
+		if !slices.ContainsFunc(desired, func(r types.Resource) bool { return r.ID == resource.ID }) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *applier) savePolicies() error {
+	for _, policyConfig := range a.plan.desired.Policies {
+		action, ok := a.plan.action(kindPolicy, policyConfig.Name)
+		if !ok {
+			continue
+		}
+		policy := a.m.policies[policyConfig.Name]
+
+		// saving a policy keeps rules that are no longer part of it, so it is replaced as a whole
+		if action == ActionUpdate {
+			if err := a.transaction.DeletePolicy(a.ctx, a.st.accountID, policy.ID); err != nil {
+				return fmt.Errorf("replace policy %s: %w", policy.Name, err)
+			}
+		}
+		if err := a.transaction.CreatePolicy(a.ctx, policy); err != nil {
+			return fmt.Errorf("save policy %s: %w", policy.Name, err)
+		}
+		a.event(eventFor(action, activity.PolicyAdded, activity.PolicyUpdated), policy.ID, policy.EventMeta())
+	}
+	return nil
+}
+
+func (a *applier) saveRoutes() error {
+	for _, routeConfig := range a.plan.desired.Routes {
+		action, ok := a.plan.action(kindRoute, routeKey(routeConfig))
+		if !ok {
+			continue
+		}
+		r := a.m.routes[routeKey(routeConfig)]
+		if err := a.transaction.SaveRoute(a.ctx, r); err != nil {
+			return fmt.Errorf("save route %s: %w", r.NetID, err)
+		}
+		a.event(eventFor(action, activity.RouteCreated, activity.RouteUpdated), string(r.ID), r.EventMeta())
+	}
+	return nil
+}
+
+func (a *applier) saveNameserverGroups() error {
+	for _, nsConfig := range a.plan.desired.NameserverGroups {
+		action, ok := a.plan.action(kindNameserverGroup, nsConfig.Name)
+		if !ok {
+			continue
+		}
+		nsGroup := a.m.nsGroups[nsConfig.Name]
+		if err := a.transaction.SaveNameServerGroup(a.ctx, nsGroup); err != nil {
+			return fmt.Errorf("save nameserver group %s: %w", nsGroup.Name, err)
+		}
+		a.event(eventFor(action, activity.NameserverGroupCreated, activity.NameserverGroupUpdated), nsGroup.ID, nsGroup.EventMeta())
+	}
+	return nil
+}
+
+func (a *applier) saveZones() error {
+	for _, zoneConfig := range a.plan.desired.DNSZones {
+		action, ok := a.plan.action(kindDNSZone, zoneConfig.Domain)
+		if !ok {
+			continue
+		}
+		zone := a.m.zones[zoneConfig.Domain]
+
+		// records are written separately, so they keep their IDs when they don't change
+		zoneOnly := *zone
+		zoneOnly.Records = nil
+		var currentRecords []*records.Record
+		if action == ActionCreate {
+			if err := a.transaction.CreateZone(a.ctx, &zoneOnly); err != nil {
+				return fmt.Errorf("create DNS zone %s: %w", zone.Domain, err)
+			}
+			a.event(activity.DNSZoneCreated, zone.ID, zone.EventMeta())
+		} else {
+			current := a.st.zones[slices.IndexFunc(a.st.zones, func(z *zones.Zone) bool { return z.ID == zone.ID })]
+			currentRecords = current.Records
+			if current.Name != zone.Name || current.Enabled != zone.Enabled || current.EnableSearchDomain != zone.EnableSearchDomain ||
+				!slices.Equal(sortedSet(current.DistributionGroups), sortedSet(zone.DistributionGroups)) {
+				if err := a.transaction.UpdateZone(a.ctx, &zoneOnly); err != nil {
+					return fmt.Errorf("update DNS zone %s: %w", zone.Domain, err)
+				}
+				a.event(activity.DNSZoneUpdated, zone.ID, zone.EventMeta())
+			}
+		}
+
+		if err := a.syncZoneRecords(zone, currentRecords); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *applier) syncZoneRecords(zone *zones.Zone, currentRecords []*records.Record) error {
+	sameRecord := func(x, y *records.Record) bool {
+		return x.Name == y.Name && x.Type == y.Type && x.Content == y.Content && x.TTL == y.TTL
+	}
+
+	var kept []*records.Record
+	for _, record := range currentRecords {
+		if slices.ContainsFunc(zone.Records, func(r *records.Record) bool { return sameRecord(r, record) }) {
+			kept = append(kept, record)
+		}
+	}
+
+	// the store deletes records only per zone, so the kept records are written back
+	if len(kept) != len(currentRecords) {
+		if err := a.transaction.DeleteZoneDNSRecords(a.ctx, a.st.accountID, zone.ID); err != nil {
+			return fmt.Errorf("delete records of DNS zone %s: %w", zone.Domain, err)
+		}
+		for _, record := range currentRecords {
+			if slices.Contains(kept, record) {
+				if err := a.transaction.CreateDNSRecord(a.ctx, record); err != nil {
+					return fmt.Errorf("save record %s: %w", record.Name, err)
+				}
+				continue
+			}
+			a.event(activity.DNSRecordDeleted, record.ID, record.EventMeta(zone.ID, zone.Name))
+		}
+	}
+
+	for _, record := range zone.Records {
+		if slices.ContainsFunc(kept, func(r *records.Record) bool { return sameRecord(r, record) }) {
+			continue
+		}
+		if err := a.transaction.CreateDNSRecord(a.ctx, record); err != nil {
+			return fmt.Errorf("create record %s: %w", record.Name, err)
+		}
+		a.event(activity.DNSRecordCreated, record.ID, record.EventMeta(zone.ID, zone.Name))
+	}
+	return nil
+}
+
+func (a *applier) saveSetupKeys() error {
+	for _, keyConfig := range a.plan.desired.SetupKeys {
+		action, ok := a.plan.action(kindSetupKey, keyConfig.Name)
+		if !ok {
+			continue
+		}
+		autoGroups, err := a.m.groupNames.idList(keyConfig.AutoGroups)
+		if err != nil {
+			return fmt.Errorf("setup key %s: %w", keyConfig.Name, err)
+		}
+
+		if action == ActionCreate {
+			if err := a.createSetupKey(keyConfig, autoGroups); err != nil {
+				return err
+			}
+			continue
+		}
+
+		current := a.st.setupKeys[slices.IndexFunc(a.st.setupKeys, func(k *types.SetupKey) bool { return k.Name == keyConfig.Name })]
+		key := current.Copy()
+		key.AutoGroups = autoGroups
+		key.Revoked = keyConfig.Revoked
+		key.Labels = keyConfig.Labels
+		key.Restrictions = keyConfig.Restrictions.Copy()
+		key.UpdatedAt = time.Now().UTC()
+		if err := a.transaction.SaveSetupKey(a.ctx, key); err != nil {
+			return fmt.Errorf("save setup key %s: %w", key.Name, err)
+		}
+		if key.Revoked && !current.Revoked {
+			a.event(activity.SetupKeyRevoked, key.Id, key.EventMeta())
+		}
+		if setupKeyChanged(current, key) {
+			a.event(activity.SetupKeyUpdated, key.Id, key.EventMeta())
+		}
+	}
+	return nil
+}
+
+// setupKeyChanged reports whether any of the fields an apply updates differs between the keys
+func setupKeyChanged(current, key *types.SetupKey) bool {
+	return !slices.Equal(sortedSet(key.AutoGroups), sortedSet(current.AutoGroups)) ||
+		key.Revoked != current.Revoked ||
+		!maps.Equal(key.Labels, current.Labels) ||
+		!reflect.DeepEqual(normalizeRestrictions(key.Restrictions), normalizeRestrictions(current.Restrictions))
+}
+
+// normalizeRestrictions returns nil for restrictions that don't restrict anything, so they compare equal to none
+func normalizeRestrictions(r *types.SetupKeyRestrictions) *types.SetupKeyRestrictions {
+	if r.IsEmpty() {
+		return nil
+	}
+	r = r.Copy()
+	r.AllowedCIDRs = emptyToNil(r.AllowedCIDRs)
+	r.AllowedOS = emptyToNil(r.AllowedOS)
+	return r
+}
+
+func (a *applier) createSetupKey(keyConfig SetupKeyConfig, autoGroups []string) error {
+	var validFor time.Duration
+	if keyConfig.ExpiresAt != nil {
+		validFor = time.Until(*keyConfig.ExpiresAt)
+		if validFor <= 0 {
+			return fmt.Errorf("setup key %s: expires_at is in the past", keyConfig.Name)
+		}
+	}
+
+	key, plainKey := types.GenerateSetupKey(keyConfig.Name, types.SetupKeyType(keyConfig.Type), validFor, autoGroups,
+		keyConfig.UsageLimit, keyConfig.Ephemeral, keyConfig.AllowExtraDNSLabels)
+	key.AccountID = a.st.accountID
+	key.ExpiresAt = keyConfig.ExpiresAt
+	key.Revoked = keyConfig.Revoked
+	key.Labels = keyConfig.Labels
+	key.Restrictions = keyConfig.Restrictions.Copy()
+	if err := a.transaction.SaveSetupKey(a.ctx, key); err != nil {
+		return fmt.Errorf("create setup key %s: %w", key.Name, err)
+	}
+
+	a.event(activity.SetupKeyCreated, key.Id, key.EventMeta())
+	a.setupKeys = append(a.setupKeys, CreatedSetupKey{Name: key.Name, Key: plainKey})
+	return nil
+}
+
+func (a *applier) deleteSetupKeys() error {
+	for _, name := range a.deletions(kindSetupKey) {
+		key := a.st.setupKeys[slices.IndexFunc(a.st.setupKeys, func(k *types.SetupKey) bool { return k.Name == name })]
+		if err := a.transaction.DeleteSetupKey(a.ctx, a.st.accountID, key.Id); err != nil {
+			return fmt.Errorf("delete setup key %s: %w", name, err)
+		}
+		a.event(activity.SetupKeyDeleted, key.Id, key.EventMeta())
+	}
+	return nil
+}
+
+func (a *applier) deleteZones() error {
+	for _, domain := range a.deletions(kindDNSZone) {
+		zone := a.st.zones[slices.IndexFunc(a.st.zones, func(z *zones.Zone) bool { return z.Domain == domain })]
+		if err := a.transaction.DeleteZoneDNSRecords(a.ctx, a.st.accountID, zone.ID); err != nil {
+			return fmt.Errorf("delete records of DNS zone %s: %w", domain, err)
+		}
+		if err := a.transaction.DeleteZone(a.ctx, a.st.accountID, zone.ID); err != nil {
+			return fmt.Errorf("delete DNS zone %s: %w", domain, err)
+		}
+		a.event(activity.DNSZoneDeleted, zone.ID, zone.EventMeta())
+	}
+	return nil
+}
+
+func (a *applier) deleteNameserverGroups() error {
+	for _, name := range a.deletions(kindNameserverGroup) {
+		for _, nsGroup := range a.st.nsGroups {
+			if nsGroup.Name != name {
+				continue
+			}
+			if err := a.transaction.DeleteNameServerGroup(a.ctx, a.st.accountID, nsGroup.ID); err != nil {
+				return fmt.Errorf("delete nameserver group %s: %w", name, err)
+			}
+			a.event(activity.NameserverGroupDeleted, nsGroup.ID, nsGroup.EventMeta())
+		}
+	}
+	return nil
+}
+
+func (a *applier) deleteRoutes() error {
+	for _, key := range a.deletions(kindRoute) {
+		for _, r := range a.st.routes {
+			routeConfig, err := a.st.routeConfig(r)
+			if err != nil {
+				return err
+			}
+			routeConfig.PeerGroups = sortedSet(routeConfig.PeerGroups)
+			if routeKey(routeConfig) != key {
+				continue
+			}
+			if err := a.transaction.DeleteRoute(a.ctx, a.st.accountID, string(r.ID)); err != nil {
+				return fmt.Errorf("delete route %s: %w", key, err)
+			}
+			a.event(activity.RouteRemoved, string(r.ID), r.EventMeta())
+		}
+	}
+	return nil
+}
+
+func (a *applier) deletePolicies() error {
+	for _, name := range a.deletions(kindPolicy) {
+		for _, policy := range a.st.policies {
+			if policy.Name != name {
+				continue
+			}
+			if err := a.transaction.DeletePolicy(a.ctx, a.st.accountID, policy.ID); err != nil {
+				return fmt.Errorf("delete policy %s: %w", name, err)
+			}
+			a.event(activity.PolicyRemoved, policy.ID, policy.EventMeta())
+		}
+	}
+	return nil
+}
+
+func (a *applier) deleteNetworks() error {
+	for _, name := range a.deletions(kindNetwork) {
+		network := a.st.networks[slices.IndexFunc(a.st.networks, func(n *networkTypes.Network) bool { return n.Name == name })]
+
+		for _, resource := range a.st.resources {
+			if resource.NetworkID != network.ID {
+				continue
+			}
+			if err := a.transaction.DeleteNetworkResource(a.ctx, a.st.accountID, resource.ID); err != nil {
+				return fmt.Errorf("delete network resource %s: %w", resource.Name, err)
+			}
+			a.event(activity.NetworkResourceDeleted, resource.ID, resource.EventMeta(network))
+		}
+		for _, router := range a.st.routers {
+			if router.NetworkID != network.ID {
+				continue
+			}
+			if err := a.transaction.DeleteNetworkRouter(a.ctx, a.st.accountID, router.ID); err != nil {
+				return fmt.Errorf("delete router of network %s: %w", name, err)
+			}
+			a.event(activity.NetworkRouterDeleted, router.ID, router.EventMeta(network))
+		}
+
+		if err := a.transaction.DeleteNetwork(a.ctx, a.st.accountID, network.ID); err != nil {
+			return fmt.Errorf("delete network %s: %w", name, err)
+		}
+		a.event(activity.NetworkDeleted, network.ID, network.EventMeta())
+	}
+	return nil
+}
+
+func (a *applier) deletePostureChecks() error {
+	for _, name := range a.deletions(kindPostureCheck) {
+		for _, checks := range a.st.postureChecks {
+			if checks.Name != name {
+				continue
+			}
+			if err := a.transaction.DeletePostureChecks(a.ctx, a.st.accountID, checks.ID); err != nil {
+				return fmt.Errorf("delete posture check %s: %w", name, err)
+			}
+			a.event(activity.PostureCheckDeleted, checks.ID, checks.EventMeta())
+		}
+	}
+	return nil
+}
+
+// deleteGroups deletes the groups missing from the document. Groups still used by objects outside the
+// document, like the auto groups of users, are not deleted silently.
+func (a *applier) deleteGroups() error {
+	for _, name := range a.deletions(kindGroup) {
+		group := a.st.managedGroup(name)
+
+		for _, user := range a.st.users {
+			if slices.Contains(user.AutoGroups, group.ID) {
+				return fmt.Errorf("group %s can't be deleted, it is an auto group of user %s", name, user.Id)
+			}
+		}
+		if a.st.dnsSettings != nil && slices.Contains(a.st.dnsSettings.DisabledManagementGroups, group.ID) {
+			return fmt.Errorf("group %s can't be deleted, it is used by the DNS settings", name)
+		}
+
+		if err := a.transaction.DeleteGroup(a.ctx, a.st.accountID, group.ID); err != nil {
+			return fmt.Errorf("delete group %s: %w", name, err)
+		}
+		a.event(activity.GroupDeleted, group.ID, group.EventMeta())
+	}
+	return nil
+}
+
+func eventFor(action ChangeAction, created, updated activity.Activity) activity.Activity {
+	if action == ActionCreate {
+		return created
+	}
+	return updated
+}
@@ -0,0 +1,246 @@
+// Package configcmd provides cobra commands to export an account network definition to a declarative
+// document and to plan and apply such a document against the store.
+// Both the management and combined binaries use these commands, each providing
+// their own Opener to handle config loading and store initialization.
+package configcmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/store"
+)
+
+// Resources holds what the config commands need to read and change an account.
+// EventStore is optional, when nil no activity events are recorded.
+type Resources struct {
+	Store      store.Store
+	EventStore activity.Store
+}
+
+// Opener initializes the resources from the command context and calls fn. When exclusive is set it
+// refuses to run while another process, such as a running management server, uses the store.
+type Opener func(cmd *cobra.Command, exclusive bool, fn func(ctx context.Context, resources Resources) error) error
+
+// NewCommands creates the config command tree with the given resource opener.
+// Returns the parent "config" command with export, plan, and apply subcommands.
+func NewCommands(opener Opener) *cobra.Command {
+	var (
+		accountID    string
+		outputFile   string
+		outputFormat string
+		documentFile string
+	)
+
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the account configuration as code",
+		Long: "Commands for exporting the groups, posture checks, policies, routes, networks, nameservers, DNS zones and setup keys " +
+			"of an account to a versioned YAML or JSON document, and for planning and applying such a document against the store. " +
+			"Objects reference each other by name, so the document can be kept in version control.",
+	}
+	configCmd.PersistentFlags().StringVar(&accountID, "account", "", "ID of the account, may be omitted when the store holds a single account")
+
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the account configuration to a document",
+		Long:  "Writes the account configuration as a versioned document to stdout or to the file given with --output. Setup key secrets are never exported.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			format, err := resolveFormat(outputFormat, outputFile)
+			if err != nil {
+				return err
+			}
+			return opener(cmd, false, func(ctx context.Context, resources Resources) error {
+				return runExport(ctx, resources.Store, cmd.OutOrStdout(), accountID, outputFile, format)
+			})
+		},
+	}
+	exportCmd.Flags().StringVarP(&outputFile, "output", "o", "", "File to write the document to, stdout when empty")
+	exportCmd.Flags().StringVar(&outputFormat, "format", "", "Document format, yaml or json. Derived from the output file extension when empty, yaml by default")
+
+	planCmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show the changes applying a document would make",
+		Long:  "Compares the document with the account configuration in the store and lists the objects that would be created, updated, or deleted, without changing anything.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			doc, err := readDocument(documentFile)
+			if err != nil {
+				return err
+			}
+			return opener(cmd, false, func(ctx context.Context, resources Resources) error {
+				return runPlan(ctx, resources.Store, cmd.OutOrStdout(), accountID, doc)
+			})
+		},
+	}
+
+	applyCmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Apply a document to the account",
+		Long: "Changes the account configuration to match the document in a single transaction and records an activity event for every change. " +
+			"Objects missing from the document are deleted. Secrets of newly created setup keys are displayed only once.\n\n" +
+			"Running management servers are not notified of the changes, so the command refuses to run while another process uses the store. " +
+			"Stop the management server before applying and start it again afterwards, peers receive the new configuration when they reconnect.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			doc, err := readDocument(documentFile)
+			if err != nil {
+				return err
+			}
+			return opener(cmd, true, func(ctx context.Context, resources Resources) error {
+				return runApply(ctx, resources.Store, resources.EventStore, cmd.OutOrStdout(), accountID, doc)
+			})
+		},
+	}
+
+	for _, cmd := range []*cobra.Command{planCmd, applyCmd} {
+		cmd.Flags().StringVarP(&documentFile, "file", "f", "", "Path to the YAML or JSON document (required)")
+		if err := cmd.MarkFlagRequired("file"); err != nil {
+			panic(err)
+		}
+	}
+
+	configCmd.AddCommand(exportCmd, planCmd, applyCmd)
+	return configCmd
+}
+
+func runExport(ctx context.Context, s store.Store, w io.Writer, accountID, outputFile, format string) error {
+	accountID, err := resolveAccountID(ctx, s, accountID)
+	if err != nil {
+		return err
+	}
+
+	doc, err := Export(ctx, s, accountID)
+	if err != nil {
+		return err
+	}
+
+	data, err := doc.Marshal(format)
+	if err != nil {
+		return fmt.Errorf("encode document: %w", err)
+	}
+
+	if outputFile == "" {
+		_, err = w.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(outputFile, data, 0600); err != nil {
+		return fmt.Errorf("write document: %w", err)
+	}
+	_, _ = fmt.Fprintf(w, "Account %s exported to %s\n", accountID, outputFile)
+	return nil
+}
+
+func runPlan(ctx context.Context, s store.Store, w io.Writer, accountID string, doc *Document) error {
+	accountID, err := resolveAccountID(ctx, s, accountID)
+	if err != nil {
+		return err
+	}
+
+	plan, err := NewPlan(ctx, s, accountID, doc)
+	if err != nil {
+		return err
+	}
+
+	printChanges(w, plan.Changes)
+	return nil
+}
+
+func runApply(ctx context.Context, s store.Store, eventStore activity.Store, w io.Writer, accountID string, doc *Document) error {
+	accountID, err := resolveAccountID(ctx, s, accountID)
+	if err != nil {
+		return err
+	}
+
+	result, err := Apply(ctx, s, eventStore, accountID, doc)
+	if err != nil {
+		return err
+	}
+
+	printChanges(w, result.Changes)
+	for _, key := range result.SetupKeys {
+		_, _ = fmt.Fprintf(w, "Setup key %q: %s\n", key.Name, key.Key)
+	}
+	if len(result.SetupKeys) > 0 {
+		_, _ = fmt.Fprintln(w, "Store the setup keys securely, they will not be shown again.")
+	}
+	return nil
+}
+
+func printChanges(w io.Writer, changes []Change) {
+	if len(changes) == 0 {
+		_, _ = fmt.Fprintln(w, "No changes, the account matches the document.")
+		return
+	}
+
+	var created, updated, deleted int
+	for _, change := range changes {
+		_, _ = fmt.Fprintf(w, "%s %s %s\n", change.Action.symbol(), change.Kind, change.Name)
+		switch change.Action {
+		case ActionCreate:
+			created++
+		case ActionUpdate:
+			updated++
+		case ActionDelete:
+			deleted++
+		}
+	}
+	_, _ = fmt.Fprintf(w, "\n%d to create, %d to update, %d to delete.\n", created, updated, deleted)
+}
+
+// resolveAccountID returns the given account ID after checking it exists, or the only account of the store
+func resolveAccountID(ctx context.Context, s store.Store, accountID string) (string, error) {
+	if accountID != "" {
+		exists, err := s.AccountExists(ctx, store.LockingStrengthNone, accountID)
+		if err != nil {
+			return "", fmt.Errorf("check account: %w", err)
+		}
+		if !exists {
+			return "", fmt.Errorf("account %s not found", accountID)
+		}
+		return accountID, nil
+	}
+
+	count, err := s.GetAccountsCounter(ctx)
+	if err != nil {
+		return "", fmt.Errorf("count accounts: %w", err)
+	}
+	if count != 1 {
+		return "", fmt.Errorf("expected exactly one account, got %d; select the account with --account", count)
+	}
+
+	accountID, err = s.GetAnyAccountID(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get account ID: %w", err)
+	}
+	return accountID, nil
+}
+
+func resolveFormat(format, outputFile string) (string, error) {
+	if format == "" {
+		if strings.EqualFold(filepath.Ext(outputFile), ".json") {
+			return "json", nil
+		}
+		return "yaml", nil
+	}
+
+	format = strings.ToLower(format)
+	if format != "yaml" && format != "json" {
+		return "", fmt.Errorf("unsupported format %q, expected yaml or json", format)
+	}
+	return format, nil
+}
+
+func readDocument(path string) (*Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read document: %w", err)
+	}
+	return ParseDocument(data)
+}
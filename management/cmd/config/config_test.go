@@ -0,0 +1,321 @@
+package configcmd
+
+import (
+	"bytes"
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/server/activity"
+	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
+	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
+	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/posture"
+	mgmtstore "github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/route"
+)
+
+const testAccountID = "account-1"
+
+func newTestStore(t *testing.T) mgmtstore.Store {
+	t.Helper()
+	ctx := context.Background()
+	st, err := mgmtstore.NewStore(ctx, types.SqliteStoreEngine, t.TempDir(), nil, false)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, st.Close(ctx)) })
+
+	setupKey, _ := types.GenerateSetupKey("servers", types.SetupKeyReusable, 0, []string{"servers"}, 0, false, false)
+	setupKey.AccountID = testAccountID
+
+	require.NoError(t, st.SaveAccount(ctx, &types.Account{
+		Id:       testAccountID,
+		Network:  types.NewNetwork(),
+		Settings: &types.Settings{},
+		Users: map[string]*types.User{
+			"admin": {Id: "admin", AccountID: testAccountID, Role: types.UserRoleAdmin, AutoGroups: []string{"devs"}},
+		},
+		Peers: map[string]*nbpeer.Peer{
+			"peer-1": {ID: "peer-1", AccountID: testAccountID, Key: "key-1", DNSLabel: "laptop", IP: netip.MustParseAddr("100.64.0.1"), Status: &nbpeer.PeerStatus{}},
+			"peer-2": {ID: "peer-2", AccountID: testAccountID, Key: "key-2", DNSLabel: "server", IP: netip.MustParseAddr("100.64.0.2"), Status: &nbpeer.PeerStatus{}},
+		},
+		Groups: map[string]*types.Group{
+			"all":     {ID: "all", AccountID: testAccountID, Name: types.GroupAllName, Issued: types.GroupIssuedAPI, Peers: []string{"peer-1", "peer-2"}},
+			"devs":    {ID: "devs", AccountID: testAccountID, Name: "devs", Issued: types.GroupIssuedAPI, Peers: []string{"peer-1"}},
+			"servers": {ID: "servers", AccountID: testAccountID, Name: "servers", Issued: types.GroupIssuedAPI, Peers: []string{"peer-2"}, Resources: []types.Resource{{ID: "db", Type: types.ResourceType(resourceTypes.Host)}}},
+			"idp":     {ID: "idp", AccountID: testAccountID, Name: "engineering", Issued: types.GroupIssuedJWT},
+		},
+		PostureChecks: []*posture.Checks{
+			{ID: "version", AccountID: testAccountID, Name: "recent client", Checks: posture.ChecksDefinition{NBVersionCheck: &posture.NBVersionCheck{MinVersion: "0.30.0"}}},
+		},
+		Policies: []*types.Policy{
+			{
+				ID: "ssh", AccountID: testAccountID, Name: "ssh", Enabled: true, SourcePostureChecks: []string{"version"},
+				Rules: []*types.PolicyRule{{
+					ID: "ssh-rule", PolicyID: "ssh", Name: "ssh", Enabled: true, Action: types.PolicyTrafficActionAccept,
+					Sources: []string{"devs", "idp"}, Destinations: []string{"servers"}, Protocol: types.PolicyRuleProtocolTCP, Ports: []string{"22"},
+				}},
+			},
+		},
+		Routes: map[route.ID]*route.Route{
+			"lan": {
+				ID: "lan", AccountID: testAccountID, NetID: "lan", Network: netip.MustParsePrefix("192.168.0.0/24"), NetworkType: route.IPv4Network,
+				Peer: "peer-2", Metric: 9999, Enabled: true, Groups: []string{"devs"},
+			},
+		},
+		NameServerGroups: map[string]*dns.NameServerGroup{
+			"google": {
+				ID: "google", AccountID: testAccountID, Name: "google", Primary: true, Enabled: true, Groups: []string{"all"},
+				NameServers: []dns.NameServer{{IP: netip.MustParseAddr("8.8.8.8"), NSType: dns.UDPNameServerType, Port: 53}},
+			},
+		},
+		Networks: []*networkTypes.Network{
+			{ID: "office", AccountID: testAccountID, Name: "office"},
+		},
+		NetworkResources: []*resourceTypes.NetworkResource{
+			{ID: "db", NetworkID: "office", AccountID: testAccountID, Name: "db", Type: resourceTypes.Host, Prefix: netip.MustParsePrefix("10.0.0.5/32"), Enabled: true},
+		},
+		NetworkRouters: []*routerTypes.NetworkRouter{
+			{ID: "office-router", NetworkID: "office", AccountID: testAccountID, Peer: "peer-2", Metric: 9999, Enabled: true},
+		},
+		SetupKeys: map[string]*types.SetupKey{setupKey.Key: setupKey},
+	}))
+	return st
+}
+
+func TestExportPlanRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	st := newTestStore(t)
+
+	doc, err := Export(ctx, st, testAccountID)
+	require.NoError(t, err)
+
+	require.Len(t, doc.Groups, 2, "the All group and JWT groups are not exported")
+	assert.Equal(t, []string{"laptop"}, doc.Groups[0].Peers)
+	require.Len(t, doc.Policies, 1)
+	assert.Equal(t, []string{"devs", "engineering"}, doc.Policies[0].Rules[0].Sources)
+	assert.Equal(t, []string{"recent client"}, doc.Policies[0].SourcePostureChecks)
+	require.Len(t, doc.Networks, 1)
+	assert.Equal(t, "10.0.0.5/32", doc.Networks[0].Resources[0].Address)
+	assert.Equal(t, []string{"servers"}, doc.Networks[0].Resources[0].Groups)
+	assert.Equal(t, "server", doc.Networks[0].Routers[0].Peer)
+	require.Len(t, doc.Routes, 1)
+	assert.Equal(t, "server", doc.Routes[0].Peer)
+	assert.Equal(t, []string{types.GroupAllName}, doc.NameserverGroups[0].Groups)
+	require.Len(t, doc.SetupKeys, 1)
+	assert.Equal(t, []string{"servers"}, doc.SetupKeys[0].AutoGroups)
+
+	for _, format := range []string{"yaml", "json"} {
+		t.Run(format, func(t *testing.T) {
+			data, err := doc.Marshal(format)
+			require.NoError(t, err)
+
+			parsed, err := ParseDocument(data)
+			require.NoError(t, err)
+			assert.Equal(t, doc, parsed)
+
+			plan, err := NewPlan(ctx, st, testAccountID, parsed)
+			require.NoError(t, err)
+			assert.Empty(t, plan.Changes)
+		})
+	}
+}
+
+func TestParseDocument(t *testing.T) {
+	_, err := ParseDocument([]byte("version: 2\n"))
+	assert.ErrorContains(t, err, "unsupported document version")
+
+	_, err = ParseDocument([]byte("version: 1\ngroups:\n  - name: devs\n    colour: red\n"))
+	assert.ErrorContains(t, err, "unknown field")
+
+	_, err = ParseDocument([]byte("version: 1\ngroups:\n  - name: devs\n  - name: devs\n"))
+	assert.ErrorContains(t, err, "duplicate group")
+
+	doc, err := ParseDocument([]byte("version: 1\nnetworks:\n  - name: office\n    resources:\n      - name: db\n        address: 10.0.0.5\n        groups: [servers]\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.5/32", doc.Networks[0].Resources[0].Address, "host addresses are compared in prefix form")
+}
+
+func TestPlanRejectsInvalidDocuments(t *testing.T) {
+	ctx := context.Background()
+	st := newTestStore(t)
+
+	tests := []struct {
+		name   string
+		modify func(doc *Document)
+		err    string
+	}{
+		{
+			name:   "unknown group reference",
+			modify: func(doc *Document) { doc.Policies[0].Rules[0].Destinations = []string{"missing"} },
+			err:    `group "missing" not found`,
+		},
+		{
+			name:   "unknown peer",
+			modify: func(doc *Document) { doc.Groups[0].Peers = []string{"desktop"} },
+			err:    `peer "desktop" not found`,
+		},
+		{
+			name:   "system group defined",
+			modify: func(doc *Document) { doc.Groups = append(doc.Groups, GroupConfig{Name: "engineering"}) },
+			err:    "managed by the system",
+		},
+		{
+			name:   "immutable setup key field",
+			modify: func(doc *Document) { doc.SetupKeys[0].Ephemeral = true },
+			err:    "only auto_groups, labels, restrictions and revoked can be changed",
+		},
+		{
+			name:   "ports with icmp",
+			modify: func(doc *Document) { doc.Policies[0].Rules[0].Protocol = "icmp" },
+			err:    "ports are not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Export(ctx, st, testAccountID)
+			require.NoError(t, err)
+			tt.modify(doc)
+
+			_, err = NewPlan(ctx, st, testAccountID, doc)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	ctx := context.Background()
+	st := newTestStore(t)
+	eventStore := &activity.InMemoryEventStore{}
+
+	doc, err := Export(ctx, st, testAccountID)
+	require.NoError(t, err)
+
+	doc.Groups = append(doc.Groups, GroupConfig{Name: "ops", Peers: []string{"laptop", "server"}})
+	doc.Policies[0].Rules[0].Ports = []string{"22", "2222"}
+	doc.Policies = append(doc.Policies, PolicyConfig{
+		Name:    "ops",
+		Enabled: true,
+		Rules: []PolicyRuleConfig{{
+			Name: "ops", Enabled: true, Action: "accept", Protocol: "all",
+			Sources: []string{"ops"}, DestinationResource: &ResourceRefConfig{Type: "host", Name: "db"},
+		}},
+	})
+	doc.Networks[0].Resources[0].Groups = []string{"ops"}
+	doc.Routes = nil
+	doc.SetupKeys = append(doc.SetupKeys, SetupKeyConfig{Name: "ops", Type: "one-off", AutoGroups: []string{"ops"}})
+	doc.normalize()
+
+	result, err := Apply(ctx, st, eventStore, testAccountID, doc)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []Change{
+		{Action: ActionCreate, Kind: kindGroup, Name: "ops"},
+		{Action: ActionUpdate, Kind: kindNetwork, Name: "office"},
+		{Action: ActionUpdate, Kind: kindPolicy, Name: "ssh"},
+		{Action: ActionCreate, Kind: kindPolicy, Name: "ops"},
+		{Action: ActionDelete, Kind: kindRoute, Name: "lan@server"},
+		{Action: ActionCreate, Kind: kindSetupKey, Name: "ops"},
+	}, result.Changes)
+	require.Len(t, result.SetupKeys, 1)
+	assert.NotEmpty(t, result.SetupKeys[0].Key)
+
+	plan, err := NewPlan(ctx, st, testAccountID, doc)
+	require.NoError(t, err)
+	assert.Empty(t, plan.Changes, "the store matches the document after apply")
+
+	policy, err := st.GetPolicyByID(ctx, mgmtstore.LockingStrengthNone, testAccountID, "ssh")
+	require.NoError(t, err)
+	require.Len(t, policy.Rules, 1, "the replaced policy keeps no stale rules")
+	assert.Equal(t, []string{"22", "2222"}, policy.Rules[0].Ports)
+
+	servers, err := st.GetGroupByID(ctx, mgmtstore.LockingStrengthNone, testAccountID, "servers")
+	require.NoError(t, err)
+	assert.Empty(t, servers.Resources, "the resource moved to the ops group")
+
+	events, err := eventStore.Get(ctx, testAccountID, 0, 100, false)
+	require.NoError(t, err)
+	var activities []activity.Activity
+	for _, event := range events {
+		activities = append(activities, event.Activity)
+	}
+	assert.Contains(t, activities, activity.GroupCreated)
+	assert.Contains(t, activities, activity.PolicyUpdated)
+	assert.Contains(t, activities, activity.RouteRemoved)
+	assert.Contains(t, activities, activity.SetupKeyCreated)
+	assert.Contains(t, activities, activity.GroupUpdated, "moving the resource updates the groups")
+	assert.NotContains(t, activities, activity.NetworkRouterUpdated)
+
+	// applying the same document again changes nothing
+	result, err = Apply(ctx, st, eventStore, testAccountID, doc)
+	require.NoError(t, err)
+	assert.Empty(t, result.Changes)
+}
+
+func TestApplySetupKeyLabelsRecordsUpdate(t *testing.T) {
+	ctx := context.Background()
+	st := newTestStore(t)
+	eventStore := &activity.InMemoryEventStore{}
+
+	doc, err := Export(ctx, st, testAccountID)
+	require.NoError(t, err)
+
+	doc.SetupKeys[0].Labels = map[string]string{"env": "prod"}
+	doc.normalize()
+
+	result, err := Apply(ctx, st, eventStore, testAccountID, doc)
+	require.NoError(t, err)
+	assert.Equal(t, []Change{{Action: ActionUpdate, Kind: kindSetupKey, Name: "servers"}}, result.Changes)
+
+	events, err := eventStore.Get(ctx, testAccountID, 0, 100, false)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, activity.SetupKeyUpdated, events[0].Activity, "changing only the labels records an update")
+}
+
+func TestApplyRefusesToDeleteGroupInUse(t *testing.T) {
+	ctx := context.Background()
+	st := newTestStore(t)
+
+	doc, err := Export(ctx, st, testAccountID)
+	require.NoError(t, err)
+
+	// devs is an auto group of a user, which is not part of the document
+	doc.Groups = doc.Groups[1:]
+	doc.Routes = nil
+	doc.Policies[0].Rules[0].Sources = []string{"engineering"}
+
+	_, err = Apply(ctx, st, nil, testAccountID, doc)
+	assert.ErrorContains(t, err, "auto group of user admin")
+
+	group, err := st.GetGroupByID(ctx, mgmtstore.LockingStrengthNone, testAccountID, "devs")
+	require.NoError(t, err, "the transaction is rolled back")
+	assert.Equal(t, "devs", group.Name)
+	_, err = st.GetRouteByID(ctx, mgmtstore.LockingStrengthNone, testAccountID, "lan")
+	assert.NoError(t, err)
+}
+
+func TestRunExportPlanOutput(t *testing.T) {
+	ctx := context.Background()
+	st := newTestStore(t)
+
+	var out bytes.Buffer
+	require.NoError(t, runExport(ctx, st, &out, "", "", "yaml"))
+	assert.Contains(t, out.String(), "version: 1")
+
+	doc, err := ParseDocument(out.Bytes())
+	require.NoError(t, err)
+	doc.Groups = append(doc.Groups, GroupConfig{Name: "ops"})
+
+	out.Reset()
+	require.NoError(t, runPlan(ctx, st, &out, testAccountID, doc))
+	assert.Contains(t, out.String(), "+ group ops")
+	assert.Contains(t, out.String(), "1 to create, 0 to update, 0 to delete.")
+
+	assert.ErrorContains(t, runPlan(ctx, st, &out, "missing", doc), "account missing not found")
+}
@@ -0,0 +1,460 @@
+package configcmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+// DocumentVersion is the version of the config document format written by export
+const DocumentVersion = 1
+
+// Document is the declarative definition of an account network. Objects reference each other by
+// name: groups, posture checks, network resources and peers (by DNS label) are never referenced by ID,
+// so a document can be kept in git and applied to any store.
+type Document struct {
+	Version          int                     `json:"version"`
+	Groups           []GroupConfig           `json:"groups,omitempty"`
+	PostureChecks    []PostureCheckConfig    `json:"posture_checks,omitempty"`
+	Networks         []NetworkConfig         `json:"networks,omitempty"`
+	Policies         []PolicyConfig          `json:"policies,omitempty"`
+	Routes           []RouteConfig           `json:"routes,omitempty"`
+	NameserverGroups []NameserverGroupConfig `json:"nameserver_groups,omitempty"`
+	DNSZones         []DNSZoneConfig         `json:"dns_zones,omitempty"`
+	SetupKeys        []SetupKeyConfig        `json:"setup_keys,omitempty"`
+}
+
+// GroupConfig is a group with its static peer members or the rules of a dynamic group
+type GroupConfig struct {
+	Name  string          `json:"name"`
+	Peers []string        `json:"peers,omitempty"`
+	Rules *api.GroupRules `json:"rules,omitempty"`
+}
+
+// PostureCheckConfig is a set of posture checks
+type PostureCheckConfig struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Checks      api.Checks `json:"checks"`
+}
+
+// PolicyConfig is an access control policy
+type PolicyConfig struct {
+	Name                string                `json:"name"`
+	Description         string                `json:"description,omitempty"`
+	Enabled             bool                  `json:"enabled"`
+	SourcePostureChecks []string              `json:"source_posture_checks,omitempty"`
+	Schedule            *types.PolicySchedule `json:"schedule,omitempty"`
+	Rules               []PolicyRuleConfig    `json:"rules"`
+}
+
+// PolicyRuleConfig is a rule of an access control policy
+type PolicyRuleConfig struct {
+	Name                string              `json:"name"`
+	Description         string              `json:"description,omitempty"`
+	Enabled             bool                `json:"enabled"`
+	Action              string              `json:"action"`
+	Bidirectional       bool                `json:"bidirectional,omitempty"`
+	Protocol            string              `json:"protocol"`
+	Ports               []string            `json:"ports,omitempty"`
+	PortRanges          []PortRangeConfig   `json:"port_ranges,omitempty"`
+	Sources             []string            `json:"sources,omitempty"`
+	SourceResource      *ResourceRefConfig  `json:"source_resource,omitempty"`
+	SourceLabels        map[string]string   `json:"source_labels,omitempty"`
+	Destinations        []string            `json:"destinations,omitempty"`
+	DestinationResource *ResourceRefConfig  `json:"destination_resource,omitempty"`
+	DestinationLabels   map[string]string   `json:"destination_labels,omitempty"`
+	AuthorizedGroups    map[string][]string `json:"authorized_groups,omitempty"`
+	AuthorizedUser      string              `json:"authorized_user,omitempty"`
+}
+
+// PortRangeConfig is an inclusive range of ports
+type PortRangeConfig struct {
+	Start uint16 `json:"start"`
+	End   uint16 `json:"end"`
+}
+
+// ResourceRefConfig references a peer by DNS label or a network resource by name
+type ResourceRefConfig struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// RouteConfig is a network route. Routes are identified by their network ID and routing peer or peer groups.
+type RouteConfig struct {
+	NetworkID           string            `json:"network_id"`
+	Description         string            `json:"description,omitempty"`
+	Network             string            `json:"network,omitempty"`
+	Domains             []string          `json:"domains,omitempty"`
+	KeepRoute           bool              `json:"keep_route,omitempty"`
+	Peer                string            `json:"peer,omitempty"`
+	PeerGroups          []string          `json:"peer_groups,omitempty"`
+	Masquerade          bool              `json:"masquerade,omitempty"`
+	Metric              int               `json:"metric"`
+	Enabled             bool              `json:"enabled"`
+	Groups              []string          `json:"groups,omitempty"`
+	DistributionLabels  map[string]string `json:"distribution_labels,omitempty"`
+	AccessControlGroups []string          `json:"access_control_groups,omitempty"`
+	SkipAutoApply       bool              `json:"skip_auto_apply,omitempty"`
+}
+
+// NetworkConfig is a network with its resources and routers
+type NetworkConfig struct {
+	Name        string                  `json:"name"`
+	Description string                  `json:"description,omitempty"`
+	Resources   []NetworkResourceConfig `json:"resources,omitempty"`
+	Routers     []NetworkRouterConfig   `json:"routers,omitempty"`
+}
+
+// NetworkResourceConfig is a host, subnet or domain resource of a network
+type NetworkResourceConfig struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Address     string   `json:"address"`
+	Groups      []string `json:"groups,omitempty"`
+	Enabled     bool     `json:"enabled"`
+}
+
+// NetworkRouterConfig is a routing peer or group of routing peers of a network
+type NetworkRouterConfig struct {
+	Peer       string   `json:"peer,omitempty"`
+	PeerGroups []string `json:"peer_groups,omitempty"`
+	Masquerade bool     `json:"masquerade,omitempty"`
+	Metric     int      `json:"metric"`
+	Enabled    bool     `json:"enabled"`
+}
+
+// NameserverGroupConfig is a group of nameservers distributed to peer groups
+type NameserverGroupConfig struct {
+	Name                 string             `json:"name"`
+	Description          string             `json:"description,omitempty"`
+	Nameservers          []NameserverConfig `json:"nameservers,omitempty"`
+	Groups               []string           `json:"groups,omitempty"`
+	Primary              bool               `json:"primary,omitempty"`
+	Domains              []string           `json:"domains,omitempty"`
+	Enabled              bool               `json:"enabled"`
+	SearchDomainsEnabled bool               `json:"search_domains_enabled,omitempty"`
+}
+
+// NameserverConfig is a single nameserver
+type NameserverConfig struct {
+	IP     string `json:"ip"`
+	NSType string `json:"ns_type"`
+	Port   int    `json:"port"`
+}
+
+// DNSZoneConfig is a custom DNS zone with its records
+type DNSZoneConfig struct {
+	Domain             string            `json:"domain"`
+	Name               string            `json:"name"`
+	Enabled            bool              `json:"enabled"`
+	EnableSearchDomain bool              `json:"enable_search_domain,omitempty"`
+	DistributionGroups []string          `json:"distribution_groups,omitempty"`
+	Records            []DNSRecordConfig `json:"records,omitempty"`
+}
+
+// DNSRecordConfig is a record of a DNS zone
+type DNSRecordConfig struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+	TTL     int    `json:"ttl"`
+}
+
+// SetupKeyConfig is a setup key. The key itself is generated on creation and never exported.
+type SetupKeyConfig struct {
+	Name                string                      `json:"name"`
+	Type                string                      `json:"type"`
+	ExpiresAt           *time.Time                  `json:"expires_at,omitempty"`
+	Revoked             bool                        `json:"revoked,omitempty"`
+	AutoGroups          []string                    `json:"auto_groups,omitempty"`
+	UsageLimit          int                         `json:"usage_limit,omitempty"`
+	Ephemeral           bool                        `json:"ephemeral,omitempty"`
+	AllowExtraDNSLabels bool                        `json:"allow_extra_dns_labels,omitempty"`
+	Labels              map[string]string           `json:"labels,omitempty"`
+	Restrictions        *types.SetupKeyRestrictions `json:"restrictions,omitempty"`
+}
+
+// routeKey identifies a route, routes of the same network ID are told apart by their routing peers
+func routeKey(r RouteConfig) string {
+	if r.Peer != "" {
+		return r.NetworkID + "@" + r.Peer
+	}
+	return r.NetworkID + "@" + strings.Join(r.PeerGroups, ",")
+}
+
+// routerKey identifies a router within a network
+func routerKey(r NetworkRouterConfig) string {
+	if r.Peer != "" {
+		return r.Peer
+	}
+	return strings.Join(r.PeerGroups, ",")
+}
+
+// ParseDocument decodes a YAML or JSON document and normalizes it for comparison
+func ParseDocument(data []byte) (*Document, error) {
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse document: %w", err)
+	}
+
+	// YAML is a superset of JSON, decoding through JSON applies the json tags and rejects unknown fields
+	jsonData, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parse document: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+	var doc Document
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("parse document: %w", err)
+	}
+
+	if doc.Version != DocumentVersion {
+		return nil, fmt.Errorf("unsupported document version %d, expected %d", doc.Version, DocumentVersion)
+	}
+
+	doc.normalize()
+	if err := doc.validateKeys(); err != nil {
+		return nil, fmt.Errorf("invalid document: %w", err)
+	}
+	return &doc, nil
+}
+
+// Marshal encodes the document as YAML or JSON
+func (d *Document) Marshal(format string) ([]byte, error) {
+	jsonData, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case "json":
+		return append(jsonData, '\n'), nil
+	case "yaml":
+		// decoding JSON into a node keeps the field order of the structs
+		var node yaml.Node
+		if err := yaml.Unmarshal(jsonData, &node); err != nil {
+			return nil, err
+		}
+		resetNodeStyle(&node)
+
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(&node); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q, expected yaml or json", format)
+	}
+}
+
+// resetNodeStyle drops the JSON flow style so the document is written in block style,
+// the encoder still quotes strings that would otherwise be read back as another type
+func resetNodeStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetNodeStyle(child)
+	}
+}
+
+// normalize sorts unordered lists and drops empty ones so that documents compare equal regardless of
+// how they were written
+func (d *Document) normalize() {
+	for i := range d.Groups {
+		d.Groups[i].Peers = sortedSet(d.Groups[i].Peers)
+	}
+	for i := range d.Networks {
+		network := &d.Networks[i]
+		for j := range network.Resources {
+			network.Resources[j].Address = canonicalResourceAddress(network.Resources[j].Address)
+			network.Resources[j].Groups = sortedSet(network.Resources[j].Groups)
+		}
+		for j := range network.Routers {
+			network.Routers[j].PeerGroups = sortedSet(network.Routers[j].PeerGroups)
+		}
+		slices.SortFunc(network.Resources, func(a, b NetworkResourceConfig) int { return strings.Compare(a.Name, b.Name) })
+		slices.SortFunc(network.Routers, func(a, b NetworkRouterConfig) int { return strings.Compare(routerKey(a), routerKey(b)) })
+		network.Resources = emptyToNil(network.Resources)
+		network.Routers = emptyToNil(network.Routers)
+	}
+	for i := range d.Policies {
+		policy := &d.Policies[i]
+		policy.SourcePostureChecks = sortedSet(policy.SourcePostureChecks)
+		if policy.Schedule != nil {
+			policy.Schedule.StartDate = utcTime(policy.Schedule.StartDate)
+			policy.Schedule.EndDate = utcTime(policy.Schedule.EndDate)
+		}
+		for j := range policy.Rules {
+			rule := &policy.Rules[j]
+			rule.Sources = sortedSet(rule.Sources)
+			rule.Destinations = sortedSet(rule.Destinations)
+			rule.Ports = emptyToNil(rule.Ports)
+			rule.PortRanges = emptyToNil(rule.PortRanges)
+			if len(rule.AuthorizedGroups) == 0 {
+				rule.AuthorizedGroups = nil
+			}
+			rule.SourceLabels = emptyMapToNil(rule.SourceLabels)
+			rule.DestinationLabels = emptyMapToNil(rule.DestinationLabels)
+			for group, users := range rule.AuthorizedGroups {
+				rule.AuthorizedGroups[group] = sortedSet(users)
+			}
+		}
+		// the order of rules has no meaning, sort them by their content to compare policies
+		slices.SortStableFunc(policy.Rules, func(a, b PolicyRuleConfig) int {
+			aData, _ := json.Marshal(a)
+			bData, _ := json.Marshal(b)
+			return bytes.Compare(aData, bData)
+		})
+	}
+	for i := range d.Routes {
+		route := &d.Routes[i]
+		if route.Network != "" {
+			route.Network = canonicalRouteNetwork(route.Network)
+		}
+		route.Domains = sortedSet(route.Domains)
+		route.PeerGroups = sortedSet(route.PeerGroups)
+		route.Groups = sortedSet(route.Groups)
+		route.AccessControlGroups = sortedSet(route.AccessControlGroups)
+		route.DistributionLabels = emptyMapToNil(route.DistributionLabels)
+	}
+	for i := range d.NameserverGroups {
+		nsGroup := &d.NameserverGroups[i]
+		nsGroup.Groups = sortedSet(nsGroup.Groups)
+		nsGroup.Domains = emptyToNil(nsGroup.Domains)
+	}
+	for i := range d.DNSZones {
+		zone := &d.DNSZones[i]
+		zone.DistributionGroups = sortedSet(zone.DistributionGroups)
+		slices.SortFunc(zone.Records, func(a, b DNSRecordConfig) int {
+			return strings.Compare(a.Name+" "+a.Type+" "+a.Content, b.Name+" "+b.Type+" "+b.Content)
+		})
+		zone.Records = emptyToNil(zone.Records)
+	}
+	for i := range d.SetupKeys {
+		key := &d.SetupKeys[i]
+		key.AutoGroups = sortedSet(key.AutoGroups)
+		key.ExpiresAt = utcTime(key.ExpiresAt)
+		key.Labels = emptyMapToNil(key.Labels)
+		if key.Restrictions.IsEmpty() {
+			key.Restrictions = nil
+		} else {
+			key.Restrictions.AllowedCIDRs = emptyToNil(key.Restrictions.AllowedCIDRs)
+			key.Restrictions.AllowedOS = emptyToNil(key.Restrictions.AllowedOS)
+		}
+		// one-off keys are limited to a single use regardless of the configured limit
+		if key.Type == string(types.SetupKeyOneOff) {
+			key.UsageLimit = 1
+		}
+	}
+
+	slices.SortFunc(d.Groups, func(a, b GroupConfig) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.PostureChecks, func(a, b PostureCheckConfig) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.Networks, func(a, b NetworkConfig) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.Policies, func(a, b PolicyConfig) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.Routes, func(a, b RouteConfig) int { return strings.Compare(routeKey(a), routeKey(b)) })
+	slices.SortFunc(d.NameserverGroups, func(a, b NameserverGroupConfig) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.DNSZones, func(a, b DNSZoneConfig) int { return strings.Compare(a.Domain, b.Domain) })
+	slices.SortFunc(d.SetupKeys, func(a, b SetupKeyConfig) int { return strings.Compare(a.Name, b.Name) })
+}
+
+// validateKeys rejects documents with several objects of a kind under the same name, names are the
+// only way objects are matched with the store
+func (d *Document) validateKeys() error {
+	checks := []struct {
+		kind string
+		keys []string
+	}{
+		{"group", keysOf(d.Groups, func(g GroupConfig) string { return g.Name })},
+		{"posture check", keysOf(d.PostureChecks, func(c PostureCheckConfig) string { return c.Name })},
+		{"network", keysOf(d.Networks, func(n NetworkConfig) string { return n.Name })},
+		{"policy", keysOf(d.Policies, func(p PolicyConfig) string { return p.Name })},
+		{"route", keysOf(d.Routes, routeKey)},
+		{"nameserver group", keysOf(d.NameserverGroups, func(n NameserverGroupConfig) string { return n.Name })},
+		{"DNS zone", keysOf(d.DNSZones, func(z DNSZoneConfig) string { return z.Domain })},
+		{"setup key", keysOf(d.SetupKeys, func(k SetupKeyConfig) string { return k.Name })},
+	}
+
+	var resourceNames []string
+	for _, network := range d.Networks {
+		checks = append(checks,
+			struct {
+				kind string
+				keys []string
+			}{"router of network " + network.Name, keysOf(network.Routers, routerKey)},
+		)
+		resourceNames = append(resourceNames, keysOf(network.Resources, func(r NetworkResourceConfig) string { return r.Name })...)
+	}
+	// policies reference resources by name only, so resource names are unique across networks
+	checks = append(checks, struct {
+		kind string
+		keys []string
+	}{"network resource", resourceNames})
+
+	for _, check := range checks {
+		seen := make(map[string]struct{}, len(check.keys))
+		for _, key := range check.keys {
+			if key == "" {
+				return fmt.Errorf("%s without a name", check.kind)
+			}
+			if _, ok := seen[key]; ok {
+				return fmt.Errorf("duplicate %s %q", check.kind, key)
+			}
+			seen[key] = struct{}{}
+		}
+	}
+	return nil
+}
+
+func keysOf[T any](items []T, key func(T) string) []string {
+	keys := make([]string, 0, len(items))
+	for _, item := range items {
+		keys = append(keys, key(item))
+	}
+	return keys
+}
+
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC().Truncate(time.Second)
+	return &utc
+}
+
+func sortedSet(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
+
+func emptyToNil[T any](values []T) []T {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+func emptyMapToNil[K comparable, V any](values map[K]V) map[K]V {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
@@ -0,0 +1,494 @@
+package configcmd
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/internals/modules/zones"
+	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
+	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
+	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/route"
+)
+
+// state is the account configuration loaded from the store, indexed to translate IDs to names and back
+type state struct {
+	accountID string
+
+	groups        []*types.Group
+	peers         []*nbpeer.Peer
+	postureChecks []*posture.Checks
+	policies      []*types.Policy
+	routes        []*route.Route
+	nsGroups      []*dns.NameServerGroup
+	networks      []*networkTypes.Network
+	resources     []*resourceTypes.NetworkResource
+	routers       []*routerTypes.NetworkRouter
+	zones         []*zones.Zone
+	setupKeys     []*types.SetupKey
+	users         []*types.User
+	dnsSettings   *types.DNSSettings
+
+	groupNames    *nameIndex
+	peerNames     *nameIndex
+	postureNames  *nameIndex
+	resourceNames *nameIndex
+}
+
+// Export reads the account configuration from the store and returns it as a normalized document
+func Export(ctx context.Context, s store.Store, accountID string) (*Document, error) {
+	st, err := loadState(ctx, s, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return st.document()
+}
+
+func loadState(ctx context.Context, s store.Store, accountID string) (*state, error) {
+	st := &state{accountID: accountID}
+
+	var err error
+	if st.groups, err = s.GetAccountGroups(ctx, store.LockingStrengthNone, accountID); err != nil {
+		return nil, fmt.Errorf("get groups: %w", err)
+	}
+	if st.peers, err = s.GetAccountPeers(ctx, store.LockingStrengthNone, accountID, "", ""); err != nil {
+		return nil, fmt.Errorf("get peers: %w", err)
+	}
+	if st.postureChecks, err = s.GetAccountPostureChecks(ctx, store.LockingStrengthNone, accountID); err != nil {
+		return nil, fmt.Errorf("get posture checks: %w", err)
+	}
+	if st.policies, err = s.GetAccountPolicies(ctx, store.LockingStrengthNone, accountID); err != nil {
+		return nil, fmt.Errorf("get policies: %w", err)
+	}
+	if st.routes, err = s.GetAccountRoutes(ctx, store.LockingStrengthNone, accountID); err != nil {
+		return nil, fmt.Errorf("get routes: %w", err)
+	}
+	if st.nsGroups, err = s.GetAccountNameServerGroups(ctx, store.LockingStrengthNone, accountID); err != nil {
+		return nil, fmt.Errorf("get nameserver groups: %w", err)
+	}
+	if st.networks, err = s.GetAccountNetworks(ctx, store.LockingStrengthNone, accountID); err != nil {
+		return nil, fmt.Errorf("get networks: %w", err)
+	}
+	if st.resources, err = s.GetNetworkResourcesByAccountID(ctx, store.LockingStrengthNone, accountID); err != nil {
+		return nil, fmt.Errorf("get network resources: %w", err)
+	}
+	if st.routers, err = s.GetNetworkRoutersByAccountID(ctx, store.LockingStrengthNone, accountID); err != nil {
+		return nil, fmt.Errorf("get network routers: %w", err)
+	}
+	if st.zones, err = s.GetAccountZones(ctx, store.LockingStrengthNone, accountID); err != nil {
+		return nil, fmt.Errorf("get DNS zones: %w", err)
+	}
+	if st.setupKeys, err = s.GetAccountSetupKeys(ctx, store.LockingStrengthNone, accountID); err != nil {
+		return nil, fmt.Errorf("get setup keys: %w", err)
+	}
+	if st.users, err = s.GetAccountUsers(ctx, store.LockingStrengthNone, accountID); err != nil {
+		return nil, fmt.Errorf("get users: %w", err)
+	}
+	if st.dnsSettings, err = s.GetAccountDNSSettings(ctx, store.LockingStrengthNone, accountID); err != nil {
+		return nil, fmt.Errorf("get DNS settings: %w", err)
+	}
+
+	st.groupNames = newNameIndex("group")
+	for _, group := range st.groups {
+		st.groupNames.add(group.ID, group.Name)
+	}
+	st.peerNames = newNameIndex("peer")
+	for _, peer := range st.peers {
+		st.peerNames.add(peer.ID, peer.DNSLabel)
+	}
+	st.postureNames = newNameIndex("posture check")
+	for _, checks := range st.postureChecks {
+		st.postureNames.add(checks.ID, checks.Name)
+	}
+	st.resourceNames = newNameIndex("network resource")
+	for _, resource := range st.resources {
+		st.resourceNames.add(resource.ID, resource.Name)
+	}
+
+	return st, nil
+}
+
+// isManagedGroup reports whether the group is part of the document. Groups issued by JWT claims or
+// integrations and the All group can be referenced but are owned by the system.
+func isManagedGroup(group *types.Group) bool {
+	if group.IsGroupAll() {
+		return false
+	}
+	return group.Issued == types.GroupIssuedAPI || group.Issued == ""
+}
+
+func (st *state) document() (*Document, error) {
+	doc := &Document{Version: DocumentVersion}
+
+	for _, group := range st.groups {
+		if !isManagedGroup(group) {
+			continue
+		}
+		groupConfig := GroupConfig{Name: group.Name, Rules: group.Rules.ToAPIResponse()}
+		if !group.IsDynamic() {
+			peers, err := st.peerNames.names(group.Peers)
+			if err != nil {
+				return nil, fmt.Errorf("group %s: %w", group.Name, err)
+			}
+			groupConfig.Peers = peers
+		}
+		doc.Groups = append(doc.Groups, groupConfig)
+	}
+
+	for _, checks := range st.postureChecks {
+		doc.PostureChecks = append(doc.PostureChecks, PostureCheckConfig{
+			Name:        checks.Name,
+			Description: checks.Description,
+			Checks:      checks.ToAPIResponse().Checks,
+		})
+	}
+
+	for _, network := range st.networks {
+		networkConfig, err := st.networkConfig(network)
+		if err != nil {
+			return nil, fmt.Errorf("network %s: %w", network.Name, err)
+		}
+		doc.Networks = append(doc.Networks, networkConfig)
+	}
+
+	for _, policy := range st.policies {
+		policyConfig, err := st.policyConfig(policy)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: %w", policy.Name, err)
+		}
+		doc.Policies = append(doc.Policies, policyConfig)
+	}
+
+	for _, r := range st.routes {
+		routeConfig, err := st.routeConfig(r)
+		if err != nil {
+			return nil, fmt.Errorf("route %s: %w", r.NetID, err)
+		}
+		doc.Routes = append(doc.Routes, routeConfig)
+	}
+
+	for _, nsGroup := range st.nsGroups {
+		groups, err := st.groupNames.names(nsGroup.Groups)
+		if err != nil {
+			return nil, fmt.Errorf("nameserver group %s: %w", nsGroup.Name, err)
+		}
+		nsConfig := NameserverGroupConfig{
+			Name:                 nsGroup.Name,
+			Description:          nsGroup.Description,
+			Groups:               groups,
+			Primary:              nsGroup.Primary,
+			Domains:              nsGroup.Domains,
+			Enabled:              nsGroup.Enabled,
+			SearchDomainsEnabled: nsGroup.SearchDomainsEnabled,
+		}
+		for _, ns := range nsGroup.NameServers {
+			nsConfig.Nameservers = append(nsConfig.Nameservers, NameserverConfig{IP: ns.IP.String(), NSType: ns.NSType.String(), Port: ns.Port})
+		}
+		doc.NameserverGroups = append(doc.NameserverGroups, nsConfig)
+	}
+
+	for _, zone := range st.zones {
+		groups, err := st.groupNames.names(zone.DistributionGroups)
+		if err != nil {
+			return nil, fmt.Errorf("DNS zone %s: %w", zone.Domain, err)
+		}
+		zoneConfig := DNSZoneConfig{
+			Domain:             zone.Domain,
+			Name:               zone.Name,
+			Enabled:            zone.Enabled,
+			EnableSearchDomain: zone.EnableSearchDomain,
+			DistributionGroups: groups,
+		}
+		for _, record := range zone.Records {
+			zoneConfig.Records = append(zoneConfig.Records, DNSRecordConfig{Name: record.Name, Type: string(record.Type), Content: record.Content, TTL: record.TTL})
+		}
+		doc.DNSZones = append(doc.DNSZones, zoneConfig)
+	}
+
+	for _, key := range st.setupKeys {
+		groups, err := st.groupNames.names(key.AutoGroups)
+		if err != nil {
+			return nil, fmt.Errorf("setup key %s: %w", key.Name, err)
+		}
+		doc.SetupKeys = append(doc.SetupKeys, SetupKeyConfig{
+			Name:                key.Name,
+			Type:                string(key.Type),
+			ExpiresAt:           key.ExpiresAt,
+			Revoked:             key.Revoked,
+			AutoGroups:          groups,
+			UsageLimit:          key.UsageLimit,
+			Ephemeral:           key.Ephemeral,
+			AllowExtraDNSLabels: key.AllowExtraDNSLabels,
+			Labels:              key.Labels,
+			Restrictions:        key.Restrictions.Copy(),
+		})
+	}
+
+	doc.normalize()
+	if err := doc.validateKeys(); err != nil {
+		return nil, fmt.Errorf("the account can't be exported: %w", err)
+	}
+	return doc, nil
+}
+
+func (st *state) networkConfig(network *networkTypes.Network) (NetworkConfig, error) {
+	networkConfig := NetworkConfig{Name: network.Name, Description: network.Description}
+
+	for _, resource := range st.resources {
+		if resource.NetworkID != network.ID {
+			continue
+		}
+		var groupIDs []string
+		for _, group := range st.groups {
+			if slices.ContainsFunc(group.Resources, func(r types.Resource) bool { return r.ID == resource.ID }) {
+				groupIDs = append(groupIDs, group.ID)
+			}
+		}
+		groups, err := st.groupNames.names(groupIDs)
+		if err != nil {
+			return NetworkConfig{}, fmt.Errorf("resource %s: %w", resource.Name, err)
+		}
+		networkConfig.Resources = append(networkConfig.Resources, NetworkResourceConfig{
+			Name:        resource.Name,
+			Description: resource.Description,
+			Address:     resourceAddress(resource),
+			Groups:      groups,
+			Enabled:     resource.Enabled,
+		})
+	}
+
+	for _, router := range st.routers {
+		if router.NetworkID != network.ID {
+			continue
+		}
+		routerConfig := NetworkRouterConfig{Masquerade: router.Masquerade, Metric: router.Metric, Enabled: router.Enabled}
+		var err error
+		if router.Peer != "" {
+			if routerConfig.Peer, err = st.peerNames.name(router.Peer); err != nil {
+				return NetworkConfig{}, fmt.Errorf("router: %w", err)
+			}
+		}
+		if routerConfig.PeerGroups, err = st.groupNames.names(router.PeerGroups); err != nil {
+			return NetworkConfig{}, fmt.Errorf("router: %w", err)
+		}
+		networkConfig.Routers = append(networkConfig.Routers, routerConfig)
+	}
+
+	return networkConfig, nil
+}
+
+func (st *state) policyConfig(policy *types.Policy) (PolicyConfig, error) {
+	postureChecks, err := st.postureNames.names(policy.SourcePostureChecks)
+	if err != nil {
+		return PolicyConfig{}, err
+	}
+
+	policyConfig := PolicyConfig{
+		Name:                policy.Name,
+		Description:         policy.Description,
+		Enabled:             policy.Enabled,
+		SourcePostureChecks: postureChecks,
+		Schedule:            policy.Schedule,
+		Rules:               []PolicyRuleConfig{},
+	}
+
+	for _, rule := range policy.Rules {
+		ruleConfig := PolicyRuleConfig{
+			Name:              rule.Name,
+			Description:       rule.Description,
+			Enabled:           rule.Enabled,
+			Action:            string(rule.Action),
+			Bidirectional:     rule.Bidirectional,
+			Protocol:          string(rule.Protocol),
+			Ports:             rule.Ports,
+			AuthorizedUser:    rule.AuthorizedUser,
+			SourceLabels:      rule.SourceLabels,
+			DestinationLabels: rule.DestinationLabels,
+		}
+		for _, portRange := range rule.PortRanges {
+			ruleConfig.PortRanges = append(ruleConfig.PortRanges, PortRangeConfig{Start: portRange.Start, End: portRange.End})
+		}
+		if ruleConfig.Sources, err = st.groupNames.names(rule.Sources); err != nil {
+			return PolicyConfig{}, fmt.Errorf("rule %s: %w", rule.Name, err)
+		}
+		if ruleConfig.Destinations, err = st.groupNames.names(rule.Destinations); err != nil {
+			return PolicyConfig{}, fmt.Errorf("rule %s: %w", rule.Name, err)
+		}
+		if ruleConfig.SourceResource, err = st.resourceRef(rule.SourceResource); err != nil {
+			return PolicyConfig{}, fmt.Errorf("rule %s: %w", rule.Name, err)
+		}
+		if ruleConfig.DestinationResource, err = st.resourceRef(rule.DestinationResource); err != nil {
+			return PolicyConfig{}, fmt.Errorf("rule %s: %w", rule.Name, err)
+		}
+		for groupID, users := range rule.AuthorizedGroups {
+			groupName, err := st.groupNames.name(groupID)
+			if err != nil {
+				return PolicyConfig{}, fmt.Errorf("rule %s: %w", rule.Name, err)
+			}
+			if ruleConfig.AuthorizedGroups == nil {
+				ruleConfig.AuthorizedGroups = make(map[string][]string)
+			}
+			ruleConfig.AuthorizedGroups[groupName] = users
+		}
+		policyConfig.Rules = append(policyConfig.Rules, ruleConfig)
+	}
+
+	return policyConfig, nil
+}
+
+func (st *state) resourceRef(resource types.Resource) (*ResourceRefConfig, error) {
+	if resource.ID == "" {
+		return nil, nil
+	}
+
+	index := st.resourceNames
+	if resource.Type == types.ResourceTypePeer {
+		index = st.peerNames
+	}
+
+	name, err := index.name(resource.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &ResourceRefConfig{Type: string(resource.Type), Name: name}, nil
+}
+
+func (st *state) routeConfig(r *route.Route) (RouteConfig, error) {
+	routeConfig := RouteConfig{
+		NetworkID:          string(r.NetID),
+		Description:        r.Description,
+		KeepRoute:          r.KeepRoute,
+		Masquerade:         r.Masquerade,
+		Metric:             r.Metric,
+		Enabled:            r.Enabled,
+		SkipAutoApply:      r.SkipAutoApply,
+		DistributionLabels: r.DistributionLabels,
+	}
+
+	if r.NetworkType == route.DomainNetwork {
+		routeConfig.Domains = r.Domains.ToSafeStringList()
+	} else {
+		routeConfig.Network = r.Network.String()
+	}
+
+	var err error
+	if r.Peer != "" {
+		if routeConfig.Peer, err = st.peerNames.name(r.Peer); err != nil {
+			return RouteConfig{}, err
+		}
+	}
+	if routeConfig.PeerGroups, err = st.groupNames.names(r.PeerGroups); err != nil {
+		return RouteConfig{}, err
+	}
+	if routeConfig.Groups, err = st.groupNames.names(r.Groups); err != nil {
+		return RouteConfig{}, err
+	}
+	if routeConfig.AccessControlGroups, err = st.groupNames.names(r.AccessControlGroups); err != nil {
+		return RouteConfig{}, err
+	}
+
+	return routeConfig, nil
+}
+
+// resourceAddress returns the address of a resource in the form it was created with
+func resourceAddress(resource *resourceTypes.NetworkResource) string {
+	if resource.Type == resourceTypes.Domain {
+		return resource.Domain
+	}
+	return resource.Prefix.String()
+}
+
+// canonicalResourceAddress formats an address the way resourceAddress returns it, so a host written as
+// 10.0.0.1 matches the stored 10.0.0.1/32
+func canonicalResourceAddress(address string) string {
+	resourceType, domain, prefix, err := resourceTypes.GetResourceType(address)
+	if err != nil {
+		return address
+	}
+	if resourceType == resourceTypes.Domain {
+		return domain
+	}
+	return prefix.String()
+}
+
+// canonicalRouteNetwork formats a route network the way the store keeps it
+func canonicalRouteNetwork(network string) string {
+	prefix, err := netip.ParsePrefix(network)
+	if err != nil {
+		return network
+	}
+	return prefix.Masked().String()
+}
+
+// nameIndex translates object IDs to the names used as references in a document and back
+type nameIndex struct {
+	kind   string
+	byID   map[string]string
+	byName map[string][]string
+}
+
+func newNameIndex(kind string) *nameIndex {
+	return &nameIndex{kind: kind, byID: make(map[string]string), byName: make(map[string][]string)}
+}
+
+func (n *nameIndex) add(id, name string) {
+	n.byID[id] = name
+	n.byName[name] = append(n.byName[name], id)
+}
+
+func (n *nameIndex) name(id string) (string, error) {
+	name, ok := n.byID[id]
+	if !ok {
+		return "", fmt.Errorf("%s %s not found", n.kind, id)
+	}
+	if len(n.byName[name]) > 1 {
+		return "", fmt.Errorf("%s name %q is shared by %d objects and can't be used as a reference", n.kind, name, len(n.byName[name]))
+	}
+	return name, nil
+}
+
+func (n *nameIndex) names(ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		name, err := n.name(id)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (n *nameIndex) id(name string) (string, error) {
+	ids := n.byName[name]
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("%s %q not found", n.kind, name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%s name %q is shared by %d objects and can't be used as a reference", n.kind, name, len(ids))
+	}
+}
+
+func (n *nameIndex) idList(names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		id, err := n.id(name)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
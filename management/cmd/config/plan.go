@@ -0,0 +1,759 @@
+package configcmd
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/internals/modules/zones"
+	"github.com/netbirdio/netbird/management/internals/modules/zones/records"
+	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
+	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
+	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/route"
+	"github.com/netbirdio/netbird/shared/management/domain"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+// ChangeAction is what applying a document does to an object
+type ChangeAction string
+
+const (
+	ActionCreate ChangeAction = "create"
+	ActionUpdate ChangeAction = "update"
+	ActionDelete ChangeAction = "delete"
+)
+
+func (a ChangeAction) symbol() string {
+	switch a {
+	case ActionCreate:
+		return "+"
+	case ActionDelete:
+		return "-"
+	default:
+		return "~"
+	}
+}
+
+const (
+	kindGroup           = "group"
+	kindPostureCheck    = "posture_check"
+	kindNetwork         = "network"
+	kindPolicy          = "policy"
+	kindRoute           = "route"
+	kindNameserverGroup = "nameserver_group"
+	kindDNSZone         = "dns_zone"
+	kindSetupKey        = "setup_key"
+)
+
+// Change is a single object created, updated, or deleted by applying a document
+type Change struct {
+	Action ChangeAction
+	Kind   string
+	Name   string
+}
+
+// Plan is the set of changes that makes the account match a document
+type Plan struct {
+	Changes []Change
+
+	state   *state
+	desired *Document
+	models  *models
+}
+
+// models are the store objects described by a document, with the IDs of the objects they replace
+type models struct {
+	groups        map[string]*types.Group
+	postureChecks map[string]*posture.Checks
+	networks      map[string]*networkTypes.Network
+	resources     map[string]*resourceTypes.NetworkResource
+	routers       map[string][]*routerTypes.NetworkRouter
+	policies      map[string]*types.Policy
+	routes        map[string]*route.Route
+	nsGroups      map[string]*dns.NameServerGroup
+	zones         map[string]*zones.Zone
+
+	groupNames    *nameIndex
+	postureNames  *nameIndex
+	resourceNames *nameIndex
+}
+
+// NewPlan compares the document with the account configuration in the store
+func NewPlan(ctx context.Context, s store.Store, accountID string, desired *Document) (*Plan, error) {
+	st, err := loadState(ctx, s, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return newPlan(st, desired)
+}
+
+func newPlan(st *state, desired *Document) (*Plan, error) {
+	current, err := st.document()
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := st.buildModels(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateSetupKeys(current.SetupKeys, desired.SetupKeys); err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	changes = append(changes, diff(kindGroup, current.Groups, desired.Groups, func(g GroupConfig) string { return g.Name })...)
+	changes = append(changes, diff(kindPostureCheck, current.PostureChecks, desired.PostureChecks, func(c PostureCheckConfig) string { return c.Name })...)
+	changes = append(changes, diff(kindNetwork, current.Networks, desired.Networks, func(n NetworkConfig) string { return n.Name })...)
+	changes = append(changes, diff(kindPolicy, current.Policies, desired.Policies, func(p PolicyConfig) string { return p.Name })...)
+	changes = append(changes, diff(kindRoute, current.Routes, desired.Routes, routeKey)...)
+	changes = append(changes, diff(kindNameserverGroup, current.NameserverGroups, desired.NameserverGroups, func(n NameserverGroupConfig) string { return n.Name })...)
+	changes = append(changes, diff(kindDNSZone, current.DNSZones, desired.DNSZones, func(z DNSZoneConfig) string { return z.Domain })...)
+	changes = append(changes, diff(kindSetupKey, current.SetupKeys, desired.SetupKeys, func(k SetupKeyConfig) string { return k.Name })...)
+
+	return &Plan{Changes: changes, state: st, desired: desired, models: m}, nil
+}
+
+// action returns the change planned for an object, if any
+func (p *Plan) action(kind, name string) (ChangeAction, bool) {
+	for _, change := range p.Changes {
+		if change.Kind == kind && change.Name == name {
+			return change.Action, true
+		}
+	}
+	return "", false
+}
+
+func diff[T any](kind string, current, desired []T, key func(T) string) []Change {
+	currentByKey := make(map[string]T, len(current))
+	for _, item := range current {
+		currentByKey[key(item)] = item
+	}
+
+	var changes []Change
+	desiredKeys := make(map[string]struct{}, len(desired))
+	for _, item := range desired {
+		name := key(item)
+		desiredKeys[name] = struct{}{}
+		existing, ok := currentByKey[name]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Action: ActionCreate, Kind: kind, Name: name})
+		case !reflect.DeepEqual(existing, item):
+			changes = append(changes, Change{Action: ActionUpdate, Kind: kind, Name: name})
+		}
+	}
+
+	for _, item := range current {
+		if _, ok := desiredKeys[key(item)]; !ok {
+			changes = append(changes, Change{Action: ActionDelete, Kind: kind, Name: key(item)})
+		}
+	}
+
+	return changes
+}
+
+// validateSetupKeys rejects changes to the setup key properties that can't be changed after creation
+func validateSetupKeys(current, desired []SetupKeyConfig) error {
+	for _, key := range desired {
+		if key.Type != string(types.SetupKeyReusable) && key.Type != string(types.SetupKeyOneOff) {
+			return fmt.Errorf("setup key %s: invalid type %q, expected %s or %s", key.Name, key.Type, types.SetupKeyReusable, types.SetupKeyOneOff)
+		}
+		if key.UsageLimit < 0 {
+			return fmt.Errorf("setup key %s: usage limit can't be negative", key.Name)
+		}
+		if err := nbpeer.ValidateLabels(key.Labels); err != nil {
+			return fmt.Errorf("setup key %s: %w", key.Name, err)
+		}
+		if err := key.Restrictions.Validate(); err != nil {
+			return fmt.Errorf("setup key %s: invalid restrictions: %w", key.Name, err)
+		}
+
+		idx := slices.IndexFunc(current, func(k SetupKeyConfig) bool { return k.Name == key.Name })
+		if idx < 0 {
+			continue
+		}
+		existing := current[idx]
+		if existing.Type != key.Type || existing.UsageLimit != key.UsageLimit || existing.Ephemeral != key.Ephemeral ||
+			existing.AllowExtraDNSLabels != key.AllowExtraDNSLabels || !reflect.DeepEqual(existing.ExpiresAt, key.ExpiresAt) {
+			return fmt.Errorf("setup key %s: only auto_groups, labels, restrictions and revoked can be changed, rename the key to replace it", key.Name)
+		}
+		if existing.Revoked && !key.Revoked {
+			return fmt.Errorf("setup key %s: a revoked key can't be restored", key.Name)
+		}
+	}
+	return nil
+}
+
+// buildModels converts the document into store objects. Objects matched by name keep the ID they have in the
+// store, new objects get a fresh ID. Every reference is resolved, so an invalid document is rejected before
+// anything is written.
+func (st *state) buildModels(doc *Document) (*models, error) {
+	m := &models{
+		groups:        make(map[string]*types.Group),
+		postureChecks: make(map[string]*posture.Checks),
+		networks:      make(map[string]*networkTypes.Network),
+		resources:     make(map[string]*resourceTypes.NetworkResource),
+		routers:       make(map[string][]*routerTypes.NetworkRouter),
+		policies:      make(map[string]*types.Policy),
+		routes:        make(map[string]*route.Route),
+		nsGroups:      make(map[string]*dns.NameServerGroup),
+		zones:         make(map[string]*zones.Zone),
+		groupNames:    newNameIndex("group"),
+		postureNames:  newNameIndex("posture check"),
+		resourceNames: newNameIndex("network resource"),
+	}
+
+	for _, group := range st.groups {
+		if !isManagedGroup(group) {
+			m.groupNames.add(group.ID, group.Name)
+		}
+	}
+	for _, groupConfig := range doc.Groups {
+		if len(m.groupNames.byName[groupConfig.Name]) > 0 {
+			return nil, fmt.Errorf("group %s is managed by the system and can't be defined in the document", groupConfig.Name)
+		}
+		id := xid.New().String()
+		if existing := st.managedGroup(groupConfig.Name); existing != nil {
+			id = existing.ID
+		}
+		m.groupNames.add(id, groupConfig.Name)
+	}
+
+	for i, checksConfig := range doc.PostureChecks {
+		checks, err := posture.NewChecksFromAPIPostureCheck(api.PostureCheck{
+			Id:          xid.New().String(),
+			Name:        checksConfig.Name,
+			Description: &checksConfig.Description,
+			Checks:      checksConfig.Checks,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("posture check %s: %w", checksConfig.Name, err)
+		}
+		if err := checks.Validate(); err != nil {
+			return nil, fmt.Errorf("posture check %s: %w", checksConfig.Name, err)
+		}
+		checks.AccountID = st.accountID
+		checks.PublicID = xid.New().String()
+		if idx := slices.IndexFunc(st.postureChecks, func(c *posture.Checks) bool { return c.Name == checksConfig.Name }); idx >= 0 {
+			checks.ID = st.postureChecks[idx].ID
+			checks.PublicID = st.postureChecks[idx].PublicID
+		}
+		// optional fields are written the way export returns them, so the document compares equal to the store
+		doc.PostureChecks[i].Checks = checks.ToAPIResponse().Checks
+		m.postureChecks[checksConfig.Name] = checks
+		m.postureNames.add(checks.ID, checks.Name)
+	}
+
+	if err := st.buildNetworks(doc, m); err != nil {
+		return nil, err
+	}
+
+	// group models come last, their resources are assigned in the network resources section
+	for _, groupConfig := range doc.Groups {
+		group, err := st.buildGroup(groupConfig, m)
+		if err != nil {
+			return nil, fmt.Errorf("group %s: %w", groupConfig.Name, err)
+		}
+		m.groups[groupConfig.Name] = group
+	}
+
+	for _, policyConfig := range doc.Policies {
+		policy, err := st.buildPolicy(policyConfig, m)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: %w", policyConfig.Name, err)
+		}
+		m.policies[policyConfig.Name] = policy
+	}
+
+	for _, routeConfig := range doc.Routes {
+		r, err := st.buildRoute(routeConfig, m)
+		if err != nil {
+			return nil, fmt.Errorf("route %s: %w", routeKey(routeConfig), err)
+		}
+		m.routes[routeKey(routeConfig)] = r
+	}
+
+	for _, nsConfig := range doc.NameserverGroups {
+		nsGroup, err := st.buildNameserverGroup(nsConfig, m)
+		if err != nil {
+			return nil, fmt.Errorf("nameserver group %s: %w", nsConfig.Name, err)
+		}
+		m.nsGroups[nsConfig.Name] = nsGroup
+	}
+
+	for _, zoneConfig := range doc.DNSZones {
+		zone, err := st.buildZone(zoneConfig, m)
+		if err != nil {
+			return nil, fmt.Errorf("DNS zone %s: %w", zoneConfig.Domain, err)
+		}
+		m.zones[zoneConfig.Domain] = zone
+	}
+
+	for _, keyConfig := range doc.SetupKeys {
+		if _, err := m.groupNames.idList(keyConfig.AutoGroups); err != nil {
+			return nil, fmt.Errorf("setup key %s: %w", keyConfig.Name, err)
+		}
+	}
+
+	return m, nil
+}
+
+// managedGroup returns the group of the document with the given name that is stored already
+func (st *state) managedGroup(name string) *types.Group {
+	for _, group := range st.groups {
+		if isManagedGroup(group) && group.Name == name {
+			return group
+		}
+	}
+	return nil
+}
+
+func (st *state) buildGroup(groupConfig GroupConfig, m *models) (*types.Group, error) {
+	id, err := m.groupNames.id(groupConfig.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	group := &types.Group{
+		ID:        id,
+		AccountID: st.accountID,
+		PublicID:  xid.New().String(),
+		Name:      groupConfig.Name,
+		Issued:    types.GroupIssuedAPI,
+		Rules:     types.GroupRulesFromAPIRequest(groupConfig.Rules),
+		Resources: m.groupResources(id),
+	}
+	if existing := st.managedGroup(groupConfig.Name); existing != nil {
+		group.PublicID = existing.PublicID
+		group.IntegrationReference = existing.IntegrationReference
+	}
+
+	if group.Rules == nil {
+		if group.Peers, err = st.peerNames.idList(groupConfig.Peers); err != nil {
+			return nil, err
+		}
+		return group, nil
+	}
+
+	if len(groupConfig.Peers) > 0 {
+		return nil, fmt.Errorf("peers of a dynamic group are computed from its rules and can't be listed")
+	}
+	if err := group.Rules.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid dynamic group rules: %w", err)
+	}
+	matcher, err := group.Rules.Matcher()
+	if err != nil {
+		return nil, fmt.Errorf("invalid dynamic group rules: %w", err)
+	}
+	group.Peers = []string{}
+	for _, peer := range st.peers {
+		// embedded proxy peers are never group members
+		if !peer.ProxyMeta.Embedded && matcher.Matches(peer) {
+			group.Peers = append(group.Peers, peer.ID)
+		}
+	}
+	return group, nil
+}
+
+// groupResources returns the network resources of the document assigned to the group
+func (m *models) groupResources(groupID string) []types.Resource {
+	var groupResources []types.Resource
+	for _, resource := range m.resources {
+		if slices.Contains(resource.GroupIDs, groupID) {
+			groupResources = append(groupResources, types.Resource{ID: resource.ID, Type: types.ResourceType(resource.Type.String())})
+		}
+	}
+	slices.SortFunc(groupResources, func(a, b types.Resource) int {
+		if a.ID < b.ID {
+			return -1
+		}
+		if a.ID > b.ID {
+			return 1
+		}
+		return 0
+	})
+	return groupResources
+}
+
+func (st *state) buildNetworks(doc *Document, m *models) error {
+	for _, networkConfig := range doc.Networks {
+		network := networkTypes.NewNetwork(st.accountID, networkConfig.Name, networkConfig.Description)
+		network.PublicID = xid.New().String()
+		if idx := slices.IndexFunc(st.networks, func(n *networkTypes.Network) bool { return n.Name == networkConfig.Name }); idx >= 0 {
+			network.ID = st.networks[idx].ID
+			network.PublicID = st.networks[idx].PublicID
+		}
+		m.networks[networkConfig.Name] = network
+
+		for _, resourceConfig := range networkConfig.Resources {
+			groupIDs, err := m.groupNames.idList(resourceConfig.Groups)
+			if err != nil {
+				return fmt.Errorf("network %s resource %s: %w", networkConfig.Name, resourceConfig.Name, err)
+			}
+			if len(groupIDs) == 0 {
+				return fmt.Errorf("network %s resource %s: at least one group is required", networkConfig.Name, resourceConfig.Name)
+			}
+			resource, err := resourceTypes.NewNetworkResource(st.accountID, network.ID, resourceConfig.Name, resourceConfig.Description, resourceConfig.Address, groupIDs, resourceConfig.Enabled)
+			if err != nil {
+				return fmt.Errorf("network %s resource %s: %w", networkConfig.Name, resourceConfig.Name, err)
+			}
+			resource.PublicID = xid.New().String()
+			if idx := slices.IndexFunc(st.resources, func(r *resourceTypes.NetworkResource) bool {
+				return r.NetworkID == network.ID && r.Name == resourceConfig.Name
+			}); idx >= 0 {
+				resource.ID = st.resources[idx].ID
+				resource.PublicID = st.resources[idx].PublicID
+			}
+			m.resources[resourceConfig.Name] = resource
+			m.resourceNames.add(resource.ID, resource.Name)
+		}
+
+		for _, routerConfig := range networkConfig.Routers {
+			router, err := st.buildRouter(network, routerConfig, m)
+			if err != nil {
+				return fmt.Errorf("network %s router %s: %w", networkConfig.Name, routerKey(routerConfig), err)
+			}
+			m.routers[networkConfig.Name] = append(m.routers[networkConfig.Name], router)
+		}
+	}
+	return nil
+}
+
+func (st *state) buildRouter(network *networkTypes.Network, routerConfig NetworkRouterConfig, m *models) (*routerTypes.NetworkRouter, error) {
+	var peerID string
+	if routerConfig.Peer != "" {
+		var err error
+		if peerID, err = st.peerNames.id(routerConfig.Peer); err != nil {
+			return nil, err
+		}
+	}
+	peerGroups, err := m.groupNames.idList(routerConfig.PeerGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	router, err := routerTypes.NewNetworkRouter(st.accountID, network.ID, peerID, peerGroups, routerConfig.Masquerade, routerConfig.Metric, routerConfig.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	router.PublicID = xid.New().String()
+
+	// routers have no name, an existing router is matched by its routing peer or peer groups
+	for _, existing := range st.routers {
+		if existing.NetworkID == network.ID && existing.Peer == router.Peer && slices.Equal(sortedSet(existing.PeerGroups), sortedSet(router.PeerGroups)) {
+			router.ID = existing.ID
+			router.PublicID = existing.PublicID
+			break
+		}
+	}
+	return router, nil
+}
+
+func (st *state) buildPolicy(policyConfig PolicyConfig, m *models) (*types.Policy, error) {
+	postureChecks, err := m.postureNames.idList(policyConfig.SourcePostureChecks)
+	if err != nil {
+		return nil, err
+	}
+	if len(policyConfig.Rules) == 0 {
+		return nil, fmt.Errorf("at least one rule is required")
+	}
+
+	policy := &types.Policy{
+		ID:                  xid.New().String(),
+		PublicID:            xid.New().String(),
+		AccountID:           st.accountID,
+		Name:                policyConfig.Name,
+		Description:         policyConfig.Description,
+		Enabled:             policyConfig.Enabled,
+		SourcePostureChecks: postureChecks,
+		Schedule:            policyConfig.Schedule,
+	}
+	if idx := slices.IndexFunc(st.policies, func(p *types.Policy) bool { return p.Name == policyConfig.Name }); idx >= 0 {
+		policy.ID = st.policies[idx].ID
+		policy.PublicID = st.policies[idx].PublicID
+	}
+	if policy.Schedule != nil {
+		if err := policy.Schedule.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid policy schedule: %w", err)
+		}
+	}
+
+	for _, ruleConfig := range policyConfig.Rules {
+		rule, err := st.buildPolicyRule(policy.ID, ruleConfig, m)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", ruleConfig.Name, err)
+		}
+		policy.Rules = append(policy.Rules, rule)
+	}
+	return policy, nil
+}
+
+func (st *state) buildPolicyRule(policyID string, ruleConfig PolicyRuleConfig, m *models) (*types.PolicyRule, error) {
+	rule := &types.PolicyRule{
+		ID:                xid.New().String(),
+		PolicyID:          policyID,
+		Name:              ruleConfig.Name,
+		Description:       ruleConfig.Description,
+		Enabled:           ruleConfig.Enabled,
+		Action:            types.PolicyTrafficActionType(ruleConfig.Action),
+		Bidirectional:     ruleConfig.Bidirectional,
+		Protocol:          types.PolicyRuleProtocolType(ruleConfig.Protocol),
+		Ports:             ruleConfig.Ports,
+		AuthorizedUser:    ruleConfig.AuthorizedUser,
+		SourceLabels:      ruleConfig.SourceLabels,
+		DestinationLabels: ruleConfig.DestinationLabels,
+	}
+
+	if rule.Action != types.PolicyTrafficActionAccept && rule.Action != types.PolicyTrafficActionDrop {
+		return nil, fmt.Errorf("invalid action %q", ruleConfig.Action)
+	}
+	switch rule.Protocol {
+	case types.PolicyRuleProtocolTCP, types.PolicyRuleProtocolUDP, types.PolicyRuleProtocolNetbirdSSH:
+	case types.PolicyRuleProtocolALL, types.PolicyRuleProtocolICMP:
+		if len(ruleConfig.Ports) > 0 || len(ruleConfig.PortRanges) > 0 {
+			return nil, fmt.Errorf("ports are not allowed with protocol %s", rule.Protocol)
+		}
+	default:
+		return nil, fmt.Errorf("invalid protocol %q", ruleConfig.Protocol)
+	}
+	for _, portRange := range ruleConfig.PortRanges {
+		if portRange.Start == 0 || portRange.Start > portRange.End {
+			return nil, fmt.Errorf("invalid port range %d-%d", portRange.Start, portRange.End)
+		}
+		rule.PortRanges = append(rule.PortRanges, types.RulePortRange{Start: portRange.Start, End: portRange.End})
+	}
+
+	var err error
+	if rule.Sources, err = m.groupNames.idList(ruleConfig.Sources); err != nil {
+		return nil, err
+	}
+	if rule.Destinations, err = m.groupNames.idList(ruleConfig.Destinations); err != nil {
+		return nil, err
+	}
+	if rule.SourceResource, err = st.resolveResourceRef(ruleConfig.SourceResource, m); err != nil {
+		return nil, err
+	}
+	if rule.DestinationResource, err = st.resolveResourceRef(ruleConfig.DestinationResource, m); err != nil {
+		return nil, err
+	}
+	if len(rule.Sources) == 0 && rule.SourceResource.ID == "" && len(rule.SourceLabels) == 0 {
+		return nil, fmt.Errorf("sources, source labels or a source resource are required")
+	}
+	if len(rule.Destinations) == 0 && rule.DestinationResource.ID == "" && len(rule.DestinationLabels) == 0 {
+		return nil, fmt.Errorf("destinations, destination labels or a destination resource are required")
+	}
+	if err = nbpeer.ValidateLabels(rule.SourceLabels); err != nil {
+		return nil, fmt.Errorf("invalid source labels: %w", err)
+	}
+	if err = nbpeer.ValidateLabels(rule.DestinationLabels); err != nil {
+		return nil, fmt.Errorf("invalid destination labels: %w", err)
+	}
+
+	for groupName, users := range ruleConfig.AuthorizedGroups {
+		groupID, err := m.groupNames.id(groupName)
+		if err != nil {
+			return nil, err
+		}
+		if rule.AuthorizedGroups == nil {
+			rule.AuthorizedGroups = make(map[string][]string)
+		}
+		rule.AuthorizedGroups[groupID] = users
+	}
+
+	return rule, nil
+}
+
+func (st *state) resolveResourceRef(ref *ResourceRefConfig, m *models) (types.Resource, error) {
+	if ref == nil {
+		return types.Resource{}, nil
+	}
+
+	if ref.Type == string(types.ResourceTypePeer) {
+		id, err := st.peerNames.id(ref.Name)
+		if err != nil {
+			return types.Resource{}, err
+		}
+		return types.Resource{ID: id, Type: types.ResourceTypePeer}, nil
+	}
+
+	resource, ok := m.resources[ref.Name]
+	if !ok {
+		return types.Resource{}, fmt.Errorf("network resource %q not found", ref.Name)
+	}
+	if ref.Type != resource.Type.String() {
+		return types.Resource{}, fmt.Errorf("network resource %q is of type %s, not %s", ref.Name, resource.Type, ref.Type)
+	}
+	return types.Resource{ID: resource.ID, Type: types.ResourceType(resource.Type.String())}, nil
+}
+
+func (st *state) buildRoute(routeConfig RouteConfig, m *models) (*route.Route, error) {
+	r := &route.Route{
+		ID:                 route.ID(xid.New().String()),
+		AccountID:          st.accountID,
+		PublicID:           xid.New().String(),
+		NetID:              route.NetID(routeConfig.NetworkID),
+		Description:        routeConfig.Description,
+		KeepRoute:          routeConfig.KeepRoute,
+		Masquerade:         routeConfig.Masquerade,
+		Metric:             routeConfig.Metric,
+		Enabled:            routeConfig.Enabled,
+		SkipAutoApply:      routeConfig.SkipAutoApply,
+		DistributionLabels: routeConfig.DistributionLabels,
+	}
+
+	if r.NetID == "" {
+		return nil, fmt.Errorf("network ID is required")
+	}
+	if r.Metric < route.MinMetric || r.Metric > route.MaxMetric {
+		return nil, fmt.Errorf("metric should be between %d and %d", route.MinMetric, route.MaxMetric)
+	}
+
+	switch {
+	case routeConfig.Network != "" && len(routeConfig.Domains) > 0:
+		return nil, fmt.Errorf("network and domains are mutually exclusive")
+	case routeConfig.Network != "":
+		networkType, prefix, err := route.ParseNetwork(routeConfig.Network)
+		if err != nil {
+			return nil, err
+		}
+		r.NetworkType = networkType
+		r.Network = prefix
+	case len(routeConfig.Domains) > 0:
+		domains, err := domain.ValidateDomains(routeConfig.Domains)
+		if err != nil {
+			return nil, fmt.Errorf("invalid domains: %w", err)
+		}
+		r.NetworkType = route.DomainNetwork
+		r.Domains = domains
+		// domain routes use a placeholder network, like the routes created through the API
+		r.Network = netip.PrefixFrom(netip.AddrFrom4([4]byte{192, 0, 2, 0}), 32)
+	default:
+		return nil, fmt.Errorf("network or domains are required")
+	}
+
+	if (routeConfig.Peer == "") == (len(routeConfig.PeerGroups) == 0) {
+		return nil, fmt.Errorf("exactly one of peer and peer_groups is required")
+	}
+	var err error
+	if routeConfig.Peer != "" {
+		if r.Peer, err = st.peerNames.id(routeConfig.Peer); err != nil {
+			return nil, err
+		}
+	}
+	if r.PeerGroups, err = m.groupNames.idList(routeConfig.PeerGroups); err != nil {
+		return nil, err
+	}
+	if len(routeConfig.Groups) == 0 && len(routeConfig.DistributionLabels) == 0 {
+		return nil, fmt.Errorf("at least one distribution group or distribution label is required")
+	}
+	if err = nbpeer.ValidateLabels(routeConfig.DistributionLabels); err != nil {
+		return nil, fmt.Errorf("invalid distribution labels: %w", err)
+	}
+	if r.Groups, err = m.groupNames.idList(routeConfig.Groups); err != nil {
+		return nil, err
+	}
+	if r.AccessControlGroups, err = m.groupNames.idList(routeConfig.AccessControlGroups); err != nil {
+		return nil, err
+	}
+	if len(r.AccessControlGroups) == 0 {
+		r.AccessControlGroups = nil
+	}
+
+	for _, existing := range st.routes {
+		if existing.NetID == r.NetID && existing.Peer == r.Peer && slices.Equal(sortedSet(existing.PeerGroups), sortedSet(r.PeerGroups)) {
+			r.ID = existing.ID
+			r.PublicID = existing.PublicID
+			break
+		}
+	}
+	return r, nil
+}
+
+func (st *state) buildNameserverGroup(nsConfig NameserverGroupConfig, m *models) (*dns.NameServerGroup, error) {
+	groups, err := m.groupNames.idList(nsConfig.Groups)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("at least one distribution group is required")
+	}
+	if len(nsConfig.Nameservers) == 0 {
+		return nil, fmt.Errorf("at least one nameserver is required")
+	}
+	if nsConfig.Primary == (len(nsConfig.Domains) > 0) {
+		return nil, fmt.Errorf("a nameserver group is either primary or has match domains")
+	}
+
+	nsGroup := &dns.NameServerGroup{
+		ID:                   xid.New().String(),
+		AccountID:            st.accountID,
+		PublicID:             xid.New().String(),
+		Name:                 nsConfig.Name,
+		Description:          nsConfig.Description,
+		Groups:               groups,
+		Primary:              nsConfig.Primary,
+		Domains:              nsConfig.Domains,
+		Enabled:              nsConfig.Enabled,
+		SearchDomainsEnabled: nsConfig.SearchDomainsEnabled,
+	}
+	for _, ns := range nsConfig.Nameservers {
+		ip, err := netip.ParseAddr(ns.IP)
+		if err != nil {
+			return nil, fmt.Errorf("invalid nameserver IP %q", ns.IP)
+		}
+		nsType := dns.ToNameServerType(ns.NSType)
+		if nsType == dns.InvalidNameServerType {
+			return nil, fmt.Errorf("invalid nameserver type %q", ns.NSType)
+		}
+		if ns.Port <= 0 || ns.Port > 65535 {
+			return nil, fmt.Errorf("invalid nameserver port %d", ns.Port)
+		}
+		nsGroup.NameServers = append(nsGroup.NameServers, dns.NameServer{IP: ip, NSType: nsType, Port: ns.Port})
+	}
+
+	if idx := slices.IndexFunc(st.nsGroups, func(n *dns.NameServerGroup) bool { return n.Name == nsConfig.Name }); idx >= 0 {
+		nsGroup.ID = st.nsGroups[idx].ID
+		nsGroup.PublicID = st.nsGroups[idx].PublicID
+	}
+	return nsGroup, nil
+}
+
+func (st *state) buildZone(zoneConfig DNSZoneConfig, m *models) (*zones.Zone, error) {
+	groups, err := m.groupNames.idList(zoneConfig.DistributionGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	zone := zones.NewZone(st.accountID, zoneConfig.Name, zoneConfig.Domain, zoneConfig.Enabled, zoneConfig.EnableSearchDomain, groups)
+	if idx := slices.IndexFunc(st.zones, func(z *zones.Zone) bool { return z.Domain == zoneConfig.Domain }); idx >= 0 {
+		zone.ID = st.zones[idx].ID
+	}
+	if err := zone.Validate(); err != nil {
+		return nil, err
+	}
+
+	for _, recordConfig := range zoneConfig.Records {
+		record := records.NewRecord(st.accountID, zone.ID, recordConfig.Name, records.RecordType(recordConfig.Type), recordConfig.Content, recordConfig.TTL)
+		if err := record.Validate(); err != nil {
+			return nil, fmt.Errorf("record %s: %w", recordConfig.Name, err)
+		}
+		if record.Name != zone.Domain && !strings.HasSuffix(record.Name, "."+zone.Domain) {
+			return nil, fmt.Errorf("record %s does not belong to the zone", recordConfig.Name)
+		}
+		zone.Records = append(zone.Records, record)
+	}
+	return zone, nil
+}
@@ -87,4 +87,6 @@ func init() {
 	ac.PersistentFlags().StringVar(&nbconfig.MgmtConfigPath, "config", defaultMgmtConfig, "Netbird config file location")
 	rootCmd.AddCommand(ac)
 	rootCmd.AddCommand(newLegacyTokenCommand())
+	rootCmd.AddCommand(newConfigCommands())
+	rootCmd.AddCommand(newStoreCommands())
 }
@@ -0,0 +1,230 @@
+// Package storecmd provides cobra commands to maintain the management store, such as moving it between
+// the supported SQL engines.
+// Both the management and combined binaries use these commands, each providing
+// their own Opener to handle config loading.
+package storecmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	activitystore "github.com/netbirdio/netbird/management/server/activity/store"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+const defaultBatchSize = 500
+
+// Environment holds the server settings the store commands need.
+type Environment struct {
+	// Datadir is the data directory SQLite database files are resolved against.
+	Datadir string
+	// EncryptionKey is the data store encryption key the activity event store is opened with.
+	EncryptionKey string
+}
+
+// Opener loads the server configuration from the command context and calls fn.
+type Opener func(cmd *cobra.Command, fn func(ctx context.Context, env Environment) error) error
+
+type migrateOptions struct {
+	from          string
+	to            string
+	fromDSN       string
+	toDSN         string
+	eventsFrom    string
+	eventsTo      string
+	eventsFromDSN string
+	eventsToDSN   string
+	skipEvents    bool
+	batchSize     int
+}
+
+// NewCommands creates the store command tree with the given opener.
+// Returns the parent "store" command with the migrate subcommand.
+func NewCommands(opener Opener) *cobra.Command {
+	storeCmd := &cobra.Command{
+		Use:   "store",
+		Short: "Manage the management store",
+	}
+
+	var opts migrateOptions
+	migrateCmd := &cobra.Command{
+		Use:   "migrate --from <engine> --to <engine>",
+		Short: "Copy the store to another database engine",
+		Long: "Copies every table of the management store and of the activity event store from one engine to another, " +
+			"for example from sqlite to postgres. Supported engines are sqlite, postgres and mysql, the event store does not support mysql. " +
+			"Rows are copied in batches and every table is verified by row count and checksum.\n\n" +
+			"The management server must be stopped, the command refuses to run while another process uses either store. " +
+			"The destination must be empty. SQLite databases default to the files in the data directory, PostgreSQL and MySQL " +
+			"connection strings default to the same environment variables the server reads. " +
+			"After a successful migration, configure the server to use the destination engine before starting it again.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opener(cmd, func(ctx context.Context, env Environment) error {
+				return runMigrate(ctx, cmd.OutOrStdout(), env, opts)
+			})
+		},
+	}
+	migrateCmd.Flags().StringVar(&opts.from, "from", "", "Engine of the current store: sqlite, postgres or mysql (required)")
+	migrateCmd.Flags().StringVar(&opts.to, "to", "", "Engine to copy the store to: sqlite, postgres or mysql (required)")
+	migrateCmd.Flags().StringVar(&opts.fromDSN, "from-dsn", "", "Connection string or SQLite file of the current store")
+	migrateCmd.Flags().StringVar(&opts.toDSN, "to-dsn", "", "Connection string or SQLite file of the destination store")
+	migrateCmd.Flags().StringVar(&opts.eventsFrom, "events-from", "", "Engine of the current activity event store, defaults to --from")
+	migrateCmd.Flags().StringVar(&opts.eventsTo, "events-to", "", "Engine to copy the activity event store to, defaults to --to")
+	migrateCmd.Flags().StringVar(&opts.eventsFromDSN, "events-from-dsn", "", "Connection string or SQLite file of the current activity event store")
+	migrateCmd.Flags().StringVar(&opts.eventsToDSN, "events-to-dsn", "", "Connection string or SQLite file of the destination activity event store")
+	migrateCmd.Flags().BoolVar(&opts.skipEvents, "skip-events", false, "Do not copy the activity event store")
+	migrateCmd.Flags().IntVar(&opts.batchSize, "batch-size", defaultBatchSize, "Number of rows read and written at once")
+	for _, name := range []string{"from", "to"} {
+		if err := migrateCmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	storeCmd.AddCommand(migrateCmd)
+	return storeCmd
+}
+
+func runMigrate(ctx context.Context, w io.Writer, env Environment, opts migrateOptions) error {
+	if opts.batchSize <= 0 {
+		return fmt.Errorf("--batch-size must be positive")
+	}
+
+	from, err := parseEngine(opts.from)
+	if err != nil {
+		return fmt.Errorf("--from: %w", err)
+	}
+	to, err := parseEngine(opts.to)
+	if err != nil {
+		return fmt.Errorf("--to: %w", err)
+	}
+
+	fromDSN, err := store.ResolveDSN(from, env.Datadir, opts.fromDSN)
+	if err != nil {
+		return fmt.Errorf("source store: %w", err)
+	}
+	toDSN, err := store.ResolveDSN(to, env.Datadir, opts.toDSN)
+	if err != nil {
+		return fmt.Errorf("destination store: %w", err)
+	}
+	if from == to && fromDSN == toDSN {
+		return fmt.Errorf("source and destination are the same database")
+	}
+
+	var eventsFrom, eventsTo types.Engine
+	if !opts.skipEvents {
+		if eventsFrom, eventsTo, err = eventEngines(opts, from, to); err != nil {
+			return err
+		}
+	}
+
+	if err := store.EnsureNotInUse(ctx, from, fromDSN); err != nil {
+		return fmt.Errorf("source store: %w", err)
+	}
+	if err := store.EnsureNotInUse(ctx, to, toDSN); err != nil {
+		return fmt.Errorf("destination store: %w", err)
+	}
+
+	src, err := store.OpenSqlStore(ctx, from, fromDSN, false)
+	if err != nil {
+		return fmt.Errorf("open source store: %w", err)
+	}
+	defer closeStore(ctx, src)
+
+	dst, err := store.OpenSqlStore(ctx, to, toDSN, false)
+	if err != nil {
+		return fmt.Errorf("open destination store: %w", err)
+	}
+	defer closeStore(ctx, dst)
+
+	if err := store.EnsureEmpty(ctx, dst.GetDB(), store.Models()); err != nil {
+		return err
+	}
+
+	var srcEvents, dstEvents *activitystore.Store
+	if !opts.skipEvents {
+		srcEvents, err = activitystore.NewSqlStoreWithEngine(ctx, eventsFrom, env.Datadir, opts.eventsFromDSN, env.EncryptionKey)
+		if err != nil {
+			return fmt.Errorf("open source event store: %w", err)
+		}
+		defer closeStore(ctx, srcEvents)
+
+		dstEvents, err = activitystore.NewSqlStoreWithEngine(ctx, eventsTo, env.Datadir, opts.eventsToDSN, env.EncryptionKey)
+		if err != nil {
+			return fmt.Errorf("open destination event store: %w", err)
+		}
+		defer closeStore(ctx, dstEvents)
+
+		if err := store.EnsureEmpty(ctx, dstEvents.GetDB(), activitystore.Models()); err != nil {
+			return fmt.Errorf("event store: %w", err)
+		}
+	}
+
+	var tables int
+	var rows int64
+	report := func(result store.TableMigrationResult) {
+		tables++
+		rows += result.Rows
+		_, _ = fmt.Fprintf(w, "  %-40s %10d rows  checksum %s\n", result.Table, result.Rows, result.Checksum[:16])
+	}
+
+	_, _ = fmt.Fprintf(w, "Copying store from %s to %s\n", from, to)
+	if _, err := store.CopyTables(ctx, src.GetDB(), dst.GetDB(), store.Models(), opts.batchSize, report); err != nil {
+		return fmt.Errorf("migrate store: %w", err)
+	}
+
+	if !opts.skipEvents {
+		_, _ = fmt.Fprintf(w, "Copying activity event store from %s to %s\n", eventsFrom, eventsTo)
+		if _, err := store.CopyTables(ctx, srcEvents.GetDB(), dstEvents.GetDB(), activitystore.Models(), opts.batchSize, report); err != nil {
+			return fmt.Errorf("migrate event store: %w", err)
+		}
+	}
+
+	_, _ = fmt.Fprintf(w, "Migration finished, %d rows in %d tables copied and verified.\n", rows, tables)
+	_, _ = fmt.Fprintf(w, "Set the store engine of the management server to %s before starting it.\n", to)
+	return nil
+}
+
+// eventEngines returns the engines of the activity event stores, which follow the store engines unless set.
+func eventEngines(opts migrateOptions, from, to types.Engine) (types.Engine, types.Engine, error) {
+	eventsFrom, eventsTo := from, to
+	var err error
+	if opts.eventsFrom != "" {
+		if eventsFrom, err = parseEngine(opts.eventsFrom); err != nil {
+			return "", "", fmt.Errorf("--events-from: %w", err)
+		}
+	}
+	if opts.eventsTo != "" {
+		if eventsTo, err = parseEngine(opts.eventsTo); err != nil {
+			return "", "", fmt.Errorf("--events-to: %w", err)
+		}
+	}
+
+	for _, engine := range []types.Engine{eventsFrom, eventsTo} {
+		if engine == types.MysqlStoreEngine {
+			return "", "", fmt.Errorf("the activity event store does not support mysql, set --events-from and --events-to or use --skip-events")
+		}
+	}
+	if eventsFrom == eventsTo && opts.eventsFromDSN == opts.eventsToDSN {
+		return "", "", fmt.Errorf("source and destination activity event stores are the same database, set --events-to-dsn or use --skip-events")
+	}
+	return eventsFrom, eventsTo, nil
+}
+
+func parseEngine(value string) (types.Engine, error) {
+	switch engine := types.Engine(value); engine {
+	case types.SqliteStoreEngine, types.PostgresStoreEngine, types.MysqlStoreEngine:
+		return engine, nil
+	default:
+		return "", fmt.Errorf("unsupported engine %q, expected sqlite, postgres or mysql", value)
+	}
+}
+
+func closeStore(ctx context.Context, s interface{ Close(context.Context) error }) {
+	if err := s.Close(ctx); err != nil {
+		log.Debugf("close store: %v", err)
+	}
+}
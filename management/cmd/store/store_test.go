@@ -0,0 +1,126 @@
+package storecmd
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	activitystore "github.com/netbirdio/netbird/management/server/activity/store"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/util/crypt"
+)
+
+func TestRunMigrate(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The SQLite store is not properly supported by Windows yet")
+	}
+	t.Setenv("NETBIRD_STORE_ENGINE", string(types.SqliteStoreEngine))
+
+	ctx := context.Background()
+	key, err := crypt.GenerateKey()
+	require.NoError(t, err)
+	srcDir := t.TempDir()
+	src, cleanUp, err := store.NewTestStoreFromSQL(ctx, "../../server/testdata/store.sql", srcDir)
+	require.NoError(t, err)
+	t.Cleanup(cleanUp)
+
+	events, err := activitystore.NewSqlStoreWithEngine(ctx, types.SqliteStoreEngine, srcDir, "", key)
+	require.NoError(t, err)
+	_, err = events.Save(ctx, &activity.Event{
+		InitiatorID: "edafee4e-63fb-11ec-90d6-0242ac120003",
+		TargetID:    "edafee4e-63fb-11ec-90d6-0242ac120003",
+		AccountID:   "bf1c8084-ba50-4ce7-9439-34653001fc3b",
+		Activity:    activity.UserJoined,
+	})
+	require.NoError(t, err)
+	require.NoError(t, events.Close(ctx))
+
+	dstDir := t.TempDir()
+	env := Environment{Datadir: srcDir, EncryptionKey: key}
+	opts := migrateOptions{
+		from:        "sqlite",
+		to:          "sqlite",
+		toDSN:       filepath.Join(dstDir, "store.db"),
+		eventsToDSN: filepath.Join(dstDir, "events.db"),
+		batchSize:   2,
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, runMigrate(ctx, &out, env, opts))
+	assert.Contains(t, out.String(), "accounts")
+	assert.Contains(t, out.String(), "events")
+	assert.Contains(t, out.String(), "Migration finished")
+
+	dst, err := store.NewSqliteStore(ctx, dstDir, nil, true)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = dst.Close(ctx) })
+	want, err := src.GetAccount(ctx, "bf1c8084-ba50-4ce7-9439-34653001fc3b")
+	require.NoError(t, err)
+	got, err := dst.GetAccount(ctx, "bf1c8084-ba50-4ce7-9439-34653001fc3b")
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	dstEvents, err := activitystore.NewSqlStoreWithEngine(ctx, types.SqliteStoreEngine, dstDir, "", key)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = dstEvents.Close(ctx) })
+	copied, err := dstEvents.Get(ctx, "bf1c8084-ba50-4ce7-9439-34653001fc3b", 0, 10, false)
+	require.NoError(t, err)
+	require.Len(t, copied, 1)
+	assert.Equal(t, activity.UserJoined, copied[0].Activity)
+
+	err = runMigrate(ctx, &out, env, opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "destination is not empty")
+}
+
+func TestRunMigrateRejectsInvalidOptions(t *testing.T) {
+	ctx := context.Background()
+	env := Environment{Datadir: t.TempDir()}
+
+	tests := []struct {
+		name string
+		opts migrateOptions
+		err  string
+	}{
+		{
+			name: "unknown engine",
+			opts: migrateOptions{from: "sqlite", to: "oracle", batchSize: 1},
+			err:  "unsupported engine",
+		},
+		{
+			name: "same database",
+			opts: migrateOptions{from: "sqlite", to: "sqlite", batchSize: 1},
+			err:  "same database",
+		},
+		{
+			name: "same event store",
+			opts: migrateOptions{from: "sqlite", to: "sqlite", toDSN: "other.db", batchSize: 1},
+			err:  "activity event stores are the same",
+		},
+		{
+			name: "mysql event store",
+			opts: migrateOptions{from: "sqlite", to: "mysql", toDSN: "user:pass@tcp(localhost:3306)/netbird", batchSize: 1},
+			err:  "does not support mysql",
+		},
+		{
+			name: "batch size",
+			opts: migrateOptions{from: "sqlite", to: "postgres", batchSize: 0},
+			err:  "--batch-size",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runMigrate(ctx, &bytes.Buffer{}, env, tt.opts)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/netbirdio/netbird/management/internals/modules/peers/ephemeral"
 	"github.com/netbirdio/netbird/management/internals/server/config"
 	"github.com/netbirdio/netbird/management/internals/shared/grpc"
+	"github.com/netbirdio/netbird/management/internals/shared/updatebus"
 	"github.com/netbirdio/netbird/management/server/account"
 	"github.com/netbirdio/netbird/management/server/integrations/integrated_validator"
 	"github.com/netbirdio/netbird/management/server/integrations/port_forwarding"
@@ -61,6 +62,9 @@ type Controller struct {
 	serverSupportedSyncMessageVersion sharedgrpc.SyncMessageVersion
 
 	perAccountServerSupportedSyncMessageVersions map[string]sharedgrpc.SyncMessageVersion
+
+	// updateBus fans updates out to the other management instances, nil when running a single instance.
+	updateBus updatebus.Bus
 }
 
 type bufferUpdate struct {
@@ -175,6 +179,7 @@ func (c *Controller) sendUpdateAccountPeers(ctx context.Context, accountID strin
 	var wg sync.WaitGroup
 	semaphore := make(chan struct{}, 10)
 
+	account.ApplyEffectiveAccess(time.Now())
 	c.injectAllProxyPolicies(ctx, account)
 	account.PrecomputePostureValidation(ctx)
 	dnsCache := &cache.DNSConfigCache{}
@@ -307,6 +312,7 @@ func (c *Controller) UpdateAccountPeers(ctx context.Context, accountID string, r
 	if c.accountManagerMetrics != nil {
 		c.accountManagerMetrics.CountUpdateAccountPeersTriggered(string(reason.Resource), string(reason.Operation))
 	}
+	c.publishAccountPeers(ctx, accountID, reason)
 	return c.sendUpdateAccountPeers(ctx, accountID, reason)
 }
 
@@ -315,6 +321,7 @@ func (c *Controller) UpdateAffectedPeers(ctx context.Context, accountID string,
 	if len(peerIDs) == 0 {
 		return nil
 	}
+	c.publishAffectedPeers(ctx, accountID, peerIDs, types.UpdateReason{})
 	return c.sendUpdateForAffectedPeers(ctx, accountID, peerIDs)
 }
 
@@ -357,6 +364,7 @@ func (c *Controller) sendUpdateForAffectedPeers(ctx context.Context, accountID s
 	// (proxy restart, embedded peer connect/disconnect) propagated a
 	// network map that omitted the synth DNS zone, and the agent kept
 	// resolving against the stale or absent record.
+	account.ApplyEffectiveAccess(time.Now())
 	c.injectAllProxyPolicies(ctx, account)
 	account.PrecomputePostureValidation(ctx)
 	dnsCache := &cache.DNSConfigCache{}
@@ -491,7 +499,17 @@ func (c *Controller) filterConnectedAffectedPeers(account *types.Account, peerID
 	return result
 }
 
+// UpdateAccountPeer updates a single peer. When the peer is not connected to this instance the update is
+// handed to the other instances on the update bus.
 func (c *Controller) UpdateAccountPeer(ctx context.Context, accountId string, peerId string) error {
+	if c.updateBus != nil && !c.peersUpdateManager.HasChannel(peerId) {
+		c.publish(ctx, &updatebus.Message{Type: updatebus.MessagePeer, AccountID: accountId, PeerIDs: []string{peerId}})
+		return nil
+	}
+	return c.updateAccountPeer(ctx, accountId, peerId)
+}
+
+func (c *Controller) updateAccountPeer(ctx context.Context, accountId string, peerId string) error {
 	if !c.peersUpdateManager.HasChannel(peerId) {
 		return fmt.Errorf("peer %s doesn't have a channel, skipping network map update", peerId)
 	}
@@ -511,6 +529,7 @@ func (c *Controller) UpdateAccountPeer(ctx context.Context, accountId string, pe
 		return fmt.Errorf("failed to get validated peers: %v", err)
 	}
 
+	account.ApplyEffectiveAccess(time.Now())
 	c.injectAllProxyPolicies(ctx, account)
 	dnsCache := &cache.DNSConfigCache{}
 	dnsDomain := c.GetDNSDomain(account.Settings)
@@ -600,6 +619,11 @@ func (c *Controller) BufferUpdateAccountPeers(ctx context.Context, accountID str
 		c.accountManagerMetrics.CountUpdateAccountPeersTriggered(string(reason.Resource), string(reason.Operation))
 	}
 
+	c.publishAccountPeers(ctx, accountID, reason)
+	return c.bufferUpdateAccountPeers(ctx, accountID, reason)
+}
+
+func (c *Controller) bufferUpdateAccountPeers(ctx context.Context, accountID string, reason types.UpdateReason) error {
 	bufUpd, _ := c.accountUpdateLocks.LoadOrStore(accountID, &bufferUpdate{})
 	b := bufUpd.(*bufferUpdate)
 
@@ -651,6 +675,7 @@ func (c *Controller) GetValidatedPeerWithComponents(ctx context.Context, isRequi
 		return nil, nil, nil, nil, 0, err
 	}
 
+	account.ApplyEffectiveAccess(time.Now())
 	c.injectAllProxyPolicies(ctx, account)
 
 	approvedPeersMap, err := c.integratedPeerValidator.GetValidatedPeers(ctx, account.Id, maps.Values(account.Groups), maps.Values(account.Peers), account.Settings.Extra)
@@ -702,6 +727,11 @@ func (c *Controller) BufferUpdateAffectedPeers(ctx context.Context, accountID st
 
 	log.WithContext(ctx).Tracef("buffer updating %d affected peers for account %s from %s with reason %s/%s", len(peerIDs), accountID, util.GetCallerName(), reason.Operation, reason.Resource)
 
+	c.publishAffectedPeers(ctx, accountID, peerIDs, reason)
+	return c.bufferUpdateAffectedPeers(ctx, accountID, peerIDs)
+}
+
+func (c *Controller) bufferUpdateAffectedPeers(ctx context.Context, accountID string, peerIDs []string) error {
 	bufUpd, _ := c.affectedPeerUpdateLocks.LoadOrStore(accountID, &bufferAffectedUpdate{
 		peerIDs: make(map[string]struct{}),
 	})
@@ -806,6 +836,7 @@ func (c *Controller) GetValidatedPeerWithMap(ctx context.Context, isRequiresAppr
 		return nil, nil, 0, err
 	}
 
+	account.ApplyEffectiveAccess(time.Now())
 	c.injectAllProxyPolicies(ctx, account)
 
 	approvedPeersMap, err := c.integratedPeerValidator.GetValidatedPeers(ctx, account.Id, maps.Values(account.Groups), maps.Values(account.Peers), account.Settings.Extra)
@@ -1001,6 +1032,23 @@ func (c *Controller) OnPeersAdded(ctx context.Context, accountID string, peerIDs
 }
 
 func (c *Controller) OnPeersDeleted(ctx context.Context, accountID string, peerIDs []string, affectedPeerIDs []string) error {
+	if len(peerIDs) > 0 {
+		c.publish(ctx, &updatebus.Message{Type: updatebus.MessagePeersDeleted, AccountID: accountID, PeerIDs: peerIDs})
+	}
+
+	if err := c.closeDeletedPeers(ctx, accountID, peerIDs); err != nil {
+		return err
+	}
+
+	if len(affectedPeerIDs) == 0 {
+		log.WithContext(ctx).Tracef("no affected peers for peer delete in account %s, skipping", accountID)
+		return nil
+	}
+	return c.BufferUpdateAffectedPeers(ctx, accountID, affectedPeerIDs, types.UpdateReason{Resource: types.UpdateResourcePeer, Operation: types.UpdateOperationDelete})
+}
+
+// closeDeletedPeers sends an empty network map to the deleted peers and closes their update channels.
+func (c *Controller) closeDeletedPeers(ctx context.Context, accountID string, peerIDs []string) error {
 	network, err := c.repo.GetAccountNetwork(ctx, accountID)
 	if err != nil {
 		return err
@@ -1033,11 +1081,7 @@ func (c *Controller) OnPeersDeleted(ctx context.Context, accountID string, peerI
 		c.peersUpdateManager.CloseChannel(ctx, peerID)
 	}
 
-	if len(affectedPeerIDs) == 0 {
-		log.WithContext(ctx).Tracef("no affected peers for peer delete in account %s, skipping", accountID)
-		return nil
-	}
-	return c.BufferUpdateAffectedPeers(ctx, accountID, affectedPeerIDs, types.UpdateReason{Resource: types.UpdateResourcePeer, Operation: types.UpdateOperationDelete})
+	return nil
 }
 
 // GetNetworkMap returns Network map for a given peer (omits original peer from the Peers result)
@@ -1077,6 +1121,7 @@ func (c *Controller) GetNetworkMap(ctx context.Context, peerID string) (*types.N
 		return nil, err
 	}
 
+	account.ApplyEffectiveAccess(time.Now())
 	c.injectAllProxyPolicies(ctx, account)
 	resourcePolicies := account.GetResourcePoliciesMap()
 	routers := account.GetResourceRoutersMap()
@@ -1092,6 +1137,7 @@ func (c *Controller) GetNetworkMap(ctx context.Context, peerID string) (*types.N
 }
 
 func (c *Controller) DisconnectPeers(ctx context.Context, accountId string, peerIDs []string) {
+	c.publish(ctx, &updatebus.Message{Type: updatebus.MessageDisconnectPeers, AccountID: accountId, PeerIDs: peerIDs})
 	c.peersUpdateManager.CloseChannels(ctx, peerIDs)
 }
 
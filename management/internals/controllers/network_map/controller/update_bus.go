@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/internals/shared/updatebus"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// SetUpdateBus connects the controller to the other management instances. Every update triggered on this
+// instance is published on the bus, and updates published by the other instances are pushed to the peers
+// connected to this one. It must be called before the controller starts serving peers.
+func (c *Controller) SetUpdateBus(bus updatebus.Bus) {
+	c.updateBus = bus
+	bus.Subscribe(c.handleBusMessage)
+}
+
+func (c *Controller) publish(ctx context.Context, msg *updatebus.Message) {
+	if c.updateBus == nil {
+		return
+	}
+	if err := c.updateBus.Publish(ctx, msg); err != nil {
+		log.WithContext(ctx).Errorf("failed to publish %s update for account %s: %v", msg.Type, msg.AccountID, err)
+	}
+}
+
+func (c *Controller) publishAccountPeers(ctx context.Context, accountID string, reason types.UpdateReason) {
+	c.publish(ctx, &updatebus.Message{
+		Type:      updatebus.MessageAccountPeers,
+		AccountID: accountID,
+		Resource:  string(reason.Resource),
+		Operation: string(reason.Operation),
+	})
+}
+
+// publishAffectedPeers publishes the affected peers, falling back to updating every peer of the account
+// when the list does not fit into a single message.
+func (c *Controller) publishAffectedPeers(ctx context.Context, accountID string, peerIDs []string, reason types.UpdateReason) {
+	if c.updateBus == nil {
+		return
+	}
+
+	err := c.updateBus.Publish(ctx, &updatebus.Message{
+		Type:      updatebus.MessageAffectedPeers,
+		AccountID: accountID,
+		PeerIDs:   peerIDs,
+		Resource:  string(reason.Resource),
+		Operation: string(reason.Operation),
+	})
+	if errors.Is(err, updatebus.ErrMessageTooLarge) {
+		c.publishAccountPeers(ctx, accountID, reason)
+		return
+	}
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to publish %s update for account %s: %v", updatebus.MessageAffectedPeers, accountID, err)
+	}
+}
+
+// handleBusMessage applies an update published by another instance to the peers connected to this one,
+// without publishing it again.
+func (c *Controller) handleBusMessage(ctx context.Context, msg *updatebus.Message) {
+	reason := types.UpdateReason{Resource: types.UpdateResource(msg.Resource), Operation: types.UpdateOperation(msg.Operation)}
+
+	switch msg.Type {
+	case updatebus.MessageAccountPeers:
+		_ = c.bufferUpdateAccountPeers(ctx, msg.AccountID, reason)
+	case updatebus.MessageAffectedPeers:
+		if c.hasConnectedPeers(msg.PeerIDs) {
+			_ = c.bufferUpdateAffectedPeers(ctx, msg.AccountID, msg.PeerIDs)
+		}
+	case updatebus.MessagePeer:
+		for _, peerID := range c.connectedPeers(msg.PeerIDs) {
+			if err := c.updateAccountPeer(ctx, msg.AccountID, peerID); err != nil {
+				log.WithContext(ctx).Errorf("failed to update peer %s from instance %s: %v", peerID, msg.Source, err)
+			}
+		}
+	case updatebus.MessagePeersDeleted:
+		connected := c.connectedPeers(msg.PeerIDs)
+		if len(connected) == 0 {
+			return
+		}
+		if err := c.closeDeletedPeers(ctx, msg.AccountID, connected); err != nil {
+			log.WithContext(ctx).Errorf("failed to close deleted peers of account %s: %v", msg.AccountID, err)
+		}
+	case updatebus.MessageDisconnectPeers:
+		c.peersUpdateManager.CloseChannels(ctx, msg.PeerIDs)
+	}
+}
+
+func (c *Controller) connectedPeers(peerIDs []string) []string {
+	var connected []string
+	for _, id := range peerIDs {
+		if c.peersUpdateManager.HasChannel(id) {
+			connected = append(connected, id)
+		}
+	}
+	return connected
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/internals/controllers/network_map/update_channel"
+	"github.com/netbirdio/netbird/management/internals/shared/updatebus"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func newBusController(bus updatebus.Bus) (*Controller, *update_channel.PeersUpdateManager) {
+	updateManager := update_channel.NewPeersUpdateManager(nil)
+	c := &Controller{peersUpdateManager: updateManager}
+	c.SetUpdateBus(bus)
+	return c, updateManager
+}
+
+type recordingBus struct {
+	published []*updatebus.Message
+}
+
+func (b *recordingBus) InstanceID() string { return "recording" }
+
+func (b *recordingBus) Publish(_ context.Context, msg *updatebus.Message) error {
+	if msg.Type == updatebus.MessageAffectedPeers {
+		return updatebus.ErrMessageTooLarge
+	}
+	b.published = append(b.published, msg)
+	return nil
+}
+
+func (b *recordingBus) Subscribe(updatebus.Handler) {}
+
+func (b *recordingBus) Close() error { return nil }
+
+func TestController_DisconnectPeersOnOtherInstance(t *testing.T) {
+	hub := updatebus.NewHub()
+	busA, busB := hub.NewBus("a"), hub.NewBus("b")
+	t.Cleanup(func() {
+		_ = busA.Close()
+		_ = busB.Close()
+	})
+
+	ctx := context.Background()
+	controllerA, _ := newBusController(busA)
+	_, updateManagerB := newBusController(busB)
+	updateManagerB.CreateChannel(ctx, "peer1")
+	updateManagerB.CreateChannel(ctx, "peer2")
+
+	controllerA.DisconnectPeers(ctx, "account", []string{"peer1"})
+
+	require.Eventually(t, func() bool {
+		return !updateManagerB.HasChannel("peer1")
+	}, time.Second, 10*time.Millisecond)
+	assert.True(t, updateManagerB.HasChannel("peer2"))
+}
+
+func TestController_UpdateAccountPeerOnOtherInstance(t *testing.T) {
+	hub := updatebus.NewHub()
+	busA, busB := hub.NewBus("a"), hub.NewBus("b")
+	t.Cleanup(func() {
+		_ = busA.Close()
+		_ = busB.Close()
+	})
+
+	received := make(chan *updatebus.Message, 1)
+	busB.Subscribe(func(_ context.Context, msg *updatebus.Message) {
+		received <- msg
+	})
+
+	controllerA, _ := newBusController(busA)
+	require.NoError(t, controllerA.UpdateAccountPeer(context.Background(), "account", "peer1"))
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, updatebus.MessagePeer, msg.Type)
+		assert.Equal(t, "account", msg.AccountID)
+		assert.Equal(t, []string{"peer1"}, msg.PeerIDs)
+	case <-time.After(time.Second):
+		t.Fatal("peer update was not published")
+	}
+}
+
+func TestController_PublishAffectedPeersFallsBackToAccount(t *testing.T) {
+	bus := &recordingBus{}
+	c, _ := newBusController(bus)
+
+	reason := types.UpdateReason{Resource: types.UpdateResourcePeer, Operation: types.UpdateOperationUpdate}
+	c.publishAffectedPeers(context.Background(), "account", []string{"peer1"}, reason)
+
+	require.Len(t, bus.published, 1)
+	assert.Equal(t, updatebus.MessageAccountPeers, bus.published[0].Type)
+	assert.Equal(t, "account", bus.published[0].AccountID)
+	assert.Equal(t, string(types.UpdateResourcePeer), bus.published[0].Resource)
+	assert.Equal(t, string(types.UpdateOperationUpdate), bus.published[0].Operation)
+}
@@ -0,0 +1,13 @@
+package customroles
+
+import (
+	"context"
+)
+
+type Manager interface {
+	GetAllRoles(ctx context.Context, accountID, userID string) ([]*Role, error)
+	GetRole(ctx context.Context, accountID, userID, roleID string) (*Role, error)
+	CreateRole(ctx context.Context, accountID, userID string, role *Role) (*Role, error)
+	UpdateRole(ctx context.Context, accountID, userID string, role *Role) (*Role, error)
+	DeleteRole(ctx context.Context, accountID, userID, roleID string) error
+}
@@ -0,0 +1,173 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/internals/modules/customroles"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+const (
+	testAccountID = "account-1"
+	ownerUserID   = "owner-1"
+	adminUserID   = "admin-1"
+	regularUserID = "user-1"
+)
+
+func setupTest(t *testing.T) (*managerImpl, store.Store, *[]activity.ActivityDescriber) {
+	t.Helper()
+
+	ctx := context.Background()
+	testStore, cleanup, err := store.NewTestStoreFromSQL(ctx, "", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	account := &types.Account{
+		Id:       testAccountID,
+		Settings: &types.Settings{},
+		Users: map[string]*types.User{
+			ownerUserID:   {Id: ownerUserID, AccountID: testAccountID, Role: types.UserRoleOwner},
+			adminUserID:   {Id: adminUserID, AccountID: testAccountID, Role: types.UserRoleAdmin},
+			regularUserID: {Id: regularUserID, AccountID: testAccountID, Role: types.UserRoleUser},
+		},
+	}
+	require.NoError(t, testStore.SaveAccount(ctx, account))
+
+	var events []activity.ActivityDescriber
+	accountManager := &mock_server.MockAccountManager{
+		StoreEventFunc: func(_ context.Context, _, _, _ string, activityID activity.ActivityDescriber, _ map[string]any) {
+			events = append(events, activityID)
+		},
+	}
+
+	return &managerImpl{
+		store:              testStore,
+		accountManager:     accountManager,
+		permissionsManager: permissions.NewManager(testStore),
+	}, testStore, &events
+}
+
+func TestManager_RoleLifecycle(t *testing.T) {
+	ctx := context.Background()
+	m, testStore, events := setupTest(t)
+
+	created, err := m.CreateRole(ctx, testAccountID, adminUserID, &customroles.Role{
+		Name: "Network Operator",
+		Permissions: roles.Permissions{
+			modules.Routes:       {operations.Read: true, operations.Update: true},
+			modules.AgentNetwork: {operations.Read: true},
+		},
+		JWTGroups: []string{"operators"},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, testAccountID, created.AccountID)
+
+	_, err = m.CreateRole(ctx, testAccountID, adminUserID, &customroles.Role{
+		Name:        "network operator",
+		Permissions: roles.Permissions{modules.Routes: {operations.Read: true}},
+	})
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.AlreadyExists, sErr.Type(), "role names are unique per account")
+
+	updated, err := m.UpdateRole(ctx, testAccountID, adminUserID, &customroles.Role{
+		ID:          created.ID,
+		Name:        "Route Operator",
+		Permissions: roles.Permissions{modules.Routes: {operations.Read: true}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Route Operator", updated.Name)
+	assert.Empty(t, updated.JWTGroups)
+
+	all, err := m.GetAllRoles(ctx, testAccountID, adminUserID)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+
+	user, err := testStore.GetUserByUserID(ctx, store.LockingStrengthNone, regularUserID)
+	require.NoError(t, err)
+	user.Role = types.UserRole(created.ID)
+	require.NoError(t, testStore.SaveUser(ctx, user))
+
+	err = m.DeleteRole(ctx, testAccountID, adminUserID, created.ID)
+	sErr, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.PreconditionFailed, sErr.Type(), "assigned roles can't be deleted")
+
+	user.Role = types.UserRoleUser
+	require.NoError(t, testStore.SaveUser(ctx, user))
+	require.NoError(t, m.DeleteRole(ctx, testAccountID, adminUserID, created.ID))
+
+	_, err = m.GetRole(ctx, testAccountID, adminUserID, created.ID)
+	sErr, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.NotFound, sErr.Type())
+
+	assert.Equal(t, []activity.ActivityDescriber{activity.CustomRoleCreated, activity.CustomRoleUpdated, activity.CustomRoleDeleted}, *events)
+}
+
+func TestManager_CreateRoleExceedingInitiatorPermissions(t *testing.T) {
+	ctx := context.Background()
+	m, _, _ := setupTest(t)
+
+	_, err := m.CreateRole(ctx, testAccountID, adminUserID, &customroles.Role{
+		Name:        "Account Manager",
+		Permissions: roles.Permissions{modules.Accounts: {operations.Delete: true}},
+	})
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.PermissionDenied, sErr.Type(), "admins can't grant account deletion")
+
+	_, err = m.CreateRole(ctx, testAccountID, ownerUserID, &customroles.Role{
+		Name:        "Account Manager",
+		Permissions: roles.Permissions{modules.Accounts: {operations.Delete: true}},
+	})
+	require.NoError(t, err)
+
+	_, err = m.CreateRole(ctx, testAccountID, regularUserID, &customroles.Role{
+		Name:        "Reader",
+		Permissions: roles.Permissions{modules.Peers: {operations.Read: true}},
+	})
+	sErr, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.PermissionDenied, sErr.Type(), "regular users can't manage roles")
+}
+
+func TestRole_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    customroles.Role
+		wantErr bool
+	}{
+		{name: "valid", role: customroles.Role{Name: "Ops", Permissions: roles.Permissions{modules.AgentNetworkUsage: {operations.Read: true}}}},
+		{name: "missing name", role: customroles.Role{Permissions: roles.Permissions{modules.Peers: {operations.Read: true}}}, wantErr: true},
+		{name: "built-in name", role: customroles.Role{Name: "Admin", Permissions: roles.Permissions{modules.Peers: {operations.Read: true}}}, wantErr: true},
+		{name: "no permissions", role: customroles.Role{Name: "Ops"}, wantErr: true},
+		{name: "unknown module", role: customroles.Role{Name: "Ops", Permissions: roles.Permissions{"billing": {operations.Read: true}}}, wantErr: true},
+		{name: "unknown operation", role: customroles.Role{Name: "Ops", Permissions: roles.Permissions{modules.Peers: {"approve": true}}}, wantErr: true},
+		{name: "empty jwt group", role: customroles.Role{Name: "Ops", Permissions: roles.Permissions{modules.Peers: {operations.Read: true}}, JWTGroups: []string{""}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.role.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package customroles
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+// Role is an account-defined role composed of module and operation grants. Users assigned a custom role carry
+// the role ID as their role.
+type Role struct {
+	ID          string `gorm:"primaryKey"`
+	AccountID   string `gorm:"index"`
+	Name        string
+	Description string
+	Permissions roles.Permissions `gorm:"serializer:json"`
+	// JWTGroups lists the JWT groups whose members get this role assigned when JWT group sync is enabled
+	JWTGroups []string `gorm:"serializer:json"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewRole(accountID, name, description string, permissions roles.Permissions, jwtGroups []string) *Role {
+	now := time.Now().UTC()
+	return &Role{
+		ID:          xid.New().String(),
+		AccountID:   accountID,
+		Name:        name,
+		Description: description,
+		Permissions: permissions,
+		JWTGroups:   jwtGroups,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// RolePermissions returns the role in the form used by the permissions manager. Custom roles only grant what is
+// explicitly listed, so modules added later are never allowed by default.
+func (r *Role) RolePermissions() roles.RolePermissions {
+	return roles.RolePermissions{
+		Role:        types.UserRole(r.ID),
+		Permissions: r.Permissions,
+		AutoAllowNew: map[operations.Operation]bool{
+			operations.Read:   false,
+			operations.Create: false,
+			operations.Update: false,
+			operations.Delete: false,
+		},
+	}
+}
+
+// MatchesJWTGroups reports whether any of the given JWT groups is mapped to the role.
+func (r *Role) MatchesJWTGroups(groups []string) bool {
+	for _, group := range r.JWTGroups {
+		if slices.Contains(groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Role) Copy() *Role {
+	permissions := make(roles.Permissions, len(r.Permissions))
+	for module, ops := range r.Permissions {
+		permissions[module] = maps.Clone(ops)
+	}
+
+	return &Role{
+		ID:          r.ID,
+		AccountID:   r.AccountID,
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: permissions,
+		JWTGroups:   slices.Clone(r.JWTGroups),
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+func (r *Role) ToAPIResponse() *api.CustomRole {
+	permissions := make(map[string]map[string]bool, len(r.Permissions))
+	for module, ops := range r.Permissions {
+		apiOps := make(map[string]bool, len(ops))
+		for op, allowed := range ops {
+			apiOps[string(op)] = allowed
+		}
+		permissions[string(module)] = apiOps
+	}
+
+	jwtGroups := r.JWTGroups
+	if jwtGroups == nil {
+		jwtGroups = []string{}
+	}
+
+	return &api.CustomRole{
+		Id:          r.ID,
+		Name:        r.Name,
+		Description: &r.Description,
+		Permissions: permissions,
+		JwtGroups:   &jwtGroups,
+	}
+}
+
+func (r *Role) FromAPIRequest(req *api.CustomRoleRequest) {
+	r.Name = req.Name
+	if req.Description != nil {
+		r.Description = *req.Description
+	}
+	if req.JwtGroups != nil {
+		r.JWTGroups = *req.JwtGroups
+	}
+
+	r.Permissions = make(roles.Permissions, len(req.Permissions))
+	for module, ops := range req.Permissions {
+		permissions := make(map[operations.Operation]bool, len(ops))
+		for op, allowed := range ops {
+			permissions[operations.Operation(op)] = allowed
+		}
+		r.Permissions[modules.Module(module)] = permissions
+	}
+}
+
+func (r *Role) Validate() error {
+	if r.Name == "" {
+		return errors.New("role name is required")
+	}
+	if len(r.Name) > 255 {
+		return errors.New("role name exceeds maximum length of 255 characters")
+	}
+	if types.StrRoleToUserRole(strings.ToLower(r.Name)) != types.UserRoleUnknown {
+		return fmt.Errorf("role name %s is reserved for a built-in role", r.Name)
+	}
+
+	if len(r.Permissions) == 0 {
+		return errors.New("at least one module permission is required")
+	}
+	for module, ops := range r.Permissions {
+		if _, ok := modules.All[module]; !ok {
+			return fmt.Errorf("unknown module %s", module)
+		}
+		for op := range ops {
+			if _, ok := operations.All[op]; !ok {
+				return fmt.Errorf("unknown operation %s for module %s", op, module)
+			}
+		}
+	}
+
+	for _, group := range r.JWTGroups {
+		if group == "" {
+			return errors.New("JWT group names can't be empty")
+		}
+	}
+
+	return nil
+}
+
+func (r *Role) EventMeta() map[string]any {
+	return map[string]any{"name": r.Name}
+}
@@ -0,0 +1,269 @@
+package eventstreaming
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/util/crypt"
+)
+
+// Platform is the destination an integration streams events to.
+type Platform string
+
+const (
+	PlatformWebhook Platform = "generic_http"
+	PlatformSyslog  Platform = "syslog"
+	PlatformS3      Platform = "s3"
+)
+
+// Config keys per platform.
+const (
+	ConfigURL    = "url"
+	ConfigSecret = "secret"
+
+	ConfigAddress  = "address"
+	ConfigProtocol = "protocol"
+	ConfigAppName  = "app_name"
+
+	ConfigBucket          = "bucket"
+	ConfigRegion          = "region"
+	ConfigEndpoint        = "endpoint"
+	ConfigPrefix          = "prefix"
+	ConfigAccessKeyID     = "access_key_id"
+	ConfigSecretAccessKey = "secret_access_key"
+	ConfigPathStyle       = "path_style"
+)
+
+// maskedValue replaces sensitive config values in API responses. Sending it
+// back on update keeps the stored value.
+const maskedValue = "****"
+
+var sensitiveConfigKeys = map[string]struct{}{
+	ConfigSecret:          {},
+	ConfigSecretAccessKey: {},
+}
+
+var platformConfigKeys = map[Platform]map[string]struct{}{
+	PlatformWebhook: {ConfigURL: {}, ConfigSecret: {}},
+	PlatformSyslog:  {ConfigAddress: {}, ConfigProtocol: {}, ConfigAppName: {}},
+	PlatformS3: {
+		ConfigBucket: {}, ConfigRegion: {}, ConfigEndpoint: {}, ConfigPrefix: {},
+		ConfigAccessKeyID: {}, ConfigSecretAccessKey: {}, ConfigPathStyle: {},
+	},
+}
+
+// DeliveryState is the persisted delivery progress of an integration. Cursor
+// only advances after a batch was accepted by the destination, so events are
+// delivered at least once.
+type DeliveryState struct {
+	// Cursor is the ID of the last delivered activity event.
+	Cursor              uint64
+	LastDeliveryAt      *time.Time
+	LastAttemptAt       *time.Time
+	LastError           string
+	ConsecutiveFailures int
+	NextRetryAt         *time.Time
+}
+
+// Integration streams the activity events of an account to an external
+// destination.
+type Integration struct {
+	ID        int64  `gorm:"primaryKey;autoIncrement"`
+	AccountID string `gorm:"index"`
+	Platform  Platform
+	Enabled   bool
+	Config    map[string]string `gorm:"serializer:json"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	State     DeliveryState `gorm:"embedded;embeddedPrefix:state_"`
+}
+
+// TableName keeps the table name explicit.
+func (Integration) TableName() string {
+	return "event_streaming_integrations"
+}
+
+// Copy returns a deep copy of the integration.
+func (i *Integration) Copy() *Integration {
+	c := *i
+	c.Config = maps.Clone(i.Config)
+	c.State.LastDeliveryAt = copyTime(i.State.LastDeliveryAt)
+	c.State.LastAttemptAt = copyTime(i.State.LastAttemptAt)
+	c.State.NextRetryAt = copyTime(i.State.NextRetryAt)
+	return &c
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
+
+// FromAPIRequest applies the request to the integration. The platform is
+// only taken from the request for new integrations, it is immutable after.
+func (i *Integration) FromAPIRequest(req *api.CreateIntegrationRequest) {
+	if i.Platform == "" {
+		i.Platform = Platform(req.Platform)
+	}
+	i.Enabled = req.Enabled
+	i.Config = maps.Clone(req.Config)
+	if i.Config == nil {
+		i.Config = make(map[string]string)
+	}
+}
+
+// KeepMaskedSecrets replaces sensitive config values that were sent back
+// masked with the values of the stored integration.
+func (i *Integration) KeepMaskedSecrets(stored *Integration) {
+	for key := range sensitiveConfigKeys {
+		if i.Config[key] == maskedValue {
+			i.Config[key] = stored.Config[key]
+		}
+	}
+}
+
+// ToAPIResponse converts the integration to its API representation with
+// sensitive config values masked.
+func (i *Integration) ToAPIResponse() *api.IntegrationResponse {
+	config := make(map[string]string, len(i.Config))
+	for key, value := range i.Config {
+		if _, ok := sensitiveConfigKeys[key]; ok && value != "" {
+			value = maskedValue
+		}
+		config[key] = value
+	}
+
+	platform := api.IntegrationResponsePlatform(i.Platform)
+	createdAt := i.CreatedAt
+	updatedAt := i.UpdatedAt
+	resp := &api.IntegrationResponse{
+		Id:        &i.ID,
+		AccountId: &i.AccountID,
+		Enabled:   &i.Enabled,
+		Platform:  &platform,
+		Config:    &config,
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+		DeliveryStatus: &api.IntegrationDeliveryStatus{
+			LastEventId:         int64(i.State.Cursor),
+			LastDeliveryAt:      i.State.LastDeliveryAt,
+			LastAttemptAt:       i.State.LastAttemptAt,
+			ConsecutiveFailures: i.State.ConsecutiveFailures,
+			NextRetryAt:         i.State.NextRetryAt,
+		},
+	}
+	if i.State.LastError != "" {
+		resp.DeliveryStatus.LastError = &i.State.LastError
+	}
+	return resp
+}
+
+// Validate checks the platform and its config.
+func (i *Integration) Validate() error {
+	allowed, ok := platformConfigKeys[i.Platform]
+	if !ok {
+		return fmt.Errorf("platform %q is not supported, use one of %s, %s or %s", i.Platform, PlatformWebhook, PlatformSyslog, PlatformS3)
+	}
+	for key := range i.Config {
+		if _, ok := allowed[key]; !ok {
+			return fmt.Errorf("unknown config key %q for platform %s", key, i.Platform)
+		}
+	}
+
+	switch i.Platform {
+	case PlatformWebhook:
+		return i.validateWebhook()
+	case PlatformSyslog:
+		return i.validateSyslog()
+	case PlatformS3:
+		return i.validateS3()
+	}
+	return nil
+}
+
+func (i *Integration) validateWebhook() error {
+	u, err := url.Parse(i.Config[ConfigURL])
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return errors.New("webhook url must be an absolute http or https URL")
+	}
+	return nil
+}
+
+func (i *Integration) validateSyslog() error {
+	if _, _, err := net.SplitHostPort(i.Config[ConfigAddress]); err != nil {
+		return fmt.Errorf("syslog address must be host:port: %w", err)
+	}
+	switch i.Config[ConfigProtocol] {
+	case "", "tcp", "udp", "tls":
+	default:
+		return fmt.Errorf("syslog protocol must be tcp, udp or tls, got %q", i.Config[ConfigProtocol])
+	}
+	return nil
+}
+
+func (i *Integration) validateS3() error {
+	if i.Config[ConfigBucket] == "" {
+		return errors.New("s3 bucket is required")
+	}
+	if i.Config[ConfigRegion] == "" {
+		return errors.New("s3 region is required")
+	}
+	// Without static credentials the AWS SDK would fall back to the
+	// management server's own identity, letting any account write as it.
+	if i.Config[ConfigAccessKeyID] == "" || i.Config[ConfigSecretAccessKey] == "" {
+		return errors.New("s3 access_key_id and secret_access_key are required")
+	}
+	if endpoint := i.Config[ConfigEndpoint]; endpoint != "" {
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+			return errors.New("s3 endpoint must be an absolute http or https URL")
+		}
+	}
+	if pathStyle := i.Config[ConfigPathStyle]; pathStyle != "" {
+		if _, err := strconv.ParseBool(pathStyle); err != nil {
+			return fmt.Errorf("s3 path_style must be true or false, got %q", pathStyle)
+		}
+	}
+	return nil
+}
+
+// EventMeta returns activity event metadata for the integration.
+func (i *Integration) EventMeta() map[string]any {
+	return map[string]any{"platform": string(i.Platform)}
+}
+
+// EncryptSensitiveData encrypts sensitive config values in place.
+func (i *Integration) EncryptSensitiveData(enc *crypt.FieldEncrypt) error {
+	return i.transformSensitive(enc, enc.Encrypt)
+}
+
+// DecryptSensitiveData decrypts sensitive config values in place.
+func (i *Integration) DecryptSensitiveData(enc *crypt.FieldEncrypt) error {
+	return i.transformSensitive(enc, enc.Decrypt)
+}
+
+func (i *Integration) transformSensitive(enc *crypt.FieldEncrypt, fn func(string) (string, error)) error {
+	if enc == nil {
+		return nil
+	}
+	for key := range sensitiveConfigKeys {
+		value := i.Config[key]
+		if value == "" {
+			continue
+		}
+		transformed, err := fn(value)
+		if err != nil {
+			return fmt.Errorf("config %s: %w", key, err)
+		}
+		i.Config[key] = transformed
+	}
+	return nil
+}
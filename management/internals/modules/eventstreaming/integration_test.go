@@ -0,0 +1,77 @@
+package eventstreaming
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/util/crypt"
+)
+
+func TestIntegration_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		platform Platform
+		config   map[string]string
+		wantErr  string
+	}{
+		{name: "webhook", platform: PlatformWebhook, config: map[string]string{ConfigURL: "https://hooks.example.com/netbird", ConfigSecret: "s3cret"}},
+		{name: "webhook without url", platform: PlatformWebhook, config: map[string]string{}, wantErr: "webhook url"},
+		{name: "webhook relative url", platform: PlatformWebhook, config: map[string]string{ConfigURL: "/hook"}, wantErr: "webhook url"},
+		{name: "syslog", platform: PlatformSyslog, config: map[string]string{ConfigAddress: "syslog.example.com:6514", ConfigProtocol: "tls"}},
+		{name: "syslog without port", platform: PlatformSyslog, config: map[string]string{ConfigAddress: "syslog.example.com"}, wantErr: "host:port"},
+		{name: "syslog bad protocol", platform: PlatformSyslog, config: map[string]string{ConfigAddress: "syslog.example.com:514", ConfigProtocol: "quic"}, wantErr: "protocol"},
+		{name: "s3", platform: PlatformS3, config: map[string]string{ConfigBucket: "logs", ConfigRegion: "eu-central-1", ConfigEndpoint: "https://minio.example.com", ConfigPathStyle: "true", ConfigAccessKeyID: "AKIA", ConfigSecretAccessKey: "secret"}},
+		{name: "s3 without bucket", platform: PlatformS3, config: map[string]string{ConfigRegion: "eu-central-1"}, wantErr: "bucket"},
+		{name: "s3 half credentials", platform: PlatformS3, config: map[string]string{ConfigBucket: "logs", ConfigRegion: "eu-central-1", ConfigAccessKeyID: "AKIA"}, wantErr: "are required"},
+		{name: "s3 without credentials", platform: PlatformS3, config: map[string]string{ConfigBucket: "logs", ConfigRegion: "eu-central-1"}, wantErr: "are required"},
+		{name: "unknown key", platform: PlatformWebhook, config: map[string]string{ConfigURL: "https://hooks.example.com", "api_key": "x"}, wantErr: "unknown config key"},
+		{name: "unsupported platform", platform: "datadog", config: map[string]string{}, wantErr: "not supported"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Integration{Platform: tt.platform, Config: tt.config}).Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestIntegration_MaskedSecrets(t *testing.T) {
+	stored := &Integration{
+		Platform: PlatformS3,
+		Config:   map[string]string{ConfigBucket: "logs", ConfigAccessKeyID: "AKIA", ConfigSecretAccessKey: "topsecret"},
+	}
+
+	resp := stored.ToAPIResponse()
+	require.NotNil(t, resp.Config)
+	assert.Equal(t, "****", (*resp.Config)[ConfigSecretAccessKey])
+	assert.Equal(t, "AKIA", (*resp.Config)[ConfigAccessKeyID])
+
+	updated := &Integration{Platform: PlatformS3}
+	updated.FromAPIRequest(&api.CreateIntegrationRequest{Platform: "generic_http", Config: *resp.Config})
+	updated.KeepMaskedSecrets(stored)
+	assert.Equal(t, PlatformS3, updated.Platform, "platform is immutable")
+	assert.Equal(t, "topsecret", updated.Config[ConfigSecretAccessKey])
+}
+
+func TestIntegration_EncryptSensitiveData(t *testing.T) {
+	key, err := crypt.GenerateKey()
+	require.NoError(t, err)
+	enc, err := crypt.NewFieldEncrypt(key)
+	require.NoError(t, err)
+
+	integration := &Integration{Config: map[string]string{ConfigURL: "https://hooks.example.com", ConfigSecret: "s3cret"}}
+	require.NoError(t, integration.EncryptSensitiveData(enc))
+	assert.NotEqual(t, "s3cret", integration.Config[ConfigSecret])
+	assert.Equal(t, "https://hooks.example.com", integration.Config[ConfigURL])
+
+	require.NoError(t, integration.DecryptSensitiveData(enc))
+	assert.Equal(t, "s3cret", integration.Config[ConfigSecret])
+}
@@ -0,0 +1,13 @@
+package eventstreaming
+
+import (
+	"context"
+)
+
+type Manager interface {
+	GetAllIntegrations(ctx context.Context, accountID, userID string) ([]*Integration, error)
+	GetIntegration(ctx context.Context, accountID, userID string, integrationID int64) (*Integration, error)
+	CreateIntegration(ctx context.Context, accountID, userID string, integration *Integration) (*Integration, error)
+	UpdateIntegration(ctx context.Context, accountID, userID string, integration *Integration) (*Integration, error)
+	DeleteIntegration(ctx context.Context, accountID, userID string, integrationID int64) error
+}
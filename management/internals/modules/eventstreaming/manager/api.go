@@ -0,0 +1,159 @@
+package manager
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/internals/modules/eventstreaming"
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/http/util"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+type handler struct {
+	manager eventstreaming.Manager
+}
+
+func RegisterEndpoints(router *mux.Router, manager eventstreaming.Manager) {
+	h := &handler{
+		manager: manager,
+	}
+
+	router.HandleFunc("/event-streaming", h.getAllIntegrations).Methods("GET", "OPTIONS")
+	router.HandleFunc("/event-streaming", h.createIntegration).Methods("POST", "OPTIONS")
+	router.HandleFunc("/event-streaming/{id}", h.getIntegration).Methods("GET", "OPTIONS")
+	router.HandleFunc("/event-streaming/{id}", h.updateIntegration).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/event-streaming/{id}", h.deleteIntegration).Methods("DELETE", "OPTIONS")
+}
+
+func (h *handler) getAllIntegrations(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	integrations, err := h.manager.GetAllIntegrations(r.Context(), userAuth.AccountId, userAuth.UserId)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	apiIntegrations := make([]*api.IntegrationResponse, 0, len(integrations))
+	for _, integration := range integrations {
+		apiIntegrations = append(apiIntegrations, integration.ToAPIResponse())
+	}
+
+	util.WriteJSONObject(r.Context(), w, apiIntegrations)
+}
+
+func (h *handler) createIntegration(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.CreateIntegrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	integration := new(eventstreaming.Integration)
+	integration.FromAPIRequest(&req)
+
+	created, err := h.manager.CreateIntegration(r.Context(), userAuth.AccountId, userAuth.UserId, integration)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, created.ToAPIResponse())
+}
+
+func (h *handler) getIntegration(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	integrationID, err := parseIntegrationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	integration, err := h.manager.GetIntegration(r.Context(), userAuth.AccountId, userAuth.UserId, integrationID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, integration.ToAPIResponse())
+}
+
+func (h *handler) updateIntegration(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	integrationID, err := parseIntegrationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.CreateIntegrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	integration := &eventstreaming.Integration{ID: integrationID}
+	integration.FromAPIRequest(&req)
+
+	updated, err := h.manager.UpdateIntegration(r.Context(), userAuth.AccountId, userAuth.UserId, integration)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, updated.ToAPIResponse())
+}
+
+func (h *handler) deleteIntegration(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	integrationID, err := parseIntegrationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	if err := h.manager.DeleteIntegration(r.Context(), userAuth.AccountId, userAuth.UserId, integrationID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
+func parseIntegrationID(r *http.Request) (int64, error) {
+	integrationID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil || integrationID <= 0 {
+		return 0, status.Errorf(status.InvalidArgument, "invalid integration ID")
+	}
+	return integrationID, nil
+}
@@ -0,0 +1,139 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/netbirdio/netbird/management/internals/modules/eventstreaming"
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+type managerImpl struct {
+	store              store.Store
+	eventStore         activity.Store
+	accountManager     account.Manager
+	permissionsManager permissions.Manager
+}
+
+// NewManager creates an event streaming integrations manager. The event store
+// is used to start new integrations at the current end of the activity log.
+func NewManager(store store.Store, eventStore activity.Store, accountManager account.Manager, permissionsManager permissions.Manager) eventstreaming.Manager {
+	return &managerImpl{
+		store:              store,
+		eventStore:         eventStore,
+		accountManager:     accountManager,
+		permissionsManager: permissionsManager,
+	}
+}
+
+func (m *managerImpl) GetAllIntegrations(ctx context.Context, accountID, userID string) ([]*eventstreaming.Integration, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetAccountEventStreamingIntegrations(ctx, store.LockingStrengthNone, accountID)
+}
+
+func (m *managerImpl) GetIntegration(ctx context.Context, accountID, userID string, integrationID int64) (*eventstreaming.Integration, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetEventStreamingIntegrationByID(ctx, store.LockingStrengthNone, accountID, integrationID)
+}
+
+// CreateIntegration stores a new integration. Streaming starts with the
+// events recorded after its creation, the existing log is not replayed.
+func (m *managerImpl) CreateIntegration(ctx context.Context, accountID, userID string, integration *eventstreaming.Integration) (*eventstreaming.Integration, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Create); err != nil {
+		return nil, err
+	}
+
+	if err := integration.Validate(); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "%s", err.Error())
+	}
+
+	lastID, err := m.eventStore.GetLastID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activity log position: %w", err)
+	}
+
+	integration = integration.Copy()
+	integration.ID = 0
+	integration.AccountID = accountID
+	integration.State = eventstreaming.DeliveryState{Cursor: lastID}
+
+	if err := m.store.CreateEventStreamingIntegration(ctx, integration); err != nil {
+		return nil, err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, fmt.Sprint(integration.ID), accountID, activity.EventStreamingIntegrationCreated, integration.EventMeta())
+
+	return integration, nil
+}
+
+// UpdateIntegration updates the enabled flag and config of an integration.
+// Its platform and delivery progress are kept.
+func (m *managerImpl) UpdateIntegration(ctx context.Context, accountID, userID string, updated *eventstreaming.Integration) (*eventstreaming.Integration, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Update); err != nil {
+		return nil, err
+	}
+
+	integration, err := m.store.GetEventStreamingIntegrationByID(ctx, store.LockingStrengthNone, accountID, updated.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated = updated.Copy()
+	updated.KeepMaskedSecrets(integration)
+	integration.Enabled = updated.Enabled
+	integration.Config = updated.Config
+
+	if err := integration.Validate(); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "%s", err.Error())
+	}
+
+	if err := m.store.UpdateEventStreamingIntegration(ctx, integration); err != nil {
+		return nil, err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, fmt.Sprint(integration.ID), accountID, activity.EventStreamingIntegrationUpdated, integration.EventMeta())
+
+	return integration, nil
+}
+
+func (m *managerImpl) DeleteIntegration(ctx context.Context, accountID, userID string, integrationID int64) error {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Delete); err != nil {
+		return err
+	}
+
+	integration, err := m.store.GetEventStreamingIntegrationByID(ctx, store.LockingStrengthNone, accountID, integrationID)
+	if err != nil {
+		return err
+	}
+
+	if err := m.store.DeleteEventStreamingIntegration(ctx, accountID, integrationID); err != nil {
+		return err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, fmt.Sprint(integrationID), accountID, activity.EventStreamingIntegrationDeleted, integration.EventMeta())
+
+	return nil
+}
+
+func (m *managerImpl) validatePermissions(ctx context.Context, accountID, userID string, operation operations.Operation) error {
+	ok, _, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Events, operation)
+	if err != nil {
+		return status.NewPermissionValidationError(err)
+	}
+	if !ok {
+		return status.NewPermissionDeniedError()
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/netbirdio/netbird/management/internals/modules/eventstreaming"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+const (
+	testAccountID = "account-1"
+	testUserID    = "user-1"
+)
+
+func setupTest(t *testing.T) (*managerImpl, store.Store, *activity.InMemoryEventStore, *[]activity.ActivityDescriber) {
+	t.Helper()
+
+	testStore, cleanup, err := store.NewTestStoreFromSQL(context.Background(), "", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	ctrl := gomock.NewController(t)
+	permissionsManager := permissions.NewMockManager(ctrl)
+	permissionsManager.EXPECT().
+		ValidateUserPermissions(gomock.Any(), testAccountID, testUserID, gomock.Any(), gomock.Any()).
+		Return(true, nil, nil).
+		AnyTimes()
+
+	var events []activity.ActivityDescriber
+	accountManager := &mock_server.MockAccountManager{
+		StoreEventFunc: func(_ context.Context, _, _, _ string, activityID activity.ActivityDescriber, _ map[string]any) {
+			events = append(events, activityID)
+		},
+	}
+
+	eventStore := &activity.InMemoryEventStore{}
+	return &managerImpl{
+		store:              testStore,
+		eventStore:         eventStore,
+		accountManager:     accountManager,
+		permissionsManager: permissionsManager,
+	}, testStore, eventStore, &events
+}
+
+func TestManager_IntegrationLifecycle(t *testing.T) {
+	ctx := context.Background()
+	m, testStore, eventStore, events := setupTest(t)
+
+	for range 3 {
+		_, err := eventStore.Save(ctx, &activity.Event{AccountID: testAccountID, Timestamp: time.Now(), Activity: activity.PeerAddedByUser})
+		require.NoError(t, err)
+	}
+
+	created, err := m.CreateIntegration(ctx, testAccountID, testUserID, &eventstreaming.Integration{
+		Platform: eventstreaming.PlatformWebhook,
+		Enabled:  true,
+		Config:   map[string]string{eventstreaming.ConfigURL: "https://hooks.example.com", eventstreaming.ConfigSecret: "s3cret"},
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, created.ID)
+	assert.Equal(t, uint64(3), created.State.Cursor, "existing events are not replayed")
+
+	require.NoError(t, testStore.UpdateEventStreamingDeliveryState(ctx, created.ID, eventstreaming.DeliveryState{Cursor: 5}))
+
+	updated, err := m.UpdateIntegration(ctx, testAccountID, testUserID, &eventstreaming.Integration{
+		ID:      created.ID,
+		Enabled: false,
+		Config:  map[string]string{eventstreaming.ConfigURL: "https://hooks.example.com/v2", eventstreaming.ConfigSecret: "****"},
+	})
+	require.NoError(t, err)
+	assert.False(t, updated.Enabled)
+
+	stored, err := m.GetIntegration(ctx, testAccountID, testUserID, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", stored.Config[eventstreaming.ConfigSecret], "masked secret keeps the stored value")
+	assert.Equal(t, "https://hooks.example.com/v2", stored.Config[eventstreaming.ConfigURL])
+	assert.Equal(t, uint64(5), stored.State.Cursor, "updates keep the delivery progress")
+
+	all, err := m.GetAllIntegrations(ctx, testAccountID, testUserID)
+	require.NoError(t, err)
+	assert.Len(t, all, 1)
+
+	require.NoError(t, m.DeleteIntegration(ctx, testAccountID, testUserID, created.ID))
+	_, err = m.GetIntegration(ctx, testAccountID, testUserID, created.ID)
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.NotFound, sErr.Type())
+
+	assert.Equal(t, []activity.ActivityDescriber{
+		activity.EventStreamingIntegrationCreated,
+		activity.EventStreamingIntegrationUpdated,
+		activity.EventStreamingIntegrationDeleted,
+	}, *events)
+}
+
+func TestManager_CreateIntegrationValidates(t *testing.T) {
+	m, _, _, _ := setupTest(t)
+
+	_, err := m.CreateIntegration(context.Background(), testAccountID, testUserID, &eventstreaming.Integration{
+		Platform: eventstreaming.PlatformSyslog,
+		Config:   map[string]string{eventstreaming.ConfigAddress: "no-port"},
+	})
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.InvalidArgument, sErr.Type())
+}
@@ -0,0 +1,60 @@
+package streamer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/netbirdio/netbird/management/internals/modules/eventstreaming"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+// Event is the payload delivered to every destination. It has the shape of
+// the events API response plus the account the event belongs to.
+type Event struct {
+	api.Event
+	AccountID string `json:"account_id"`
+}
+
+func toEvent(event *activity.Event) *Event {
+	meta := make(map[string]string, len(event.Meta))
+	for key, value := range event.Meta {
+		meta[key] = fmt.Sprintf("%v", value)
+	}
+
+	return &Event{
+		Event: api.Event{
+			Id:             fmt.Sprint(event.ID),
+			InitiatorId:    event.InitiatorID,
+			InitiatorName:  event.InitiatorName,
+			InitiatorEmail: event.InitiatorEmail,
+			Activity:       event.Activity.Message(),
+			ActivityCode:   api.EventActivityCode(event.Activity.StringCode()),
+			TargetId:       event.TargetID,
+			Timestamp:      event.Timestamp,
+			Meta:           meta,
+		},
+		AccountID: event.AccountID,
+	}
+}
+
+// Sink delivers batches of events to one destination. Send returns nil only
+// when the whole batch was accepted; on error the batch is sent again later.
+type Sink interface {
+	Send(ctx context.Context, events []*Event) error
+	Close() error
+}
+
+// NewSink creates the sink for the platform of an integration.
+func NewSink(ctx context.Context, integration *eventstreaming.Integration) (Sink, error) {
+	switch integration.Platform {
+	case eventstreaming.PlatformWebhook:
+		return newWebhookSink(integration.Config), nil
+	case eventstreaming.PlatformSyslog:
+		return newSyslogSink(integration.Config), nil
+	case eventstreaming.PlatformS3:
+		return newS3Sink(ctx, integration.Config)
+	default:
+		return nil, fmt.Errorf("platform %q is not supported", integration.Platform)
+	}
+}
@@ -0,0 +1,147 @@
+package streamer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/internals/modules/eventstreaming"
+	"github.com/netbirdio/netbird/management/server/activity"
+)
+
+func testEvents() []*Event {
+	ts := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	return []*Event{
+		toEvent(&activity.Event{ID: 7, AccountID: testAccountID, Timestamp: ts, Activity: activity.PeerAddedByUser, InitiatorID: "user-1"}),
+		toEvent(&activity.Event{ID: 8, AccountID: testAccountID, Timestamp: ts, Activity: activity.PeerRemovedByUser, InitiatorID: "user-1"}),
+	}
+}
+
+func TestWebhookSink_SignsBatch(t *testing.T) {
+	var (
+		gotBody      []byte
+		gotSignature string
+		gotTimestamp string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotSignature = r.Header.Get(SignatureHeader)
+		gotTimestamp = r.Header.Get(TimestampHeader)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	sink := newWebhookSink(map[string]string{eventstreaming.ConfigURL: srv.URL, eventstreaming.ConfigSecret: "s3cret"})
+	require.NoError(t, sink.Send(context.Background(), testEvents()))
+
+	var events []Event
+	require.NoError(t, json.Unmarshal(gotBody, &events))
+	require.Len(t, events, 2)
+	assert.Equal(t, "7", events[0].Id)
+	assert.Equal(t, testAccountID, events[0].AccountID)
+	assert.Equal(t, Sign("s3cret", gotTimestamp, gotBody), gotSignature)
+	assert.True(t, strings.HasPrefix(gotSignature, "sha256="))
+}
+
+func TestWebhookSink_RejectedStatusFails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	sink := newWebhookSink(map[string]string{eventstreaming.ConfigURL: srv.URL})
+	assert.ErrorContains(t, sink.Send(context.Background(), testEvents()), "status 503")
+}
+
+func TestSyslogSink_TCPOctetCounting(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = ln.Close() }()
+
+	received := make(chan []string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		reader := bufio.NewReader(conn)
+		var msgs []string
+		for range 2 {
+			lenStr, err := reader.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, _ := strconv.Atoi(strings.TrimSpace(lenStr))
+			buf := make([]byte, n)
+			if _, err := io.ReadFull(reader, buf); err != nil {
+				return
+			}
+			msgs = append(msgs, string(buf))
+		}
+		received <- msgs
+	}()
+
+	sink := newSyslogSink(map[string]string{eventstreaming.ConfigAddress: ln.Addr().String()})
+	defer func() { _ = sink.Close() }()
+	require.NoError(t, sink.Send(context.Background(), testEvents()))
+
+	select {
+	case msgs := <-received:
+		require.Len(t, msgs, 2)
+		assert.True(t, strings.HasPrefix(msgs[0], "<110>1 2026-03-04T10:00:00Z "), msgs[0])
+		assert.Contains(t, msgs[0], " netbird - activity - {")
+		assert.Contains(t, msgs[1], `"activity_code":"user.peer.delete"`)
+	case <-time.After(5 * time.Second):
+		t.Fatal("syslog messages not received")
+	}
+}
+
+func TestS3Sink_PutsBatchObject(t *testing.T) {
+	var (
+		gotPath  string
+		gotLines []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		body, _ := io.ReadAll(r.Body)
+		gotLines = strings.Split(strings.TrimSpace(string(body)), "\n")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	sink, err := newS3Sink(context.Background(), map[string]string{
+		eventstreaming.ConfigBucket:          "audit",
+		eventstreaming.ConfigRegion:          "us-east-1",
+		eventstreaming.ConfigEndpoint:        srv.URL,
+		eventstreaming.ConfigPathStyle:       "true",
+		eventstreaming.ConfigPrefix:          "/netbird/",
+		eventstreaming.ConfigAccessKeyID:     "AKIA",
+		eventstreaming.ConfigSecretAccessKey: "secret",
+	})
+	require.NoError(t, err)
+	require.NoError(t, sink.Send(context.Background(), testEvents()))
+
+	assert.Equal(t, "/audit/netbird/"+testAccountID+"/2026/03/04/7-8.jsonl", gotPath)
+	require.Len(t, gotLines, 2)
+	assert.Contains(t, gotLines[1], `"id":"8"`)
+}
+
+func TestS3Sink_RequiresStaticCredentials(t *testing.T) {
+	_, err := newS3Sink(context.Background(), map[string]string{
+		eventstreaming.ConfigBucket: "audit",
+		eventstreaming.ConfigRegion: "us-east-1",
+	})
+	require.Error(t, err, "the server's own AWS identity must never back an account sink")
+}
@@ -0,0 +1,284 @@
+// Package streamer delivers the activity log to event streaming integrations.
+package streamer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/internals/modules/eventstreaming"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/store"
+)
+
+const (
+	defaultPollInterval = 5 * time.Second
+	batchSize           = 100
+	// maxBatchesPerRun bounds the catch-up work of one integration per poll
+	// so a large backlog does not delay the next poll indefinitely.
+	maxBatchesPerRun = 10
+	deliveryTimeout  = time.Minute
+
+	minBackoff = 5 * time.Second
+	maxBackoff = 10 * time.Minute
+
+	// settleDelay holds back events younger than this. Event IDs can become
+	// visible out of order while concurrent inserts commit, and the cursor
+	// must not move past an ID that has not been read yet.
+	settleDelay = 2 * time.Second
+)
+
+// SinkFactory creates the sink of an integration.
+type SinkFactory func(ctx context.Context, integration *eventstreaming.Integration) (Sink, error)
+
+type cachedSink struct {
+	sink      Sink
+	updatedAt time.Time
+}
+
+// Streamer polls the activity log and delivers new events to every enabled
+// integration. Delivery progress is persisted after each accepted batch;
+// failed batches are retried with exponential backoff.
+type Streamer struct {
+	store      store.Store
+	eventStore activity.Store
+	newSink    SinkFactory
+	now        func() time.Time
+
+	mu     sync.Mutex
+	sinks  map[int64]*cachedSink
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New creates a streamer that builds sinks with newSink, or NewSink when nil.
+func New(store store.Store, eventStore activity.Store, newSink SinkFactory) *Streamer {
+	if newSink == nil {
+		newSink = NewSink
+	}
+	return &Streamer{
+		store:      store,
+		eventStore: eventStore,
+		newSink:    newSink,
+		now:        time.Now,
+		sinks:      make(map[int64]*cachedSink),
+	}
+}
+
+// Start polls for new events every interval until Stop is called.
+func (s *Streamer) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.WithContext(ctx).Info("stopping event streaming routine")
+				s.closeSinks()
+				return
+			case <-ticker.C:
+				s.Run(ctx)
+			}
+		}
+	}()
+}
+
+// Stop stops polling and waits for in-flight deliveries to finish.
+func (s *Streamer) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+}
+
+// Run delivers pending events to all enabled integrations once.
+func (s *Streamer) Run(ctx context.Context) {
+	integrations, err := s.store.GetEnabledEventStreamingIntegrations(ctx, store.LockingStrengthNone)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get event streaming integrations: %v", err)
+		return
+	}
+
+	s.pruneSinks(integrations)
+
+	var wg sync.WaitGroup
+	for _, integration := range integrations {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.deliver(ctx, integration)
+		}()
+	}
+	wg.Wait()
+}
+
+func (s *Streamer) deliver(ctx context.Context, integration *eventstreaming.Integration) {
+	state := integration.State
+	if state.NextRetryAt != nil && s.now().Before(*state.NextRetryAt) {
+		return
+	}
+
+	for range maxBatchesPerRun {
+		events, err := s.eventStore.GetAfter(ctx, integration.AccountID, state.Cursor, batchSize)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to read activity events for integration %d: %v", integration.ID, err)
+			return
+		}
+		full := len(events) == batchSize
+		events = s.settled(events)
+		if len(events) == 0 {
+			return
+		}
+
+		err = s.send(ctx, integration, events)
+		attemptAt := s.now().UTC()
+		state.LastAttemptAt = &attemptAt
+		if err != nil {
+			state.ConsecutiveFailures++
+			state.LastError = err.Error()
+			retryAt := attemptAt.Add(backoff(state.ConsecutiveFailures))
+			state.NextRetryAt = &retryAt
+			log.WithContext(ctx).Warnf("failed to deliver %d events to %s integration %d, retrying at %s: %v",
+				len(events), integration.Platform, integration.ID, retryAt.Format(time.RFC3339), err)
+			s.dropSink(integration.ID)
+			s.saveState(ctx, integration.ID, state)
+			return
+		}
+
+		state.Cursor = events[len(events)-1].ID
+		state.LastDeliveryAt = &attemptAt
+		state.LastError = ""
+		state.ConsecutiveFailures = 0
+		state.NextRetryAt = nil
+		if !s.saveState(ctx, integration.ID, state) || !full {
+			return
+		}
+	}
+}
+
+// settled drops the events that are too recent to be sure no lower ID is
+// still being committed.
+func (s *Streamer) settled(events []*activity.Event) []*activity.Event {
+	cutoff := s.now().Add(-settleDelay)
+	for i, event := range events {
+		if event.Timestamp.After(cutoff) {
+			return events[:i]
+		}
+	}
+	return events
+}
+
+func (s *Streamer) send(ctx context.Context, integration *eventstreaming.Integration, events []*activity.Event) error {
+	sink, err := s.sink(ctx, integration)
+	if err != nil {
+		return err
+	}
+
+	batch := make([]*Event, 0, len(events))
+	for _, event := range events {
+		batch = append(batch, toEvent(event))
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+	return sink.Send(sendCtx, batch)
+}
+
+func (s *Streamer) saveState(ctx context.Context, integrationID int64, state eventstreaming.DeliveryState) bool {
+	if err := s.store.UpdateEventStreamingDeliveryState(ctx, integrationID, state); err != nil {
+		log.WithContext(ctx).Errorf("failed to save delivery state of integration %d: %v", integrationID, err)
+		return false
+	}
+	return true
+}
+
+// sink returns the cached sink of an integration, recreating it when the
+// integration was updated since it was built.
+func (s *Streamer) sink(ctx context.Context, integration *eventstreaming.Integration) (Sink, error) {
+	s.mu.Lock()
+	cached, ok := s.sinks[integration.ID]
+	s.mu.Unlock()
+	if ok && cached.updatedAt.Equal(integration.UpdatedAt) {
+		return cached.sink, nil
+	}
+	if ok {
+		s.dropSink(integration.ID)
+	}
+
+	sink, err := s.newSink(ctx, integration)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.sinks[integration.ID] = &cachedSink{sink: sink, updatedAt: integration.UpdatedAt}
+	s.mu.Unlock()
+	return sink, nil
+}
+
+func (s *Streamer) dropSink(integrationID int64) {
+	s.mu.Lock()
+	cached, ok := s.sinks[integrationID]
+	delete(s.sinks, integrationID)
+	s.mu.Unlock()
+	if ok {
+		_ = cached.sink.Close()
+	}
+}
+
+// pruneSinks closes the sinks of integrations that were deleted or disabled.
+func (s *Streamer) pruneSinks(enabled []*eventstreaming.Integration) {
+	keep := make(map[int64]struct{}, len(enabled))
+	for _, integration := range enabled {
+		keep[integration.ID] = struct{}{}
+	}
+
+	s.mu.Lock()
+	var stale []int64
+	for id := range s.sinks {
+		if _, ok := keep[id]; !ok {
+			stale = append(stale, id)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, id := range stale {
+		s.dropSink(id)
+	}
+}
+
+func (s *Streamer) closeSinks() {
+	s.mu.Lock()
+	ids := make([]int64, 0, len(s.sinks))
+	for id := range s.sinks {
+		ids = append(ids, id)
+	}
+	s.mu.Unlock()
+
+	for _, id := range ids {
+		s.dropSink(id)
+	}
+}
+
+// backoff returns the retry delay after the given number of consecutive
+// failures: minBackoff doubled per failure, capped at maxBackoff.
+func backoff(failures int) time.Duration {
+	delay := minBackoff
+	for i := 1; i < failures && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
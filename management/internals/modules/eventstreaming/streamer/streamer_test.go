@@ -0,0 +1,177 @@
+package streamer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/internals/modules/eventstreaming"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/store"
+)
+
+const testAccountID = "account-1"
+
+type fakeSink struct {
+	mu      sync.Mutex
+	fail    error
+	batches [][]*Event
+	closed  bool
+}
+
+func (f *fakeSink) Send(_ context.Context, events []*Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.fail != nil {
+		return f.fail
+	}
+	f.batches = append(f.batches, events)
+	return nil
+}
+
+func (f *fakeSink) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	return nil
+}
+
+func setupStreamer(t *testing.T, sink *fakeSink) (*Streamer, store.Store, *activity.InMemoryEventStore, *eventstreaming.Integration) {
+	t.Helper()
+	ctx := context.Background()
+
+	testStore, cleanup, err := store.NewTestStoreFromSQL(ctx, "", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	integration := &eventstreaming.Integration{
+		AccountID: testAccountID,
+		Platform:  eventstreaming.PlatformWebhook,
+		Enabled:   true,
+		Config:    map[string]string{eventstreaming.ConfigURL: "https://hooks.example.com"},
+	}
+	require.NoError(t, testStore.CreateEventStreamingIntegration(ctx, integration))
+
+	eventStore := &activity.InMemoryEventStore{}
+	s := New(testStore, eventStore, func(context.Context, *eventstreaming.Integration) (Sink, error) {
+		return sink, nil
+	})
+	return s, testStore, eventStore, integration
+}
+
+func saveEvents(t *testing.T, eventStore activity.Store, accountID string, n int, at time.Time) {
+	t.Helper()
+	for range n {
+		_, err := eventStore.Save(context.Background(), &activity.Event{
+			AccountID: accountID,
+			Timestamp: at,
+			Activity:  activity.PeerAddedByUser,
+			Meta:      map[string]any{"name": "peer"},
+		})
+		require.NoError(t, err)
+	}
+}
+
+func getState(t *testing.T, testStore store.Store, integrationID int64) eventstreaming.DeliveryState {
+	t.Helper()
+	integration, err := testStore.GetEventStreamingIntegrationByID(context.Background(), store.LockingStrengthNone, testAccountID, integrationID)
+	require.NoError(t, err)
+	return integration.State
+}
+
+func TestStreamer_DeliversNewEventsOnce(t *testing.T) {
+	ctx := context.Background()
+	sink := &fakeSink{}
+	s, testStore, eventStore, integration := setupStreamer(t, sink)
+
+	past := time.Now().Add(-time.Minute)
+	saveEvents(t, eventStore, testAccountID, batchSize+5, past)
+	saveEvents(t, eventStore, "other-account", 2, past)
+
+	s.Run(ctx)
+
+	require.Len(t, sink.batches, 2)
+	assert.Len(t, sink.batches[0], batchSize)
+	assert.Len(t, sink.batches[1], 5)
+	assert.Equal(t, testAccountID, sink.batches[0][0].AccountID)
+	assert.Equal(t, "peer", sink.batches[0][0].Meta["name"])
+
+	state := getState(t, testStore, integration.ID)
+	assert.Equal(t, uint64(batchSize+5), state.Cursor)
+	assert.NotNil(t, state.LastDeliveryAt)
+
+	s.Run(ctx)
+	assert.Len(t, sink.batches, 2, "delivered events are not sent again")
+}
+
+func TestStreamer_HoldsBackUnsettledEvents(t *testing.T) {
+	sink := &fakeSink{}
+	s, _, eventStore, _ := setupStreamer(t, sink)
+
+	saveEvents(t, eventStore, testAccountID, 1, time.Now().Add(-time.Minute))
+	saveEvents(t, eventStore, testAccountID, 1, time.Now())
+
+	s.Run(context.Background())
+
+	require.Len(t, sink.batches, 1)
+	assert.Len(t, sink.batches[0], 1)
+}
+
+func TestStreamer_RetriesWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	sink := &fakeSink{fail: errors.New("webhook responded with status 503")}
+	s, testStore, eventStore, integration := setupStreamer(t, sink)
+
+	now := time.Now()
+	s.now = func() time.Time { return now }
+	saveEvents(t, eventStore, testAccountID, 3, now.Add(-time.Minute))
+
+	s.Run(ctx)
+
+	state := getState(t, testStore, integration.ID)
+	assert.Zero(t, state.Cursor, "cursor does not advance on failure")
+	assert.Equal(t, 1, state.ConsecutiveFailures)
+	assert.Equal(t, "webhook responded with status 503", state.LastError)
+	require.NotNil(t, state.NextRetryAt)
+	assert.WithinDuration(t, now.Add(minBackoff), *state.NextRetryAt, time.Second)
+	assert.True(t, sink.closed, "failed sinks are rebuilt")
+
+	sink.fail = nil
+	s.Run(ctx)
+	assert.Empty(t, sink.batches, "no attempt before the retry time")
+
+	now = now.Add(minBackoff + time.Second)
+	s.Run(ctx)
+	require.Len(t, sink.batches, 1)
+
+	state = getState(t, testStore, integration.ID)
+	assert.Equal(t, uint64(3), state.Cursor)
+	assert.Zero(t, state.ConsecutiveFailures)
+	assert.Empty(t, state.LastError)
+	assert.Nil(t, state.NextRetryAt)
+}
+
+func TestStreamer_SkipsDisabledIntegrations(t *testing.T) {
+	ctx := context.Background()
+	sink := &fakeSink{}
+	s, testStore, eventStore, integration := setupStreamer(t, sink)
+
+	integration.Enabled = false
+	require.NoError(t, testStore.UpdateEventStreamingIntegration(ctx, integration))
+	saveEvents(t, eventStore, testAccountID, 1, time.Now().Add(-time.Minute))
+
+	s.Run(ctx)
+	assert.Empty(t, sink.batches)
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, minBackoff, backoff(1))
+	assert.Equal(t, 2*minBackoff, backoff(2))
+	assert.Equal(t, 4*minBackoff, backoff(3))
+	assert.Equal(t, maxBackoff, backoff(50))
+}
@@ -0,0 +1,122 @@
+package streamer
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/netbirdio/netbird/management/internals/modules/eventstreaming"
+)
+
+const (
+	// syslogPriority is facility log audit (13) with severity informational (6).
+	syslogPriority = 13*8 + 6
+	syslogMsgID    = "activity"
+	defaultAppName = "netbird"
+
+	syslogDialTimeout  = 10 * time.Second
+	syslogWriteTimeout = 30 * time.Second
+)
+
+// syslogSink sends one RFC 5424 message per event with the JSON event as the
+// message body. Stream transports use octet-counting framing (RFC 6587).
+type syslogSink struct {
+	address  string
+	protocol string
+	appName  string
+	hostname string
+
+	conn net.Conn
+}
+
+func newSyslogSink(config map[string]string) *syslogSink {
+	protocol := config[eventstreaming.ConfigProtocol]
+	if protocol == "" {
+		protocol = "tcp"
+	}
+	appName := config[eventstreaming.ConfigAppName]
+	if appName == "" {
+		appName = defaultAppName
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+
+	return &syslogSink{
+		address:  config[eventstreaming.ConfigAddress],
+		protocol: protocol,
+		appName:  appName,
+		hostname: hostname,
+	}
+}
+
+func (s *syslogSink) Send(ctx context.Context, events []*Event) error {
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return fmt.Errorf("connect to syslog server: %w", err)
+		}
+		s.conn = conn
+	}
+
+	if err := s.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout)); err != nil {
+		return s.fail(err)
+	}
+	for _, event := range events {
+		msg, err := s.format(event)
+		if err != nil {
+			return err
+		}
+		if s.protocol != "udp" {
+			msg = append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+		}
+		if _, err := s.conn.Write(msg); err != nil {
+			return s.fail(err)
+		}
+	}
+	return nil
+}
+
+// fail drops the connection so the next batch reconnects.
+func (s *syslogSink) fail(err error) error {
+	_ = s.conn.Close()
+	s.conn = nil
+	return fmt.Errorf("write to syslog server: %w", err)
+}
+
+func (s *syslogSink) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: syslogDialTimeout}
+	if s.protocol == "tls" {
+		host, _, err := net.SplitHostPort(s.address)
+		if err != nil {
+			return nil, err
+		}
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}}
+		return tlsDialer.DialContext(ctx, "tcp", s.address)
+	}
+	return dialer.DialContext(ctx, s.protocol, s.address)
+}
+
+func (s *syslogSink) format(event *Event) ([]byte, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("marshal event: %w", err)
+	}
+	header := fmt.Sprintf("<%d>1 %s %s %s - %s - ", syslogPriority, event.Timestamp.UTC().Format(time.RFC3339Nano), s.hostname, s.appName, syslogMsgID)
+	return append([]byte(header), body...), nil
+}
+
+func (s *syslogSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
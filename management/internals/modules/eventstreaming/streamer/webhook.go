@@ -0,0 +1,88 @@
+package streamer
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/netbirdio/netbird/management/internals/modules/eventstreaming"
+)
+
+const (
+	// TimestampHeader carries the unix time the webhook request was signed at.
+	TimestampHeader = "X-NetBird-Timestamp"
+	// SignatureHeader carries "sha256=" and the hex HMAC-SHA256 of
+	// "<timestamp>.<body>" keyed with the integration secret.
+	SignatureHeader = "X-NetBird-Signature"
+
+	webhookTimeout = 30 * time.Second
+)
+
+// webhookSink posts each batch as a JSON array.
+type webhookSink struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+func newWebhookSink(config map[string]string) *webhookSink {
+	return &webhookSink{
+		url:    config[eventstreaming.ConfigURL],
+		secret: config[eventstreaming.ConfigSecret],
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+func (s *webhookSink) Send(ctx context.Context, events []*Event) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return fmt.Errorf("marshal events: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "netbird-management")
+	req.Header.Set(TimestampHeader, timestamp)
+	if s.secret != "" {
+		req.Header.Set(SignatureHeader, Sign(s.secret, timestamp, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post events: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *webhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// Sign returns the SignatureHeader value for a webhook body. Receivers
+// recompute it with their copy of the secret to authenticate deliveries.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -187,8 +187,13 @@ func (m *managerImpl) DeletePeers(ctx context.Context, accountID string, peerIDs
 			log.WithContext(ctx).Debugf("DeletePeers: deleted peer %s", peerID)
 
 			if !(peer.ProxyMeta.Embedded || peer.Meta.KernelVersion == "wasm") {
+				event := activity.PeerRemovedByUser
+				// removing a peer that is still waiting for approval rejects it
+				if peer.Status != nil && peer.Status.RequiresApproval && userID != activity.SystemInitiator {
+					event = activity.PeerRejected
+				}
 				eventsToStore = append(eventsToStore, func() {
-					m.accountManager.StoreEvent(ctx, userID, peer.ID, accountID, activity.PeerRemovedByUser, peer.EventMeta(dnsDomain))
+					m.accountManager.StoreEvent(ctx, userID, peer.ID, accountID, event, peer.EventMeta(dnsDomain))
 				})
 			}
 
@@ -19,6 +19,9 @@ type Manager interface {
 	DeleteAllServices(ctx context.Context, accountID, userID string) error
 	SetCertificateIssuedAt(ctx context.Context, accountID, serviceID string) error
 	SetStatus(ctx context.Context, accountID, serviceID string, status Status) error
+	RegisterWebAuthnCredential(ctx context.Context, accountID, serviceID, userName string, credential *WebAuthnCredential) error
+	UpdateWebAuthnSignCount(ctx context.Context, accountID, serviceID, userName string, credentialID []byte, signCount uint32) error
+	RevokeSessions(ctx context.Context, accountID, serviceID string, revoked []*RevokedSession) error
 	ReloadAllServicesForAccount(ctx context.Context, accountID string) error
 	ReloadService(ctx context.Context, accountID, serviceID string) error
 	GetGlobalServices(ctx context.Context) ([]*Service, error)
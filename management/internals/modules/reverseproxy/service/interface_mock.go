@@ -233,6 +233,20 @@ func (mr *MockManagerMockRecorder) GetServiceIDByTargetID(ctx, accountID, resour
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceIDByTargetID", reflect.TypeOf((*MockManager)(nil).GetServiceIDByTargetID), ctx, accountID, resourceID)
 }
 
+// RegisterWebAuthnCredential mocks base method.
+func (m *MockManager) RegisterWebAuthnCredential(ctx context.Context, accountID, serviceID, userName string, credential *WebAuthnCredential) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterWebAuthnCredential", ctx, accountID, serviceID, userName, credential)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterWebAuthnCredential indicates an expected call of RegisterWebAuthnCredential.
+func (mr *MockManagerMockRecorder) RegisterWebAuthnCredential(ctx, accountID, serviceID, userName, credential any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterWebAuthnCredential", reflect.TypeOf((*MockManager)(nil).RegisterWebAuthnCredential), ctx, accountID, serviceID, userName, credential)
+}
+
 // ReloadAllServicesForAccount mocks base method.
 func (m *MockManager) ReloadAllServicesForAccount(ctx context.Context, accountID string) error {
 	m.ctrl.T.Helper()
@@ -275,6 +289,20 @@ func (mr *MockManagerMockRecorder) RenewServiceFromPeer(ctx, accountID, peerID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewServiceFromPeer", reflect.TypeOf((*MockManager)(nil).RenewServiceFromPeer), ctx, accountID, peerID, serviceID)
 }
 
+// RevokeSessions mocks base method.
+func (m *MockManager) RevokeSessions(ctx context.Context, accountID, serviceID string, revoked []*RevokedSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessions", ctx, accountID, serviceID, revoked)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSessions indicates an expected call of RevokeSessions.
+func (mr *MockManagerMockRecorder) RevokeSessions(ctx, accountID, serviceID, revoked any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessions", reflect.TypeOf((*MockManager)(nil).RevokeSessions), ctx, accountID, serviceID, revoked)
+}
+
 // SetCertificateIssuedAt mocks base method.
 func (m *MockManager) SetCertificateIssuedAt(ctx context.Context, accountID, serviceID string) error {
 	m.ctrl.T.Helper()
@@ -343,3 +371,17 @@ func (mr *MockManagerMockRecorder) UpdateService(ctx, accountID, userID, service
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*MockManager)(nil).UpdateService), ctx, accountID, userID, service)
 }
+
+// UpdateWebAuthnSignCount mocks base method.
+func (m *MockManager) UpdateWebAuthnSignCount(ctx context.Context, accountID, serviceID, userName string, credentialID []byte, signCount uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebAuthnSignCount", ctx, accountID, serviceID, userName, credentialID, signCount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebAuthnSignCount indicates an expected call of UpdateWebAuthnSignCount.
+func (mr *MockManagerMockRecorder) UpdateWebAuthnSignCount(ctx, accountID, serviceID, userName, credentialID, signCount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebAuthnSignCount", reflect.TypeOf((*MockManager)(nil).UpdateWebAuthnSignCount), ctx, accountID, serviceID, userName, credentialID, signCount)
+}
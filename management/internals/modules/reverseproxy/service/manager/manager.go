@@ -300,6 +300,13 @@ func (m *Manager) initializeServiceForCreate(ctx context.Context, accountID stri
 		}
 	}
 
+	if err := validateTOTPSecrets(service.Auth.TOTPAuth); err != nil {
+		return err
+	}
+	if err := service.Auth.WebAuthnAuth.AssignHandles(); err != nil {
+		return fmt.Errorf("assign webauthn handles: %w", err)
+	}
+
 	keyPair, err := sessionkey.GenerateKeyPair()
 	if err != nil {
 		return fmt.Errorf("generate session keys: %w", err)
@@ -647,6 +654,12 @@ func (m *Manager) executeServiceUpdate(ctx context.Context, transaction store.St
 	if err := validateHeaderAuthValues(service.Auth.HeaderAuths); err != nil {
 		return err
 	}
+	if err := validateTOTPSecrets(service.Auth.TOTPAuth); err != nil {
+		return err
+	}
+	if err := service.Auth.WebAuthnAuth.AssignHandles(); err != nil {
+		return fmt.Errorf("assign webauthn handles: %w", err)
+	}
 	m.preserveServiceMetadata(service, existingService)
 	m.preserveListenPort(service, existingService)
 	updateInfo.serviceEnabledChanged = existingService.Enabled != service.Enabled
@@ -734,6 +747,63 @@ func (m *Manager) preserveExistingAuthSecrets(svc, existingService *service.Serv
 	}
 
 	preserveHeaderAuthHashes(svc.Auth.HeaderAuths, existingService.Auth.HeaderAuths)
+	preserveTOTPSecrets(svc.Auth.TOTPAuth, existingService.Auth.TOTPAuth)
+	preserveWebAuthnUsers(svc.Auth.WebAuthnAuth, existingService.Auth.WebAuthnAuth)
+	svc.Auth.RevokedSessions = existingService.Auth.RevokedSessions
+}
+
+// preserveTOTPSecrets fills in empty TOTP secrets from the existing user of
+// the same name so that enrolled authenticator apps keep working on update.
+func preserveTOTPSecrets(cfg, existing *service.TOTPAuthConfig) {
+	if cfg == nil || existing == nil {
+		return
+	}
+	for _, u := range cfg.Users {
+		if u == nil || u.Secret != "" {
+			continue
+		}
+		if prev := existing.TOTPUser(u.Name); prev != nil {
+			u.Secret = prev.Secret
+		}
+	}
+}
+
+// preserveWebAuthnUsers carries registered passkeys and user handles over
+// from the existing user of the same name. The API never sets them; they are
+// only written by the proxy registration ceremony. A pending enrollment code
+// is kept unless a new one was supplied.
+func preserveWebAuthnUsers(cfg, existing *service.WebAuthnAuthConfig) {
+	if cfg == nil || existing == nil {
+		return
+	}
+	for _, u := range cfg.Users {
+		if u == nil {
+			continue
+		}
+		prev := existing.WebAuthnUser(u.Name)
+		if prev == nil {
+			continue
+		}
+		u.Handle = prev.Handle
+		u.Credentials = prev.Credentials
+		if u.EnrollmentCode == "" {
+			u.EnrollmentCode = prev.EnrollmentCode
+		}
+	}
+}
+
+// validateTOTPSecrets checks that all users of an enabled TOTP config have a
+// secret (either freshly provided or preserved from the existing service).
+func validateTOTPSecrets(cfg *service.TOTPAuthConfig) error {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+	for i, u := range cfg.Users {
+		if u != nil && u.Secret == "" {
+			return status.Errorf(status.InvalidArgument, "totp_auth.users[%d]: secret is required", i)
+		}
+	}
+	return nil
 }
 
 // preserveHeaderAuthHashes fills in empty header auth values from the existing
@@ -983,6 +1053,107 @@ func (m *Manager) SetStatus(ctx context.Context, accountID, serviceID string, st
 	})
 }
 
+// RegisterWebAuthnCredential stores a passkey registered through the proxy
+// login page and consumes the user's enrollment code.
+func (m *Manager) RegisterWebAuthnCredential(ctx context.Context, accountID, serviceID, userName string, credential *service.WebAuthnCredential) error {
+	var svc *service.Service
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		svc, err = transaction.GetServiceByID(ctx, store.LockingStrengthUpdate, accountID, serviceID)
+		if err != nil {
+			return fmt.Errorf("failed to get service: %w", err)
+		}
+
+		user := webAuthnUser(svc, userName)
+		if user == nil {
+			return status.Errorf(status.NotFound, "webauthn user %s not found", userName)
+		}
+		if user.EnrollmentCode == "" {
+			return status.Errorf(status.PreconditionFailed, "webauthn user %s has no pending enrollment", userName)
+		}
+
+		user.Credentials = append(user.Credentials, credential)
+		user.EnrollmentCode = ""
+
+		if err = transaction.UpdateService(ctx, svc); err != nil {
+			return fmt.Errorf("failed to update service: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	meta := svc.EventMeta()
+	meta["user"] = userName
+	m.accountManager.StoreEvent(ctx, "", serviceID, accountID, activity.ServicePasskeyRegistered, meta)
+
+	return nil
+}
+
+// UpdateWebAuthnSignCount records the authenticator signature counter after a
+// successful passkey login so that cloned authenticators can be detected.
+func (m *Manager) UpdateWebAuthnSignCount(ctx context.Context, accountID, serviceID, userName string, credentialID []byte, signCount uint32) error {
+	return m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		svc, err := transaction.GetServiceByID(ctx, store.LockingStrengthUpdate, accountID, serviceID)
+		if err != nil {
+			return fmt.Errorf("failed to get service: %w", err)
+		}
+
+		user := webAuthnUser(svc, userName)
+		if user == nil {
+			return status.Errorf(status.NotFound, "webauthn user %s not found", userName)
+		}
+
+		for _, cred := range user.Credentials {
+			if cred != nil && slices.Equal(cred.ID, credentialID) {
+				cred.SignCount = signCount
+				return transaction.UpdateService(ctx, svc)
+			}
+		}
+
+		return status.Errorf(status.NotFound, "webauthn credential not found for user %s", userName)
+	})
+}
+
+func webAuthnUser(svc *service.Service, name string) *service.WebAuthnUser {
+	if svc.Auth.WebAuthnAuth == nil {
+		return nil
+	}
+	return svc.Auth.WebAuthnAuth.WebAuthnUser(name)
+}
+
+// RevokeSessions adds the given sessions to the service's revocation list and
+// pushes the updated mapping so proxies reject the revoked cookies.
+func (m *Manager) RevokeSessions(ctx context.Context, accountID, serviceID string, revoked []*service.RevokedSession) error {
+	var svc *service.Service
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		svc, err = transaction.GetServiceByID(ctx, store.LockingStrengthUpdate, accountID, serviceID)
+		if err != nil {
+			return fmt.Errorf("failed to get service: %w", err)
+		}
+
+		svc.Auth.AddRevokedSessions(revoked, time.Now())
+
+		if err = transaction.UpdateService(ctx, svc); err != nil {
+			return fmt.Errorf("failed to update service: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := m.replaceHostByLookup(ctx, accountID, svc); err != nil {
+		return fmt.Errorf("failed to replace host by lookup for service %s: %w", svc.ID, err)
+	}
+
+	m.proxyController.SendServiceUpdateToCluster(ctx, accountID, svc.ToProtoMapping(service.Update, "", m.proxyController.GetOIDCValidationConfig()), svc.ProxyCluster)
+
+	return nil
+}
+
 func (m *Manager) ReloadService(ctx context.Context, accountID, serviceID string) error {
 	s, err := m.store.GetServiceByID(ctx, store.LockingStrengthNone, accountID, serviceID)
 	if err != nil {
@@ -2,13 +2,16 @@ package service
 
 import (
 	"crypto/rand"
+	"encoding/base32"
 	"errors"
 	"fmt"
+	"html/template"
 	"math/big"
 	"net"
 	"net/http"
 	"net/netip"
 	"net/url"
+	"path"
 	"regexp"
 	"slices"
 	"strconv"
@@ -82,6 +85,12 @@ type TargetOptions struct {
 	// non-agent-network target. The agent-network synthesizer sets this true
 	// only when the account's EnableLogCollection toggle is off.
 	DisableAccessLog bool `json:"disable_access_log,omitempty"`
+	// MirrorTarget is an http(s) URL that receives an asynchronous copy of
+	// the requests sent to this target. Mirror responses are discarded and
+	// never affect the client response.
+	MirrorTarget string `json:"mirror_target,omitempty"`
+	// MirrorPercent is the share of requests (1-100) copied to MirrorTarget.
+	MirrorPercent int `json:"mirror_percent,omitempty"`
 }
 
 // MiddlewareSlot mirrors proto.MiddlewareSlot / middleware.Slot.
@@ -151,11 +160,164 @@ type HeaderAuthConfig struct {
 	Value   string `json:"value"`
 }
 
+// TOTPUser is a user enrolled for time-based one-time password auth.
+// Secret is the base32 RFC 6238 shared secret. Unlike PINs and passwords it
+// must stay recoverable to verify codes, so it is encrypted at rest with the
+// store's field encryption instead of being hashed.
+type TOTPUser struct {
+	Name   string `json:"name"`
+	Secret string `json:"secret"`
+}
+
+type TOTPAuthConfig struct {
+	Enabled bool        `json:"enabled"`
+	Users   []*TOTPUser `json:"users,omitempty"`
+}
+
+// WebAuthnCredential is a passkey registered through the proxy login page.
+// Only public material is kept; SignCount guards against cloned authenticators.
+type WebAuthnCredential struct {
+	ID              []byte    `json:"id"`
+	PublicKey       []byte    `json:"public_key"`
+	AttestationType string    `json:"attestation_type,omitempty"`
+	Transports      []string  `json:"transports,omitempty"`
+	AAGUID          []byte    `json:"aaguid,omitempty"`
+	SignCount       uint32    `json:"sign_count"`
+	BackupEligible  bool      `json:"backup_eligible"`
+	BackupState     bool      `json:"backup_state"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// WebAuthnUser is a user allowed to sign in with a passkey. EnrollmentCode
+// holds the hash of the one-time code the user presents on the login page to
+// register a new passkey; it is cleared once the registration completes.
+type WebAuthnUser struct {
+	Name           string                `json:"name"`
+	Handle         []byte                `json:"handle,omitempty"`
+	EnrollmentCode string                `json:"enrollment_code,omitempty"`
+	Credentials    []*WebAuthnCredential `json:"credentials,omitempty"`
+}
+
+type WebAuthnAuthConfig struct {
+	Enabled bool            `json:"enabled"`
+	Users   []*WebAuthnUser `json:"users,omitempty"`
+}
+
+// TOTPUser returns the enrolled TOTP user with the given name, or nil.
+func (c *TOTPAuthConfig) TOTPUser(name string) *TOTPUser {
+	for _, u := range c.Users {
+		if u != nil && u.Name == name {
+			return u
+		}
+	}
+	return nil
+}
+
+// WebAuthnUser returns the WebAuthn user with the given name, or nil.
+func (c *WebAuthnAuthConfig) WebAuthnUser(name string) *WebAuthnUser {
+	for _, u := range c.Users {
+		if u != nil && u.Name == name {
+			return u
+		}
+	}
+	return nil
+}
+
+// Copy returns a deep copy of the TOTP config.
+func (c *TOTPAuthConfig) Copy() *TOTPAuthConfig {
+	cp := &TOTPAuthConfig{Enabled: c.Enabled}
+	for _, u := range c.Users {
+		if u == nil {
+			continue
+		}
+		uc := *u
+		cp.Users = append(cp.Users, &uc)
+	}
+	return cp
+}
+
+// Copy returns a deep copy of the WebAuthn config.
+func (c *WebAuthnAuthConfig) Copy() *WebAuthnAuthConfig {
+	cp := &WebAuthnAuthConfig{Enabled: c.Enabled}
+	for _, u := range c.Users {
+		if u == nil {
+			continue
+		}
+		uc := *u
+		uc.Handle = slices.Clone(u.Handle)
+		uc.Credentials = make([]*WebAuthnCredential, 0, len(u.Credentials))
+		for _, cred := range u.Credentials {
+			if cred == nil {
+				continue
+			}
+			cc := *cred
+			cc.ID = slices.Clone(cred.ID)
+			cc.PublicKey = slices.Clone(cred.PublicKey)
+			cc.AAGUID = slices.Clone(cred.AAGUID)
+			cc.Transports = slices.Clone(cred.Transports)
+			uc.Credentials = append(uc.Credentials, &cc)
+		}
+		cp.Users = append(cp.Users, &uc)
+	}
+	return cp
+}
+
+// AssignHandles gives every user without one a random user handle. The
+// handle identifies the user to authenticators and must not change once a
+// passkey is registered. Safe to call on a nil config.
+func (c *WebAuthnAuthConfig) AssignHandles() error {
+	if c == nil {
+		return nil
+	}
+	for _, u := range c.Users {
+		if u == nil || len(u.Handle) > 0 {
+			continue
+		}
+		handle := make([]byte, 32)
+		if _, err := rand.Read(handle); err != nil {
+			return err
+		}
+		u.Handle = handle
+	}
+	return nil
+}
+
 type AuthConfig struct {
 	PasswordAuth *PasswordAuthConfig `json:"password_auth,omitempty" gorm:"serializer:json"`
 	PinAuth      *PINAuthConfig      `json:"pin_auth,omitempty" gorm:"serializer:json"`
 	BearerAuth   *BearerAuthConfig   `json:"bearer_auth,omitempty" gorm:"serializer:json"`
 	HeaderAuths  []*HeaderAuthConfig `json:"header_auths,omitempty" gorm:"serializer:json"`
+	TOTPAuth     *TOTPAuthConfig     `json:"totp_auth,omitempty" gorm:"serializer:json"`
+	WebAuthnAuth *WebAuthnAuthConfig `json:"webauthn_auth,omitempty" gorm:"serializer:json"`
+	// RevokedSessions lists revoked proxy sessions that have not expired yet.
+	// It is not part of the API payload; it is distributed to proxies with
+	// the mapping so they reject the revoked session cookies.
+	RevokedSessions []*RevokedSession `json:"revoked_sessions,omitempty" gorm:"serializer:json"`
+}
+
+// RevokedSession identifies a revoked proxy session by its token ID. The
+// entry can be dropped once the token has expired on its own.
+type RevokedSession struct {
+	ID        string    `json:"id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// AddRevokedSessions appends the given sessions to the revocation list and
+// drops entries that have expired by now.
+func (a *AuthConfig) AddRevokedSessions(revoked []*RevokedSession, now time.Time) {
+	known := make(map[string]struct{}, len(a.RevokedSessions))
+	kept := make([]*RevokedSession, 0, len(a.RevokedSessions)+len(revoked))
+	for _, r := range append(a.RevokedSessions, revoked...) {
+		if r == nil || !r.ExpiresAt.After(now) {
+			continue
+		}
+		if _, ok := known[r.ID]; ok {
+			continue
+		}
+		known[r.ID] = struct{}{}
+		kept = append(kept, r)
+	}
+	a.RevokedSessions = kept
 }
 
 // AccessRestrictions controls who can connect to the service based on IP or geography.
@@ -178,6 +340,51 @@ func (r AccessRestrictions) Copy() AccessRestrictions {
 	}
 }
 
+// ErrorPages holds custom html/template sources the proxy renders instead
+// of its built-in error pages, one per status class. Templates receive
+// .Code, .Title, .Message and .RequestID.
+type ErrorPages struct {
+	// ClientError is rendered for 4xx responses generated by the proxy.
+	ClientError string `json:"client_error,omitempty"`
+	// ServerError is rendered for 5xx responses generated by the proxy,
+	// including the maintenance page.
+	ServerError string `json:"server_error,omitempty"`
+}
+
+// MaintenanceConfig puts an HTTP service into maintenance mode: the proxy
+// answers 503 with Retry-After instead of forwarding, except for members
+// of AllowedGroups and clients connecting from AllowedCIDRs.
+type MaintenanceConfig struct {
+	Enabled           bool     `json:"enabled"`
+	Message           string   `json:"message,omitempty"`
+	RetryAfterSeconds int      `json:"retry_after_seconds,omitempty"`
+	AllowedGroups     []string `json:"allowed_groups,omitempty"`
+	AllowedCIDRs      []string `json:"allowed_cidrs,omitempty"`
+}
+
+// Copy returns a deep copy of the maintenance config.
+func (m *MaintenanceConfig) Copy() *MaintenanceConfig {
+	c := *m
+	c.AllowedGroups = slices.Clone(m.AllowedGroups)
+	c.AllowedCIDRs = slices.Clone(m.AllowedCIDRs)
+	return &c
+}
+
+// ConnectionLimits caps concurrent connections and bandwidth for an L4
+// (tcp, tls, udp) service. Zero fields are unlimited. Bandwidths are bytes
+// per second and apply to each direction independently.
+type ConnectionLimits struct {
+	MaxConnections          int   `json:"max_connections,omitempty"`
+	MaxConnectionsPerSource int   `json:"max_connections_per_source,omitempty"`
+	ConnectionBandwidth     int64 `json:"connection_bandwidth,omitempty"`
+	AggregateBandwidth      int64 `json:"aggregate_bandwidth,omitempty"`
+}
+
+// IsZero reports whether no limit is configured.
+func (c *ConnectionLimits) IsZero() bool {
+	return c == nil || *c == ConnectionLimits{}
+}
+
 func (a *AuthConfig) HashSecrets() error {
 	if a.PasswordAuth != nil && a.PasswordAuth.Enabled && a.PasswordAuth.Password != "" {
 		hashedPassword, err := argon2id.Hash(a.PasswordAuth.Password)
@@ -205,6 +412,19 @@ func (a *AuthConfig) HashSecrets() error {
 		}
 	}
 
+	if a.WebAuthnAuth != nil && a.WebAuthnAuth.Enabled {
+		for _, u := range a.WebAuthnAuth.Users {
+			if u == nil || u.EnrollmentCode == "" {
+				continue
+			}
+			hashedCode, err := argon2id.Hash(u.EnrollmentCode)
+			if err != nil {
+				return fmt.Errorf("hash webauthn enrollment code for %q: %w", u.Name, err)
+			}
+			u.EnrollmentCode = hashedCode
+		}
+	}
+
 	return nil
 }
 
@@ -220,6 +440,20 @@ func (a *AuthConfig) ClearSecrets() {
 			h.Value = ""
 		}
 	}
+	if a.TOTPAuth != nil {
+		for _, u := range a.TOTPAuth.Users {
+			if u != nil {
+				u.Secret = ""
+			}
+		}
+	}
+	if a.WebAuthnAuth != nil {
+		for _, u := range a.WebAuthnAuth.Users {
+			if u != nil {
+				u.EnrollmentCode = ""
+			}
+		}
+	}
 }
 
 type Meta struct {
@@ -255,6 +489,34 @@ type Service struct {
 	Private bool
 	// AccessGroups is the group ID allowlist for inbound peers on private services. Mutually exclusive with bearer SSO.
 	AccessGroups []string `json:"access_groups,omitempty" gorm:"serializer:json"`
+	// AuthorizationRules restrict paths to users and groups after authentication. HTTP-only.
+	AuthorizationRules []*AuthorizationRule `json:"authorization_rules,omitempty" gorm:"serializer:json"`
+	// ErrorPages replaces the proxy's built-in error pages. HTTP-only.
+	ErrorPages *ErrorPages `json:"error_pages,omitempty" gorm:"serializer:json"`
+	// Maintenance, when enabled, short-circuits requests with a 503. HTTP-only.
+	Maintenance *MaintenanceConfig `json:"maintenance,omitempty" gorm:"serializer:json"`
+	// ConnectionLimits caps connections and bandwidth. L4-only.
+	ConnectionLimits *ConnectionLimits `json:"connection_limits,omitempty" gorm:"serializer:json"`
+}
+
+// AuthorizationRule restricts a path prefix, optionally for specific HTTP
+// methods, to the listed NetBird users and members of the listed groups.
+// Rules are evaluated by the proxy in order; the first match decides.
+type AuthorizationRule struct {
+	Path    string   `json:"path"`
+	Methods []string `json:"methods,omitempty"`
+	Groups  []string `json:"groups,omitempty"`
+	Users   []string `json:"users,omitempty"`
+}
+
+// Copy returns a deep copy of the rule.
+func (r *AuthorizationRule) Copy() *AuthorizationRule {
+	return &AuthorizationRule{
+		Path:    r.Path,
+		Methods: append([]string(nil), r.Methods...),
+		Groups:  append([]string(nil), r.Groups...),
+		Users:   append([]string(nil), r.Users...),
+	}
 }
 
 // InitNewRecord generates a new unique ID and resets metadata for a newly created
@@ -304,6 +566,14 @@ func (s *Service) ToAPIResponse() *api.Service {
 		authConfig.HeaderAuths = &apiHeaders
 	}
 
+	if s.Auth.TOTPAuth != nil {
+		authConfig.TotpAuth = totpAuthToAPI(s.Auth.TOTPAuth)
+	}
+
+	if s.Auth.WebAuthnAuth != nil {
+		authConfig.WebauthnAuth = webAuthnAuthToAPI(s.Auth.WebAuthnAuth)
+	}
+
 	// Convert internal targets to API targets
 	apiTargets := make([]api.ServiceTarget, 0, len(s.Targets))
 	for _, target := range s.Targets {
@@ -362,6 +632,15 @@ func (s *Service) ToAPIResponse() *api.Service {
 		resp.AccessGroups = &groups
 	}
 
+	if len(s.AuthorizationRules) > 0 {
+		rules := authorizationRulesToAPI(s.AuthorizationRules)
+		resp.AuthorizationRules = &rules
+	}
+
+	resp.ErrorPages = errorPagesToAPI(s.ErrorPages)
+	resp.Maintenance = maintenanceToAPI(s.Maintenance)
+	resp.ConnectionLimits = connectionLimitsToAPI(s.ConnectionLimits)
+
 	if s.ProxyCluster != "" {
 		resp.ProxyCluster = &s.ProxyCluster
 	}
@@ -399,6 +678,21 @@ func (s *Service) ToProtoMapping(operation Operation, authToken string, oidcConf
 		}
 	}
 
+	if s.Auth.TOTPAuth != nil && s.Auth.TOTPAuth.Enabled {
+		auth.Totp = true
+	}
+
+	if s.Auth.WebAuthnAuth != nil && s.Auth.WebAuthnAuth.Enabled {
+		auth.Webauthn = true
+	}
+
+	now := time.Now()
+	for _, r := range s.Auth.RevokedSessions {
+		if r != nil && r.ExpiresAt.After(now) {
+			auth.RevokedSessions = append(auth.RevokedSessions, r.ID)
+		}
+	}
+
 	mapping := &proto.ProxyMapping{
 		Type:             operationToProtoType(operation),
 		Id:               s.ID,
@@ -418,9 +712,208 @@ func (s *Service) ToProtoMapping(operation Operation, authToken string, oidcConf
 		mapping.AccessRestrictions = r
 	}
 
+	if len(s.AuthorizationRules) > 0 {
+		mapping.AuthorizationRules = authorizationRulesToProto(s.AuthorizationRules)
+	}
+
+	if s.ErrorPages != nil && (s.ErrorPages.ClientError != "" || s.ErrorPages.ServerError != "") {
+		mapping.ErrorPages = &proto.ErrorPages{
+			ClientError: s.ErrorPages.ClientError,
+			ServerError: s.ErrorPages.ServerError,
+		}
+	}
+
+	if s.Maintenance != nil && s.Maintenance.Enabled {
+		mapping.Maintenance = &proto.MaintenanceMode{
+			Message:           s.Maintenance.Message,
+			RetryAfterSeconds: int32(s.Maintenance.RetryAfterSeconds), //nolint:gosec // bounded by validation
+			AllowedGroups:     s.Maintenance.AllowedGroups,
+			AllowedCidrs:      s.Maintenance.AllowedCIDRs,
+		}
+	}
+
+	if !s.ConnectionLimits.IsZero() {
+		mapping.ConnectionLimits = &proto.ConnectionLimits{
+			MaxConnections:          int32(s.ConnectionLimits.MaxConnections),          //nolint:gosec // bounded by validation
+			MaxConnectionsPerSource: int32(s.ConnectionLimits.MaxConnectionsPerSource), //nolint:gosec // bounded by validation
+			ConnectionBandwidth:     s.ConnectionLimits.ConnectionBandwidth,
+			AggregateBandwidth:      s.ConnectionLimits.AggregateBandwidth,
+		}
+	}
+
 	return mapping
 }
 
+func errorPagesToAPI(p *ErrorPages) *api.ServiceErrorPages {
+	if p == nil || (p.ClientError == "" && p.ServerError == "") {
+		return nil
+	}
+	res := &api.ServiceErrorPages{}
+	if p.ClientError != "" {
+		res.ClientError = &p.ClientError
+	}
+	if p.ServerError != "" {
+		res.ServerError = &p.ServerError
+	}
+	return res
+}
+
+func errorPagesFromAPI(p *api.ServiceErrorPages) *ErrorPages {
+	if p == nil {
+		return nil
+	}
+	res := &ErrorPages{}
+	if p.ClientError != nil {
+		res.ClientError = *p.ClientError
+	}
+	if p.ServerError != nil {
+		res.ServerError = *p.ServerError
+	}
+	if res.ClientError == "" && res.ServerError == "" {
+		return nil
+	}
+	return res
+}
+
+func maintenanceToAPI(m *MaintenanceConfig) *api.ServiceMaintenance {
+	if m == nil {
+		return nil
+	}
+	res := &api.ServiceMaintenance{Enabled: m.Enabled}
+	if m.Message != "" {
+		res.Message = &m.Message
+	}
+	if m.RetryAfterSeconds > 0 {
+		res.RetryAfterSeconds = &m.RetryAfterSeconds
+	}
+	if len(m.AllowedGroups) > 0 {
+		groups := slices.Clone(m.AllowedGroups)
+		res.AllowedGroups = &groups
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		cidrs := slices.Clone(m.AllowedCIDRs)
+		res.AllowedCidrs = &cidrs
+	}
+	return res
+}
+
+func maintenanceFromAPI(m *api.ServiceMaintenance) *MaintenanceConfig {
+	if m == nil {
+		return nil
+	}
+	res := &MaintenanceConfig{Enabled: m.Enabled}
+	if m.Message != nil {
+		res.Message = *m.Message
+	}
+	if m.RetryAfterSeconds != nil {
+		res.RetryAfterSeconds = *m.RetryAfterSeconds
+	}
+	if m.AllowedGroups != nil {
+		res.AllowedGroups = slices.Clone(*m.AllowedGroups)
+	}
+	if m.AllowedCidrs != nil {
+		res.AllowedCIDRs = slices.Clone(*m.AllowedCidrs)
+	}
+	return res
+}
+
+func connectionLimitsToAPI(c *ConnectionLimits) *api.ServiceConnectionLimits {
+	if c.IsZero() {
+		return nil
+	}
+	res := &api.ServiceConnectionLimits{}
+	if c.MaxConnections > 0 {
+		res.MaxConnections = &c.MaxConnections
+	}
+	if c.MaxConnectionsPerSource > 0 {
+		res.MaxConnectionsPerSource = &c.MaxConnectionsPerSource
+	}
+	if c.ConnectionBandwidth > 0 {
+		res.ConnectionBandwidth = &c.ConnectionBandwidth
+	}
+	if c.AggregateBandwidth > 0 {
+		res.AggregateBandwidth = &c.AggregateBandwidth
+	}
+	return res
+}
+
+func connectionLimitsFromAPI(c *api.ServiceConnectionLimits) *ConnectionLimits {
+	if c == nil {
+		return nil
+	}
+	res := &ConnectionLimits{}
+	if c.MaxConnections != nil {
+		res.MaxConnections = *c.MaxConnections
+	}
+	if c.MaxConnectionsPerSource != nil {
+		res.MaxConnectionsPerSource = *c.MaxConnectionsPerSource
+	}
+	if c.ConnectionBandwidth != nil {
+		res.ConnectionBandwidth = *c.ConnectionBandwidth
+	}
+	if c.AggregateBandwidth != nil {
+		res.AggregateBandwidth = *c.AggregateBandwidth
+	}
+	return res
+}
+
+func authorizationRulesToProto(rules []*AuthorizationRule) []*proto.AuthorizationRule {
+	out := make([]*proto.AuthorizationRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, &proto.AuthorizationRule{
+			Path:    r.Path,
+			Methods: r.Methods,
+			Groups:  r.Groups,
+			Users:   r.Users,
+		})
+	}
+	return out
+}
+
+func authorizationRulesToAPI(rules []*AuthorizationRule) []api.ServiceAuthorizationRule {
+	out := make([]api.ServiceAuthorizationRule, 0, len(rules))
+	for _, r := range rules {
+		rule := api.ServiceAuthorizationRule{Path: r.Path}
+		if len(r.Methods) > 0 {
+			methods := append([]string(nil), r.Methods...)
+			rule.Methods = &methods
+		}
+		if len(r.Groups) > 0 {
+			groups := append([]string(nil), r.Groups...)
+			rule.Groups = &groups
+		}
+		if len(r.Users) > 0 {
+			users := append([]string(nil), r.Users...)
+			rule.Users = &users
+		}
+		out = append(out, rule)
+	}
+	return out
+}
+
+func authorizationRulesFromAPI(rules *[]api.ServiceAuthorizationRule) []*AuthorizationRule {
+	if rules == nil || len(*rules) == 0 {
+		return nil
+	}
+	out := make([]*AuthorizationRule, 0, len(*rules))
+	for _, r := range *rules {
+		rule := &AuthorizationRule{Path: r.Path}
+		if r.Methods != nil {
+			for _, m := range *r.Methods {
+				rule.Methods = append(rule.Methods, strings.ToUpper(strings.TrimSpace(m)))
+			}
+		}
+		if r.Groups != nil {
+			rule.Groups = append([]string(nil), *r.Groups...)
+		}
+		if r.Users != nil {
+			rule.Users = append([]string(nil), *r.Users...)
+		}
+		out = append(out, rule)
+	}
+	return out
+}
+
 // buildPathMappings constructs PathMapping entries from targets.
 // For HTTP/HTTPS, each target becomes a path-based route with a full URL.
 // For L4/TLS, a single target maps to a host:port address.
@@ -519,7 +1012,8 @@ func pathRewriteToProto(mode PathRewriteMode) proto.PathRewriteMode {
 
 func targetOptionsToAPI(opts TargetOptions) *api.ServiceTargetOptions {
 	if !opts.SkipTLSVerify && opts.RequestTimeout == 0 && opts.SessionIdleTimeout == 0 &&
-		opts.PathRewrite == "" && len(opts.CustomHeaders) == 0 && !opts.DirectUpstream {
+		opts.PathRewrite == "" && len(opts.CustomHeaders) == 0 && !opts.DirectUpstream &&
+		opts.MirrorTarget == "" {
 		return nil
 	}
 	apiOpts := &api.ServiceTargetOptions{}
@@ -544,6 +1038,10 @@ func targetOptionsToAPI(opts TargetOptions) *api.ServiceTargetOptions {
 	if opts.DirectUpstream {
 		apiOpts.DirectUpstream = &opts.DirectUpstream
 	}
+	if opts.MirrorTarget != "" {
+		apiOpts.MirrorTarget = &opts.MirrorTarget
+		apiOpts.MirrorPercent = &opts.MirrorPercent
+	}
 	return apiOpts
 }
 
@@ -552,7 +1050,7 @@ func targetOptionsToProto(opts TargetOptions) *proto.PathTargetOptions {
 		len(opts.CustomHeaders) == 0 && !opts.DirectUpstream &&
 		len(opts.Middlewares) == 0 && opts.CaptureMaxRequestBytes == 0 &&
 		opts.CaptureMaxResponseBytes == 0 && len(opts.CaptureContentTypes) == 0 &&
-		!opts.AgentNetwork && !opts.DisableAccessLog {
+		!opts.AgentNetwork && !opts.DisableAccessLog && opts.MirrorTarget == "" {
 		return nil
 	}
 	popts := &proto.PathTargetOptions{
@@ -563,6 +1061,10 @@ func targetOptionsToProto(opts TargetOptions) *proto.PathTargetOptions {
 		AgentNetwork:     opts.AgentNetwork,
 		DisableAccessLog: opts.DisableAccessLog,
 	}
+	if opts.MirrorTarget != "" {
+		popts.MirrorTarget = opts.MirrorTarget
+		popts.MirrorPercent = uint32(opts.MirrorPercent) //nolint:gosec
+	}
 	if opts.RequestTimeout != 0 {
 		popts.RequestTimeout = durationpb.New(opts.RequestTimeout)
 	}
@@ -663,6 +1165,13 @@ func targetOptionsFromAPI(idx int, o *api.ServiceTargetOptions) (TargetOptions,
 	if o.DirectUpstream != nil {
 		opts.DirectUpstream = *o.DirectUpstream
 	}
+	if o.MirrorTarget != nil {
+		opts.MirrorTarget = *o.MirrorTarget
+		opts.MirrorPercent = 100
+	}
+	if o.MirrorPercent != nil {
+		opts.MirrorPercent = *o.MirrorPercent
+	}
 	return opts, nil
 }
 
@@ -685,6 +1194,10 @@ func (s *Service) FromAPIRequest(req *api.ServiceRequest, accountID string) erro
 	} else {
 		s.AccessGroups = nil
 	}
+	s.AuthorizationRules = authorizationRulesFromAPI(req.AuthorizationRules)
+	s.ErrorPages = errorPagesFromAPI(req.ErrorPages)
+	s.Maintenance = maintenanceFromAPI(req.Maintenance)
+	s.ConnectionLimits = connectionLimitsFromAPI(req.ConnectionLimits)
 
 	targets, err := targetsFromAPI(accountID, req.Targets)
 	if err != nil {
@@ -782,9 +1295,79 @@ func authFromAPI(reqAuth *api.ServiceAuthConfig) AuthConfig {
 			})
 		}
 	}
+	if reqAuth.TotpAuth != nil {
+		auth.TOTPAuth = totpAuthFromAPI(reqAuth.TotpAuth)
+	}
+	if reqAuth.WebauthnAuth != nil {
+		auth.WebAuthnAuth = webAuthnAuthFromAPI(reqAuth.WebauthnAuth)
+	}
 	return auth
 }
 
+func totpAuthFromAPI(c *api.TOTPAuthConfig) *TOTPAuthConfig {
+	cfg := &TOTPAuthConfig{Enabled: c.Enabled}
+	if c.Users == nil {
+		return cfg
+	}
+	for _, u := range *c.Users {
+		user := &TOTPUser{Name: u.Name}
+		if u.Secret != nil {
+			user.Secret = *u.Secret
+		}
+		cfg.Users = append(cfg.Users, user)
+	}
+	return cfg
+}
+
+func webAuthnAuthFromAPI(c *api.WebAuthnAuthConfig) *WebAuthnAuthConfig {
+	cfg := &WebAuthnAuthConfig{Enabled: c.Enabled}
+	if c.Users == nil {
+		return cfg
+	}
+	for _, u := range *c.Users {
+		user := &WebAuthnUser{Name: u.Name}
+		if u.EnrollmentCode != nil {
+			user.EnrollmentCode = *u.EnrollmentCode
+		}
+		cfg.Users = append(cfg.Users, user)
+	}
+	return cfg
+}
+
+func totpAuthToAPI(c *TOTPAuthConfig) *api.TOTPAuthConfig {
+	users := make([]api.TOTPUser, 0, len(c.Users))
+	for _, u := range c.Users {
+		if u == nil {
+			continue
+		}
+		users = append(users, api.TOTPUser{Name: u.Name})
+	}
+	return &api.TOTPAuthConfig{
+		Enabled: c.Enabled,
+		Users:   &users,
+	}
+}
+
+func webAuthnAuthToAPI(c *WebAuthnAuthConfig) *api.WebAuthnAuthConfig {
+	users := make([]api.WebAuthnUser, 0, len(c.Users))
+	for _, u := range c.Users {
+		if u == nil {
+			continue
+		}
+		count := len(u.Credentials)
+		pending := u.EnrollmentCode != ""
+		users = append(users, api.WebAuthnUser{
+			Name:              u.Name,
+			CredentialCount:   &count,
+			EnrollmentPending: &pending,
+		})
+	}
+	return &api.WebAuthnAuthConfig{
+		Enabled: c.Enabled,
+		Users:   &users,
+	}
+}
+
 func restrictionsFromAPI(r *api.AccessRestrictions) (AccessRestrictions, error) {
 	if r == nil {
 		return AccessRestrictions{}, nil
@@ -871,12 +1454,30 @@ func (s *Service) Validate() error {
 	if err := validateHeaderAuths(s.Auth.HeaderAuths); err != nil {
 		return err
 	}
+	if err := validateTOTPAuth(s.Auth.TOTPAuth); err != nil {
+		return err
+	}
+	if err := validateWebAuthnAuth(s.Auth.WebAuthnAuth); err != nil {
+		return err
+	}
 	if err := validateAccessRestrictions(&s.Restrictions); err != nil {
 		return err
 	}
 	if err := s.validatePrivateRequirements(); err != nil {
 		return err
 	}
+	if err := s.validateAuthorizationRules(); err != nil {
+		return err
+	}
+	if err := s.validateErrorPages(); err != nil {
+		return err
+	}
+	if err := s.validateMaintenance(); err != nil {
+		return err
+	}
+	if err := s.validateConnectionLimits(); err != nil {
+		return err
+	}
 
 	switch s.Mode {
 	case ModeHTTP:
@@ -907,6 +1508,169 @@ func (s *Service) validatePrivateRequirements() error {
 	return nil
 }
 
+const maxAuthorizationRules = 64
+
+// authorizationRuleMethods are the HTTP methods accepted in authorization rules.
+var authorizationRuleMethods = map[string]struct{}{
+	http.MethodGet:     {},
+	http.MethodHead:    {},
+	http.MethodPost:    {},
+	http.MethodPut:     {},
+	http.MethodPatch:   {},
+	http.MethodDelete:  {},
+	http.MethodOptions: {},
+	http.MethodConnect: {},
+	http.MethodTrace:   {},
+}
+
+// validateAuthorizationRules checks the per-path rules. They are only
+// enforceable when the proxy learns the user identity and groups, which
+// requires SSO or a private service.
+func (s *Service) validateAuthorizationRules() error {
+	if len(s.AuthorizationRules) == 0 {
+		return nil
+	}
+	if s.Mode != "" && s.Mode != ModeHTTP {
+		return fmt.Errorf("authorization rules are only supported for HTTP services, got %q", s.Mode)
+	}
+	if !s.Private && (s.Auth.BearerAuth == nil || !s.Auth.BearerAuth.Enabled) {
+		return errors.New("authorization rules require bearer auth (SSO) or a private service")
+	}
+	if len(s.AuthorizationRules) > maxAuthorizationRules {
+		return fmt.Errorf("authorization rules count %d exceeds maximum of %d", len(s.AuthorizationRules), maxAuthorizationRules)
+	}
+	for i, r := range s.AuthorizationRules {
+		if r == nil {
+			return fmt.Errorf("authorization rule %d is empty", i)
+		}
+		if !strings.HasPrefix(r.Path, "/") {
+			return fmt.Errorf("authorization rule %d: path must start with /", i)
+		}
+		if !isCleanRulePath(r.Path) {
+			return fmt.Errorf("authorization rule %d: path %q is not in clean form", i, r.Path)
+		}
+		if !isCleanRulePath(r.Path) {
+			return fmt.Errorf("authorization rule %d: path %q is not in clean form", i, r.Path)
+		}
+		for _, m := range r.Methods {
+			if _, ok := authorizationRuleMethods[m]; !ok {
+				return fmt.Errorf("authorization rule %d: unsupported method %q", i, m)
+			}
+		}
+		if len(r.Groups) == 0 && len(r.Users) == 0 {
+			return fmt.Errorf("authorization rule %d: at least one group or user is required", i)
+		}
+	}
+	return nil
+}
+
+// isCleanRulePath reports whether p is in clean form, allowing a trailing
+// slash. The proxy only matches rules against clean request paths.
+func isCleanRulePath(p string) bool {
+	cleaned := path.Clean(p)
+	if cleaned != "/" && strings.HasSuffix(p, "/") {
+		cleaned += "/"
+	}
+	return cleaned == p
+}
+
+const (
+	maxErrorPageBytes         = 64 << 10
+	maxMaintenanceMessage     = 1024
+	maxMaintenanceRetryAfter  = 7 * 24 * 60 * 60
+	maxMaintenanceAllowGroups = 64
+)
+
+// validateErrorPages checks that the custom error pages parse as
+// html/template sources the proxy can render.
+func (s *Service) validateErrorPages() error {
+	if s.ErrorPages == nil {
+		return nil
+	}
+	if s.Mode != "" && s.Mode != ModeHTTP {
+		return fmt.Errorf("custom error pages are only supported for HTTP services, got %q", s.Mode)
+	}
+	for _, page := range []struct{ field, src string }{
+		{"client_error", s.ErrorPages.ClientError},
+		{"server_error", s.ErrorPages.ServerError},
+	} {
+		if page.src == "" {
+			continue
+		}
+		if len(page.src) > maxErrorPageBytes {
+			return fmt.Errorf("error_pages.%s exceeds maximum size of %d bytes", page.field, maxErrorPageBytes)
+		}
+		if _, err := template.New(page.field).Parse(page.src); err != nil {
+			return fmt.Errorf("error_pages.%s: invalid template: %w", page.field, err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) validateMaintenance() error {
+	m := s.Maintenance
+	if m == nil {
+		return nil
+	}
+	if s.Mode != "" && s.Mode != ModeHTTP {
+		return fmt.Errorf("maintenance mode is only supported for HTTP services, got %q", s.Mode)
+	}
+	if len(m.Message) > maxMaintenanceMessage {
+		return fmt.Errorf("maintenance message exceeds maximum length of %d characters", maxMaintenanceMessage)
+	}
+	if m.RetryAfterSeconds < 0 || m.RetryAfterSeconds > maxMaintenanceRetryAfter {
+		return fmt.Errorf("maintenance retry_after_seconds must be between 0 and %d", maxMaintenanceRetryAfter)
+	}
+	if len(m.AllowedGroups) > maxMaintenanceAllowGroups {
+		return fmt.Errorf("maintenance allowed_groups: exceeds maximum of %d entries", maxMaintenanceAllowGroups)
+	}
+	if len(m.AllowedCIDRs) > maxCIDREntries {
+		return fmt.Errorf("maintenance allowed_cidrs: exceeds maximum of %d entries", maxCIDREntries)
+	}
+	return validateCIDRList("maintenance allowed_cidrs", m.AllowedCIDRs)
+}
+
+const (
+	maxConnectionLimit = 1_000_000
+	// minBandwidth keeps shaped connections usable; anything lower is
+	// almost certainly a unit mistake (bits vs bytes, per minute).
+	minBandwidth = 1024
+)
+
+func (s *Service) validateConnectionLimits() error {
+	c := s.ConnectionLimits
+	if c == nil {
+		return nil
+	}
+	if s.Mode != ModeTCP && s.Mode != ModeUDP && s.Mode != ModeTLS {
+		return fmt.Errorf("connection limits are only supported for tcp, udp and tls services, got %q", s.Mode)
+	}
+	if c.MaxConnections < 0 || c.MaxConnections > maxConnectionLimit {
+		return fmt.Errorf("connection_limits.max_connections must be between 0 and %d", maxConnectionLimit)
+	}
+	if c.MaxConnectionsPerSource < 0 || c.MaxConnectionsPerSource > maxConnectionLimit {
+		return fmt.Errorf("connection_limits.max_connections_per_source must be between 0 and %d", maxConnectionLimit)
+	}
+	if c.MaxConnections > 0 && c.MaxConnectionsPerSource > c.MaxConnections {
+		return errors.New("connection_limits.max_connections_per_source must not exceed max_connections")
+	}
+	for _, bw := range []struct {
+		field string
+		value int64
+	}{
+		{"connection_bandwidth", c.ConnectionBandwidth},
+		{"aggregate_bandwidth", c.AggregateBandwidth},
+	} {
+		if bw.value < 0 || (bw.value > 0 && bw.value < minBandwidth) {
+			return fmt.Errorf("connection_limits.%s must be 0 (unlimited) or at least %d bytes per second", bw.field, minBandwidth)
+		}
+	}
+	if c.AggregateBandwidth > 0 && c.ConnectionBandwidth > c.AggregateBandwidth {
+		return errors.New("connection_limits.connection_bandwidth must not exceed aggregate_bandwidth")
+	}
+	return nil
+}
+
 func (s *Service) validateHTTPMode() error {
 	if s.Domain == "" {
 		return errors.New("service domain is required")
@@ -1071,6 +1835,9 @@ func (s *Service) validateL4Target(target *Target) error {
 	if len(target.Options.CustomHeaders) > 0 {
 		return errors.New("custom_headers is not supported for L4 services")
 	}
+	if target.Options.MirrorTarget != "" {
+		return errors.New("mirror_target is not supported for L4 services")
+	}
 	return nil
 }
 
@@ -1155,6 +1922,36 @@ func validateTargetOptions(idx int, opts *TargetOptions) error {
 		return err
 	}
 
+	if err := validateMirror(idx, opts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateMirror(idx int, opts *TargetOptions) error {
+	if opts.MirrorTarget == "" {
+		if opts.MirrorPercent != 0 {
+			return fmt.Errorf("target %d: mirror_percent requires mirror_target", idx)
+		}
+		return nil
+	}
+	u, err := url.Parse(opts.MirrorTarget)
+	if err != nil {
+		return fmt.Errorf("target %d: parse mirror_target: %w", idx, err)
+	}
+	if u.Scheme != TargetProtoHTTP && u.Scheme != TargetProtoHTTPS {
+		return fmt.Errorf("target %d: mirror_target scheme must be http or https", idx)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("target %d: mirror_target has empty host", idx)
+	}
+	if u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("target %d: mirror_target must not include credentials, query or fragment", idx)
+	}
+	if opts.MirrorPercent < 1 || opts.MirrorPercent > 100 {
+		return fmt.Errorf("target %d: mirror_percent must be between 1 and 100", idx)
+	}
 	return nil
 }
 
@@ -1226,6 +2023,84 @@ func validateHeaderAuths(headers []*HeaderAuthConfig) error {
 	return nil
 }
 
+const (
+	maxSecondFactorUsers = 100
+	// minTOTPSecretBytes is the RFC 4226 minimum shared secret length (128 bits).
+	minTOTPSecretBytes = 16
+)
+
+var secondFactorUserNameRe = regexp.MustCompile(`^[A-Za-z0-9._@+-]{1,64}$`)
+
+// NormalizeTOTPSecret upper-cases a base32 TOTP secret and strips the spaces
+// and padding authenticator apps commonly display.
+func NormalizeTOTPSecret(secret string) string {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	return strings.TrimRight(secret, "=")
+}
+
+// validateTOTPAuth validates TOTP users and normalizes their secrets in place.
+// Empty secrets are allowed here; on update they are filled from the existing
+// service before being required.
+func validateTOTPAuth(c *TOTPAuthConfig) error {
+	if c == nil || !c.Enabled {
+		return nil
+	}
+	if len(c.Users) > maxSecondFactorUsers {
+		return fmt.Errorf("totp_auth: exceeds maximum of %d users", maxSecondFactorUsers)
+	}
+	seen := make(map[string]struct{}, len(c.Users))
+	for i, u := range c.Users {
+		if u == nil {
+			return fmt.Errorf("totp_auth.users[%d]: user is required", i)
+		}
+		if !secondFactorUserNameRe.MatchString(u.Name) {
+			return fmt.Errorf("totp_auth.users[%d]: invalid name %q", i, u.Name)
+		}
+		if _, ok := seen[u.Name]; ok {
+			return fmt.Errorf("totp_auth.users[%d]: duplicate name %q", i, u.Name)
+		}
+		seen[u.Name] = struct{}{}
+		if u.Secret == "" {
+			continue
+		}
+		u.Secret = NormalizeTOTPSecret(u.Secret)
+		key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(u.Secret)
+		if err != nil {
+			return fmt.Errorf("totp_auth.users[%d]: secret is not valid base32", i)
+		}
+		if len(key) < minTOTPSecretBytes {
+			return fmt.Errorf("totp_auth.users[%d]: secret must be at least %d bytes", i, minTOTPSecretBytes)
+		}
+	}
+	return nil
+}
+
+func validateWebAuthnAuth(c *WebAuthnAuthConfig) error {
+	if c == nil || !c.Enabled {
+		return nil
+	}
+	if len(c.Users) > maxSecondFactorUsers {
+		return fmt.Errorf("webauthn_auth: exceeds maximum of %d users", maxSecondFactorUsers)
+	}
+	seen := make(map[string]struct{}, len(c.Users))
+	for i, u := range c.Users {
+		if u == nil {
+			return fmt.Errorf("webauthn_auth.users[%d]: user is required", i)
+		}
+		if !secondFactorUserNameRe.MatchString(u.Name) {
+			return fmt.Errorf("webauthn_auth.users[%d]: invalid name %q", i, u.Name)
+		}
+		if _, ok := seen[u.Name]; ok {
+			return fmt.Errorf("webauthn_auth.users[%d]: duplicate name %q", i, u.Name)
+		}
+		seen[u.Name] = struct{}{}
+		if len(u.EnrollmentCode) > maxHeaderValueLen {
+			return fmt.Errorf("webauthn_auth.users[%d]: enrollment code exceeds maximum length of %d", i, maxHeaderValueLen)
+		}
+	}
+	return nil
+}
+
 const (
 	maxCIDREntries    = 200
 	maxCountryEntries = 50
@@ -1328,7 +2203,9 @@ func (s *Service) EventMeta() map[string]any {
 func (s *Service) isAuthEnabled() bool {
 	if (s.Auth.PasswordAuth != nil && s.Auth.PasswordAuth.Enabled) ||
 		(s.Auth.PinAuth != nil && s.Auth.PinAuth.Enabled) ||
-		(s.Auth.BearerAuth != nil && s.Auth.BearerAuth.Enabled) {
+		(s.Auth.BearerAuth != nil && s.Auth.BearerAuth.Enabled) ||
+		(s.Auth.TOTPAuth != nil && s.Auth.TOTPAuth.Enabled) ||
+		(s.Auth.WebAuthnAuth != nil && s.Auth.WebAuthnAuth.Enabled) {
 		return true
 	}
 	for _, h := range s.Auth.HeaderAuths {
@@ -1383,35 +2260,80 @@ func (s *Service) Copy() *Service {
 			authCopy.HeaderAuths[i] = &hCopy
 		}
 	}
+	if s.Auth.TOTPAuth != nil {
+		authCopy.TOTPAuth = s.Auth.TOTPAuth.Copy()
+	}
+	if s.Auth.WebAuthnAuth != nil {
+		authCopy.WebAuthnAuth = s.Auth.WebAuthnAuth.Copy()
+	}
+	if len(s.Auth.RevokedSessions) > 0 {
+		authCopy.RevokedSessions = make([]*RevokedSession, 0, len(s.Auth.RevokedSessions))
+		for _, r := range s.Auth.RevokedSessions {
+			if r == nil {
+				continue
+			}
+			rCopy := *r
+			authCopy.RevokedSessions = append(authCopy.RevokedSessions, &rCopy)
+		}
+	}
 
 	var accessGroups []string
 	if len(s.AccessGroups) > 0 {
 		accessGroups = append([]string(nil), s.AccessGroups...)
 	}
 
+	var authorizationRules []*AuthorizationRule
+	if len(s.AuthorizationRules) > 0 {
+		authorizationRules = make([]*AuthorizationRule, 0, len(s.AuthorizationRules))
+		for _, r := range s.AuthorizationRules {
+			authorizationRules = append(authorizationRules, r.Copy())
+		}
+	}
+
+	var errorPages *ErrorPages
+	if s.ErrorPages != nil {
+		p := *s.ErrorPages
+		errorPages = &p
+	}
+
+	var maintenance *MaintenanceConfig
+	if s.Maintenance != nil {
+		maintenance = s.Maintenance.Copy()
+	}
+
+	var connectionLimits *ConnectionLimits
+	if s.ConnectionLimits != nil {
+		c := *s.ConnectionLimits
+		connectionLimits = &c
+	}
+
 	return &Service{
-		ID:                s.ID,
-		AccountID:         s.AccountID,
-		Name:              s.Name,
-		Domain:            s.Domain,
-		ProxyCluster:      s.ProxyCluster,
-		Targets:           targets,
-		Enabled:           s.Enabled,
-		Terminated:        s.Terminated,
-		PassHostHeader:    s.PassHostHeader,
-		RewriteRedirects:  s.RewriteRedirects,
-		Auth:              authCopy,
-		Restrictions:      s.Restrictions.Copy(),
-		Meta:              s.Meta,
-		SessionPrivateKey: s.SessionPrivateKey,
-		SessionPublicKey:  s.SessionPublicKey,
-		Source:            s.Source,
-		SourcePeer:        s.SourcePeer,
-		Mode:              s.Mode,
-		ListenPort:        s.ListenPort,
-		PortAutoAssigned:  s.PortAutoAssigned,
-		Private:           s.Private,
-		AccessGroups:      accessGroups,
+		ID:                 s.ID,
+		AccountID:          s.AccountID,
+		Name:               s.Name,
+		Domain:             s.Domain,
+		ProxyCluster:       s.ProxyCluster,
+		Targets:            targets,
+		Enabled:            s.Enabled,
+		Terminated:         s.Terminated,
+		PassHostHeader:     s.PassHostHeader,
+		RewriteRedirects:   s.RewriteRedirects,
+		Auth:               authCopy,
+		Restrictions:       s.Restrictions.Copy(),
+		Meta:               s.Meta,
+		SessionPrivateKey:  s.SessionPrivateKey,
+		SessionPublicKey:   s.SessionPublicKey,
+		Source:             s.Source,
+		SourcePeer:         s.SourcePeer,
+		Mode:               s.Mode,
+		ListenPort:         s.ListenPort,
+		PortAutoAssigned:   s.PortAutoAssigned,
+		Private:            s.Private,
+		AccessGroups:       accessGroups,
+		AuthorizationRules: authorizationRules,
+		ErrorPages:         errorPages,
+		Maintenance:        maintenance,
+		ConnectionLimits:   connectionLimits,
 	}
 }
 
@@ -1428,6 +2350,19 @@ func (s *Service) EncryptSensitiveData(enc *crypt.FieldEncrypt) error {
 		}
 	}
 
+	if s.Auth.TOTPAuth != nil {
+		for _, u := range s.Auth.TOTPAuth.Users {
+			if u == nil || u.Secret == "" {
+				continue
+			}
+			var err error
+			u.Secret, err = enc.Encrypt(u.Secret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -1444,6 +2379,19 @@ func (s *Service) DecryptSensitiveData(enc *crypt.FieldEncrypt) error {
 		}
 	}
 
+	if s.Auth.TOTPAuth != nil {
+		for _, u := range s.Auth.TOTPAuth.Users {
+			if u == nil || u.Secret == "" {
+				continue
+			}
+			var err error
+			u.Secret, err = enc.Decrypt(u.Secret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -55,20 +55,34 @@ func GenerateKeyPair() (*KeyPair, error) {
 // pairs positionally with groups; pass nil when names couldn't be
 // resolved.
 func SignToken(privKeyB64, userID, email, domain string, method auth.Method, groups, groupNames []string, expiration time.Duration) (string, error) {
+	token, _, err := SignTokenWithID(privKeyB64, userID, email, domain, method, groups, groupNames, expiration)
+	return token, err
+}
+
+// SignTokenWithID behaves like SignToken and additionally returns the random
+// session ID carried in the token's jti claim, which identifies the session
+// for listing and revocation.
+func SignTokenWithID(privKeyB64, userID, email, domain string, method auth.Method, groups, groupNames []string, expiration time.Duration) (string, string, error) {
 	privKeyBytes, err := base64.StdEncoding.DecodeString(privKeyB64)
 	if err != nil {
-		return "", fmt.Errorf("decode private key: %w", err)
+		return "", "", fmt.Errorf("decode private key: %w", err)
 	}
 
 	if len(privKeyBytes) != ed25519.PrivateKeySize {
-		return "", fmt.Errorf("invalid private key size: got %d, want %d", len(privKeyBytes), ed25519.PrivateKeySize)
+		return "", "", fmt.Errorf("invalid private key size: got %d, want %d", len(privKeyBytes), ed25519.PrivateKeySize)
 	}
 
 	privKey := ed25519.PrivateKey(privKeyBytes)
 
+	sessionID, err := newSessionID()
+	if err != nil {
+		return "", "", err
+	}
+
 	now := time.Now()
 	claims := Claims{
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        sessionID,
 			Issuer:    auth.SessionJWTIssuer,
 			Subject:   userID,
 			Audience:  jwt.ClaimStrings{domain},
@@ -85,8 +99,16 @@ func SignToken(privKeyB64, userID, email, domain string, method auth.Method, gro
 	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
 	signedToken, err := token.SignedString(privKey)
 	if err != nil {
-		return "", fmt.Errorf("sign token: %w", err)
+		return "", "", fmt.Errorf("sign token: %w", err)
 	}
 
-	return signedToken, nil
+	return signedToken, sessionID, nil
+}
+
+func newSessionID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate session id: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }
@@ -0,0 +1,16 @@
+package sessions
+
+import (
+	"context"
+	"time"
+)
+
+type Manager interface {
+	RecordSession(ctx context.Context, session *Session) error
+	GetServiceSessions(ctx context.Context, accountID, userID, serviceID string) ([]*Session, error)
+	RevokeSession(ctx context.Context, accountID, userID, serviceID, sessionID string) error
+	RevokeServiceSessions(ctx context.Context, accountID, userID, serviceID string) error
+	CleanupExpiredSessions(ctx context.Context) (int64, error)
+	StartPeriodicCleanup(ctx context.Context, cleanupInterval time.Duration)
+	StopPeriodicCleanup()
+}
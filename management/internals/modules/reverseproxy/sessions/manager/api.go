@@ -0,0 +1,98 @@
+package manager
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/sessions"
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/http/util"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+type handler struct {
+	manager sessions.Manager
+}
+
+func RegisterEndpoints(router *mux.Router, manager sessions.Manager) {
+	h := &handler{
+		manager: manager,
+	}
+
+	router.HandleFunc("/reverse-proxies/services/{serviceId}/sessions", h.getSessions).Methods("GET", "OPTIONS")
+	router.HandleFunc("/reverse-proxies/services/{serviceId}/sessions", h.revokeSessions).Methods("DELETE", "OPTIONS")
+	router.HandleFunc("/reverse-proxies/services/{serviceId}/sessions/{sessionId}", h.revokeSession).Methods("DELETE", "OPTIONS")
+}
+
+func (h *handler) getSessions(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	serviceID := mux.Vars(r)["serviceId"]
+	if serviceID == "" {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "service ID is required"), w)
+		return
+	}
+
+	serviceSessions, err := h.manager.GetServiceSessions(r.Context(), userAuth.AccountId, userAuth.UserId, serviceID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	apiSessions := make([]*api.ProxySession, 0, len(serviceSessions))
+	for _, s := range serviceSessions {
+		apiSessions = append(apiSessions, s.ToAPIResponse())
+	}
+
+	util.WriteJSONObject(r.Context(), w, apiSessions)
+}
+
+func (h *handler) revokeSessions(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	serviceID := mux.Vars(r)["serviceId"]
+	if serviceID == "" {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "service ID is required"), w)
+		return
+	}
+
+	if err := h.manager.RevokeServiceSessions(r.Context(), userAuth.AccountId, userAuth.UserId, serviceID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
+func (h *handler) revokeSession(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	vars := mux.Vars(r)
+	serviceID := vars["serviceId"]
+	sessionID := vars["sessionId"]
+	if serviceID == "" || sessionID == "" {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "service ID and session ID are required"), w)
+		return
+	}
+
+	if err := h.manager.RevokeSession(r.Context(), userAuth.AccountId, userAuth.UserId, serviceID, sessionID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
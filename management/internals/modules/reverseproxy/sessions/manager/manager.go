@@ -0,0 +1,204 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/service"
+	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/sessions"
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+const defaultCleanupInterval = time.Hour
+
+type managerImpl struct {
+	store              store.Store
+	accountManager     account.Manager
+	permissionsManager permissions.Manager
+	serviceManager     service.Manager
+	cleanupCancel      context.CancelFunc
+}
+
+// NewManager creates a proxy session manager. Revocations are pushed to the
+// proxies through the service manager.
+func NewManager(store store.Store, accountManager account.Manager, permissionsManager permissions.Manager, serviceManager service.Manager) sessions.Manager {
+	return &managerImpl{
+		store:              store,
+		accountManager:     accountManager,
+		permissionsManager: permissionsManager,
+		serviceManager:     serviceManager,
+	}
+}
+
+// RecordSession stores a session issued by the proxy auth flow.
+func (m *managerImpl) RecordSession(ctx context.Context, session *sessions.Session) error {
+	return m.store.CreateProxySession(ctx, session)
+}
+
+// GetServiceSessions returns the sessions of a service that have not expired,
+// revoked ones included.
+func (m *managerImpl) GetServiceSessions(ctx context.Context, accountID, userID, serviceID string) ([]*sessions.Session, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.store.GetServiceByID(ctx, store.LockingStrengthNone, accountID, serviceID); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetServiceProxySessions(ctx, store.LockingStrengthNone, accountID, serviceID, time.Now())
+}
+
+// RevokeSession revokes a single session and pushes the revocation to the
+// proxies serving the service.
+func (m *managerImpl) RevokeSession(ctx context.Context, accountID, userID, serviceID, sessionID string) error {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Update); err != nil {
+		return err
+	}
+
+	session, err := m.store.GetProxySessionByID(ctx, store.LockingStrengthNone, accountID, serviceID, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if session.Revoked() {
+		return nil
+	}
+
+	if err := m.revoke(ctx, accountID, serviceID, []*sessions.Session{session}); err != nil {
+		return err
+	}
+
+	svc, err := m.store.GetServiceByID(ctx, store.LockingStrengthNone, accountID, serviceID)
+	if err != nil {
+		return err
+	}
+	meta := svc.EventMeta()
+	meta["session_id"] = session.ID
+	meta["session_user"] = session.UserID
+	m.accountManager.StoreEvent(ctx, userID, serviceID, accountID, activity.ServiceSessionRevoked, meta)
+
+	return nil
+}
+
+// RevokeServiceSessions revokes every active session of a service.
+func (m *managerImpl) RevokeServiceSessions(ctx context.Context, accountID, userID, serviceID string) error {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Update); err != nil {
+		return err
+	}
+
+	svc, err := m.store.GetServiceByID(ctx, store.LockingStrengthNone, accountID, serviceID)
+	if err != nil {
+		return err
+	}
+
+	all, err := m.store.GetServiceProxySessions(ctx, store.LockingStrengthNone, accountID, serviceID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	active := make([]*sessions.Session, 0, len(all))
+	for _, s := range all {
+		if !s.Revoked() {
+			active = append(active, s)
+		}
+	}
+
+	if len(active) == 0 {
+		return nil
+	}
+
+	if err := m.revoke(ctx, accountID, serviceID, active); err != nil {
+		return err
+	}
+
+	meta := svc.EventMeta()
+	meta["sessions"] = len(active)
+	m.accountManager.StoreEvent(ctx, userID, serviceID, accountID, activity.ServiceSessionsRevoked, meta)
+
+	return nil
+}
+
+func (m *managerImpl) revoke(ctx context.Context, accountID, serviceID string, toRevoke []*sessions.Session) error {
+	ids := make([]string, 0, len(toRevoke))
+	revoked := make([]*service.RevokedSession, 0, len(toRevoke))
+	for _, s := range toRevoke {
+		ids = append(ids, s.ID)
+		revoked = append(revoked, &service.RevokedSession{ID: s.ID, ExpiresAt: s.ExpiresAt})
+	}
+
+	if err := m.store.RevokeProxySessions(ctx, accountID, serviceID, ids, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return m.serviceManager.RevokeSessions(ctx, accountID, serviceID, revoked)
+}
+
+func (m *managerImpl) validatePermissions(ctx context.Context, accountID, userID string, operation operations.Operation) error {
+	ok, _, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Services, operation)
+	if err != nil {
+		return status.NewPermissionValidationError(err)
+	}
+	if !ok {
+		return status.NewPermissionDeniedError()
+	}
+	return nil
+}
+
+// CleanupExpiredSessions deletes sessions that have expired.
+func (m *managerImpl) CleanupExpiredSessions(ctx context.Context) (int64, error) {
+	deletedCount, err := m.store.DeleteExpiredProxySessions(ctx, time.Now())
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to cleanup expired proxy sessions: %v", err)
+		return 0, err
+	}
+
+	if deletedCount > 0 {
+		log.WithContext(ctx).Debugf("cleaned up %d expired proxy sessions", deletedCount)
+	}
+
+	return deletedCount, nil
+}
+
+// StartPeriodicCleanup starts a background goroutine that periodically deletes expired sessions
+func (m *managerImpl) StartPeriodicCleanup(ctx context.Context, cleanupInterval time.Duration) {
+	if cleanupInterval <= 0 {
+		cleanupInterval = defaultCleanupInterval
+	}
+
+	cleanupCtx, cancel := context.WithCancel(ctx)
+	m.cleanupCancel = cancel
+
+	ticker := time.NewTicker(cleanupInterval)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-cleanupCtx.Done():
+				log.WithContext(cleanupCtx).Info("stopping proxy session cleanup routine")
+				return
+			case <-ticker.C:
+				if _, err := m.CleanupExpiredSessions(cleanupCtx); err != nil {
+					log.WithContext(cleanupCtx).Errorf("periodic proxy session cleanup failed: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// StopPeriodicCleanup stops the periodic cleanup routine
+func (m *managerImpl) StopPeriodicCleanup() {
+	if m.cleanupCancel != nil {
+		m.cleanupCancel()
+	}
+}
@@ -0,0 +1,98 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/service"
+	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/sessions"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/store"
+)
+
+const (
+	testAccountID = "account-1"
+	testUserID    = "user-1"
+	testServiceID = "service-1"
+)
+
+func TestRevokeServiceSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := store.NewMockStore(ctrl)
+	mockPerms := permissions.NewMockManager(ctrl)
+	mockServices := service.NewMockManager(ctrl)
+
+	var events []activity.ActivityDescriber
+	accountMgr := &mock_server.MockAccountManager{
+		StoreEventFunc: func(_ context.Context, _, _, _ string, activityID activity.ActivityDescriber, _ map[string]any) {
+			events = append(events, activityID)
+		},
+	}
+
+	expiresAt := time.Now().Add(time.Hour)
+	revokedAt := time.Now().Add(-time.Minute)
+
+	mockPerms.EXPECT().
+		ValidateUserPermissions(gomock.Any(), testAccountID, testUserID, modules.Services, operations.Update).
+		Return(true, nil, nil)
+	mockStore.EXPECT().
+		GetServiceByID(gomock.Any(), store.LockingStrengthNone, testAccountID, testServiceID).
+		Return(&service.Service{ID: testServiceID, AccountID: testAccountID, Name: "svc", Domain: "svc.example.com"}, nil)
+	mockStore.EXPECT().
+		GetServiceProxySessions(gomock.Any(), store.LockingStrengthNone, testAccountID, testServiceID, gomock.Any()).
+		Return([]*sessions.Session{
+			{ID: "active", ExpiresAt: expiresAt},
+			{ID: "already-revoked", ExpiresAt: expiresAt, RevokedAt: &revokedAt},
+		}, nil)
+	mockStore.EXPECT().
+		RevokeProxySessions(gomock.Any(), testAccountID, testServiceID, []string{"active"}, gomock.Any()).
+		Return(nil)
+	mockServices.EXPECT().
+		RevokeSessions(gomock.Any(), testAccountID, testServiceID, []*service.RevokedSession{{ID: "active", ExpiresAt: expiresAt}}).
+		Return(nil)
+
+	m := NewManager(mockStore, accountMgr, mockPerms, mockServices)
+	require.NoError(t, m.RevokeServiceSessions(context.Background(), testAccountID, testUserID, testServiceID))
+	assert.Equal(t, []activity.ActivityDescriber{activity.ServiceSessionsRevoked}, events)
+}
+
+func TestRevokeSession_AlreadyRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := store.NewMockStore(ctrl)
+	mockPerms := permissions.NewMockManager(ctrl)
+	mockServices := service.NewMockManager(ctrl)
+
+	revokedAt := time.Now()
+	mockPerms.EXPECT().
+		ValidateUserPermissions(gomock.Any(), testAccountID, testUserID, modules.Services, operations.Update).
+		Return(true, nil, nil)
+	mockStore.EXPECT().
+		GetProxySessionByID(gomock.Any(), store.LockingStrengthNone, testAccountID, testServiceID, "session-1").
+		Return(&sessions.Session{ID: "session-1", RevokedAt: &revokedAt}, nil)
+
+	m := NewManager(mockStore, &mock_server.MockAccountManager{}, mockPerms, mockServices)
+	require.NoError(t, m.RevokeSession(context.Background(), testAccountID, testUserID, testServiceID, "session-1"))
+}
+
+func TestGetServiceSessions_PermissionDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := store.NewMockStore(ctrl)
+	mockPerms := permissions.NewMockManager(ctrl)
+
+	mockPerms.EXPECT().
+		ValidateUserPermissions(gomock.Any(), testAccountID, testUserID, modules.Services, operations.Read).
+		Return(false, nil, nil)
+
+	m := NewManager(mockStore, &mock_server.MockAccountManager{}, mockPerms, service.NewMockManager(ctrl))
+	_, err := m.GetServiceSessions(context.Background(), testAccountID, testUserID, testServiceID)
+	require.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package sessions
+
+import (
+	"time"
+
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+// Session is an authenticated session the reverse proxy issued for a
+// service. Its ID is the jti claim of the session token, which lets proxies
+// reject the cookie once the session is revoked.
+type Session struct {
+	ID        string `gorm:"primaryKey"`
+	AccountID string `gorm:"index"`
+	ServiceID string `gorm:"index"`
+	UserID    string
+	UserEmail string
+	Method    string
+	SourceIP  string
+	UserAgent string
+	IssuedAt  time.Time
+	ExpiresAt time.Time `gorm:"index"`
+	RevokedAt *time.Time
+}
+
+// TableName keeps the table name scoped to the reverse proxy.
+func (Session) TableName() string {
+	return "proxy_sessions"
+}
+
+// Revoked reports whether the session has been revoked.
+func (s *Session) Revoked() bool {
+	return s.RevokedAt != nil
+}
+
+// ToAPIResponse converts the session to its API representation.
+func (s *Session) ToAPIResponse() *api.ProxySession {
+	resp := &api.ProxySession{
+		Id:         s.ID,
+		ServiceId:  s.ServiceID,
+		UserId:     s.UserID,
+		AuthMethod: s.Method,
+		IssuedAt:   s.IssuedAt,
+		ExpiresAt:  s.ExpiresAt,
+		RevokedAt:  s.RevokedAt,
+	}
+	if s.UserEmail != "" {
+		resp.UserEmail = &s.UserEmail
+	}
+	if s.SourceIP != "" {
+		resp.SourceIp = &s.SourceIP
+	}
+	if s.UserAgent != "" {
+		resp.UserAgent = &s.UserAgent
+	}
+	return resp
+}
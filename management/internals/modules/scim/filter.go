@@ -0,0 +1,206 @@
+package scim
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Filter is a parsed SCIM filter expression (RFC 7644 section 3.4.2.2). Attribute comparisons can be combined
+// with "and" and "or", where "and" binds tighter. Grouping with parentheses and complex attribute filters are
+// not supported. String comparisons are case-insensitive.
+type Filter struct {
+	// anyOf holds the "or" branches, each being a list of comparisons that must all match
+	anyOf [][]comparison
+}
+
+type comparison struct {
+	attribute string
+	operator  string
+	value     string
+}
+
+var filterOperators = map[string]struct{}{
+	"eq": {}, "ne": {}, "co": {}, "sw": {}, "ew": {}, "pr": {}, "gt": {}, "ge": {}, "lt": {}, "le": {},
+}
+
+// ParseFilter parses a filter expression. An empty expression yields a nil filter that matches everything.
+func ParseFilter(expression string) (*Filter, error) {
+	tokens, err := tokenizeFilter(expression)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	filter := &Filter{}
+	var branch []comparison
+	for i := 0; i < len(tokens); {
+		attribute := strings.ToLower(tokens[i])
+		if i+1 >= len(tokens) {
+			return nil, fmt.Errorf("missing operator after %s", tokens[i])
+		}
+		operator := strings.ToLower(tokens[i+1])
+		if _, ok := filterOperators[operator]; !ok {
+			return nil, fmt.Errorf("unsupported filter operator %s", tokens[i+1])
+		}
+		i += 2
+
+		cmp := comparison{attribute: attribute, operator: operator}
+		if operator != "pr" {
+			if i >= len(tokens) {
+				return nil, fmt.Errorf("missing value for %s %s", attribute, operator)
+			}
+			cmp.value = tokens[i]
+			i++
+		}
+		branch = append(branch, cmp)
+
+		if i == len(tokens) {
+			break
+		}
+		switch strings.ToLower(tokens[i]) {
+		case "and":
+		case "or":
+			filter.anyOf = append(filter.anyOf, branch)
+			branch = nil
+		default:
+			return nil, fmt.Errorf("unexpected token %s", tokens[i])
+		}
+		i++
+		if i == len(tokens) {
+			return nil, fmt.Errorf("filter ends with a logical operator")
+		}
+	}
+	filter.anyOf = append(filter.anyOf, branch)
+
+	return filter, nil
+}
+
+// Matches evaluates the filter against a resource, given a lookup of its lowercase attribute paths.
+func (f *Filter) Matches(attribute func(path string) []string) bool {
+	if f == nil {
+		return true
+	}
+
+	for _, branch := range f.anyOf {
+		matched := true
+		for _, cmp := range branch {
+			if !cmp.matches(attribute(cmp.attribute)) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// EqualityValue returns the value the filter requires for the attribute when the filter is a single "eq"
+// comparison on it, which lets callers resolve lookups such as userName eq "x" directly.
+func (f *Filter) EqualityValue(path string) (string, bool) {
+	if f == nil || len(f.anyOf) != 1 || len(f.anyOf[0]) != 1 {
+		return "", false
+	}
+	cmp := f.anyOf[0][0]
+	if cmp.attribute != path || cmp.operator != "eq" {
+		return "", false
+	}
+	return cmp.value, true
+}
+
+func (c comparison) matches(values []string) bool {
+	if c.operator == "pr" {
+		for _, value := range values {
+			if value != "" {
+				return true
+			}
+		}
+		return false
+	}
+
+	if c.operator == "ne" {
+		for _, value := range values {
+			if strings.EqualFold(value, c.value) {
+				return false
+			}
+		}
+		return true
+	}
+
+	expected := strings.ToLower(c.value)
+	for _, value := range values {
+		value = strings.ToLower(value)
+		var ok bool
+		switch c.operator {
+		case "eq":
+			ok = value == expected
+		case "co":
+			ok = strings.Contains(value, expected)
+		case "sw":
+			ok = strings.HasPrefix(value, expected)
+		case "ew":
+			ok = strings.HasSuffix(value, expected)
+		case "gt":
+			ok = value > expected
+		case "ge":
+			ok = value >= expected
+		case "lt":
+			ok = value < expected
+		case "le":
+			ok = value <= expected
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenizeFilter splits the expression on whitespace, keeping quoted values, unquoted, as single tokens.
+func tokenizeFilter(expression string) ([]string, error) {
+	var tokens []string
+	runes := []rune(strings.TrimSpace(expression))
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		if runes[i] == '(' || runes[i] == ')' || runes[i] == '[' || runes[i] == ']' {
+			return nil, fmt.Errorf("grouping in filters is not supported")
+		}
+
+		if runes[i] == '"' {
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				if runes[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("unterminated string in filter")
+			}
+			value, err := strconv.Unquote(string(runes[i : end+1]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid string in filter: %w", err)
+			}
+			tokens = append(tokens, value)
+			i = end + 1
+			continue
+		}
+
+		end := i
+		for end < len(runes) && !unicode.IsSpace(runes[end]) {
+			end++
+		}
+		tokens = append(tokens, string(runes[i:end]))
+		i = end
+	}
+	return tokens, nil
+}
@@ -0,0 +1,72 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	active := true
+	user := &User{
+		ID:          "user-1",
+		UserName:    "Alice@Example.com",
+		DisplayName: "Alice Doe",
+		Emails:      []Email{{Value: "alice@example.com", Primary: true}},
+		Active:      &active,
+	}
+
+	tests := []struct {
+		name    string
+		filter  string
+		matches bool
+	}{
+		{name: "empty filter", filter: "", matches: true},
+		{name: "case insensitive equality", filter: `userName eq "alice@example.com"`, matches: true},
+		{name: "not equal", filter: `userName ne "alice@example.com"`, matches: false},
+		{name: "starts with", filter: `displayName sw "ali"`, matches: true},
+		{name: "contains on multi valued", filter: `emails.value co "example"`, matches: true},
+		{name: "present", filter: `displayName pr`, matches: true},
+		{name: "boolean", filter: `active eq true`, matches: true},
+		{name: "and binds tighter than or", filter: `userName eq "bob" and active eq true or displayName ew "doe"`, matches: true},
+		{name: "and", filter: `userName eq "alice@example.com" and active eq false`, matches: false},
+		{name: "unknown attribute", filter: `title eq "x"`, matches: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := ParseFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.matches, filter.Matches(user.Attribute))
+		})
+	}
+}
+
+func TestParseFilter_Invalid(t *testing.T) {
+	for _, expression := range []string{
+		`userName`,
+		`userName xx "a"`,
+		`userName eq`,
+		`userName eq "a" and`,
+		`(userName eq "a")`,
+		`userName eq "a`,
+	} {
+		_, err := ParseFilter(expression)
+		assert.Error(t, err, expression)
+	}
+}
+
+func TestFilter_EqualityValue(t *testing.T) {
+	filter, err := ParseFilter(`userName eq "alice"`)
+	require.NoError(t, err)
+
+	value, ok := filter.EqualityValue("username")
+	assert.True(t, ok)
+	assert.Equal(t, "alice", value)
+
+	filter, err = ParseFilter(`userName eq "alice" or userName eq "bob"`)
+	require.NoError(t, err)
+	_, ok = filter.EqualityValue("username")
+	assert.False(t, ok)
+}
@@ -0,0 +1,193 @@
+package scim
+
+import (
+	"crypto/sha256"
+	b64 "encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	b "github.com/hashicorp/go-secure-stdlib/base62"
+
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+const (
+	// TokenPrefix is the prefix of SCIM integration bearer tokens
+	TokenPrefix = "nbs_"
+	// tokenSecretLength is the length of the random part of a token
+	tokenSecretLength = 40
+	// tokenVisibleLength is the number of leading token characters kept in masked tokens
+	tokenVisibleLength = 7
+
+	// IntegrationType is the integration type recorded on the users and groups provisioned over SCIM
+	IntegrationType = "scim"
+)
+
+// Integration is a SCIM client, usually an identity provider, allowed to provision the users and groups of an
+// account with its own bearer token.
+type Integration struct {
+	ID        int64  `gorm:"primaryKey;autoIncrement"`
+	AccountID string `gorm:"index"`
+	Provider  string
+	Prefix    string
+	Enabled   bool
+	// GroupPrefixes limits the provisioned groups to the ones with a name starting with any of the prefixes
+	GroupPrefixes []string `gorm:"serializer:json"`
+	// UserGroupPrefixes is kept for API compatibility with directory sync integrations, SCIM clients select
+	// the provisioned users on their side
+	UserGroupPrefixes []string `gorm:"serializer:json"`
+	// ConnectorID is the embedded IdP connector the provisioned users sign in with, if any
+	ConnectorID string
+	// HashedToken is the SHA-256 hash of the bearer token (base64 encoded)
+	HashedToken string `gorm:"index"`
+	// MaskedToken is the token with all but its leading characters masked
+	MaskedToken  string
+	CreatedBy    string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	LastSyncedAt time.Time
+}
+
+// TableName keeps the table name explicit.
+func (Integration) TableName() string {
+	return "scim_integrations"
+}
+
+// SyncLog records a provisioning request handled for an integration.
+type SyncLog struct {
+	ID            int64  `gorm:"primaryKey;autoIncrement"`
+	AccountID     string `gorm:"index"`
+	IntegrationID int64  `gorm:"index"`
+	Level         string
+	Message       string
+	Timestamp     time.Time
+}
+
+// TableName keeps the table name explicit.
+func (SyncLog) TableName() string {
+	return "scim_sync_logs"
+}
+
+func (l *SyncLog) ToAPIResponse() api.IdpIntegrationSyncLog {
+	return api.IdpIntegrationSyncLog{
+		Id:        l.ID,
+		Level:     l.Level,
+		Message:   l.Message,
+		Timestamp: l.Timestamp,
+	}
+}
+
+// GenerateToken creates a new bearer token for the integration, stores its hash and returns the plain token.
+func (i *Integration) GenerateToken() (string, error) {
+	secret, err := b.Random(tokenSecretLength)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate random secret: %w", err)
+	}
+
+	plainToken := TokenPrefix + secret
+	i.HashedToken = HashToken(plainToken)
+	i.MaskedToken = plainToken[:tokenVisibleLength] + strings.Repeat("*", len(plainToken)-tokenVisibleLength)
+
+	return plainToken, nil
+}
+
+// HashToken creates a SHA-256 hash of the token (base64 encoded)
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return b64.StdEncoding.EncodeToString(hash[:])
+}
+
+// AllowsGroup reports whether a group with the given name may be provisioned by the integration.
+func (i *Integration) AllowsGroup(name string) bool {
+	if len(i.GroupPrefixes) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(i.GroupPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(name, prefix)
+	})
+}
+
+func (i *Integration) FromCreateRequest(req *api.CreateScimIntegrationRequest) {
+	i.Provider = req.Provider
+	i.Prefix = req.Prefix
+	i.Enabled = true
+	if req.GroupPrefixes != nil {
+		i.GroupPrefixes = *req.GroupPrefixes
+	}
+	if req.UserGroupPrefixes != nil {
+		i.UserGroupPrefixes = *req.UserGroupPrefixes
+	}
+	if req.ConnectorId != nil {
+		i.ConnectorID = *req.ConnectorId
+	}
+}
+
+// ApplyUpdateRequest applies the fields set in the request to the integration.
+func (i *Integration) ApplyUpdateRequest(req *api.UpdateScimIntegrationRequest) {
+	if req.Enabled != nil {
+		i.Enabled = *req.Enabled
+	}
+	if req.Prefix != nil {
+		i.Prefix = *req.Prefix
+	}
+	if req.GroupPrefixes != nil {
+		i.GroupPrefixes = *req.GroupPrefixes
+	}
+	if req.UserGroupPrefixes != nil {
+		i.UserGroupPrefixes = *req.UserGroupPrefixes
+	}
+	if req.ConnectorId != nil {
+		i.ConnectorID = *req.ConnectorId
+	}
+}
+
+// ToAPIResponse converts the integration to its API representation. The token is only returned in full right
+// after it was generated.
+func (i *Integration) ToAPIResponse(plainToken string) *api.ScimIntegration {
+	token := i.MaskedToken
+	if plainToken != "" {
+		token = plainToken
+	}
+
+	resp := &api.ScimIntegration{
+		Id:                i.ID,
+		Provider:          i.Provider,
+		Prefix:            i.Prefix,
+		Enabled:           i.Enabled,
+		GroupPrefixes:     nonNil(i.GroupPrefixes),
+		UserGroupPrefixes: nonNil(i.UserGroupPrefixes),
+		AuthToken:         token,
+		LastSyncedAt:      i.LastSyncedAt,
+	}
+	if i.ConnectorID != "" {
+		resp.ConnectorId = &i.ConnectorID
+	}
+	return resp
+}
+
+func (i *Integration) Validate() error {
+	if i.Provider == "" {
+		return errors.New("provider is required")
+	}
+	if i.Prefix == "" {
+		return errors.New("prefix is required")
+	}
+	if slices.Contains(i.GroupPrefixes, "") {
+		return errors.New("group prefixes can't be empty")
+	}
+	return nil
+}
+
+func (i *Integration) EventMeta() map[string]any {
+	return map[string]any{"provider": i.Provider, "prefix": i.Prefix}
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,33 @@
+package scim
+
+import (
+	"context"
+)
+
+type Manager interface {
+	GetAllIntegrations(ctx context.Context, accountID, userID string) ([]*Integration, error)
+	GetIntegration(ctx context.Context, accountID, userID string, integrationID int64) (*Integration, error)
+	// CreateIntegration stores a new integration and returns it with its plain bearer token.
+	CreateIntegration(ctx context.Context, accountID, userID string, integration *Integration) (*Integration, string, error)
+	UpdateIntegration(ctx context.Context, accountID, userID string, integration *Integration) (*Integration, error)
+	DeleteIntegration(ctx context.Context, accountID, userID string, integrationID int64) error
+	RegenerateToken(ctx context.Context, accountID, userID string, integrationID int64) (string, error)
+	GetSyncLogs(ctx context.Context, accountID, userID string, integrationID int64) ([]*SyncLog, error)
+
+	// Authenticate returns the enabled integration the bearer token belongs to.
+	Authenticate(ctx context.Context, token string) (*Integration, error)
+
+	ListUsers(ctx context.Context, integration *Integration, filter *Filter) ([]*User, error)
+	GetUser(ctx context.Context, integration *Integration, id string) (*User, error)
+	CreateUser(ctx context.Context, integration *Integration, user *User) (*User, error)
+	ReplaceUser(ctx context.Context, integration *Integration, id string, user *User) (*User, error)
+	PatchUser(ctx context.Context, integration *Integration, id string, operations []PatchOperation) (*User, error)
+	DeleteUser(ctx context.Context, integration *Integration, id string) error
+
+	ListGroups(ctx context.Context, integration *Integration, filter *Filter) ([]*Group, error)
+	GetGroup(ctx context.Context, integration *Integration, id string) (*Group, error)
+	CreateGroup(ctx context.Context, integration *Integration, group *Group) (*Group, error)
+	ReplaceGroup(ctx context.Context, integration *Integration, id string, group *Group) (*Group, error)
+	PatchGroup(ctx context.Context, integration *Integration, id string, operations []PatchOperation) (*Group, error)
+	DeleteGroup(ctx context.Context, integration *Integration, id string) error
+}
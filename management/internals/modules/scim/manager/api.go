@@ -0,0 +1,218 @@
+package manager
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/internals/modules/scim"
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/http/util"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+type handler struct {
+	manager scim.Manager
+}
+
+// RegisterEndpoints registers the endpoints managing SCIM integrations and the SCIM 2.0 endpoints the
+// integrations provision through.
+func RegisterEndpoints(router *mux.Router, manager scim.Manager) {
+	h := &handler{
+		manager: manager,
+	}
+
+	router.HandleFunc("/integrations/scim-idp", h.getAllIntegrations).Methods("GET", "OPTIONS")
+	router.HandleFunc("/integrations/scim-idp", h.createIntegration).Methods("POST", "OPTIONS")
+	router.HandleFunc("/integrations/scim-idp/{id}", h.getIntegration).Methods("GET", "OPTIONS")
+	router.HandleFunc("/integrations/scim-idp/{id}", h.updateIntegration).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/integrations/scim-idp/{id}", h.deleteIntegration).Methods("DELETE", "OPTIONS")
+	router.HandleFunc("/integrations/scim-idp/{id}/token", h.regenerateToken).Methods("POST", "OPTIONS")
+	router.HandleFunc("/integrations/scim-idp/{id}/logs", h.getSyncLogs).Methods("GET", "OPTIONS")
+
+	registerSCIMEndpoints(router, manager)
+}
+
+func (h *handler) getAllIntegrations(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	integrations, err := h.manager.GetAllIntegrations(r.Context(), userAuth.AccountId, userAuth.UserId)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	apiIntegrations := make([]*api.ScimIntegration, 0, len(integrations))
+	for _, integration := range integrations {
+		apiIntegrations = append(apiIntegrations, integration.ToAPIResponse(""))
+	}
+
+	util.WriteJSONObject(r.Context(), w, apiIntegrations)
+}
+
+func (h *handler) createIntegration(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.CreateScimIntegrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	integration := new(scim.Integration)
+	integration.FromCreateRequest(&req)
+
+	created, plainToken, err := h.manager.CreateIntegration(r.Context(), userAuth.AccountId, userAuth.UserId, integration)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, created.ToAPIResponse(plainToken))
+}
+
+func (h *handler) getIntegration(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	integrationID, err := parseIntegrationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	integration, err := h.manager.GetIntegration(r.Context(), userAuth.AccountId, userAuth.UserId, integrationID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, integration.ToAPIResponse(""))
+}
+
+func (h *handler) updateIntegration(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	integrationID, err := parseIntegrationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.UpdateScimIntegrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	integration, err := h.manager.GetIntegration(r.Context(), userAuth.AccountId, userAuth.UserId, integrationID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+	integration.ApplyUpdateRequest(&req)
+
+	updated, err := h.manager.UpdateIntegration(r.Context(), userAuth.AccountId, userAuth.UserId, integration)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, updated.ToAPIResponse(""))
+}
+
+func (h *handler) deleteIntegration(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	integrationID, err := parseIntegrationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	if err := h.manager.DeleteIntegration(r.Context(), userAuth.AccountId, userAuth.UserId, integrationID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
+func (h *handler) regenerateToken(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	integrationID, err := parseIntegrationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	plainToken, err := h.manager.RegenerateToken(r.Context(), userAuth.AccountId, userAuth.UserId, integrationID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, api.ScimTokenResponse{AuthToken: plainToken})
+}
+
+func (h *handler) getSyncLogs(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	integrationID, err := parseIntegrationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	logs, err := h.manager.GetSyncLogs(r.Context(), userAuth.AccountId, userAuth.UserId, integrationID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	apiLogs := make([]api.IdpIntegrationSyncLog, 0, len(logs))
+	for _, entry := range logs {
+		apiLogs = append(apiLogs, entry.ToAPIResponse())
+	}
+
+	util.WriteJSONObject(r.Context(), w, apiLogs)
+}
+
+func parseIntegrationID(r *http.Request) (int64, error) {
+	integrationID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil || integrationID <= 0 {
+		return 0, status.Errorf(status.InvalidArgument, "invalid integration ID")
+	}
+	return integrationID, nil
+}
@@ -0,0 +1,237 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/internals/modules/scim"
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+const (
+	// syncLogsLimit is the number of most recent sync log entries returned for an integration
+	syncLogsLimit = 100
+
+	syncLogLevelInfo  = "info"
+	syncLogLevelError = "error"
+)
+
+type managerImpl struct {
+	store              store.Store
+	accountManager     account.Manager
+	permissionsManager permissions.Manager
+}
+
+// NewManager creates a SCIM manager. Provisioning requests are applied through the account manager with the
+// system initiator, so they go through the same user and group updates as the management API.
+func NewManager(store store.Store, accountManager account.Manager, permissionsManager permissions.Manager) scim.Manager {
+	return &managerImpl{
+		store:              store,
+		accountManager:     accountManager,
+		permissionsManager: permissionsManager,
+	}
+}
+
+func (m *managerImpl) GetAllIntegrations(ctx context.Context, accountID, userID string) ([]*scim.Integration, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetAccountSCIMIntegrations(ctx, store.LockingStrengthNone, accountID)
+}
+
+func (m *managerImpl) GetIntegration(ctx context.Context, accountID, userID string, integrationID int64) (*scim.Integration, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetSCIMIntegrationByID(ctx, store.LockingStrengthNone, accountID, integrationID)
+}
+
+func (m *managerImpl) CreateIntegration(ctx context.Context, accountID, userID string, integration *scim.Integration) (*scim.Integration, string, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Create); err != nil {
+		return nil, "", err
+	}
+
+	if err := integration.Validate(); err != nil {
+		return nil, "", status.Errorf(status.InvalidArgument, "%s", err.Error())
+	}
+
+	created := *integration
+	created.ID = 0
+	created.AccountID = accountID
+	created.CreatedBy = userID
+	created.LastSyncedAt = time.Time{}
+
+	plainToken, err := created.GenerateToken()
+	if err != nil {
+		return nil, "", status.Errorf(status.Internal, "failed to generate SCIM token: %v", err)
+	}
+
+	if err := m.store.CreateSCIMIntegration(ctx, &created); err != nil {
+		return nil, "", err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, fmt.Sprint(created.ID), accountID, activity.SCIMIntegrationCreated, created.EventMeta())
+
+	return &created, plainToken, nil
+}
+
+// UpdateIntegration updates the settings of an integration. Its provider and token are kept.
+func (m *managerImpl) UpdateIntegration(ctx context.Context, accountID, userID string, updated *scim.Integration) (*scim.Integration, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Update); err != nil {
+		return nil, err
+	}
+
+	integration, err := m.store.GetSCIMIntegrationByID(ctx, store.LockingStrengthNone, accountID, updated.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	integration.Enabled = updated.Enabled
+	integration.Prefix = updated.Prefix
+	integration.GroupPrefixes = updated.GroupPrefixes
+	integration.UserGroupPrefixes = updated.UserGroupPrefixes
+	integration.ConnectorID = updated.ConnectorID
+
+	if err := integration.Validate(); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "%s", err.Error())
+	}
+
+	if err := m.store.UpdateSCIMIntegration(ctx, integration); err != nil {
+		return nil, err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, fmt.Sprint(integration.ID), accountID, activity.SCIMIntegrationUpdated, integration.EventMeta())
+
+	return integration, nil
+}
+
+// DeleteIntegration deletes an integration and its sync logs. The users and groups it provisioned are kept.
+func (m *managerImpl) DeleteIntegration(ctx context.Context, accountID, userID string, integrationID int64) error {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Delete); err != nil {
+		return err
+	}
+
+	integration, err := m.store.GetSCIMIntegrationByID(ctx, store.LockingStrengthNone, accountID, integrationID)
+	if err != nil {
+		return err
+	}
+
+	if err := m.store.DeleteSCIMIntegration(ctx, accountID, integrationID); err != nil {
+		return err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, fmt.Sprint(integrationID), accountID, activity.SCIMIntegrationDeleted, integration.EventMeta())
+
+	return nil
+}
+
+// RegenerateToken replaces the bearer token of an integration and returns the new plain token. The previous
+// token stops working immediately.
+func (m *managerImpl) RegenerateToken(ctx context.Context, accountID, userID string, integrationID int64) (string, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Update); err != nil {
+		return "", err
+	}
+
+	integration, err := m.store.GetSCIMIntegrationByID(ctx, store.LockingStrengthNone, accountID, integrationID)
+	if err != nil {
+		return "", err
+	}
+
+	plainToken, err := integration.GenerateToken()
+	if err != nil {
+		return "", status.Errorf(status.Internal, "failed to generate SCIM token: %v", err)
+	}
+
+	if err := m.store.UpdateSCIMIntegration(ctx, integration); err != nil {
+		return "", err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, fmt.Sprint(integrationID), accountID, activity.SCIMIntegrationTokenRegenerated, integration.EventMeta())
+
+	return plainToken, nil
+}
+
+func (m *managerImpl) GetSyncLogs(ctx context.Context, accountID, userID string, integrationID int64) ([]*scim.SyncLog, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.store.GetSCIMIntegrationByID(ctx, store.LockingStrengthNone, accountID, integrationID); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetSCIMSyncLogs(ctx, store.LockingStrengthNone, accountID, integrationID, syncLogsLimit)
+}
+
+func (m *managerImpl) Authenticate(ctx context.Context, token string) (*scim.Integration, error) {
+	if token == "" {
+		return nil, status.Errorf(status.Unauthenticated, "missing SCIM token")
+	}
+
+	integration, err := m.store.GetSCIMIntegrationByHashedToken(ctx, scim.HashToken(token))
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Type() == status.NotFound {
+			return nil, status.Errorf(status.Unauthenticated, "invalid SCIM token")
+		}
+		return nil, err
+	}
+
+	if !integration.Enabled {
+		return nil, status.Errorf(status.Unauthenticated, "SCIM integration is disabled")
+	}
+
+	return integration, nil
+}
+
+// recordSync stores a sync log entry for a provisioning request and bumps the integration sync time. Failures
+// are only logged, they must not fail the request that was already applied.
+func (m *managerImpl) recordSync(ctx context.Context, integration *scim.Integration, err error, format string, args ...any) {
+	level := syncLogLevelInfo
+	message := fmt.Sprintf(format, args...)
+	if err != nil {
+		level = syncLogLevelError
+		message = fmt.Sprintf("%s: %v", message, err)
+	}
+
+	now := time.Now().UTC()
+	entry := &scim.SyncLog{
+		AccountID:     integration.AccountID,
+		IntegrationID: integration.ID,
+		Level:         level,
+		Message:       message,
+		Timestamp:     now,
+	}
+	if storeErr := m.store.CreateSCIMSyncLog(ctx, entry); storeErr != nil {
+		log.WithContext(ctx).Errorf("failed to store SCIM sync log for integration %d: %v", integration.ID, storeErr)
+	}
+
+	if err != nil {
+		return
+	}
+
+	if storeErr := m.store.UpdateSCIMIntegrationLastSynced(ctx, integration.ID, now); storeErr != nil {
+		log.WithContext(ctx).Errorf("failed to update SCIM integration %d sync time: %v", integration.ID, storeErr)
+	}
+}
+
+func (m *managerImpl) validatePermissions(ctx context.Context, accountID, userID string, operation operations.Operation) error {
+	ok, _, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Users, operation)
+	if err != nil {
+		return status.NewPermissionValidationError(err)
+	}
+	if !ok {
+		return status.NewPermissionDeniedError()
+	}
+	return nil
+}
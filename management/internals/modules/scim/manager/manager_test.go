@@ -0,0 +1,329 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/internals/modules/scim"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+const (
+	testAccountID = "account-1"
+	ownerUserID   = "owner-1"
+	adminUserID   = "admin-1"
+	regularUserID = "user-1"
+)
+
+func setupTest(t *testing.T) (*managerImpl, store.Store, *[]activity.ActivityDescriber) {
+	t.Helper()
+
+	ctx := context.Background()
+	testStore, cleanup, err := store.NewTestStoreFromSQL(ctx, "", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	account := &types.Account{
+		Id:       testAccountID,
+		Settings: &types.Settings{},
+		Users: map[string]*types.User{
+			ownerUserID:   {Id: ownerUserID, AccountID: testAccountID, Role: types.UserRoleOwner, AutoGroups: []string{}},
+			adminUserID:   {Id: adminUserID, AccountID: testAccountID, Role: types.UserRoleAdmin, AutoGroups: []string{}},
+			regularUserID: {Id: regularUserID, AccountID: testAccountID, Role: types.UserRoleUser, AutoGroups: []string{}},
+		},
+	}
+	require.NoError(t, testStore.SaveAccount(ctx, account))
+
+	var events []activity.ActivityDescriber
+	saveUser := func(ctx context.Context, user *types.User) error {
+		if user.Role == types.UserRoleOwner && user.Blocked {
+			return status.Errorf(status.PermissionDenied, "unable to block owner user")
+		}
+		return testStore.SaveUser(ctx, user)
+	}
+	accountManager := &mock_server.MockAccountManager{
+		StoreEventFunc: func(_ context.Context, _, _, _ string, activityID activity.ActivityDescriber, _ map[string]any) {
+			events = append(events, activityID)
+		},
+		SaveOrAddUserFunc: func(ctx context.Context, _, _ string, user *types.User, _ bool) (*types.UserInfo, error) {
+			return &types.UserInfo{ID: user.Id}, saveUser(ctx, user)
+		},
+		SaveOrAddUsersFunc: func(ctx context.Context, _, _ string, users []*types.User, _ bool) ([]*types.UserInfo, error) {
+			for _, user := range users {
+				if err := saveUser(ctx, user); err != nil {
+					return nil, err
+				}
+			}
+			return nil, nil
+		},
+		DeleteUserFunc: func(ctx context.Context, accountID, _, userID string) error {
+			return testStore.DeleteUser(ctx, accountID, userID)
+		},
+		SaveGroupFunc: func(ctx context.Context, accountID, _ string, group *types.Group, create bool) error {
+			group.AccountID = accountID
+			if create {
+				return testStore.CreateGroup(ctx, group)
+			}
+			return testStore.UpdateGroup(ctx, group)
+		},
+		DeleteGroupFunc: func(ctx context.Context, accountID, _, groupID string) error {
+			return testStore.DeleteGroup(ctx, accountID, groupID)
+		},
+	}
+
+	return &managerImpl{
+		store:              testStore,
+		accountManager:     accountManager,
+		permissionsManager: permissions.NewManager(testStore),
+	}, testStore, &events
+}
+
+func createIntegration(t *testing.T, m *managerImpl, integration *scim.Integration) (*scim.Integration, string) {
+	t.Helper()
+
+	created, token, err := m.CreateIntegration(context.Background(), testAccountID, adminUserID, integration)
+	require.NoError(t, err)
+	return created, token
+}
+
+func TestManager_IntegrationLifecycle(t *testing.T) {
+	ctx := context.Background()
+	m, _, events := setupTest(t)
+
+	_, _, err := m.CreateIntegration(ctx, testAccountID, regularUserID, &scim.Integration{Provider: "okta", Prefix: "okta"})
+	assert.Error(t, err, "regular users can't manage SCIM integrations")
+
+	_, _, err = m.CreateIntegration(ctx, testAccountID, adminUserID, &scim.Integration{Provider: "okta"})
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.InvalidArgument, s.Type())
+
+	created, token := createIntegration(t, m, &scim.Integration{Provider: "okta", Prefix: "okta", Enabled: true})
+	assert.True(t, len(token) > len(scim.TokenPrefix))
+	assert.Equal(t, scim.TokenPrefix, token[:len(scim.TokenPrefix)])
+	assert.NotContains(t, created.ToAPIResponse("").AuthToken, token[len(scim.TokenPrefix):])
+	assert.Equal(t, token, created.ToAPIResponse(token).AuthToken)
+
+	authenticated, err := m.Authenticate(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, authenticated.ID)
+
+	_, err = m.Authenticate(ctx, token+"x")
+	s, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.Unauthenticated, s.Type())
+
+	newToken, err := m.RegenerateToken(ctx, testAccountID, adminUserID, created.ID)
+	require.NoError(t, err)
+	_, err = m.Authenticate(ctx, token)
+	assert.Error(t, err, "the previous token must stop working")
+	_, err = m.Authenticate(ctx, newToken)
+	require.NoError(t, err)
+
+	created.Enabled = false
+	_, err = m.UpdateIntegration(ctx, testAccountID, adminUserID, created)
+	require.NoError(t, err)
+	_, err = m.Authenticate(ctx, newToken)
+	assert.Error(t, err, "disabled integrations can't authenticate")
+
+	require.NoError(t, m.DeleteIntegration(ctx, testAccountID, adminUserID, created.ID))
+	_, err = m.GetIntegration(ctx, testAccountID, adminUserID, created.ID)
+	assert.Error(t, err)
+
+	assert.Equal(t, []activity.ActivityDescriber{
+		activity.SCIMIntegrationCreated,
+		activity.SCIMIntegrationTokenRegenerated,
+		activity.SCIMIntegrationUpdated,
+		activity.SCIMIntegrationDeleted,
+	}, *events)
+}
+
+func TestManager_ProvisionUsers(t *testing.T) {
+	ctx := context.Background()
+	m, testStore, _ := setupTest(t)
+	integration, _ := createIntegration(t, m, &scim.Integration{Provider: "okta", Prefix: "okta"})
+
+	created, err := m.CreateUser(ctx, integration, &scim.User{
+		ExternalID: "00u1",
+		UserName:   "alice@example.com",
+		Name:       &scim.Name{GivenName: "Alice", FamilyName: "Doe"},
+		Emails:     []scim.Email{{Value: "alice@example.com", Primary: true}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "00u1", created.ID)
+	assert.Equal(t, "Alice Doe", created.DisplayName)
+	require.NotNil(t, created.Active)
+	assert.True(t, *created.Active)
+
+	stored, err := testStore.GetUserByUserID(ctx, store.LockingStrengthNone, "00u1")
+	require.NoError(t, err)
+	assert.Equal(t, types.UserIssuedIntegration, stored.Issued)
+	assert.Equal(t, int(integration.ID), stored.IntegrationReference.ID)
+	assert.Equal(t, scim.IntegrationType, stored.IntegrationReference.IntegrationType)
+
+	_, err = m.CreateUser(ctx, integration, &scim.User{ExternalID: "00u2", UserName: "alice@example.com", Emails: []scim.Email{{Value: "ALICE@example.com"}}})
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.AlreadyExists, s.Type())
+
+	filter, err := scim.ParseFilter(`userName eq "alice@example.com"`)
+	require.NoError(t, err)
+	users, err := m.ListUsers(ctx, integration, filter)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "00u1", users[0].ID)
+
+	patched, err := m.PatchUser(ctx, integration, "00u1", []scim.PatchOperation{
+		{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)},
+		{Op: "replace", Value: json.RawMessage(`{"displayName":"Alice D."}`)},
+	})
+	require.NoError(t, err)
+	assert.False(t, *patched.Active)
+	assert.Equal(t, "Alice D.", patched.DisplayName)
+
+	stored, err = testStore.GetUserByUserID(ctx, store.LockingStrengthNone, "00u1")
+	require.NoError(t, err)
+	assert.True(t, stored.Blocked, "deactivated users are blocked")
+
+	active := true
+	replaced, err := m.ReplaceUser(ctx, integration, "00u1", &scim.User{UserName: "alice@example.com", DisplayName: "Alice", Active: &active})
+	require.NoError(t, err)
+	assert.True(t, *replaced.Active)
+
+	inactive := false
+	_, err = m.ReplaceUser(ctx, integration, ownerUserID, &scim.User{UserName: ownerUserID, Active: &inactive})
+	s, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.NotFound, s.Type(), "the owner was not provisioned by the integration")
+
+	require.NoError(t, m.DeleteUser(ctx, integration, "00u1"))
+	_, err = m.GetUser(ctx, integration, "00u1")
+	s, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.NotFound, s.Type())
+
+	logs, err := m.GetSyncLogs(ctx, testAccountID, adminUserID, integration.ID)
+	require.NoError(t, err)
+	assert.NotEmpty(t, logs)
+	assert.Equal(t, "delete user 00u1", logs[0].Message)
+}
+
+func TestManager_WritesOnlyProvisionedUsers(t *testing.T) {
+	ctx := context.Background()
+	m, testStore, _ := setupTest(t)
+	integration, _ := createIntegration(t, m, &scim.Integration{Provider: "okta", Prefix: "okta"})
+	other, _ := createIntegration(t, m, &scim.Integration{Provider: "entra", Prefix: "entra"})
+
+	dashboardUser := &types.User{Id: "dashboard-1", AccountID: testAccountID, Role: types.UserRoleUser, AutoGroups: []string{}, Issued: types.UserIssuedAPI}
+	require.NoError(t, testStore.SaveUser(ctx, dashboardUser))
+	otherUser, err := m.CreateUser(ctx, other, &scim.User{ExternalID: "00u9", UserName: "bob@example.com"})
+	require.NoError(t, err)
+
+	for _, id := range []string{ownerUserID, adminUserID, dashboardUser.Id, otherUser.ID} {
+		user, err := m.GetUser(ctx, integration, id)
+		require.NoError(t, err, "users of the account can be read")
+		assert.Equal(t, id, user.ID)
+
+		_, err = m.PatchUser(ctx, integration, id, []scim.PatchOperation{{Op: "replace", Path: "active", Value: json.RawMessage(`false`)}})
+		assertNotFound(t, err)
+		_, err = m.ReplaceUser(ctx, integration, id, &scim.User{UserName: id})
+		assertNotFound(t, err)
+		assertNotFound(t, m.DeleteUser(ctx, integration, id))
+
+		stored, err := testStore.GetUserByUserID(ctx, store.LockingStrengthNone, id)
+		require.NoError(t, err, "the user must not be deleted")
+		assert.False(t, stored.Blocked, "the user must not be deactivated")
+	}
+}
+
+func assertNotFound(t *testing.T, err error) {
+	t.Helper()
+
+	s, ok := status.FromError(err)
+	require.True(t, ok, "expected a status error, got %v", err)
+	assert.Equal(t, status.NotFound, s.Type())
+}
+
+func TestManager_ProvisionUsersWithConnector(t *testing.T) {
+	ctx := context.Background()
+	m, testStore, _ := setupTest(t)
+	integration, _ := createIntegration(t, m, &scim.Integration{Provider: "okta", Prefix: "okta", ConnectorID: "okta-oidc"})
+
+	created, err := m.CreateUser(ctx, integration, &scim.User{ExternalID: "00u1", UserName: "alice@example.com"})
+	require.NoError(t, err)
+	assert.NotEqual(t, "00u1", created.ID, "the user ID must match the subject issued by the connector")
+	assert.Equal(t, "00u1", created.ExternalID)
+
+	_, err = testStore.GetUserByUserID(ctx, store.LockingStrengthNone, created.ID)
+	require.NoError(t, err)
+}
+
+func TestManager_ProvisionGroups(t *testing.T) {
+	ctx := context.Background()
+	m, testStore, _ := setupTest(t)
+	integration, _ := createIntegration(t, m, &scim.Integration{Provider: "okta", Prefix: "okta", GroupPrefixes: []string{"nb-"}})
+
+	_, err := m.CreateGroup(ctx, integration, &scim.Group{DisplayName: "engineering"})
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.InvalidArgument, s.Type(), "groups outside the prefixes are rejected")
+
+	_, err = m.CreateGroup(ctx, integration, &scim.Group{DisplayName: "nb-engineering", Members: []scim.Reference{{Value: "missing"}}})
+	assert.Error(t, err, "unknown members are rejected")
+
+	group, err := m.CreateGroup(ctx, integration, &scim.Group{DisplayName: "nb-engineering", Members: []scim.Reference{{Value: regularUserID}}})
+	require.NoError(t, err)
+	require.Len(t, group.Members, 1)
+
+	stored, err := testStore.GetGroupByID(ctx, store.LockingStrengthNone, testAccountID, group.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.GroupIssuedIntegration, stored.Issued)
+	assertAutoGroups(t, testStore, regularUserID, group.ID, true)
+
+	patched, err := m.PatchGroup(ctx, integration, group.ID, []scim.PatchOperation{
+		{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"` + adminUserID + `"}]`)},
+		{Op: "remove", Path: `members[value eq "` + regularUserID + `"]`},
+		{Op: "replace", Path: "displayName", Value: json.RawMessage(`"nb-platform"`)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "nb-platform", patched.DisplayName)
+	require.Len(t, patched.Members, 1)
+	assert.Equal(t, adminUserID, patched.Members[0].Value)
+	assertAutoGroups(t, testStore, regularUserID, group.ID, false)
+	assertAutoGroups(t, testStore, adminUserID, group.ID, true)
+
+	user, err := m.GetUser(ctx, integration, adminUserID)
+	require.NoError(t, err)
+	assert.Equal(t, []scim.Reference{{Value: group.ID, Display: "nb-platform"}}, user.Groups)
+
+	apiGroup := &types.Group{ID: "api-group", AccountID: testAccountID, Name: "nb-api", Issued: types.GroupIssuedAPI}
+	require.NoError(t, testStore.CreateGroup(ctx, apiGroup))
+	groups, err := m.ListGroups(ctx, integration, nil)
+	require.NoError(t, err)
+	require.Len(t, groups, 1, "only the groups of the integration are exposed")
+	_, err = m.GetGroup(ctx, integration, apiGroup.ID)
+	assert.Error(t, err)
+
+	require.NoError(t, m.DeleteGroup(ctx, integration, group.ID))
+	assertAutoGroups(t, testStore, adminUserID, group.ID, false)
+	_, err = testStore.GetGroupByID(ctx, store.LockingStrengthNone, testAccountID, group.ID)
+	assert.Error(t, err)
+}
+
+func assertAutoGroups(t *testing.T, s store.Store, userID, groupID string, contains bool) {
+	t.Helper()
+
+	user, err := s.GetUserByUserID(context.Background(), store.LockingStrengthNone, userID)
+	require.NoError(t, err)
+	assert.Equal(t, contains, slices.Contains(user.AutoGroups, groupID))
+}
@@ -0,0 +1,804 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/idp/dex"
+	"github.com/netbirdio/netbird/management/internals/modules/scim"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/integration_reference"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+func (m *managerImpl) ListUsers(ctx context.Context, integration *scim.Integration, filter *scim.Filter) ([]*scim.User, error) {
+	users, err := m.getAccountUsers(ctx, integration.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := m.getIntegrationGroups(ctx, integration)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*scim.User, 0, len(users))
+	for _, user := range users {
+		scimUser := toSCIMUser(integration, user, groups)
+		if filter.Matches(scimUser.Attribute) {
+			result = append(result, scimUser)
+		}
+	}
+
+	return result, nil
+}
+
+func (m *managerImpl) GetUser(ctx context.Context, integration *scim.Integration, id string) (*scim.User, error) {
+	user, err := m.getAccountUser(ctx, integration, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.renderUser(ctx, integration, user)
+}
+
+// CreateUser adds a user with the ID the integration identifies it by. The user signs in with that ID, so the
+// integration connector is encoded into it when the account uses the embedded IdP.
+func (m *managerImpl) CreateUser(ctx context.Context, integration *scim.Integration, user *scim.User) (*scim.User, error) {
+	userID, err := provisionedUserID(integration, user)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := m.createUser(ctx, integration, userID, user)
+	m.recordSync(ctx, integration, err, "create user %s", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.renderUser(ctx, integration, created)
+}
+
+func (m *managerImpl) createUser(ctx context.Context, integration *scim.Integration, userID string, user *scim.User) (*types.User, error) {
+	users, err := m.getAccountUsers(ctx, integration.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	email := user.PrimaryEmail()
+	for _, existing := range users {
+		if existing.Id == userID {
+			return nil, status.Errorf(status.AlreadyExists, "user %s already exists", userID)
+		}
+		if email != "" && strings.EqualFold(existing.Email, email) {
+			return nil, status.Errorf(status.AlreadyExists, "user with email %s already exists", email)
+		}
+	}
+
+	newUser := &types.User{
+		Id:         userID,
+		AccountID:  integration.AccountID,
+		Role:       types.UserRoleUser,
+		Blocked:    !isActive(user),
+		AutoGroups: []string{},
+		Issued:     types.UserIssuedIntegration,
+		IntegrationReference: integration_reference.IntegrationReference{
+			ID:              int(integration.ID),
+			IntegrationType: scim.IntegrationType,
+		},
+		Name:  user.FullName(),
+		Email: email,
+	}
+
+	if _, err := m.accountManager.SaveOrAddUser(ctx, integration.AccountID, activity.SystemInitiator, newUser, true); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetUserByUserID(ctx, store.LockingStrengthNone, userID)
+}
+
+func (m *managerImpl) ReplaceUser(ctx context.Context, integration *scim.Integration, id string, user *scim.User) (*scim.User, error) {
+	existing, err := m.getProvisionedUser(ctx, integration, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := m.updateUser(ctx, integration, existing, user)
+	m.recordSync(ctx, integration, err, "replace user %s", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.renderUser(ctx, integration, updated)
+}
+
+func (m *managerImpl) PatchUser(ctx context.Context, integration *scim.Integration, id string, operations []scim.PatchOperation) (*scim.User, error) {
+	existing, err := m.getProvisionedUser(ctx, integration, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := m.patchUser(ctx, integration, existing, operations)
+	m.recordSync(ctx, integration, err, "patch user %s", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.renderUser(ctx, integration, updated)
+}
+
+func (m *managerImpl) patchUser(ctx context.Context, integration *scim.Integration, existing *types.User, operations []scim.PatchOperation) (*types.User, error) {
+	user := toSCIMUser(integration, existing, nil)
+	for _, operation := range operations {
+		if err := applyUserPatch(user, operation); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.updateUser(ctx, integration, existing, user)
+}
+
+// updateUser saves the name, email and active state of a user. Deactivating a user blocks it, which expires
+// the sessions of its peers.
+func (m *managerImpl) updateUser(ctx context.Context, integration *scim.Integration, existing *types.User, user *scim.User) (*types.User, error) {
+	active := isActive(user)
+	if existing.IsBlocked() != !active && existing.Role == types.UserRoleOwner {
+		return nil, status.Errorf(status.PermissionDenied, "unable to deactivate the account owner")
+	}
+
+	update := existing.Copy()
+	update.Blocked = !active
+	update.Name = user.FullName()
+	update.Email = user.PrimaryEmail()
+
+	if _, err := m.accountManager.SaveOrAddUser(ctx, integration.AccountID, activity.SystemInitiator, update, false); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetUserByUserID(ctx, store.LockingStrengthNone, existing.Id)
+}
+
+func (m *managerImpl) DeleteUser(ctx context.Context, integration *scim.Integration, id string) error {
+	user, err := m.getProvisionedUser(ctx, integration, id)
+	if err != nil {
+		return err
+	}
+
+	if user.Role == types.UserRoleOwner {
+		err = status.Errorf(status.PermissionDenied, "unable to delete the account owner")
+	} else {
+		err = m.accountManager.DeleteUser(ctx, integration.AccountID, activity.SystemInitiator, user.Id)
+	}
+	m.recordSync(ctx, integration, err, "delete user %s", id)
+
+	return err
+}
+
+func (m *managerImpl) ListGroups(ctx context.Context, integration *scim.Integration, filter *scim.Filter) ([]*scim.Group, error) {
+	groups, err := m.getIntegrationGroups(ctx, integration)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := m.getAccountUsers(ctx, integration.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*scim.Group, 0, len(groups))
+	for _, group := range groups {
+		scimGroup := toSCIMGroup(group, users)
+		if filter.Matches(scimGroup.Attribute) {
+			result = append(result, scimGroup)
+		}
+	}
+
+	return result, nil
+}
+
+func (m *managerImpl) GetGroup(ctx context.Context, integration *scim.Integration, id string) (*scim.Group, error) {
+	group, err := m.getIntegrationGroup(ctx, integration, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.renderGroup(ctx, integration, group)
+}
+
+// CreateGroup adds an integration issued group and assigns it to the member users as an auto group.
+func (m *managerImpl) CreateGroup(ctx context.Context, integration *scim.Integration, group *scim.Group) (*scim.Group, error) {
+	created, err := m.createGroup(ctx, integration, group)
+	m.recordSync(ctx, integration, err, "create group %s", group.DisplayName)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.renderGroup(ctx, integration, created)
+}
+
+func (m *managerImpl) createGroup(ctx context.Context, integration *scim.Integration, group *scim.Group) (*types.Group, error) {
+	if err := validateGroupName(integration, group.DisplayName); err != nil {
+		return nil, err
+	}
+
+	users, err := m.getAccountUsers(ctx, integration.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMembers(group.Members, users); err != nil {
+		return nil, err
+	}
+
+	newGroup := &types.Group{
+		ID:     xid.New().String(),
+		Name:   group.DisplayName,
+		Issued: types.GroupIssuedIntegration,
+		IntegrationReference: integration_reference.IntegrationReference{
+			ID:              int(integration.ID),
+			IntegrationType: scim.IntegrationType,
+		},
+	}
+	if err := m.accountManager.CreateGroup(ctx, integration.AccountID, activity.SystemInitiator, newGroup); err != nil {
+		return nil, err
+	}
+
+	if err := m.setGroupMembers(ctx, integration.AccountID, newGroup.ID, group.Members, users); err != nil {
+		return nil, err
+	}
+
+	return newGroup, nil
+}
+
+func (m *managerImpl) ReplaceGroup(ctx context.Context, integration *scim.Integration, id string, group *scim.Group) (*scim.Group, error) {
+	existing, err := m.getIntegrationGroup(ctx, integration, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := m.updateGroup(ctx, integration, existing, group)
+	m.recordSync(ctx, integration, err, "replace group %s", existing.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.renderGroup(ctx, integration, updated)
+}
+
+func (m *managerImpl) PatchGroup(ctx context.Context, integration *scim.Integration, id string, operations []scim.PatchOperation) (*scim.Group, error) {
+	existing, err := m.getIntegrationGroup(ctx, integration, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := m.patchGroup(ctx, integration, existing, operations)
+	m.recordSync(ctx, integration, err, "patch group %s", existing.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.renderGroup(ctx, integration, updated)
+}
+
+func (m *managerImpl) patchGroup(ctx context.Context, integration *scim.Integration, existing *types.Group, operations []scim.PatchOperation) (*types.Group, error) {
+	users, err := m.getAccountUsers(ctx, integration.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	group := toSCIMGroup(existing, users)
+	for _, operation := range operations {
+		if err := applyGroupPatch(group, operation); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.updateGroup(ctx, integration, existing, group)
+}
+
+// updateGroup renames the group if needed and syncs its members with the ones of the SCIM group.
+func (m *managerImpl) updateGroup(ctx context.Context, integration *scim.Integration, existing *types.Group, group *scim.Group) (*types.Group, error) {
+	if err := validateGroupName(integration, group.DisplayName); err != nil {
+		return nil, err
+	}
+
+	users, err := m.getAccountUsers(ctx, integration.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMembers(group.Members, users); err != nil {
+		return nil, err
+	}
+
+	updated := existing.Copy()
+	if updated.Name != group.DisplayName {
+		updated.Name = group.DisplayName
+		if err := m.accountManager.UpdateGroup(ctx, integration.AccountID, activity.SystemInitiator, updated); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := m.setGroupMembers(ctx, integration.AccountID, existing.ID, group.Members, users); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeleteGroup removes the group from the auto groups of its members before deleting it, other links such as
+// policies still prevent the deletion.
+func (m *managerImpl) DeleteGroup(ctx context.Context, integration *scim.Integration, id string) error {
+	group, err := m.getIntegrationGroup(ctx, integration, id)
+	if err != nil {
+		return err
+	}
+
+	err = m.deleteGroup(ctx, integration, group)
+	m.recordSync(ctx, integration, err, "delete group %s", group.Name)
+
+	return err
+}
+
+func (m *managerImpl) deleteGroup(ctx context.Context, integration *scim.Integration, group *types.Group) error {
+	users, err := m.getAccountUsers(ctx, integration.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if err := m.setGroupMembers(ctx, integration.AccountID, group.ID, nil, users); err != nil {
+		return err
+	}
+
+	err = m.accountManager.DeleteGroup(ctx, integration.AccountID, activity.SystemInitiator, group.ID)
+	if _, ok := status.FromError(err); !ok {
+		return status.Errorf(status.PreconditionFailed, "%s", err.Error())
+	}
+	return err
+}
+
+// setGroupMembers adds the group to the auto groups of the members and removes it from the other users.
+func (m *managerImpl) setGroupMembers(ctx context.Context, accountID, groupID string, members []scim.Reference, users []*types.User) error {
+	memberIDs := make(map[string]struct{}, len(members))
+	for _, member := range members {
+		memberIDs[member.Value] = struct{}{}
+	}
+
+	var updates []*types.User
+	for _, user := range users {
+		_, isMember := memberIDs[user.Id]
+		hasGroup := slices.Contains(user.AutoGroups, groupID)
+		if isMember == hasGroup {
+			continue
+		}
+
+		update := user.Copy()
+		if isMember {
+			update.AutoGroups = append(update.AutoGroups, groupID)
+		} else {
+			update.AutoGroups = slices.DeleteFunc(update.AutoGroups, func(id string) bool { return id == groupID })
+		}
+		updates = append(updates, update)
+	}
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	_, err := m.accountManager.SaveOrAddUsers(ctx, accountID, activity.SystemInitiator, updates, false)
+	return err
+}
+
+// getAccountUsers returns the regular users of the account, service users can't be provisioned.
+func (m *managerImpl) getAccountUsers(ctx context.Context, accountID string) ([]*types.User, error) {
+	users, err := m.store.GetAccountUsers(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(users, func(user *types.User) bool { return user.IsServiceUser }), nil
+}
+
+// getAccountUser returns a regular user of the account. Every such user can be read over SCIM, so clients
+// can match existing users.
+func (m *managerImpl) getAccountUser(ctx context.Context, integration *scim.Integration, id string) (*types.User, error) {
+	user, err := m.store.GetUserByUserID(ctx, store.LockingStrengthNone, id)
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Type() == status.NotFound {
+			return nil, status.NewUserNotFoundError(id)
+		}
+		return nil, err
+	}
+
+	if user.AccountID != integration.AccountID || user.IsServiceUser {
+		return nil, status.NewUserNotFoundError(id)
+	}
+
+	return user, nil
+}
+
+// getProvisionedUser returns a user provisioned by the integration. Only those users can be changed or deleted
+// over SCIM, users created in the dashboard or by another integration are reported as not found.
+func (m *managerImpl) getProvisionedUser(ctx context.Context, integration *scim.Integration, id string) (*types.User, error) {
+	user, err := m.getAccountUser(ctx, integration, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isIntegrationUser(integration, user) {
+		return nil, status.NewUserNotFoundError(id)
+	}
+
+	return user, nil
+}
+
+// getIntegrationGroups returns the groups provisioned by the integration, other groups are not exposed over
+// SCIM.
+func (m *managerImpl) getIntegrationGroups(ctx context.Context, integration *scim.Integration) ([]*types.Group, error) {
+	groups, err := m.store.GetAccountGroups(ctx, store.LockingStrengthNone, integration.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(groups, func(group *types.Group) bool { return !isIntegrationGroup(integration, group) }), nil
+}
+
+func (m *managerImpl) getIntegrationGroup(ctx context.Context, integration *scim.Integration, id string) (*types.Group, error) {
+	group, err := m.store.GetGroupByID(ctx, store.LockingStrengthNone, integration.AccountID, id)
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Type() == status.NotFound {
+			return nil, status.NewGroupNotFoundError(id)
+		}
+		return nil, err
+	}
+
+	if !isIntegrationGroup(integration, group) {
+		return nil, status.NewGroupNotFoundError(id)
+	}
+
+	return group, nil
+}
+
+func (m *managerImpl) renderUser(ctx context.Context, integration *scim.Integration, user *types.User) (*scim.User, error) {
+	groups, err := m.getIntegrationGroups(ctx, integration)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSCIMUser(integration, user, groups), nil
+}
+
+func (m *managerImpl) renderGroup(ctx context.Context, integration *scim.Integration, group *types.Group) (*scim.Group, error) {
+	users, err := m.getAccountUsers(ctx, integration.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSCIMGroup(group, users), nil
+}
+
+func isIntegrationUser(integration *scim.Integration, user *types.User) bool {
+	return user.Issued == types.UserIssuedIntegration &&
+		user.IntegrationReference.IntegrationType == scim.IntegrationType &&
+		user.IntegrationReference.ID == int(integration.ID)
+}
+
+func isIntegrationGroup(integration *scim.Integration, group *types.Group) bool {
+	return group.Issued == types.GroupIssuedIntegration &&
+		group.IntegrationReference.IntegrationType == scim.IntegrationType &&
+		group.IntegrationReference.ID == int(integration.ID)
+}
+
+// provisionedUserID returns the NetBird user ID of a SCIM user, derived from its external ID or user name.
+func provisionedUserID(integration *scim.Integration, user *scim.User) (string, error) {
+	externalID := user.ExternalID
+	if externalID == "" {
+		externalID = user.UserName
+	}
+	if externalID == "" {
+		return "", status.Errorf(status.InvalidArgument, "user externalId or userName is required")
+	}
+
+	if integration.ConnectorID != "" {
+		return dex.EncodeDexUserID(externalID, integration.ConnectorID), nil
+	}
+	return externalID, nil
+}
+
+func toSCIMUser(integration *scim.Integration, user *types.User, groups []*types.Group) *scim.User {
+	externalID := user.Id
+	if integration.ConnectorID != "" {
+		if rawID, connectorID, err := dex.DecodeDexUserID(user.Id); err == nil && connectorID == integration.ConnectorID {
+			externalID = rawID
+		}
+	}
+
+	active := !user.IsBlocked()
+	scimUser := &scim.User{
+		Schemas:     []string{scim.UserSchema},
+		ID:          user.Id,
+		ExternalID:  externalID,
+		UserName:    user.Email,
+		DisplayName: user.Name,
+		Active:      &active,
+		Meta:        &scim.Meta{ResourceType: "User"},
+	}
+	if scimUser.UserName == "" {
+		scimUser.UserName = externalID
+	}
+	if user.Email != "" {
+		scimUser.Emails = []scim.Email{{Value: user.Email, Type: "work", Primary: true}}
+	}
+	if !user.CreatedAt.IsZero() {
+		scimUser.Meta.Created = &user.CreatedAt
+	}
+
+	for _, group := range groups {
+		if slices.Contains(user.AutoGroups, group.ID) {
+			scimUser.Groups = append(scimUser.Groups, scim.Reference{Value: group.ID, Display: group.Name})
+		}
+	}
+
+	return scimUser
+}
+
+func toSCIMGroup(group *types.Group, users []*types.User) *scim.Group {
+	scimGroup := &scim.Group{
+		Schemas:     []string{scim.GroupSchema},
+		ID:          group.ID,
+		DisplayName: group.Name,
+		Meta:        &scim.Meta{ResourceType: "Group"},
+	}
+
+	for _, user := range users {
+		if slices.Contains(user.AutoGroups, group.ID) {
+			scimGroup.Members = append(scimGroup.Members, scim.Reference{Value: user.Id, Display: user.Email})
+		}
+	}
+
+	return scimGroup
+}
+
+func validateGroupName(integration *scim.Integration, name string) error {
+	if name == "" {
+		return status.Errorf(status.InvalidArgument, "group displayName is required")
+	}
+	if !integration.AllowsGroup(name) {
+		return status.Errorf(status.InvalidArgument, "group %s doesn't match the group prefixes of the integration", name)
+	}
+	return nil
+}
+
+func validateMembers(members []scim.Reference, users []*types.User) error {
+	for _, member := range members {
+		if !slices.ContainsFunc(users, func(user *types.User) bool { return user.Id == member.Value }) {
+			return status.Errorf(status.InvalidArgument, "group member %s doesn't exist", member.Value)
+		}
+	}
+	return nil
+}
+
+// isActive reports whether the user is active, users without the attribute are active.
+func isActive(user *scim.User) bool {
+	return user.Active == nil || *user.Active
+}
+
+// applyUserPatch applies a patch operation to the SCIM user. Attributes NetBird doesn't store are ignored.
+func applyUserPatch(user *scim.User, operation scim.PatchOperation) error {
+	op, err := patchOp(operation)
+	if err != nil {
+		return err
+	}
+
+	if operation.Path == "" {
+		return applyAttributes(operation.Value, func(path string, value json.RawMessage) error {
+			return applyUserAttribute(user, op, path, value)
+		})
+	}
+
+	return applyUserAttribute(user, op, strings.ToLower(operation.Path), operation.Value)
+}
+
+func applyUserAttribute(user *scim.User, op, path string, value json.RawMessage) error {
+	if strings.HasPrefix(path, "emails[") && strings.HasSuffix(path, "].value") {
+		path = "emails.value"
+	}
+
+	switch path {
+	case "active":
+		if op == scim.PatchOpRemove {
+			return status.Errorf(status.InvalidArgument, "active can't be removed")
+		}
+		active, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		user.Active = &active
+	case "externalid":
+		return patchString(&user.ExternalID, op, value)
+	case "username":
+		return patchString(&user.UserName, op, value)
+	case "displayname":
+		return patchString(&user.DisplayName, op, value)
+	case "name":
+		if op == scim.PatchOpRemove {
+			user.Name = nil
+			return nil
+		}
+		name := &scim.Name{}
+		if err := unmarshalValue(value, name); err != nil {
+			return err
+		}
+		user.Name = name
+	case "name.givenname", "name.familyname", "name.formatted":
+		if user.Name == nil {
+			user.Name = &scim.Name{}
+		}
+		field := map[string]*string{
+			"name.givenname":  &user.Name.GivenName,
+			"name.familyname": &user.Name.FamilyName,
+			"name.formatted":  &user.Name.Formatted,
+		}[path]
+		return patchString(field, op, value)
+	case "emails":
+		if op == scim.PatchOpRemove {
+			user.Emails = nil
+			return nil
+		}
+		var emails []scim.Email
+		if err := unmarshalValue(value, &emails); err != nil {
+			return err
+		}
+		if op == scim.PatchOpAdd {
+			emails = append(user.Emails, emails...)
+		}
+		user.Emails = emails
+	case "emails.value":
+		var email string
+		if op != scim.PatchOpRemove {
+			if err := unmarshalValue(value, &email); err != nil {
+				return err
+			}
+		}
+		if len(user.Emails) == 0 {
+			user.Emails = []scim.Email{{Primary: true}}
+		}
+		user.Emails[0].Value = email
+	}
+
+	return nil
+}
+
+// applyGroupPatch applies a patch operation to the SCIM group. Attributes NetBird doesn't store are ignored.
+func applyGroupPatch(group *scim.Group, operation scim.PatchOperation) error {
+	op, err := patchOp(operation)
+	if err != nil {
+		return err
+	}
+
+	if operation.Path == "" {
+		return applyAttributes(operation.Value, func(path string, value json.RawMessage) error {
+			return applyGroupAttribute(group, op, path, value)
+		})
+	}
+
+	return applyGroupAttribute(group, op, operation.Path, operation.Value)
+}
+
+func applyGroupAttribute(group *scim.Group, op, path string, value json.RawMessage) error {
+	// member removals can select the member with a value filter, e.g. members[value eq "id"]
+	if op == scim.PatchOpRemove && strings.HasPrefix(strings.ToLower(path), "members[") && strings.HasSuffix(path, "]") {
+		filter, err := scim.ParseFilter(path[len("members[") : len(path)-1])
+		if err != nil || filter == nil {
+			return status.Errorf(status.InvalidArgument, "invalid members path %s", path)
+		}
+		group.Members = slices.DeleteFunc(group.Members, func(member scim.Reference) bool {
+			return filter.Matches(func(attribute string) []string {
+				if attribute == "value" {
+					return []string{member.Value}
+				}
+				return nil
+			})
+		})
+		return nil
+	}
+
+	switch strings.ToLower(path) {
+	case "displayname":
+		if op == scim.PatchOpRemove {
+			return status.Errorf(status.InvalidArgument, "displayName can't be removed")
+		}
+		return patchString(&group.DisplayName, op, value)
+	case "members":
+		var members []scim.Reference
+		if len(value) > 0 {
+			if err := unmarshalValue(value, &members); err != nil {
+				return err
+			}
+		}
+
+		switch op {
+		case scim.PatchOpAdd:
+			for _, member := range members {
+				if !slices.ContainsFunc(group.Members, func(existing scim.Reference) bool { return existing.Value == member.Value }) {
+					group.Members = append(group.Members, member)
+				}
+			}
+		case scim.PatchOpRemove:
+			if len(members) == 0 {
+				group.Members = nil
+				return nil
+			}
+			group.Members = slices.DeleteFunc(group.Members, func(existing scim.Reference) bool {
+				return slices.ContainsFunc(members, func(member scim.Reference) bool { return member.Value == existing.Value })
+			})
+		case scim.PatchOpReplace:
+			group.Members = members
+		}
+	}
+
+	return nil
+}
+
+func patchOp(operation scim.PatchOperation) (string, error) {
+	op := strings.ToLower(operation.Op)
+	switch op {
+	case scim.PatchOpAdd, scim.PatchOpRemove, scim.PatchOpReplace:
+		return op, nil
+	default:
+		return "", status.Errorf(status.InvalidArgument, "unsupported patch operation %q", operation.Op)
+	}
+}
+
+// applyAttributes applies an operation without a path, whose value holds the attributes to change.
+func applyAttributes(value json.RawMessage, apply func(path string, value json.RawMessage) error) error {
+	var attributes map[string]json.RawMessage
+	if err := unmarshalValue(value, &attributes); err != nil {
+		return err
+	}
+
+	for path, attributeValue := range attributes {
+		if err := apply(strings.ToLower(path), attributeValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func patchString(field *string, op string, value json.RawMessage) error {
+	if op == scim.PatchOpRemove {
+		*field = ""
+		return nil
+	}
+	return unmarshalValue(value, field)
+}
+
+// parseBool accepts booleans and their string form, which some identity providers send for active.
+func parseBool(value json.RawMessage) (bool, error) {
+	var result bool
+	if err := json.Unmarshal(value, &result); err == nil {
+		return result, nil
+	}
+
+	var text string
+	if err := unmarshalValue(value, &text); err != nil {
+		return false, err
+	}
+	result, err := strconv.ParseBool(strings.ToLower(text))
+	if err != nil {
+		return false, status.Errorf(status.InvalidArgument, "invalid boolean value %q", text)
+	}
+	return result, nil
+}
+
+func unmarshalValue(value json.RawMessage, target any) error {
+	if err := json.Unmarshal(value, target); err != nil {
+		return status.Errorf(status.InvalidArgument, "invalid patch value: %v", err)
+	}
+	return nil
+}
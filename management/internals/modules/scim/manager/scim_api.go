@@ -0,0 +1,346 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/internals/modules/scim"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+const (
+	// SCIMPathPrefix is the API path the SCIM 2.0 endpoints are served under. Requests to it authenticate with
+	// the integration bearer token instead of a user session.
+	SCIMPathPrefix = "/api/scim/v2"
+
+	scimContentType = "application/scim+json"
+	// maxPageSize caps the number of resources returned in a single list response
+	maxPageSize = 1000
+)
+
+type scimHandler struct {
+	manager scim.Manager
+}
+
+func registerSCIMEndpoints(router *mux.Router, manager scim.Manager) {
+	h := &scimHandler{
+		manager: manager,
+	}
+
+	router.HandleFunc("/scim/v2/ServiceProviderConfig", h.authenticated(h.getServiceProviderConfig)).Methods("GET")
+	router.HandleFunc("/scim/v2/Users", h.authenticated(h.listUsers)).Methods("GET")
+	router.HandleFunc("/scim/v2/Users", h.authenticated(h.createUser)).Methods("POST")
+	router.HandleFunc("/scim/v2/Users/{id}", h.authenticated(h.getUser)).Methods("GET")
+	router.HandleFunc("/scim/v2/Users/{id}", h.authenticated(h.replaceUser)).Methods("PUT")
+	router.HandleFunc("/scim/v2/Users/{id}", h.authenticated(h.patchUser)).Methods("PATCH")
+	router.HandleFunc("/scim/v2/Users/{id}", h.authenticated(h.deleteUser)).Methods("DELETE")
+	router.HandleFunc("/scim/v2/Groups", h.authenticated(h.listGroups)).Methods("GET")
+	router.HandleFunc("/scim/v2/Groups", h.authenticated(h.createGroup)).Methods("POST")
+	router.HandleFunc("/scim/v2/Groups/{id}", h.authenticated(h.getGroup)).Methods("GET")
+	router.HandleFunc("/scim/v2/Groups/{id}", h.authenticated(h.replaceGroup)).Methods("PUT")
+	router.HandleFunc("/scim/v2/Groups/{id}", h.authenticated(h.patchGroup)).Methods("PATCH")
+	router.HandleFunc("/scim/v2/Groups/{id}", h.authenticated(h.deleteGroup)).Methods("DELETE")
+}
+
+type scimHandlerFunc func(w http.ResponseWriter, r *http.Request, integration *scim.Integration)
+
+// authenticated resolves the integration from the bearer token of the request.
+func (h *scimHandler) authenticated(next scimHandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var token string
+		fields := strings.Fields(r.Header.Get("Authorization"))
+		if len(fields) == 2 && strings.EqualFold(fields[0], "Bearer") {
+			token = fields[1]
+		}
+
+		integration, err := h.manager.Authenticate(r.Context(), token)
+		if err != nil {
+			writeSCIMError(r.Context(), w, err)
+			return
+		}
+
+		next(w, r, integration)
+	}
+}
+
+func (h *scimHandler) getServiceProviderConfig(w http.ResponseWriter, r *http.Request, _ *scim.Integration) {
+	supported := func(ok bool) map[string]any { return map[string]any{"supported": ok} }
+
+	writeSCIMResponse(r.Context(), w, http.StatusOK, map[string]any{
+		"schemas":        []string{scim.ServiceProviderConfigSchema},
+		"patch":          supported(true),
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxPageSize},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with the SCIM integration token",
+		}},
+	})
+}
+
+func (h *scimHandler) listUsers(w http.ResponseWriter, r *http.Request, integration *scim.Integration) {
+	filter, err := parseFilterParam(r)
+	if err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	users, err := h.manager.ListUsers(r.Context(), integration, filter)
+	if err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	resources := make([]any, 0, len(users))
+	for _, user := range users {
+		resources = append(resources, user)
+	}
+	writeListResponse(w, r, resources)
+}
+
+func (h *scimHandler) createUser(w http.ResponseWriter, r *http.Request, integration *scim.Integration) {
+	var user scim.User
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		writeSCIMError(r.Context(), w, status.Errorf(status.InvalidArgument, "couldn't parse JSON request"))
+		return
+	}
+
+	created, err := h.manager.CreateUser(r.Context(), integration, &user)
+	if err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	writeSCIMResponse(r.Context(), w, http.StatusCreated, created)
+}
+
+func (h *scimHandler) getUser(w http.ResponseWriter, r *http.Request, integration *scim.Integration) {
+	user, err := h.manager.GetUser(r.Context(), integration, mux.Vars(r)["id"])
+	if err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	writeSCIMResponse(r.Context(), w, http.StatusOK, user)
+}
+
+func (h *scimHandler) replaceUser(w http.ResponseWriter, r *http.Request, integration *scim.Integration) {
+	var user scim.User
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		writeSCIMError(r.Context(), w, status.Errorf(status.InvalidArgument, "couldn't parse JSON request"))
+		return
+	}
+
+	updated, err := h.manager.ReplaceUser(r.Context(), integration, mux.Vars(r)["id"], &user)
+	if err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	writeSCIMResponse(r.Context(), w, http.StatusOK, updated)
+}
+
+func (h *scimHandler) patchUser(w http.ResponseWriter, r *http.Request, integration *scim.Integration) {
+	var req scim.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeSCIMError(r.Context(), w, status.Errorf(status.InvalidArgument, "couldn't parse JSON request"))
+		return
+	}
+
+	updated, err := h.manager.PatchUser(r.Context(), integration, mux.Vars(r)["id"], req.Operations)
+	if err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	writeSCIMResponse(r.Context(), w, http.StatusOK, updated)
+}
+
+func (h *scimHandler) deleteUser(w http.ResponseWriter, r *http.Request, integration *scim.Integration) {
+	if err := h.manager.DeleteUser(r.Context(), integration, mux.Vars(r)["id"]); err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *scimHandler) listGroups(w http.ResponseWriter, r *http.Request, integration *scim.Integration) {
+	filter, err := parseFilterParam(r)
+	if err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	groups, err := h.manager.ListGroups(r.Context(), integration, filter)
+	if err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	resources := make([]any, 0, len(groups))
+	for _, group := range groups {
+		resources = append(resources, group)
+	}
+	writeListResponse(w, r, resources)
+}
+
+func (h *scimHandler) createGroup(w http.ResponseWriter, r *http.Request, integration *scim.Integration) {
+	var group scim.Group
+	if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+		writeSCIMError(r.Context(), w, status.Errorf(status.InvalidArgument, "couldn't parse JSON request"))
+		return
+	}
+
+	created, err := h.manager.CreateGroup(r.Context(), integration, &group)
+	if err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	writeSCIMResponse(r.Context(), w, http.StatusCreated, created)
+}
+
+func (h *scimHandler) getGroup(w http.ResponseWriter, r *http.Request, integration *scim.Integration) {
+	group, err := h.manager.GetGroup(r.Context(), integration, mux.Vars(r)["id"])
+	if err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	writeSCIMResponse(r.Context(), w, http.StatusOK, group)
+}
+
+func (h *scimHandler) replaceGroup(w http.ResponseWriter, r *http.Request, integration *scim.Integration) {
+	var group scim.Group
+	if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+		writeSCIMError(r.Context(), w, status.Errorf(status.InvalidArgument, "couldn't parse JSON request"))
+		return
+	}
+
+	updated, err := h.manager.ReplaceGroup(r.Context(), integration, mux.Vars(r)["id"], &group)
+	if err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	writeSCIMResponse(r.Context(), w, http.StatusOK, updated)
+}
+
+func (h *scimHandler) patchGroup(w http.ResponseWriter, r *http.Request, integration *scim.Integration) {
+	var req scim.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeSCIMError(r.Context(), w, status.Errorf(status.InvalidArgument, "couldn't parse JSON request"))
+		return
+	}
+
+	updated, err := h.manager.PatchGroup(r.Context(), integration, mux.Vars(r)["id"], req.Operations)
+	if err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	writeSCIMResponse(r.Context(), w, http.StatusOK, updated)
+}
+
+func (h *scimHandler) deleteGroup(w http.ResponseWriter, r *http.Request, integration *scim.Integration) {
+	if err := h.manager.DeleteGroup(r.Context(), integration, mux.Vars(r)["id"]); err != nil {
+		writeSCIMError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseFilterParam(r *http.Request) (*scim.Filter, error) {
+	filter, err := scim.ParseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "invalid filter: %v", err)
+	}
+	return filter, nil
+}
+
+// writeListResponse writes the page of resources selected by the startIndex and count query parameters.
+// startIndex is 1-based as defined by RFC 7644.
+func writeListResponse(w http.ResponseWriter, r *http.Request, resources []any) {
+	startIndex := 1
+	if value, err := strconv.Atoi(r.URL.Query().Get("startIndex")); err == nil && value > 1 {
+		startIndex = value
+	}
+	count := maxPageSize
+	if value, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && value >= 0 && value < maxPageSize {
+		count = value
+	}
+
+	page := []any{}
+	if start := startIndex - 1; start < len(resources) {
+		page = resources[start:min(start+count, len(resources))]
+	}
+
+	writeSCIMResponse(r.Context(), w, http.StatusOK, scim.ListResponse{
+		Schemas:      []string{scim.ListResponseSchema},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	})
+}
+
+func writeSCIMResponse(ctx context.Context, w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.WithContext(ctx).Errorf("failed to write SCIM response: %v", err)
+	}
+}
+
+// writeSCIMError converts an error to a SCIM error response.
+func writeSCIMError(ctx context.Context, w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	scimType := ""
+	detail := "internal server error"
+
+	if s, ok := status.FromError(err); ok {
+		detail = s.Message
+		switch s.Type() {
+		case status.NotFound:
+			code = http.StatusNotFound
+		case status.AlreadyExists, status.UserAlreadyExists:
+			code = http.StatusConflict
+			scimType = "uniqueness"
+		case status.InvalidArgument:
+			code = http.StatusBadRequest
+			scimType = "invalidValue"
+		case status.PreconditionFailed:
+			code = http.StatusPreconditionFailed
+		case status.PermissionDenied:
+			code = http.StatusForbidden
+		case status.Unauthenticated, status.Unauthorized:
+			code = http.StatusUnauthorized
+		default:
+			code = http.StatusInternalServerError
+			detail = "internal server error"
+		}
+	}
+
+	if code == http.StatusInternalServerError {
+		log.WithContext(ctx).Errorf("SCIM request failed: %v", err)
+	}
+
+	writeSCIMResponse(ctx, w, code, scim.Error{
+		Schemas:  []string{scim.ErrorSchema},
+		Status:   strconv.Itoa(code),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
@@ -0,0 +1,181 @@
+package scim
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SCIM 2.0 schema URNs (RFC 7643, RFC 7644).
+const (
+	UserSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	GroupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	ListResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	ServiceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+// Patch operation names, matched case-insensitively.
+const (
+	PatchOpAdd     = "add"
+	PatchOpRemove  = "remove"
+	PatchOpReplace = "replace"
+)
+
+// User is the SCIM representation of a NetBird user.
+type User struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *Name       `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []Email     `json:"emails,omitempty"`
+	Active      *bool       `json:"active,omitempty"`
+	Groups      []Reference `json:"groups,omitempty"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// Reference points to another SCIM resource, such as a group member.
+type Reference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type Meta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+}
+
+// Group is the SCIM representation of a NetBird group. Members are the users having the group in their auto
+// groups.
+type Group struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []Reference `json:"members,omitempty"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// PrimaryEmail returns the primary email of the user, falling back to the first one.
+func (u *User) PrimaryEmail() string {
+	for _, email := range u.Emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+// FullName returns the display name of the user, falling back to the name components.
+func (u *User) FullName() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name == nil {
+		return ""
+	}
+	if u.Name.Formatted != "" {
+		return u.Name.Formatted
+	}
+	if u.Name.GivenName != "" && u.Name.FamilyName != "" {
+		return u.Name.GivenName + " " + u.Name.FamilyName
+	}
+	return u.Name.GivenName + u.Name.FamilyName
+}
+
+// Attribute returns the values of a user attribute for filter evaluation.
+func (u *User) Attribute(path string) []string {
+	switch path {
+	case "id":
+		return []string{u.ID}
+	case "externalid":
+		return []string{u.ExternalID}
+	case "username":
+		return []string{u.UserName}
+	case "displayname":
+		return []string{u.DisplayName}
+	case "emails", "emails.value":
+		values := make([]string, 0, len(u.Emails))
+		for _, email := range u.Emails {
+			values = append(values, email.Value)
+		}
+		return values
+	case "name.givenname":
+		if u.Name != nil {
+			return []string{u.Name.GivenName}
+		}
+	case "name.familyname":
+		if u.Name != nil {
+			return []string{u.Name.FamilyName}
+		}
+	case "active":
+		if u.Active != nil && *u.Active {
+			return []string{"true"}
+		}
+		return []string{"false"}
+	}
+	return nil
+}
+
+// Attribute returns the values of a group attribute for filter evaluation.
+func (g *Group) Attribute(path string) []string {
+	switch path {
+	case "id":
+		return []string{g.ID}
+	case "externalid":
+		return []string{g.ExternalID}
+	case "displayname":
+		return []string{g.DisplayName}
+	case "members", "members.value":
+		values := make([]string, 0, len(g.Members))
+		for _, member := range g.Members {
+			values = append(values, member.Value)
+		}
+		return values
+	}
+	return nil
+}
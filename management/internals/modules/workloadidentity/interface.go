@@ -0,0 +1,13 @@
+package workloadidentity
+
+import (
+	"context"
+)
+
+type Manager interface {
+	GetAllProviders(ctx context.Context, accountID, userID string) ([]*Provider, error)
+	GetProvider(ctx context.Context, accountID, userID, providerID string) (*Provider, error)
+	CreateProvider(ctx context.Context, accountID, userID string, provider *Provider) (*Provider, error)
+	UpdateProvider(ctx context.Context, accountID, userID string, provider *Provider) (*Provider, error)
+	DeleteProvider(ctx context.Context, accountID, userID, providerID string) error
+}
@@ -0,0 +1,187 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/netbirdio/netbird/management/internals/modules/workloadidentity"
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+type managerImpl struct {
+	store              store.Store
+	accountManager     account.Manager
+	permissionsManager permissions.Manager
+}
+
+func NewManager(store store.Store, accountManager account.Manager, permissionsManager permissions.Manager) workloadidentity.Manager {
+	return &managerImpl{
+		store:              store,
+		accountManager:     accountManager,
+		permissionsManager: permissionsManager,
+	}
+}
+
+func (m *managerImpl) GetAllProviders(ctx context.Context, accountID, userID string) ([]*workloadidentity.Provider, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetAccountWorkloadIdentityProviders(ctx, store.LockingStrengthNone, accountID)
+}
+
+func (m *managerImpl) GetProvider(ctx context.Context, accountID, userID, providerID string) (*workloadidentity.Provider, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetWorkloadIdentityProviderByID(ctx, store.LockingStrengthNone, accountID, providerID)
+}
+
+func (m *managerImpl) CreateProvider(ctx context.Context, accountID, userID string, provider *workloadidentity.Provider) (*workloadidentity.Provider, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Create); err != nil {
+		return nil, err
+	}
+
+	provider = workloadidentity.NewProvider(accountID, provider)
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err := validateProvider(ctx, transaction, provider); err != nil {
+			return err
+		}
+
+		if err := transaction.CreateWorkloadIdentityProvider(ctx, provider); err != nil {
+			return fmt.Errorf("failed to create workload identity provider: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, provider.ID, accountID, activity.WorkloadIdentityProviderCreated, provider.EventMeta())
+
+	return provider, nil
+}
+
+func (m *managerImpl) UpdateProvider(ctx context.Context, accountID, userID string, updatedProvider *workloadidentity.Provider) (*workloadidentity.Provider, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Update); err != nil {
+		return nil, err
+	}
+
+	var provider *workloadidentity.Provider
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		provider, err = transaction.GetWorkloadIdentityProviderByID(ctx, store.LockingStrengthUpdate, accountID, updatedProvider.ID)
+		if err != nil {
+			return err
+		}
+
+		createdAt := provider.CreatedAt
+		provider = updatedProvider.Copy()
+		provider.AccountID = accountID
+		provider.CreatedAt = createdAt
+		provider.UpdatedAt = time.Now().UTC()
+
+		if err = validateProvider(ctx, transaction, provider); err != nil {
+			return err
+		}
+
+		if err = transaction.UpdateWorkloadIdentityProvider(ctx, provider); err != nil {
+			return fmt.Errorf("failed to update workload identity provider: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, provider.ID, accountID, activity.WorkloadIdentityProviderUpdated, provider.EventMeta())
+
+	return provider, nil
+}
+
+func (m *managerImpl) DeleteProvider(ctx context.Context, accountID, userID, providerID string) error {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Delete); err != nil {
+		return err
+	}
+
+	var provider *workloadidentity.Provider
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		provider, err = transaction.GetWorkloadIdentityProviderByID(ctx, store.LockingStrengthUpdate, accountID, providerID)
+		if err != nil {
+			return err
+		}
+
+		if err = transaction.DeleteWorkloadIdentityProvider(ctx, accountID, providerID); err != nil {
+			return fmt.Errorf("failed to delete workload identity provider: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, providerID, accountID, activity.WorkloadIdentityProviderDeleted, provider.EventMeta())
+
+	return nil
+}
+
+// validatePermissions checks the setup keys permissions, as a provider enrolls peers the same way a setup key does.
+func (m *managerImpl) validatePermissions(ctx context.Context, accountID, userID string, operation operations.Operation) error {
+	ok, _, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.SetupKeys, operation)
+	if err != nil {
+		return status.NewPermissionValidationError(err)
+	}
+	if !ok {
+		return status.NewPermissionDeniedError()
+	}
+
+	return nil
+}
+
+// validateProvider ensures the provider name is unique within the account and its auto groups exist.
+func validateProvider(ctx context.Context, transaction store.Store, provider *workloadidentity.Provider) error {
+	existingProviders, err := transaction.GetAccountWorkloadIdentityProviders(ctx, store.LockingStrengthNone, provider.AccountID)
+	if err != nil {
+		return fmt.Errorf("failed to get workload identity providers: %w", err)
+	}
+
+	for _, existing := range existingProviders {
+		if existing.ID != provider.ID && strings.EqualFold(existing.Name, provider.Name) {
+			return status.Errorf(status.AlreadyExists, "workload identity provider with name %s already exists", provider.Name)
+		}
+	}
+
+	if len(provider.AutoGroups) == 0 {
+		return nil
+	}
+
+	groups, err := transaction.GetGroupsByIDs(ctx, store.LockingStrengthNone, provider.AccountID, provider.AutoGroups)
+	if err != nil {
+		return err
+	}
+
+	for _, groupID := range provider.AutoGroups {
+		group, ok := groups[groupID]
+		if !ok {
+			return status.Errorf(status.NotFound, "group not found: %s", groupID)
+		}
+		if group.IsGroupAll() {
+			return status.Errorf(status.InvalidArgument, "can't add 'All' group to the workload identity provider")
+		}
+	}
+
+	return nil
+}
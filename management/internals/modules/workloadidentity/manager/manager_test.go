@@ -0,0 +1,135 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/internals/modules/workloadidentity"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+const (
+	testAccountID = "account-1"
+	adminUserID   = "admin-1"
+	regularUserID = "user-1"
+	ciGroupID     = "group-ci"
+	allGroupID    = "group-all"
+)
+
+func setupTest(t *testing.T) (*managerImpl, *[]activity.ActivityDescriber) {
+	t.Helper()
+
+	ctx := context.Background()
+	testStore, cleanup, err := store.NewTestStoreFromSQL(ctx, "", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	account := &types.Account{
+		Id:       testAccountID,
+		Settings: &types.Settings{},
+		Users: map[string]*types.User{
+			adminUserID:   {Id: adminUserID, AccountID: testAccountID, Role: types.UserRoleAdmin},
+			regularUserID: {Id: regularUserID, AccountID: testAccountID, Role: types.UserRoleUser},
+		},
+		Groups: map[string]*types.Group{
+			ciGroupID:  {ID: ciGroupID, AccountID: testAccountID, Name: "CI"},
+			allGroupID: {ID: allGroupID, AccountID: testAccountID, Name: types.GroupAllName},
+		},
+	}
+	require.NoError(t, testStore.SaveAccount(ctx, account))
+
+	var events []activity.ActivityDescriber
+	accountManager := &mock_server.MockAccountManager{
+		StoreEventFunc: func(_ context.Context, _, _, _ string, activityID activity.ActivityDescriber, _ map[string]any) {
+			events = append(events, activityID)
+		},
+	}
+
+	return &managerImpl{
+		store:              testStore,
+		accountManager:     accountManager,
+		permissionsManager: permissions.NewManager(testStore),
+	}, &events
+}
+
+func newTestProvider(name string, autoGroups ...string) *workloadidentity.Provider {
+	return &workloadidentity.Provider{
+		Name:       name,
+		Enabled:    true,
+		Issuer:     "https://token.actions.githubusercontent.com",
+		Audiences:  []string{"netbird"},
+		ClaimRules: []workloadidentity.ClaimRule{{Claim: "repository", Values: []string{"netbirdio/*"}}},
+		AutoGroups: autoGroups,
+		Ephemeral:  true,
+	}
+}
+
+func TestManager_ProviderLifecycle(t *testing.T) {
+	ctx := context.Background()
+	m, events := setupTest(t)
+
+	created, err := m.CreateProvider(ctx, testAccountID, adminUserID, newTestProvider("GitHub Actions", ciGroupID))
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, testAccountID, created.AccountID)
+
+	_, err = m.CreateProvider(ctx, testAccountID, adminUserID, newTestProvider("github actions"))
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.AlreadyExists, sErr.Type(), "provider names are unique per account")
+
+	update := newTestProvider("GitHub", ciGroupID)
+	update.ID = created.ID
+	update.Ephemeral = false
+	updated, err := m.UpdateProvider(ctx, testAccountID, adminUserID, update)
+	require.NoError(t, err)
+	assert.Equal(t, "GitHub", updated.Name)
+	assert.False(t, updated.Ephemeral)
+	assert.Equal(t, created.CreatedAt, updated.CreatedAt)
+
+	fetched, err := m.GetProvider(ctx, testAccountID, adminUserID, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{ciGroupID}, fetched.AutoGroups)
+	assert.Equal(t, update.ClaimRules, fetched.ClaimRules)
+
+	all, err := m.GetAllProviders(ctx, testAccountID, adminUserID)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+
+	require.NoError(t, m.DeleteProvider(ctx, testAccountID, adminUserID, created.ID))
+
+	_, err = m.GetProvider(ctx, testAccountID, adminUserID, created.ID)
+	sErr, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.NotFound, sErr.Type())
+
+	assert.Equal(t, []activity.ActivityDescriber{activity.WorkloadIdentityProviderCreated, activity.WorkloadIdentityProviderUpdated, activity.WorkloadIdentityProviderDeleted}, *events)
+}
+
+func TestManager_CreateProviderValidation(t *testing.T) {
+	ctx := context.Background()
+	m, _ := setupTest(t)
+
+	_, err := m.CreateProvider(ctx, testAccountID, adminUserID, newTestProvider("GitHub Actions", "missing-group"))
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.NotFound, sErr.Type())
+
+	_, err = m.CreateProvider(ctx, testAccountID, adminUserID, newTestProvider("GitHub Actions", allGroupID))
+	sErr, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.InvalidArgument, sErr.Type())
+
+	_, err = m.CreateProvider(ctx, testAccountID, regularUserID, newTestProvider("GitHub Actions"))
+	sErr, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.PermissionDenied, sErr.Type(), "regular users can't manage providers")
+}
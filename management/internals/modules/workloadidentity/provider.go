@@ -0,0 +1,287 @@
+package workloadidentity
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+// Provider trusts the OIDC tokens of an external issuer, e.g. GitHub Actions, GitLab CI or a Kubernetes cluster,
+// to enroll peers without a setup key. A token is accepted when it is signed by the issuer, is meant for one of
+// the audiences and its claims match every claim rule.
+type Provider struct {
+	ID        string `gorm:"primaryKey"`
+	AccountID string `gorm:"index"`
+	Name      string
+	Enabled   bool
+	// Issuer is the expected iss claim of the tokens
+	Issuer string
+	// JWKSURL is the location of the issuer signing keys, discovered from the issuer when empty
+	JWKSURL string `gorm:"column:jwks_url"`
+	// Audiences are the accepted aud claims of the tokens
+	Audiences  []string    `gorm:"serializer:json"`
+	ClaimRules []ClaimRule `gorm:"serializer:json"`
+	// AutoGroups are the groups the enrolled peers are added to
+	AutoGroups []string `gorm:"serializer:json"`
+	// Ephemeral makes the enrolled peers ephemeral
+	Ephemeral bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TableName keeps the table name explicit.
+func (Provider) TableName() string {
+	return "workload_identity_providers"
+}
+
+// ClaimRule matches a token claim against a list of patterns. Nested claims are addressed with dots, e.g.
+// kubernetes.io.namespace, and a '*' in a pattern matches any sequence of characters.
+type ClaimRule struct {
+	Claim  string   `json:"claim"`
+	Values []string `json:"values"`
+}
+
+func NewProvider(accountID string, p *Provider) *Provider {
+	now := time.Now().UTC()
+	provider := p.Copy()
+	provider.ID = xid.New().String()
+	provider.AccountID = accountID
+	provider.CreatedAt = now
+	provider.UpdatedAt = now
+	return provider
+}
+
+func (p *Provider) Copy() *Provider {
+	rules := make([]ClaimRule, 0, len(p.ClaimRules))
+	for _, rule := range p.ClaimRules {
+		rules = append(rules, ClaimRule{Claim: rule.Claim, Values: slices.Clone(rule.Values)})
+	}
+
+	return &Provider{
+		ID:         p.ID,
+		AccountID:  p.AccountID,
+		Name:       p.Name,
+		Enabled:    p.Enabled,
+		Issuer:     p.Issuer,
+		JWKSURL:    p.JWKSURL,
+		Audiences:  slices.Clone(p.Audiences),
+		ClaimRules: rules,
+		AutoGroups: slices.Clone(p.AutoGroups),
+		Ephemeral:  p.Ephemeral,
+		CreatedAt:  p.CreatedAt,
+		UpdatedAt:  p.UpdatedAt,
+	}
+}
+
+func (p *Provider) Validate() error {
+	if p.Name == "" {
+		return errors.New("provider name is required")
+	}
+	if len(p.Name) > 255 {
+		return errors.New("provider name exceeds maximum length of 255 characters")
+	}
+	if err := validateURL(p.Issuer); err != nil {
+		return fmt.Errorf("invalid issuer: %w", err)
+	}
+	if p.JWKSURL != "" {
+		if err := validateURL(p.JWKSURL); err != nil {
+			return fmt.Errorf("invalid JWKS URL: %w", err)
+		}
+	}
+	if len(p.Audiences) == 0 || slices.Contains(p.Audiences, "") {
+		return errors.New("at least one non-empty audience is required")
+	}
+
+	// issuers like GitHub Actions sign the tokens of every repository, the rules are what limits the enrollment
+	// to the account's workloads
+	if len(p.ClaimRules) == 0 {
+		return errors.New("at least one claim rule is required")
+	}
+	for _, rule := range p.ClaimRules {
+		if rule.Claim == "" {
+			return errors.New("claim rule without a claim")
+		}
+		if len(rule.Values) == 0 || slices.Contains(rule.Values, "") {
+			return fmt.Errorf("claim rule %s requires at least one non-empty value", rule.Claim)
+		}
+	}
+
+	for _, group := range p.AutoGroups {
+		if group == "" {
+			return errors.New("auto group IDs can't be empty")
+		}
+	}
+
+	return nil
+}
+
+// MatchClaims returns an error naming the first claim rule the claims don't satisfy.
+func (p *Provider) MatchClaims(claims map[string]any) error {
+	for _, rule := range p.ClaimRules {
+		if !rule.Matches(claims) {
+			return fmt.Errorf("claim %s doesn't match the allowed values", rule.Claim)
+		}
+	}
+	return nil
+}
+
+// Matches reports whether the claim is a string, or a list containing a string, that matches any of the values.
+func (r ClaimRule) Matches(claims map[string]any) bool {
+	claim, ok := lookupClaim(claims, r.Claim)
+	if !ok {
+		return false
+	}
+
+	var values []string
+	switch c := claim.(type) {
+	case string:
+		values = []string{c}
+	case []any:
+		for _, v := range c {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+	case bool, float64:
+		values = []string{fmt.Sprint(c)}
+	}
+
+	for _, pattern := range r.Values {
+		for _, value := range values {
+			if matchPattern(pattern, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lookupClaim resolves a dotted claim path. Claim names may contain dots themselves, as kubernetes.io does, so
+// the longest claim name is tried first on every level.
+func lookupClaim(claims map[string]any, path string) (any, bool) {
+	if v, ok := claims[path]; ok {
+		return v, true
+	}
+	for i := strings.LastIndex(path, "."); i > 0; i = strings.LastIndex(path[:i], ".") {
+		nested, ok := claims[path[:i]].(map[string]any)
+		if !ok {
+			continue
+		}
+		if v, ok := lookupClaim(nested, path[i+1:]); ok {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func matchPattern(pattern, value string) bool {
+	if !strings.Contains(pattern, "*") {
+		return pattern == value
+	}
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	matched, _ := regexp.MatchString("^"+strings.Join(parts, ".*")+"$", value)
+	return matched
+}
+
+// validateURL requires an https URL, the keys and discovery document of the issuer decide which tokens are
+// trusted. Plain http is only allowed for loopback hosts, e.g. an issuer running next to the server.
+func validateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%q is not an HTTPS URL", rawURL)
+	}
+	switch u.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if isLoopbackHost(u.Hostname()) {
+			return nil
+		}
+		return fmt.Errorf("%q must use https, http is only allowed for loopback hosts", rawURL)
+	default:
+		return fmt.Errorf("%q is not an HTTPS URL", rawURL)
+	}
+}
+
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	addr, err := netip.ParseAddr(host)
+	return err == nil && addr.IsLoopback()
+}
+
+func (p *Provider) ToAPIResponse() *api.WorkloadIdentityProvider {
+	rules := make([]api.WorkloadIdentityClaimRule, 0, len(p.ClaimRules))
+	for _, rule := range p.ClaimRules {
+		rules = append(rules, api.WorkloadIdentityClaimRule{Claim: rule.Claim, Values: slices.Clone(rule.Values)})
+	}
+
+	autoGroups := p.AutoGroups
+	if autoGroups == nil {
+		autoGroups = []string{}
+	}
+
+	resp := &api.WorkloadIdentityProvider{
+		Id:         p.ID,
+		Name:       p.Name,
+		Enabled:    p.Enabled,
+		Issuer:     p.Issuer,
+		Audiences:  slices.Clone(p.Audiences),
+		ClaimRules: rules,
+		AutoGroups: autoGroups,
+		Ephemeral:  p.Ephemeral,
+		CreatedAt:  p.CreatedAt,
+		UpdatedAt:  p.UpdatedAt,
+	}
+	if p.JWKSURL != "" {
+		resp.JwksUrl = &p.JWKSURL
+	}
+	return resp
+}
+
+func (p *Provider) FromAPIRequest(req *api.WorkloadIdentityProviderRequest) {
+	p.Name = req.Name
+	p.Issuer = req.Issuer
+	p.Audiences = req.Audiences
+	p.JWKSURL = ""
+	if req.JwksUrl != nil {
+		p.JWKSURL = *req.JwksUrl
+	}
+	p.Enabled = true
+	if req.Enabled != nil {
+		p.Enabled = *req.Enabled
+	}
+	p.Ephemeral = false
+	if req.Ephemeral != nil {
+		p.Ephemeral = *req.Ephemeral
+	}
+	p.AutoGroups = nil
+	if req.AutoGroups != nil {
+		p.AutoGroups = *req.AutoGroups
+	}
+
+	p.ClaimRules = make([]ClaimRule, 0, len(req.ClaimRules))
+	for _, rule := range req.ClaimRules {
+		p.ClaimRules = append(p.ClaimRules, ClaimRule{Claim: rule.Claim, Values: rule.Values})
+	}
+}
+
+func (p *Provider) EventMeta() map[string]any {
+	return map[string]any{"name": p.Name, "issuer": p.Issuer}
+}
@@ -0,0 +1,143 @@
+package workloadidentity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	log "github.com/sirupsen/logrus"
+
+	nbjwt "github.com/netbirdio/netbird/shared/auth/jwt"
+)
+
+const discoveryTimeout = 10 * time.Second
+
+// Verifier verifies workload tokens against the configuration of their provider. It keeps a JWT validator,
+// and with it the cached signing keys, per provider configuration.
+type Verifier struct {
+	mu         sync.Mutex
+	validators map[string]*providerValidator
+	httpClient *http.Client
+}
+
+type providerValidator struct {
+	configKey string
+	validator *nbjwt.Validator
+}
+
+func NewVerifier() *Verifier {
+	return &Verifier{
+		validators: make(map[string]*providerValidator),
+		httpClient: &http.Client{Timeout: discoveryTimeout},
+	}
+}
+
+// Verify checks the signature, issuer, audience and expiry of the token and matches its claims against the
+// claim rules of the provider. It returns the token claims when the token is accepted.
+func (v *Verifier) Verify(ctx context.Context, provider *Provider, token string) (map[string]any, error) {
+	validator, err := v.getValidator(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := validator.ValidateAndParse(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid workload identity token: %w", err)
+	}
+
+	mapClaims, ok := parsed.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("invalid workload identity token: unexpected claims")
+	}
+
+	// workload tokens are short-lived by design, a token without expiry would grant enrollment forever
+	if _, ok := mapClaims["exp"]; !ok {
+		return nil, errors.New("invalid workload identity token: token has no expiration time")
+	}
+
+	if err := provider.MatchClaims(mapClaims); err != nil {
+		return nil, err
+	}
+
+	return mapClaims, nil
+}
+
+func (v *Verifier) getValidator(ctx context.Context, provider *Provider) (*nbjwt.Validator, error) {
+	configKey := strings.Join(append([]string{provider.Issuer, provider.JWKSURL}, provider.Audiences...), "|")
+
+	v.mu.Lock()
+	cached, ok := v.validators[provider.ID]
+	v.mu.Unlock()
+	if ok && cached.configKey == configKey {
+		return cached.validator, nil
+	}
+
+	keysLocation := provider.JWKSURL
+	if keysLocation == "" {
+		var err error
+		keysLocation, err = v.discoverKeysLocation(ctx, provider.Issuer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	validator := nbjwt.NewValidator(provider.Issuer, slices.Clone(provider.Audiences), keysLocation, true)
+
+	v.mu.Lock()
+	v.validators[provider.ID] = &providerValidator{configKey: configKey, validator: validator}
+	v.mu.Unlock()
+
+	return validator, nil
+}
+
+// discoverKeysLocation reads the JWKS URL from the OpenID configuration of the issuer.
+func (v *Verifier) discoverKeysLocation(ctx context.Context, issuer string) (string, error) {
+	wellKnown := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnown, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to discover issuer %s: %w", issuer, err)
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to discover issuer %s: %w", issuer, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read discovery document of issuer %s: %w", issuer, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to discover issuer %s: %s", issuer, resp.Status)
+	}
+
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.Unmarshal(body, &discovery); err != nil {
+		return "", fmt.Errorf("failed to decode discovery document of issuer %s: %w", issuer, err)
+	}
+
+	if discovery.Issuer != issuer {
+		return "", fmt.Errorf("discovery document of issuer %s announces issuer %s", issuer, discovery.Issuer)
+	}
+	if discovery.JWKSURI == "" {
+		return "", fmt.Errorf("discovery document of issuer %s has no jwks_uri", issuer)
+	}
+
+	log.WithContext(ctx).Debugf("discovered JWKS URL %s of workload identity issuer %s", discovery.JWKSURI, issuer)
+
+	return discovery.JWKSURI, nil
+}
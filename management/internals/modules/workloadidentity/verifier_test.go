@@ -0,0 +1,218 @@
+package workloadidentity
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKeyID = "test-key"
+
+// testIssuer is a local stand-in for an OIDC issuer serving its discovery document and signing keys.
+type testIssuer struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	issuer := &testIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": issuer.URL, "jwks_uri": issuer.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": testKeyID,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	issuer.Server = httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+
+	return issuer
+}
+
+func (i *testIssuer) sign(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testKeyID
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func (i *testIssuer) claims(overrides jwt.MapClaims) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"iss":        i.URL,
+		"aud":        "netbird",
+		"sub":        "repo:netbirdio/netbird:ref:refs/heads/main",
+		"iat":        time.Now().Unix(),
+		"exp":        time.Now().Add(5 * time.Minute).Unix(),
+		"repository": "netbirdio/netbird",
+		"ref":        "refs/heads/main",
+	}
+	for k, v := range overrides {
+		if v == nil {
+			delete(claims, k)
+			continue
+		}
+		claims[k] = v
+	}
+	return claims
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	issuer := newTestIssuer(t)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	provider := &Provider{
+		ID:        "provider-1",
+		Issuer:    issuer.URL,
+		Audiences: []string{"netbird"},
+		ClaimRules: []ClaimRule{
+			{Claim: "repository", Values: []string{"netbirdio/*"}},
+			{Claim: "ref", Values: []string{"refs/heads/main", "refs/tags/*"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		key     *rsa.PrivateKey
+		claims  jwt.MapClaims
+		wantErr bool
+	}{
+		{name: "accepted", claims: issuer.claims(nil)},
+		{name: "tag ref", claims: issuer.claims(jwt.MapClaims{"ref": "refs/tags/v1.0.0"})},
+		{name: "other repository", claims: issuer.claims(jwt.MapClaims{"repository": "someone/else"}), wantErr: true},
+		{name: "other branch", claims: issuer.claims(jwt.MapClaims{"ref": "refs/heads/feature"}), wantErr: true},
+		{name: "missing claim", claims: issuer.claims(jwt.MapClaims{"ref": nil}), wantErr: true},
+		{name: "other audience", claims: issuer.claims(jwt.MapClaims{"aud": "sts.amazonaws.com"}), wantErr: true},
+		{name: "other issuer", claims: issuer.claims(jwt.MapClaims{"iss": "https://gitlab.com"}), wantErr: true},
+		{name: "expired", claims: issuer.claims(jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()}), wantErr: true},
+		{name: "no expiry", claims: issuer.claims(jwt.MapClaims{"exp": nil}), wantErr: true},
+		{name: "signed by another key", key: otherKey, claims: issuer.claims(nil), wantErr: true},
+	}
+
+	verifier := NewVerifier()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := issuer.key
+			if tt.key != nil {
+				key = tt.key
+			}
+
+			claims, err := verifier.Verify(context.Background(), provider, issuer.sign(t, key, tt.claims))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.claims["sub"], claims["sub"])
+		})
+	}
+}
+
+func TestVerifier_ConfiguredJWKSURL(t *testing.T) {
+	issuer := newTestIssuer(t)
+
+	provider := &Provider{
+		ID: "provider-1",
+		// Kubernetes issuers are often not reachable, their keys are configured explicitly
+		Issuer:     "https://kubernetes.default.svc.cluster.local",
+		JWKSURL:    issuer.URL + "/keys",
+		Audiences:  []string{"netbird"},
+		ClaimRules: []ClaimRule{{Claim: "kubernetes.io.namespace", Values: []string{"ci"}}},
+	}
+
+	token := issuer.sign(t, issuer.key, issuer.claims(jwt.MapClaims{
+		"iss":           provider.Issuer,
+		"kubernetes.io": map[string]any{"namespace": "ci", "serviceaccount": map[string]any{"name": "runner"}},
+	}))
+
+	verifier := NewVerifier()
+	_, err := verifier.Verify(context.Background(), provider, token)
+	require.NoError(t, err)
+
+	provider.ClaimRules = []ClaimRule{{Claim: "kubernetes.io.namespace", Values: []string{"prod"}}}
+	_, err = verifier.Verify(context.Background(), provider, token)
+	assert.Error(t, err)
+}
+
+func TestClaimRule_Matches(t *testing.T) {
+	claims := map[string]any{
+		"sub":           "system:serviceaccount:ci:runner",
+		"groups":        []any{"dev", "ops"},
+		"ref_protected": true,
+		"kubernetes.io": map[string]any{"namespace": "ci"},
+	}
+
+	assert.True(t, ClaimRule{Claim: "sub", Values: []string{"system:serviceaccount:ci:*"}}.Matches(claims))
+	assert.False(t, ClaimRule{Claim: "sub", Values: []string{"system:serviceaccount:prod:*"}}.Matches(claims))
+	assert.True(t, ClaimRule{Claim: "groups", Values: []string{"ops"}}.Matches(claims))
+	assert.True(t, ClaimRule{Claim: "ref_protected", Values: []string{"true"}}.Matches(claims))
+	assert.True(t, ClaimRule{Claim: "kubernetes.io.namespace", Values: []string{"ci"}}.Matches(claims))
+	assert.False(t, ClaimRule{Claim: "kubernetes.io.pod", Values: []string{"*"}}.Matches(claims))
+	assert.False(t, ClaimRule{Claim: "sub", Values: []string{"system.serviceaccount.ci.runner"}}.Matches(claims), "only '*' is a wildcard")
+}
+
+func TestProvider_Validate(t *testing.T) {
+	valid := func() *Provider {
+		return &Provider{
+			Name:       "GitHub Actions",
+			Issuer:     "https://token.actions.githubusercontent.com",
+			Audiences:  []string{"netbird"},
+			ClaimRules: []ClaimRule{{Claim: "repository", Values: []string{"netbirdio/netbird"}}},
+		}
+	}
+
+	assert.NoError(t, valid().Validate())
+
+	loopback := valid()
+	loopback.Issuer = "http://127.0.0.1:8080"
+	loopback.JWKSURL = "http://localhost:8080/keys"
+	assert.NoError(t, loopback.Validate(), "plain http is allowed for loopback hosts")
+
+	tests := []struct {
+		name   string
+		modify func(p *Provider)
+	}{
+		{name: "missing name", modify: func(p *Provider) { p.Name = "" }},
+		{name: "invalid issuer", modify: func(p *Provider) { p.Issuer = "token.actions.githubusercontent.com" }},
+		{name: "invalid jwks url", modify: func(p *Provider) { p.JWKSURL = "file:///keys" }},
+		{name: "plain http issuer", modify: func(p *Provider) { p.Issuer = "http://token.actions.githubusercontent.com" }},
+		{name: "plain http jwks url", modify: func(p *Provider) { p.JWKSURL = "http://keys.example.com/keys" }},
+		{name: "no audience", modify: func(p *Provider) { p.Audiences = nil }},
+		{name: "no claim rules", modify: func(p *Provider) { p.ClaimRules = nil }},
+		{name: "rule without values", modify: func(p *Provider) { p.ClaimRules[0].Values = nil }},
+		{name: "empty auto group", modify: func(p *Provider) { p.AutoGroups = []string{""} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := valid()
+			tt.modify(p)
+			assert.Error(t, p.Validate())
+		})
+	}
+}
@@ -57,9 +57,16 @@ type Config struct {
 
 	AgentNetwork AgentNetwork
 
+	// UpdateBus connects management instances serving the same store so all of them can serve peers.
+	UpdateBus UpdateBus
+
 	// disable default all-to-all policy
 	DisableDefaultPolicy bool
 
+	// ActivityEventRetentionDays specifies the number of days to retain activity events.
+	// Older events are deleted by a daily cleanup routine. 0 or negative keeps events indefinitely.
+	ActivityEventRetentionDays int
+
 	// EmbeddedIdP contains configuration for the embedded Dex OIDC provider.
 	// When set, Dex will be embedded in the management server and serve requests at /oauth2/
 	EmbeddedIdP *idp.EmbeddedIdPConfig
@@ -187,6 +194,17 @@ type StoreConfig struct {
 	Engine types.Engine
 }
 
+// UpdateBus contains the configuration of the bus distributing peer updates and jobs between
+// management instances.
+type UpdateBus struct {
+	// Engine selects the bus, "postgres" uses PostgreSQL LISTEN/NOTIFY. Empty runs a single instance.
+	Engine string
+	// DSN of the PostgreSQL database. Empty falls back to the DSN of the PostgreSQL store.
+	DSN string
+	// InstanceID identifies this instance on the bus. A random ID is used when empty.
+	InstanceID string
+}
+
 // AgentNetwork contains agent-network (LLM gateway) configuration.
 type AgentNetwork struct {
 	// PricingDefaultsFile is the path to the YAML file holding the default
@@ -234,4 +252,17 @@ type ReverseProxy struct {
 	// AccessLogCleanupIntervalHours specifies how often (in hours) to run the cleanup routine.
 	// Defaults to 24 hours if not set or set to 0.
 	AccessLogCleanupIntervalHours int
+
+	// ClusterAccessLogs selects the access log sinks of shared proxy clusters, keyed by cluster address.
+	// Proxies receive them with their mapping snapshot and use them in addition to their local sinks.
+	// Proxies operated by an account never receive them, as they would see the sink credentials.
+	ClusterAccessLogs map[string]ClusterAccessLog
+}
+
+// ClusterAccessLog configures the access log delivery of the proxies of one cluster.
+type ClusterAccessLog struct {
+	// Sinks are access log sink URIs as accepted by the proxy's --access-log-sinks flag.
+	Sinks []string
+	// ManagementMode selects the entries sent to management: "full" or "usage". Empty keeps the proxy's setting.
+	ManagementMode string
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/netbirdio/netbird/management/internals/modules/peers/ephemeral"
 	"github.com/netbirdio/netbird/management/internals/modules/peers/ephemeral/manager"
 	"github.com/netbirdio/netbird/management/internals/shared/grpc"
+	"github.com/netbirdio/netbird/management/internals/shared/updatebus"
 	"github.com/netbirdio/netbird/management/server"
 	"github.com/netbirdio/netbird/management/server/auth"
 	"github.com/netbirdio/netbird/management/server/integrations/integrated_validator"
@@ -33,7 +34,23 @@ func (s *BaseServer) PeersUpdateManager() network_map.PeersUpdateManager {
 
 func (s *BaseServer) JobManager() *job.Manager {
 	return Create(s, func() *job.Manager {
-		return job.NewJobManager(s.Metrics(), s.Store(), s.PeersManager())
+		manager := job.NewJobManager(s.Metrics(), s.Store(), s.PeersManager())
+		if bus := s.UpdateBus(); bus != nil {
+			manager.SetUpdateBus(bus)
+		}
+		return manager
+	})
+}
+
+// UpdateBus returns the bus shared with the other management instances, nil when running a single instance.
+func (s *BaseServer) UpdateBus() updatebus.Bus {
+	return Create(s, func() updatebus.Bus {
+		cfg := s.Config.UpdateBus
+		bus, err := updatebus.New(context.Background(), cfg.Engine, cfg.DSN, cfg.InstanceID)
+		if err != nil {
+			log.Fatalf("failed to create update bus: %v", err)
+		}
+		return bus
 	})
 }
 
@@ -123,7 +140,11 @@ func (s *BaseServer) EphemeralManager() ephemeral.Manager {
 
 func (s *BaseServer) NetworkMapController() network_map.Controller {
 	return Create(s, func() network_map.Controller {
-		return nmapcontroller.NewController(context.Background(), s.Store(), s.Metrics(), s.PeersUpdateManager(), s.AccountRequestBuffer(), s.IntegratedValidator(), s.SettingsManager(), s.DNSDomain(), s.ProxyController(), s.EphemeralManager(), s.Config)
+		controller := nmapcontroller.NewController(context.Background(), s.Store(), s.Metrics(), s.PeersUpdateManager(), s.AccountRequestBuffer(), s.IntegratedValidator(), s.SettingsManager(), s.DNSDomain(), s.ProxyController(), s.EphemeralManager(), s.Config)
+		if bus := s.UpdateBus(); bus != nil {
+			controller.SetUpdateBus(bus)
+		}
+		return controller
 	})
 }
 
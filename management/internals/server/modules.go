@@ -8,6 +8,15 @@ import (
 
 	"github.com/netbirdio/management-integrations/integrations"
 
+	"github.com/netbirdio/netbird/management/internals/modules/customroles"
+	customrolesmanager "github.com/netbirdio/netbird/management/internals/modules/customroles/manager"
+	"github.com/netbirdio/netbird/management/internals/modules/scim"
+	scimmanager "github.com/netbirdio/netbird/management/internals/modules/scim/manager"
+	"github.com/netbirdio/netbird/management/internals/modules/workloadidentity"
+	workloadidentitymanager "github.com/netbirdio/netbird/management/internals/modules/workloadidentity/manager"
+	"github.com/netbirdio/netbird/management/internals/modules/eventstreaming"
+	eventstreamingmanager "github.com/netbirdio/netbird/management/internals/modules/eventstreaming/manager"
+	"github.com/netbirdio/netbird/management/internals/modules/eventstreaming/streamer"
 	"github.com/netbirdio/netbird/management/internals/modules/peers"
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/domain/manager"
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/proxy"
@@ -58,7 +67,11 @@ func (s *BaseServer) GeoLocationManager() geolocation.Geolocation {
 
 func (s *BaseServer) PermissionsManager() permissions.Manager {
 	return Create(s, func() permissions.Manager {
-		return permissions.NewManager(s.Store())
+		manager := permissions.NewManager(s.Store())
+		s.AfterInit(func(s *BaseServer) {
+			manager.SetAccountManager(s.AccountManager())
+		})
+		return manager
 	})
 }
 
@@ -225,6 +238,41 @@ func (s *BaseServer) RecordsManager() records.Manager {
 	})
 }
 
+// EventStreamingManager manages the event streaming integrations of accounts.
+func (s *BaseServer) EventStreamingManager() eventstreaming.Manager {
+	return Create(s, func() eventstreaming.Manager {
+		return eventstreamingmanager.NewManager(s.Store(), s.EventStore(), s.AccountManager(), s.PermissionsManager())
+	})
+}
+
+// CustomRolesManager manages the account-defined user roles.
+func (s *BaseServer) CustomRolesManager() customroles.Manager {
+	return Create(s, func() customroles.Manager {
+		return customrolesmanager.NewManager(s.Store(), s.AccountManager(), s.PermissionsManager())
+	})
+}
+
+// WorkloadIdentityManager manages the workload identity providers peers enroll with.
+func (s *BaseServer) WorkloadIdentityManager() workloadidentity.Manager {
+	return Create(s, func() workloadidentity.Manager {
+		return workloadidentitymanager.NewManager(s.Store(), s.AccountManager(), s.PermissionsManager())
+	})
+}
+
+// ScimManager manages the SCIM integrations and applies their provisioning requests.
+func (s *BaseServer) ScimManager() scim.Manager {
+	return Create(s, func() scim.Manager {
+		return scimmanager.NewManager(s.Store(), s.AccountManager(), s.PermissionsManager())
+	})
+}
+
+// EventStreamer delivers the activity log to the enabled event streaming integrations.
+func (s *BaseServer) EventStreamer() *streamer.Streamer {
+	return Create(s, func() *streamer.Streamer {
+		return streamer.New(s.Store(), s.EventStore(), nil)
+	})
+}
+
 func (s *BaseServer) ServiceManager() service.Manager {
 	return Create(s, func() service.Manager {
 		return nbreverseproxy.NewManager(s.Store(), s.AccountManager(), s.PermissionsManager(), s.ServiceProxyController(), s.ProxyManager(), s.ReverseProxyDomainManager())
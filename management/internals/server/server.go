@@ -20,6 +20,7 @@ import (
 
 	"github.com/netbirdio/netbird/encryption"
 	nbconfig "github.com/netbirdio/netbird/management/internals/server/config"
+	"github.com/netbirdio/netbird/management/server/activity"
 	"github.com/netbirdio/netbird/management/server/idp"
 	"github.com/netbirdio/netbird/management/server/metrics"
 	"github.com/netbirdio/netbird/management/server/store"
@@ -138,6 +139,8 @@ func (s *BaseServer) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to expose metrics: %v", err)
 	}
 	s.EphemeralManager().LoadInitialPeers(srvCtx)
+	s.EventStreamer().Start(srvCtx, 0)
+	activity.StartRetentionCleanup(srvCtx, s.EventStore(), s.Config.ActivityEventRetentionDays, 0)
 
 	var tlsConfig *tls.Config
 	tlsEnabled := false
@@ -263,6 +266,10 @@ func (s *BaseServer) Stop() error {
 		s.proxyAuthClose = nil
 	}
 	runExtensionShutdownHooks(ctx, s.grpcExtensions)
+	s.EventStreamer().Stop()
+	if s.UpdateBus() != nil {
+		_ = s.UpdateBus().Close()
+	}
 	_ = s.Store().Close(ctx)
 	_ = s.EventStore().Close(ctx)
 	if s.update != nil {
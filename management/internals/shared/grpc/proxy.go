@@ -36,6 +36,8 @@ import (
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/proxy"
 	rpservice "github.com/netbirdio/netbird/management/internals/modules/reverseproxy/service"
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/sessionkey"
+	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/sessions"
+	nbconfig "github.com/netbirdio/netbird/management/internals/server/config"
 	"github.com/netbirdio/netbird/management/server/idp"
 	"github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/types"
@@ -132,6 +134,9 @@ type ProxyServiceServer struct {
 	// Manager that records reverse proxy usage for activity accounting
 	activityManager activity.Manager
 
+	// Manager that records issued proxy sessions for listing and revocation
+	sessionsManager sessions.Manager
+
 	// Store for one-time authentication tokens
 	tokenStore *OneTimeTokenStore
 
@@ -144,6 +149,9 @@ type ProxyServiceServer struct {
 	// Store for PKCE verifiers
 	pkceVerifierStore *PKCEVerifierStore
 
+	// Store for pending WebAuthn ceremonies and used TOTP codes
+	secondFactorStore *SecondFactorStore
+
 	// tokenTTL is the lifetime of one-time tokens generated for proxy
 	// authentication. Defaults to defaultProxyTokenTTL when zero.
 	tokenTTL time.Duration
@@ -152,6 +160,10 @@ type ProxyServiceServer struct {
 	// initial snapshot delivery. Configurable via NB_PROXY_SNAPSHOT_BATCH_SIZE.
 	snapshotBatchSize int
 
+	// clusterAccessLogs are the access log settings of shared proxy
+	// clusters, keyed by cluster address.
+	clusterAccessLogs map[string]nbconfig.ClusterAccessLog
+
 	cancel context.CancelFunc
 }
 
@@ -254,6 +266,14 @@ func (s *ProxyServiceServer) SetServiceManager(manager rpservice.Manager) {
 	s.serviceManager = manager
 }
 
+// SetSessionsManager wires the manager that records issued proxy sessions.
+// Optional — when nil sessions are still issued but not tracked.
+func (s *ProxyServiceServer) SetSessionsManager(manager sessions.Manager) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessionsManager = manager
+}
+
 // SetActivityManager wires the manager that records reverse proxy usage.
 func (s *ProxyServiceServer) SetActivityManager(manager activity.Manager) {
 	s.mu.Lock()
@@ -297,6 +317,35 @@ func (s *ProxyServiceServer) SetProxyConnectAuthorizer(authorizer ProxyConnectAu
 	s.mu.Unlock()
 }
 
+// SetClusterAccessLogs sets the access log settings sent to the proxies of
+// shared clusters with their mapping snapshot. Must be called before serving.
+func (s *ProxyServiceServer) SetClusterAccessLogs(clusters map[string]nbconfig.ClusterAccessLog) {
+	s.mu.Lock()
+	s.clusterAccessLogs = clusters
+	s.mu.Unlock()
+}
+
+// clusterConfig returns the settings for the cluster of conn, or nil when
+// there are none. Proxies operated by an account never receive them, the
+// sinks may carry credentials of the operator.
+func (s *ProxyServiceServer) clusterConfig(conn *proxyConnection) *proto.ProxyClusterConfig {
+	if conn.accountID != nil {
+		return nil
+	}
+
+	s.mu.RLock()
+	accessLog, ok := s.clusterAccessLogs[conn.address]
+	s.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	return &proto.ProxyClusterConfig{
+		AccessLogSinks:      accessLog.Sinks,
+		AccessLogManagement: accessLog.ManagementMode,
+	}
+}
+
 // proxyConnectAuthorizer returns the connect authorizer under read lock.
 func (s *ProxyServiceServer) proxyConnectAuthorizer() ProxyConnectAuthorizer {
 	s.mu.RLock()
@@ -700,10 +749,14 @@ func (s *ProxyServiceServer) sendSnapshotSync(ctx context.Context, conn *proxyCo
 			}
 			m.AuthToken = token
 		}
-		if err := stream.Send(&proto.SyncMappingsResponse{
+		resp := &proto.SyncMappingsResponse{
 			Mapping:             mappings[i:end],
 			InitialSyncComplete: end == len(mappings),
-		}); err != nil {
+		}
+		if resp.InitialSyncComplete {
+			resp.ClusterConfig = s.clusterConfig(conn)
+		}
+		if err := stream.Send(resp); err != nil {
 			return fmt.Errorf("send snapshot batch: %w", err)
 		}
 
@@ -715,6 +768,7 @@ func (s *ProxyServiceServer) sendSnapshotSync(ctx context.Context, conn *proxyCo
 	if len(mappings) == 0 {
 		if err := stream.Send(&proto.SyncMappingsResponse{
 			InitialSyncComplete: true,
+			ClusterConfig:       s.clusterConfig(conn),
 		}); err != nil {
 			return fmt.Errorf("send snapshot completion: %w", err)
 		}
@@ -797,10 +851,14 @@ func (s *ProxyServiceServer) sendSnapshot(ctx context.Context, conn *proxyConnec
 			}
 			m.AuthToken = token
 		}
-		if err := conn.stream.Send(&proto.GetMappingUpdateResponse{
+		resp := &proto.GetMappingUpdateResponse{
 			Mapping:             mappings[i:end],
 			InitialSyncComplete: end == len(mappings),
-		}); err != nil {
+		}
+		if resp.InitialSyncComplete {
+			resp.ClusterConfig = s.clusterConfig(conn)
+		}
+		if err := conn.stream.Send(resp); err != nil {
 			return fmt.Errorf("send snapshot batch: %w", err)
 		}
 	}
@@ -808,6 +866,7 @@ func (s *ProxyServiceServer) sendSnapshot(ctx context.Context, conn *proxyConnec
 	if len(mappings) == 0 {
 		if err := conn.stream.Send(&proto.GetMappingUpdateResponse{
 			InitialSyncComplete: true,
+			ClusterConfig:       s.clusterConfig(conn),
 		}); err != nil {
 			return fmt.Errorf("send snapshot completion: %w", err)
 		}
@@ -1220,6 +1279,10 @@ func shallowCloneMapping(m *proto.ProxyMapping) *proto.ProxyMapping {
 		ListenPort:         m.ListenPort,
 		AccessRestrictions: m.AccessRestrictions,
 		Private:            m.Private,
+		AuthorizationRules: m.AuthorizationRules,
+		ErrorPages:         m.ErrorPages,
+		Maintenance:        m.Maintenance,
+		ConnectionLimits:   m.ConnectionLimits,
 	}
 }
 
@@ -1234,12 +1297,17 @@ func (s *ProxyServiceServer) Authenticate(ctx context.Context, req *proto.Authen
 		return nil, status.Errorf(codes.FailedPrecondition, "get service from store: %v", err)
 	}
 
+	if wa := req.GetWebauthn(); wa != nil && isWebAuthnBeginStage(wa.GetStage()) {
+		return s.beginWebAuthn(ctx, service, wa)
+	}
+
 	authenticated, userId, method := s.authenticateRequest(ctx, req, service)
 
-	// Non-OIDC schemes (PIN/Password/Header) authenticate against per-service
+	// Non-OIDC schemes (PIN/Password/Header/TOTP/WebAuthn) authenticate against per-service
 	// secrets and have no user-level group context, so groups stay nil. Email
 	// is also empty — these schemes don't resolve a user record at sign time.
-	token, err := s.generateSessionToken(ctx, authenticated, service, userId, "", method, nil, nil)
+	origin := SessionOrigin{IP: req.GetClientIp(), UserAgent: req.GetUserAgent()}
+	token, err := s.generateSessionToken(ctx, authenticated, service, userId, "", method, nil, nil, origin)
 	if err != nil {
 		return nil, err
 	}
@@ -1258,6 +1326,10 @@ func (s *ProxyServiceServer) authenticateRequest(ctx context.Context, req *proto
 		return s.authenticatePassword(ctx, req.GetId(), v, service.Auth.PasswordAuth)
 	case *proto.AuthenticateRequest_HeaderAuth:
 		return s.authenticateHeader(ctx, req.GetId(), v, service.Auth.HeaderAuths)
+	case *proto.AuthenticateRequest_Totp:
+		return s.authenticateTOTP(ctx, req.GetId(), v, service.Auth.TOTPAuth)
+	case *proto.AuthenticateRequest_Webauthn:
+		return s.authenticateWebAuthn(ctx, service, v)
 	default:
 		return false, "", ""
 	}
@@ -1328,12 +1400,29 @@ func (s *ProxyServiceServer) logAuthenticationError(ctx context.Context, err err
 	}
 }
 
-func (s *ProxyServiceServer) generateSessionToken(ctx context.Context, authenticated bool, service *rpservice.Service, userId, userEmail string, method proxyauth.Method, groupIDs, groupNames []string) (string, error) {
-	if !authenticated || service.SessionPrivateKey == "" {
+func (s *ProxyServiceServer) generateSessionToken(ctx context.Context, authenticated bool, service *rpservice.Service, userId, userEmail string, method proxyauth.Method, groupIDs, groupNames []string, origin SessionOrigin) (string, error) {
+	if !authenticated {
 		return "", nil
 	}
 
-	token, err := sessionkey.SignToken(
+	token, sessionID, err := signSessionToken(ctx, service, userId, userEmail, method, groupIDs, groupNames)
+	if err != nil || token == "" {
+		return "", err
+	}
+
+	s.recordSession(ctx, service, sessionID, userId, userEmail, method, origin)
+
+	return token, nil
+}
+
+// signSessionToken signs a session token for the service without recording
+// the session. It returns an empty token when the service has no session key.
+func signSessionToken(ctx context.Context, service *rpservice.Service, userId, userEmail string, method proxyauth.Method, groupIDs, groupNames []string) (string, string, error) {
+	if service.SessionPrivateKey == "" {
+		return "", "", nil
+	}
+
+	token, sessionID, err := sessionkey.SignTokenWithID(
 		service.SessionPrivateKey,
 		userId,
 		userEmail,
@@ -1345,10 +1434,45 @@ func (s *ProxyServiceServer) generateSessionToken(ctx context.Context, authentic
 	)
 	if err != nil {
 		log.WithContext(ctx).WithError(err).Error("failed to sign session token")
-		return "", status.Errorf(codes.Internal, "sign session token: %v", err)
+		return "", "", status.Errorf(codes.Internal, "sign session token: %v", err)
 	}
 
-	return token, nil
+	return token, sessionID, nil
+}
+
+// SessionOrigin describes the client a proxy session is issued to.
+type SessionOrigin struct {
+	IP        string
+	UserAgent string
+}
+
+// recordSession hands an issued session to the sessions manager so it can be
+// listed and revoked. Issuing the token must not fail on it, so the error is
+// logged and dropped here.
+func (s *ProxyServiceServer) recordSession(ctx context.Context, service *rpservice.Service, sessionID, userID, userEmail string, method proxyauth.Method, origin SessionOrigin) {
+	s.mu.RLock()
+	manager := s.sessionsManager
+	s.mu.RUnlock()
+	if manager == nil {
+		return
+	}
+
+	now := time.Now().UTC()
+	err := manager.RecordSession(ctx, &sessions.Session{
+		ID:        sessionID,
+		AccountID: service.AccountID,
+		ServiceID: service.ID,
+		UserID:    userID,
+		UserEmail: userEmail,
+		Method:    method.String(),
+		SourceIP:  origin.IP,
+		UserAgent: origin.UserAgent,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(proxyauth.DefaultSessionExpiry),
+	})
+	if err != nil {
+		log.WithContext(ctx).Debugf("record proxy session for service %s: %v", service.ID, err)
+	}
 }
 
 // pairGroupIDsAndNames splits a slice of resolved *types.Group records
@@ -1691,7 +1815,7 @@ func sameAccount(userAccountID, serviceAccountID string) bool {
 // middlewares on the proxy can authorise without an extra management round-trip.
 // A user the store cannot resolve, or whose account is pending approval or
 // blocked, gets no token at all, so the browser never receives a session cookie.
-func (s *ProxyServiceServer) GenerateSessionToken(ctx context.Context, domain, userID string, method proxyauth.Method) (string, error) {
+func (s *ProxyServiceServer) GenerateSessionToken(ctx context.Context, domain, userID string, method proxyauth.Method, origin SessionOrigin) (string, error) {
 	service, err := s.getServiceByDomain(ctx, domain)
 	if err != nil {
 		return "", fmt.Errorf("service not found for domain %s: %w", domain, err)
@@ -1728,7 +1852,7 @@ func (s *ProxyServiceServer) GenerateSessionToken(ctx context.Context, domain, u
 
 	groupIDs, groupNames := pairGroupIDsAndNames(userGroups)
 
-	token, err := sessionkey.SignToken(
+	token, sessionID, err := sessionkey.SignTokenWithID(
 		service.SessionPrivateKey,
 		userID,
 		user.Email,
@@ -1742,6 +1866,7 @@ func (s *ProxyServiceServer) GenerateSessionToken(ctx context.Context, domain, u
 		return "", err
 	}
 
+	s.recordSession(ctx, service, sessionID, userID, user.Email, method, origin)
 	s.recordUserLogin(ctx, service.AccountID, user)
 
 	return token, nil
@@ -2101,7 +2226,10 @@ func (s *ProxyServiceServer) ValidateTunnelPeer(ctx context.Context, req *proto.
 		}, nil
 	}
 
-	token, err := s.generateSessionToken(ctx, true, service, principalID, displayIdentity, proxyauth.MethodOIDC, groupIDs, groupNames)
+	// The proxy asks again whenever its tunnel peer cache misses, so the
+	// session isn't recorded: every miss would add a row for the same peer.
+	// Access follows the peer and its groups rather than the cookie anyway.
+	token, _, err := signSessionToken(ctx, service, principalID, displayIdentity, proxyauth.MethodOIDC, groupIDs, groupNames)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,8 @@ import (
 	"github.com/netbirdio/netbird/management/internals/modules/peers"
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/proxy"
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/service"
+	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/sessionkey"
+	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/sessions"
 	"github.com/netbirdio/netbird/management/server/idp"
 	"github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/types"
@@ -71,6 +73,18 @@ func (m *mockReverseProxyManager) SetStatus(ctx context.Context, accountID, reve
 	return nil
 }
 
+func (m *mockReverseProxyManager) RegisterWebAuthnCredential(_ context.Context, _, _, _ string, _ *service.WebAuthnCredential) error {
+	return nil
+}
+
+func (m *mockReverseProxyManager) UpdateWebAuthnSignCount(_ context.Context, _, _, _ string, _ []byte, _ uint32) error {
+	return nil
+}
+
+func (m *mockReverseProxyManager) RevokeSessions(_ context.Context, _, _ string, _ []*service.RevokedSession) error {
+	return nil
+}
+
 func (m *mockReverseProxyManager) ReloadAllServicesForAccount(ctx context.Context, accountID string) error {
 	return nil
 }
@@ -804,6 +818,56 @@ func TestValidateTunnelPeerRecordsActivity(t *testing.T) {
 	assert.Equal(t, peerID, activityManager.seenMarks[0].peerID, "activity must be attributed to the calling peer")
 }
 
+// recordingSessionsManager counts the sessions handed to RecordSession; the
+// embedded interface satisfies the rest (and panics if anything else is called).
+type recordingSessionsManager struct {
+	sessions.Manager
+	recorded int
+}
+
+func (m *recordingSessionsManager) RecordSession(_ context.Context, _ *sessions.Session) error {
+	m.recorded++
+	return nil
+}
+
+// TestValidateTunnelPeerRecordsNoSession keeps the mesh fast path from adding a
+// session row every time the proxy's tunnel peer cache misses.
+func TestValidateTunnelPeerRecordsNoSession(t *testing.T) {
+	const (
+		domain    = "app.example.com"
+		accountID = "account1"
+	)
+
+	keyPair, err := sessionkey.GenerateKeyPair()
+	require.NoError(t, err)
+	sessionsManager := &recordingSessionsManager{}
+	server := &ProxyServiceServer{
+		activityManager: &mockActivityManager{},
+		serviceManager: &mockReverseProxyManager{
+			proxiesByAccount: map[string][]*service.Service{
+				accountID: {{ID: "svc1", Domain: domain, AccountID: accountID, SessionPrivateKey: keyPair.PrivateKey}},
+			},
+		},
+		peersManager: &mockTunnelPeersManager{
+			peer: &peer.Peer{ID: "peer1", Name: "agent", Status: &peer.PeerStatus{LastSeen: time.Now()}},
+		},
+		usersManager: &mockUsersManager{users: map[string]*types.User{}},
+	}
+	server.SetSessionsManager(sessionsManager)
+
+	for i := 0; i < 3; i++ {
+		resp, err := server.ValidateTunnelPeer(context.Background(), &proto.ValidateTunnelPeerRequest{
+			Domain:   domain,
+			TunnelIp: "100.64.0.1",
+		})
+		require.NoError(t, err)
+		require.True(t, resp.GetValid(), "peer should be granted access")
+		assert.NotEmpty(t, resp.GetSessionToken(), "the peer still gets a session token")
+	}
+
+	assert.Zero(t, sessionsManager.recorded, "tunnel peer sessions must not be recorded")
+}
+
 // TestValidateTunnelPeerDeniedRecordsNoActivity keeps the write on the granted
 // path only: a refused peer is not evidence its owner was active.
 func TestValidateTunnelPeerDeniedRecordsNoActivity(t *testing.T) {
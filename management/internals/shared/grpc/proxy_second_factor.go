@@ -0,0 +1,388 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rpservice "github.com/netbirdio/netbird/management/internals/modules/reverseproxy/service"
+	proxyauth "github.com/netbirdio/netbird/proxy/auth"
+	"github.com/netbirdio/netbird/shared/hash/argon2id"
+	"github.com/netbirdio/netbird/shared/management/proto"
+)
+
+const (
+	totpPeriod = 30
+	totpSkew   = 1
+	// totpReplayWindow covers every time step a code is accepted for given
+	// the configured skew.
+	totpReplayWindow = (2*totpSkew + 1) * totpPeriod * time.Second
+
+	// webAuthnCeremonyTTL bounds how long a begin stage stays valid.
+	webAuthnCeremonyTTL = 5 * time.Minute
+)
+
+// SetSecondFactorStore wires the store holding pending WebAuthn ceremonies
+// and used TOTP codes. TOTP works without it but cannot reject replayed
+// codes; WebAuthn requires it.
+func (s *ProxyServiceServer) SetSecondFactorStore(store *SecondFactorStore) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secondFactorStore = store
+}
+
+func (s *ProxyServiceServer) getSecondFactorStore() *SecondFactorStore {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.secondFactorStore
+}
+
+func (s *ProxyServiceServer) authenticateTOTP(ctx context.Context, serviceID string, req *proto.AuthenticateRequest_Totp, auth *rpservice.TOTPAuthConfig) (bool, string, proxyauth.Method) {
+	if auth == nil || !auth.Enabled {
+		log.WithContext(ctx).Debugf("TOTP authentication attempted but not enabled for service %s", serviceID)
+		return false, "", ""
+	}
+
+	userName := req.Totp.GetUser()
+	user := auth.TOTPUser(userName)
+	if user == nil || user.Secret == "" {
+		log.WithContext(ctx).Tracef("TOTP authentication failed: unknown user")
+		return false, "", ""
+	}
+
+	code := req.Totp.GetCode()
+	valid, err := totp.ValidateCustom(code, user.Secret, time.Now().UTC(), totp.ValidateOpts{
+		Period:    totpPeriod,
+		Skew:      totpSkew,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		log.WithContext(ctx).Tracef("TOTP authentication failed: %v", err)
+		return false, "", ""
+	}
+	if !valid {
+		log.WithContext(ctx).Tracef("TOTP authentication failed: invalid code")
+		return false, "", ""
+	}
+
+	if store := s.getSecondFactorStore(); store != nil {
+		used, err := store.MarkTOTPCodeUsed(serviceID, userName, code, totpReplayWindow)
+		if err != nil {
+			log.WithContext(ctx).Errorf("TOTP authentication error: %v", err)
+			return false, "", ""
+		}
+		if used {
+			log.WithContext(ctx).Debugf("TOTP code replayed for user %s on service %s", userName, serviceID)
+			return false, "", ""
+		}
+	}
+
+	return true, userName, proxyauth.MethodTOTP
+}
+
+// webAuthnCeremony is the state persisted between a begin and a finish stage.
+// It binds the challenge to the service and user it was issued for.
+type webAuthnCeremony struct {
+	ServiceID    string               `json:"service_id"`
+	User         string               `json:"user"`
+	Registration bool                 `json:"registration"`
+	Session      webauthn.SessionData `json:"session"`
+}
+
+// webAuthnUser adapts a service WebAuthn user to the webauthn.User interface.
+type webAuthnUser struct {
+	user *rpservice.WebAuthnUser
+}
+
+func (u webAuthnUser) WebAuthnID() []byte {
+	return u.user.Handle
+}
+
+func (u webAuthnUser) WebAuthnName() string {
+	return u.user.Name
+}
+
+func (u webAuthnUser) WebAuthnDisplayName() string {
+	return u.user.Name
+}
+
+func (u webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	creds := make([]webauthn.Credential, 0, len(u.user.Credentials))
+	for _, c := range u.user.Credentials {
+		if c == nil {
+			continue
+		}
+		transports := make([]protocol.AuthenticatorTransport, 0, len(c.Transports))
+		for _, t := range c.Transports {
+			transports = append(transports, protocol.AuthenticatorTransport(t))
+		}
+		creds = append(creds, webauthn.Credential{
+			ID:              c.ID,
+			PublicKey:       c.PublicKey,
+			AttestationType: c.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: c.BackupEligible,
+				BackupState:    c.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    c.AAGUID,
+				SignCount: c.SignCount,
+			},
+		})
+	}
+	return creds
+}
+
+func credentialFromWebAuthn(c *webauthn.Credential) *rpservice.WebAuthnCredential {
+	transports := make([]string, 0, len(c.Transport))
+	for _, t := range c.Transport {
+		transports = append(transports, string(t))
+	}
+	return &rpservice.WebAuthnCredential{
+		ID:              c.ID,
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		Transports:      transports,
+		AAGUID:          c.Authenticator.AAGUID,
+		SignCount:       c.Authenticator.SignCount,
+		BackupEligible:  c.Flags.BackupEligible,
+		BackupState:     c.Flags.BackupState,
+		CreatedAt:       time.Now().UTC(),
+	}
+}
+
+// newWebAuthn builds the relying party for a service. The RP ID is the
+// service domain, so passkeys are scoped to it and only usable over HTTPS.
+func newWebAuthn(service *rpservice.Service) (*webauthn.WebAuthn, error) {
+	return webauthn.New(&webauthn.Config{
+		RPID:          service.Domain,
+		RPDisplayName: service.Name,
+		RPOrigins:     []string{"https://" + service.Domain},
+	})
+}
+
+func isWebAuthnBeginStage(stage proto.WebAuthnStage) bool {
+	return stage == proto.WebAuthnStage_WEBAUTHN_STAGE_BEGIN_LOGIN || stage == proto.WebAuthnStage_WEBAUTHN_STAGE_BEGIN_REGISTRATION
+}
+
+// beginWebAuthn starts a login or registration ceremony and returns the
+// options the browser passes to navigator.credentials. An unknown user or a
+// wrong enrollment code yields an unsuccessful response without options.
+func (s *ProxyServiceServer) beginWebAuthn(ctx context.Context, service *rpservice.Service, req *proto.WebAuthnRequest) (*proto.AuthenticateResponse, error) {
+	store := s.getSecondFactorStore()
+	if store == nil {
+		return nil, status.Errorf(codes.Unavailable, "webauthn is not available")
+	}
+
+	user := s.webAuthnUserForRequest(ctx, service, req)
+	if user == nil {
+		return &proto.AuthenticateResponse{}, nil
+	}
+
+	wa, err := newWebAuthn(service)
+	if err != nil {
+		log.WithContext(ctx).Errorf("WebAuthn configuration error for service %s: %v", service.ID, err)
+		return nil, status.Errorf(codes.Internal, "configure webauthn: %v", err)
+	}
+
+	registration := req.GetStage() == proto.WebAuthnStage_WEBAUTHN_STAGE_BEGIN_REGISTRATION
+	var (
+		options any
+		session *webauthn.SessionData
+	)
+	if registration {
+		options, session, err = wa.BeginRegistration(webAuthnUser{user: user},
+			webauthn.WithExclusions(webauthn.Credentials(webAuthnUser{user: user}.WebAuthnCredentials()).CredentialDescriptors()),
+			webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementPreferred),
+		)
+	} else {
+		if len(user.Credentials) == 0 {
+			return &proto.AuthenticateResponse{}, nil
+		}
+		options, session, err = wa.BeginLogin(webAuthnUser{user: user})
+	}
+	if err != nil {
+		log.WithContext(ctx).Debugf("WebAuthn begin failed for service %s: %v", service.ID, err)
+		return &proto.AuthenticateResponse{}, nil
+	}
+
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode webauthn options: %v", err)
+	}
+
+	sessionID, err := newWebAuthnSessionID()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generate webauthn session: %v", err)
+	}
+
+	ceremony, err := json.Marshal(webAuthnCeremony{
+		ServiceID:    service.ID,
+		User:         user.Name,
+		Registration: registration,
+		Session:      *session,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode webauthn session: %v", err)
+	}
+	if err := store.StoreCeremony(sessionID, string(ceremony), webAuthnCeremonyTTL); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return &proto.AuthenticateResponse{
+		WebauthnOptions:   optionsJSON,
+		WebauthnSessionId: sessionID,
+	}, nil
+}
+
+// authenticateWebAuthn completes a login or registration ceremony. A
+// successful registration both stores the new passkey and signs the user in.
+func (s *ProxyServiceServer) authenticateWebAuthn(ctx context.Context, service *rpservice.Service, req *proto.AuthenticateRequest_Webauthn) (bool, string, proxyauth.Method) {
+	store := s.getSecondFactorStore()
+	if store == nil {
+		log.WithContext(ctx).Warnf("WebAuthn authentication attempted for service %s but no second factor store is configured", service.ID)
+		return false, "", ""
+	}
+
+	ceremony, ok := loadWebAuthnCeremony(store, req.Webauthn.GetSessionId())
+	if !ok || ceremony.ServiceID != service.ID || ceremony.User != req.Webauthn.GetUser() {
+		log.WithContext(ctx).Tracef("WebAuthn authentication failed: unknown or mismatched session")
+		return false, "", ""
+	}
+
+	registration := req.Webauthn.GetStage() == proto.WebAuthnStage_WEBAUTHN_STAGE_FINISH_REGISTRATION
+	if ceremony.Registration != registration {
+		log.WithContext(ctx).Tracef("WebAuthn authentication failed: stage does not match session")
+		return false, "", ""
+	}
+
+	user := s.webAuthnUserForRequest(ctx, service, req.Webauthn)
+	if user == nil {
+		return false, "", ""
+	}
+
+	wa, err := newWebAuthn(service)
+	if err != nil {
+		log.WithContext(ctx).Errorf("WebAuthn configuration error for service %s: %v", service.ID, err)
+		return false, "", ""
+	}
+
+	if registration {
+		return s.finishWebAuthnRegistration(ctx, wa, service, user, ceremony, req.Webauthn.GetCredential())
+	}
+	return s.finishWebAuthnLogin(ctx, wa, service, user, ceremony, req.Webauthn.GetCredential())
+}
+
+func (s *ProxyServiceServer) finishWebAuthnRegistration(ctx context.Context, wa *webauthn.WebAuthn, service *rpservice.Service, user *rpservice.WebAuthnUser, ceremony *webAuthnCeremony, credential []byte) (bool, string, proxyauth.Method) {
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(credential)
+	if err != nil {
+		log.WithContext(ctx).Tracef("WebAuthn registration failed: parse credential: %v", err)
+		return false, "", ""
+	}
+
+	cred, err := wa.CreateCredential(webAuthnUser{user: user}, ceremony.Session, parsed)
+	if err != nil {
+		log.WithContext(ctx).Tracef("WebAuthn registration failed: %v", err)
+		return false, "", ""
+	}
+
+	if err := s.serviceManager.RegisterWebAuthnCredential(ctx, service.AccountID, service.ID, user.Name, credentialFromWebAuthn(cred)); err != nil {
+		log.WithContext(ctx).Errorf("failed to store passkey for user %s on service %s: %v", user.Name, service.ID, err)
+		return false, "", ""
+	}
+
+	return true, user.Name, proxyauth.MethodWebAuthn
+}
+
+func (s *ProxyServiceServer) finishWebAuthnLogin(ctx context.Context, wa *webauthn.WebAuthn, service *rpservice.Service, user *rpservice.WebAuthnUser, ceremony *webAuthnCeremony, credential []byte) (bool, string, proxyauth.Method) {
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(credential)
+	if err != nil {
+		log.WithContext(ctx).Tracef("WebAuthn login failed: parse credential: %v", err)
+		return false, "", ""
+	}
+
+	cred, err := wa.ValidateLogin(webAuthnUser{user: user}, ceremony.Session, parsed)
+	if err != nil {
+		log.WithContext(ctx).Tracef("WebAuthn login failed: %v", err)
+		return false, "", ""
+	}
+
+	if cred.Authenticator.CloneWarning {
+		log.WithContext(ctx).Warnf("WebAuthn login rejected for user %s on service %s: possible cloned authenticator", user.Name, service.ID)
+		return false, "", ""
+	}
+
+	if err := s.serviceManager.UpdateWebAuthnSignCount(ctx, service.AccountID, service.ID, user.Name, cred.ID, cred.Authenticator.SignCount); err != nil {
+		log.WithContext(ctx).Warnf("failed to update passkey sign count for user %s on service %s: %v", user.Name, service.ID, err)
+	}
+
+	return true, user.Name, proxyauth.MethodWebAuthn
+}
+
+// webAuthnUserForRequest resolves the WebAuthn user a request refers to.
+// Registration stages additionally require the user's pending enrollment code.
+func (s *ProxyServiceServer) webAuthnUserForRequest(ctx context.Context, service *rpservice.Service, req *proto.WebAuthnRequest) *rpservice.WebAuthnUser {
+	auth := service.Auth.WebAuthnAuth
+	if auth == nil || !auth.Enabled {
+		log.WithContext(ctx).Debugf("WebAuthn authentication attempted but not enabled for service %s", service.ID)
+		return nil
+	}
+
+	user := auth.WebAuthnUser(req.GetUser())
+	if user == nil || len(user.Handle) == 0 {
+		log.WithContext(ctx).Tracef("WebAuthn authentication failed: unknown user")
+		return nil
+	}
+
+	switch req.GetStage() {
+	case proto.WebAuthnStage_WEBAUTHN_STAGE_BEGIN_REGISTRATION, proto.WebAuthnStage_WEBAUTHN_STAGE_FINISH_REGISTRATION:
+		if user.EnrollmentCode == "" {
+			log.WithContext(ctx).Tracef("WebAuthn registration failed: no pending enrollment")
+			return nil
+		}
+		if err := argon2id.Verify(req.GetEnrollmentCode(), user.EnrollmentCode); err != nil {
+			s.logAuthenticationError(ctx, err, "WebAuthn enrollment")
+			return nil
+		}
+	}
+
+	return user
+}
+
+func loadWebAuthnCeremony(store *SecondFactorStore, sessionID string) (*webAuthnCeremony, bool) {
+	if sessionID == "" {
+		return nil, false
+	}
+	raw, ok := store.LoadAndDeleteCeremony(sessionID)
+	if !ok {
+		return nil, false
+	}
+	var ceremony webAuthnCeremony
+	if err := json.NewDecoder(bytes.NewReader([]byte(raw))).Decode(&ceremony); err != nil {
+		log.Debugf("failed to decode webauthn ceremony: %v", err)
+		return nil, false
+	}
+	return &ceremony, true
+}
+
+func newWebAuthnSessionID() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("read random: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,230 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cachestore "github.com/eko/gocache/lib/v4/store"
+	gocache_store "github.com/eko/gocache/store/go_cache/v4"
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpservice "github.com/netbirdio/netbird/management/internals/modules/reverseproxy/service"
+	proxyauth "github.com/netbirdio/netbird/proxy/auth"
+	"github.com/netbirdio/netbird/shared/management/proto"
+)
+
+const testTOTPSecret = "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+
+func currentTOTPCode(t *testing.T) string {
+	t.Helper()
+	code, err := totp.GenerateCodeCustom(testTOTPSecret, time.Now().UTC(), totp.ValidateOpts{
+		Period:    totpPeriod,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	})
+	require.NoError(t, err)
+	return code
+}
+
+func totpRequest(user, code string) *proto.AuthenticateRequest_Totp {
+	return &proto.AuthenticateRequest_Totp{Totp: &proto.TOTPRequest{User: user, Code: code}}
+}
+
+func TestAuthenticateTOTP(t *testing.T) {
+	auth := &rpservice.TOTPAuthConfig{
+		Enabled: true,
+		Users:   []*rpservice.TOTPUser{{Name: "alice", Secret: testTOTPSecret}},
+	}
+
+	t.Run("valid code", func(t *testing.T) {
+		s := &ProxyServiceServer{}
+		ok, user, method := s.authenticateTOTP(context.Background(), "svc", totpRequest("alice", currentTOTPCode(t)), auth)
+		assert.True(t, ok)
+		assert.Equal(t, "alice", user)
+		assert.Equal(t, proxyauth.MethodTOTP, method)
+	})
+
+	t.Run("wrong code", func(t *testing.T) {
+		s := &ProxyServiceServer{}
+		ok, _, _ := s.authenticateTOTP(context.Background(), "svc", totpRequest("alice", "000000"), auth)
+		assert.False(t, ok)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		s := &ProxyServiceServer{}
+		ok, _, _ := s.authenticateTOTP(context.Background(), "svc", totpRequest("bob", currentTOTPCode(t)), auth)
+		assert.False(t, ok)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		s := &ProxyServiceServer{}
+		disabled := &rpservice.TOTPAuthConfig{Users: auth.Users}
+		ok, _, _ := s.authenticateTOTP(context.Background(), "svc", totpRequest("alice", currentTOTPCode(t)), disabled)
+		assert.False(t, ok)
+	})
+
+	t.Run("replayed code rejected", func(t *testing.T) {
+		s := &ProxyServiceServer{}
+		s.SetSecondFactorStore(NewSecondFactorStore(context.Background(), testCacheStore(t)))
+
+		code := currentTOTPCode(t)
+		ok, _, _ := s.authenticateTOTP(context.Background(), "svc", totpRequest("alice", code), auth)
+		require.True(t, ok)
+
+		ok, _, _ = s.authenticateTOTP(context.Background(), "svc", totpRequest("alice", code), auth)
+		assert.False(t, ok, "a code must only be accepted once")
+	})
+}
+
+func TestSecondFactorStore_CeremonyIsSingleUse(t *testing.T) {
+	store := NewSecondFactorStore(context.Background(), testCacheStore(t))
+
+	require.NoError(t, store.StoreCeremony("session", "state", time.Minute))
+
+	got, ok := store.LoadAndDeleteCeremony("session")
+	require.True(t, ok)
+	assert.Equal(t, "state", got)
+
+	_, ok = store.LoadAndDeleteCeremony("session")
+	assert.False(t, ok, "ceremony must not be loadable twice")
+}
+
+func TestSecondFactorStore_TOTPCodeAcceptedOnceConcurrently(t *testing.T) {
+	stores := map[string]cachestore.StoreInterface{
+		"shared cache store":          testCacheStore(t),
+		"store without set-if-absent": gocache_store.NewGoCache(gocache.New(time.Minute, time.Minute)),
+	}
+	for name, cacheStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			store := NewSecondFactorStore(context.Background(), cacheStore)
+
+			const attempts = 50
+			var (
+				wg       sync.WaitGroup
+				accepted atomic.Int32
+			)
+			for i := 0; i < attempts; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					used, err := store.MarkTOTPCodeUsed("svc", "alice", "123456", time.Minute)
+					assert.NoError(t, err)
+					if !used {
+						accepted.Add(1)
+					}
+				}()
+			}
+			wg.Wait()
+
+			assert.Equal(t, int32(1), accepted.Load(), "a code must only be accepted once")
+		})
+	}
+}
+
+func TestSecondFactorStore_CeremonyLoadedOnceConcurrently(t *testing.T) {
+	stores := map[string]cachestore.StoreInterface{
+		"shared cache store":           testCacheStore(t),
+		"store without get-and-delete": gocache_store.NewGoCache(gocache.New(time.Minute, time.Minute)),
+	}
+	for name, cacheStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			store := NewSecondFactorStore(context.Background(), cacheStore)
+			require.NoError(t, store.StoreCeremony("session", "state", time.Minute))
+
+			const attempts = 50
+			var (
+				wg     sync.WaitGroup
+				loaded atomic.Int32
+			)
+			for i := 0; i < attempts; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, ok := store.LoadAndDeleteCeremony("session"); ok {
+						loaded.Add(1)
+					}
+				}()
+			}
+			wg.Wait()
+
+			assert.Equal(t, int32(1), loaded.Load(), "a ceremony must only be loaded once")
+		})
+	}
+}
+
+func TestAuthenticateWebAuthn_UnknownSession(t *testing.T) {
+	s := &ProxyServiceServer{serviceManager: &mockReverseProxyManager{}}
+	s.SetSecondFactorStore(NewSecondFactorStore(context.Background(), testCacheStore(t)))
+
+	svc := &rpservice.Service{
+		ID:     "svc",
+		Domain: "app.example.com",
+		Auth: rpservice.AuthConfig{
+			WebAuthnAuth: &rpservice.WebAuthnAuthConfig{
+				Enabled: true,
+				Users:   []*rpservice.WebAuthnUser{{Name: "alice", Handle: []byte("handle")}},
+			},
+		},
+	}
+
+	ok, _, _ := s.authenticateWebAuthn(context.Background(), svc, &proto.AuthenticateRequest_Webauthn{
+		Webauthn: &proto.WebAuthnRequest{
+			Stage:     proto.WebAuthnStage_WEBAUTHN_STAGE_FINISH_LOGIN,
+			User:      "alice",
+			SessionId: "missing",
+		},
+	})
+	assert.False(t, ok)
+}
+
+func TestBeginWebAuthn_Registration(t *testing.T) {
+	s := &ProxyServiceServer{serviceManager: &mockReverseProxyManager{}}
+	s.SetSecondFactorStore(NewSecondFactorStore(context.Background(), testCacheStore(t)))
+
+	auth := rpservice.AuthConfig{
+		WebAuthnAuth: &rpservice.WebAuthnAuthConfig{
+			Enabled: true,
+			Users:   []*rpservice.WebAuthnUser{{Name: "alice", EnrollmentCode: "enroll-me-please"}},
+		},
+	}
+	require.NoError(t, auth.HashSecrets())
+	require.NoError(t, auth.WebAuthnAuth.AssignHandles())
+	svc := &rpservice.Service{ID: "svc", Name: "App", Domain: "app.example.com", Auth: auth}
+
+	t.Run("wrong enrollment code", func(t *testing.T) {
+		resp, err := s.beginWebAuthn(context.Background(), svc, &proto.WebAuthnRequest{
+			Stage:          proto.WebAuthnStage_WEBAUTHN_STAGE_BEGIN_REGISTRATION,
+			User:           "alice",
+			EnrollmentCode: "nope",
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetWebauthnOptions())
+	})
+
+	t.Run("valid enrollment code", func(t *testing.T) {
+		resp, err := s.beginWebAuthn(context.Background(), svc, &proto.WebAuthnRequest{
+			Stage:          proto.WebAuthnStage_WEBAUTHN_STAGE_BEGIN_REGISTRATION,
+			User:           "alice",
+			EnrollmentCode: "enroll-me-please",
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.GetWebauthnOptions())
+		assert.NotEmpty(t, resp.GetWebauthnSessionId())
+	})
+
+	t.Run("login without passkeys", func(t *testing.T) {
+		resp, err := s.beginWebAuthn(context.Background(), svc, &proto.WebAuthnRequest{
+			Stage: proto.WebAuthnStage_WEBAUTHN_STAGE_BEGIN_LOGIN,
+			User:  "alice",
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetWebauthnOptions())
+	})
+}
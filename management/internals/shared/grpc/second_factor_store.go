@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/eko/gocache/lib/v4/cache"
+	"github.com/eko/gocache/lib/v4/store"
+	log "github.com/sirupsen/logrus"
+
+	nbcache "github.com/netbirdio/netbird/management/server/cache"
+)
+
+// SecondFactorStore keeps the short-lived state the TOTP and WebAuthn proxy
+// auth schemes need between requests: pending WebAuthn ceremonies and
+// recently accepted TOTP codes.
+// Supports both in-memory and Redis storage via NB_IDP_CACHE_REDIS_ADDRESS env var.
+type SecondFactorStore struct {
+	cache *cache.Cache[string]
+	store store.StoreInterface
+	ctx   context.Context
+
+	// mu serializes the TOTP replay check and the ceremony consumption for
+	// stores without SetIfAbsent and GetAndDelete.
+	mu sync.Mutex
+}
+
+// NewSecondFactorStore creates a second-factor store using the provided shared cache store.
+func NewSecondFactorStore(ctx context.Context, cacheStore store.StoreInterface) *SecondFactorStore {
+	return &SecondFactorStore{
+		cache: cache.New[string](cacheStore),
+		store: cacheStore,
+		ctx:   ctx,
+	}
+}
+
+// StoreCeremony saves the encoded state of a pending WebAuthn ceremony under
+// its session ID. The entry is deleted automatically after ttl.
+func (s *SecondFactorStore) StoreCeremony(sessionID, ceremony string, ttl time.Duration) error {
+	if err := s.cache.Set(s.ctx, ceremonyKey(sessionID), ceremony, store.WithExpiration(ttl)); err != nil {
+		return fmt.Errorf("failed to store webauthn ceremony: %w", err)
+	}
+	return nil
+}
+
+// LoadAndDeleteCeremony retrieves and removes the ceremony state for the
+// given session ID, enforcing single-use challenges. Concurrent calls for the
+// same session return the ceremony to exactly one caller.
+func (s *SecondFactorStore) LoadAndDeleteCeremony(sessionID string) (string, bool) {
+	key := ceremonyKey(sessionID)
+	if deleter, ok := s.store.(nbcache.GetAndDeleter); ok {
+		ceremony, found, err := deleter.GetAndDelete(s.ctx, key)
+		if err != nil {
+			log.Warnf("Failed to load webauthn ceremony: %v", err)
+			return "", false
+		}
+		return ceremony, found
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ceremony, err := s.cache.Get(s.ctx, key)
+	if err != nil {
+		return "", false
+	}
+
+	if err := s.cache.Delete(s.ctx, key); err != nil {
+		log.Warnf("Failed to delete webauthn ceremony: %v", err)
+	}
+
+	return ceremony, true
+}
+
+// MarkTOTPCodeUsed records that code was accepted for the given service user
+// and reports whether it had already been used within ttl. A code replayed
+// inside its validity window is rejected by the caller. Concurrent calls with
+// the same code report it unused to exactly one caller.
+func (s *SecondFactorStore) MarkTOTPCodeUsed(serviceID, user, code string, ttl time.Duration) (bool, error) {
+	key := fmt.Sprintf("totp:%s:%s:%s", serviceID, user, code)
+	if setter, ok := s.store.(nbcache.SetIfAbsenter); ok {
+		stored, err := setter.SetIfAbsent(s.ctx, key, "1", ttl)
+		if err != nil {
+			return false, fmt.Errorf("failed to record totp code: %w", err)
+		}
+		return !stored, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.cache.Get(s.ctx, key); err == nil {
+		return true, nil
+	}
+
+	if err := s.cache.Set(s.ctx, key, "1", store.WithExpiration(ttl)); err != nil {
+		return false, fmt.Errorf("failed to record totp code: %w", err)
+	}
+	return false, nil
+}
+
+func ceremonyKey(sessionID string) string {
+	return "webauthn:" + sessionID
+}
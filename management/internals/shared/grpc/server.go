@@ -654,6 +654,12 @@ func extractPeerMeta(ctx context.Context, meta *proto.PeerSystemMeta) nbpeer.Pee
 		})
 	}
 
+	labels := meta.GetLabels()
+	if err := nbpeer.ValidateLabels(labels); err != nil {
+		log.WithContext(ctx).Warnf("ignoring labels reported by peer %s: %v", meta.GetHostname(), err)
+		labels = nil
+	}
+
 	return nbpeer.PeerSystemMeta{
 		Hostname:           meta.GetHostname(),
 		GoOS:               meta.GetGoOS(),
@@ -688,6 +694,7 @@ func extractPeerMeta(ctx context.Context, meta *proto.PeerSystemMeta) nbpeer.Pee
 		Files:              files,
 		Capabilities:       capabilitiesToInt32(meta.GetCapabilities()),
 		SyncMessageVersion: int(meta.GetSyncMessageVersion()),
+		Labels:             labels,
 	}
 }
 
@@ -782,13 +789,15 @@ func (s *Server) Login(ctx context.Context, req *proto.EncryptedMessage) (*proto
 	}
 
 	peer, network, postureChecks, enableSSH, err := s.accountManager.LoginPeer(ctx, types.PeerLogin{
-		WireGuardPubKey: peerKey.String(),
-		SSHKey:          string(sshKey),
-		Meta:            peerMeta,
-		UserID:          userID,
-		SetupKey:        loginReq.GetSetupKey(),
-		ConnectionIP:    realIP,
-		ExtraDNSLabels:  loginReq.GetDnsLabels(),
+		WireGuardPubKey:            peerKey.String(),
+		SSHKey:                     string(sshKey),
+		Meta:                       peerMeta,
+		UserID:                     userID,
+		SetupKey:                   loginReq.GetSetupKey(),
+		ConnectionIP:               realIP,
+		ExtraDNSLabels:             loginReq.GetDnsLabels(),
+		WorkloadIdentityProviderID: loginReq.GetWorkloadIdentity().GetProviderId(),
+		WorkloadIdentityToken:      loginReq.GetWorkloadIdentity().GetToken(),
 	})
 	if err != nil {
 		if errors.Is(err, internalStatus.ErrNoAuthMethodProvided) {
@@ -517,6 +517,18 @@ func (m *testValidateSessionServiceManager) DeleteAccountCluster(_ context.Conte
 	return nil
 }
 
+func (m *testValidateSessionServiceManager) RegisterWebAuthnCredential(_ context.Context, _, _, _ string, _ *service.WebAuthnCredential) error {
+	return nil
+}
+
+func (m *testValidateSessionServiceManager) UpdateWebAuthnSignCount(_ context.Context, _, _, _ string, _ []byte, _ uint32) error {
+	return nil
+}
+
+func (m *testValidateSessionServiceManager) RevokeSessions(_ context.Context, _, _ string, _ []*service.RevokedSession) error {
+	return nil
+}
+
 type testValidateSessionProxyManager struct{}
 
 func (m *testValidateSessionProxyManager) Connect(_ context.Context, _, _, _, _ string, _ *string, _ *proxy.Capabilities) (*proxy.Proxy, error) {
@@ -0,0 +1,104 @@
+// Package updatebus distributes peer update triggers and job dispatches between management instances
+// that serve the same store, so every instance can push changes to the peers connected to it.
+package updatebus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/rs/xid"
+)
+
+// EnginePostgres selects the bus using PostgreSQL LISTEN/NOTIFY.
+const EnginePostgres = "postgres"
+
+// postgresDSNEnv holds the DSN of the PostgreSQL store, used when no bus DSN is configured.
+const postgresDSNEnv = "NB_STORE_ENGINE_POSTGRES_DSN"
+
+// MessageType identifies what a Message asks the receiving instances to do.
+type MessageType string
+
+const (
+	// MessageAccountPeers asks to update every connected peer of the account.
+	MessageAccountPeers MessageType = "account_peers"
+	// MessageAffectedPeers asks to update the listed peers of the account.
+	MessageAffectedPeers MessageType = "affected_peers"
+	// MessagePeer asks to update a single peer of the account.
+	MessagePeer MessageType = "peer"
+	// MessagePeersDeleted asks to send an empty network map to the listed peers and close their streams.
+	MessagePeersDeleted MessageType = "peers_deleted"
+	// MessageDisconnectPeers asks to close the streams of the listed peers.
+	MessageDisconnectPeers MessageType = "disconnect_peers"
+	// MessageJobDispatch asks the instance holding the job stream of the peer to send it the job in Payload.
+	MessageJobDispatch MessageType = "job_dispatch"
+	// MessageJobResult answers a MessageJobDispatch, Error is empty when the job was sent.
+	MessageJobResult MessageType = "job_result"
+)
+
+// ErrMessageTooLarge is returned by Publish when the encoded message exceeds the limit of the bus.
+var ErrMessageTooLarge = errors.New("update bus message too large")
+
+// Message is published to every other instance on the bus.
+type Message struct {
+	Type MessageType `json:"type"`
+	// Source is the ID of the publishing instance, it is set by the bus.
+	Source string `json:"source,omitempty"`
+	// Target restricts delivery to a single instance when set.
+	Target    string   `json:"target,omitempty"`
+	AccountID string   `json:"account_id,omitempty"`
+	PeerIDs   []string `json:"peer_ids,omitempty"`
+	Resource  string   `json:"resource,omitempty"`
+	Operation string   `json:"operation,omitempty"`
+	// RequestID correlates a MessageJobResult with its MessageJobDispatch.
+	RequestID string `json:"request_id,omitempty"`
+	Payload   []byte `json:"payload,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Handler processes a message published by another instance.
+type Handler func(ctx context.Context, msg *Message)
+
+// Bus delivers published messages to the handlers of every other instance. Messages published by an
+// instance are never delivered back to it, and messages with a Target only reach that instance.
+// Delivery is best effort, a message published while an instance is disconnected is lost for it.
+type Bus interface {
+	// InstanceID returns the ID of this instance on the bus.
+	InstanceID() string
+	// Publish sends the message to the other instances.
+	Publish(ctx context.Context, msg *Message) error
+	// Subscribe registers a handler for messages of the other instances.
+	Subscribe(handler Handler)
+	// Close stops delivering messages and releases the resources of the bus.
+	Close() error
+}
+
+// New creates the bus selected by engine. It returns a nil Bus when engine is empty, in which case updates
+// stay within this instance. An empty dsn falls back to the DSN of the PostgreSQL store.
+func New(ctx context.Context, engine, dsn, instanceID string) (Bus, error) {
+	switch engine {
+	case "":
+		return nil, nil
+	case EnginePostgres:
+		if dsn == "" {
+			dsn = os.Getenv(postgresDSNEnv)
+		}
+		if dsn == "" {
+			return nil, fmt.Errorf("the %s update bus requires a DSN, set it in the config or %s", engine, postgresDSNEnv)
+		}
+		return NewPostgresBus(ctx, dsn, instanceID)
+	default:
+		return nil, fmt.Errorf("unsupported update bus engine %q", engine)
+	}
+}
+
+// NewInstanceID returns a random instance ID.
+func NewInstanceID() string {
+	return xid.New().String()
+}
+
+// accepts reports whether an instance with the given ID handles the message.
+func accepts(instanceID string, msg *Message) bool {
+	return msg.Source != instanceID && (msg.Target == "" || msg.Target == instanceID)
+}
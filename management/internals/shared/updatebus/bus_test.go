@@ -0,0 +1,101 @@
+package updatebus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func collect(b Bus) <-chan *Message {
+	received := make(chan *Message, 10)
+	b.Subscribe(func(_ context.Context, msg *Message) {
+		received <- msg
+	})
+	return received
+}
+
+func TestMemoryBus_DeliversToOtherInstances(t *testing.T) {
+	hub := NewHub()
+	a := hub.NewBus("a")
+	b := hub.NewBus("b")
+	c := hub.NewBus("c")
+	t.Cleanup(func() {
+		_ = a.Close()
+		_ = b.Close()
+		_ = c.Close()
+	})
+
+	fromA, fromB, fromC := collect(a), collect(b), collect(c)
+
+	msg := &Message{Type: MessageAffectedPeers, AccountID: "account", PeerIDs: []string{"peer1"}}
+	require.NoError(t, a.Publish(context.Background(), msg))
+	msg.PeerIDs[0] = "changed"
+
+	for _, ch := range []<-chan *Message{fromB, fromC} {
+		select {
+		case got := <-ch:
+			assert.Equal(t, "a", got.Source)
+			assert.Equal(t, MessageAffectedPeers, got.Type)
+			assert.Equal(t, []string{"peer1"}, got.PeerIDs)
+		case <-time.After(time.Second):
+			t.Fatal("message was not delivered")
+		}
+	}
+
+	select {
+	case <-fromA:
+		t.Fatal("message was delivered back to the publisher")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestMemoryBus_Target(t *testing.T) {
+	hub := NewHub()
+	a := hub.NewBus("a")
+	b := hub.NewBus("b")
+	c := hub.NewBus("c")
+	t.Cleanup(func() {
+		_ = a.Close()
+		_ = b.Close()
+		_ = c.Close()
+	})
+
+	fromB, fromC := collect(b), collect(c)
+	require.NoError(t, a.Publish(context.Background(), &Message{Type: MessageJobResult, Target: "c", RequestID: "r1"}))
+
+	select {
+	case got := <-fromC:
+		assert.Equal(t, "r1", got.RequestID)
+	case <-time.After(time.Second):
+		t.Fatal("message was not delivered to the target")
+	}
+
+	select {
+	case <-fromB:
+		t.Fatal("message was delivered to another instance than the target")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestMemoryBus_Close(t *testing.T) {
+	hub := NewHub()
+	a := hub.NewBus("")
+	b := hub.NewBus("")
+	assert.NotEmpty(t, a.InstanceID())
+	assert.NotEqual(t, a.InstanceID(), b.InstanceID())
+
+	fromB := collect(b)
+	require.NoError(t, b.Close())
+	require.NoError(t, b.Close())
+	require.NoError(t, a.Publish(context.Background(), &Message{Type: MessageAccountPeers}))
+
+	select {
+	case <-fromB:
+		t.Fatal("closed bus received a message")
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.NoError(t, a.Close())
+}
@@ -0,0 +1,127 @@
+package updatebus
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const memoryQueueSize = 1000
+
+// Hub connects in-process buses, each bus acting as a separate management instance. It is meant for tests
+// and for running several instances inside one process.
+type Hub struct {
+	mu    sync.RWMutex
+	buses map[*MemoryBus]struct{}
+}
+
+// NewHub returns an empty Hub.
+func NewHub() *Hub {
+	return &Hub{buses: make(map[*MemoryBus]struct{})}
+}
+
+// NewBus connects a new instance to the hub. An empty instanceID is replaced by a random one.
+func (h *Hub) NewBus(instanceID string) *MemoryBus {
+	if instanceID == "" {
+		instanceID = NewInstanceID()
+	}
+
+	b := &MemoryBus{
+		hub:        h,
+		instanceID: instanceID,
+		queue:      make(chan *Message, memoryQueueSize),
+		done:       make(chan struct{}),
+	}
+	go b.deliver()
+
+	h.mu.Lock()
+	h.buses[b] = struct{}{}
+	h.mu.Unlock()
+
+	return b
+}
+
+// MemoryBus is a Bus connected to the other buses of its Hub.
+type MemoryBus struct {
+	hub        *Hub
+	instanceID string
+
+	mu       sync.RWMutex
+	handlers []Handler
+
+	queue     chan *Message
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+var _ Bus = (*MemoryBus)(nil)
+
+// InstanceID returns the ID of this instance on the hub.
+func (b *MemoryBus) InstanceID() string {
+	return b.instanceID
+}
+
+// Publish queues a copy of the message on every other bus of the hub.
+func (b *MemoryBus) Publish(ctx context.Context, msg *Message) error {
+	out := *msg
+	out.Source = b.instanceID
+	out.PeerIDs = append([]string(nil), msg.PeerIDs...)
+	out.Payload = append([]byte(nil), msg.Payload...)
+
+	b.hub.mu.RLock()
+	defer b.hub.mu.RUnlock()
+
+	for other := range b.hub.buses {
+		if !accepts(other.instanceID, &out) {
+			continue
+		}
+		select {
+		case other.queue <- &out:
+		default:
+			log.WithContext(ctx).Warnf("update bus queue of instance %s is full, dropping %s message", other.instanceID, out.Type)
+		}
+	}
+	return nil
+}
+
+// Subscribe registers a handler for messages of the other buses.
+func (b *MemoryBus) Subscribe(handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+// Close disconnects the bus from the hub.
+func (b *MemoryBus) Close() error {
+	b.closeOnce.Do(func() {
+		b.hub.mu.Lock()
+		delete(b.hub.buses, b)
+		b.hub.mu.Unlock()
+		close(b.done)
+	})
+	return nil
+}
+
+func (b *MemoryBus) deliver() {
+	for {
+		select {
+		case <-b.done:
+			return
+		case msg := <-b.queue:
+			dispatch(context.Background(), b.handlersSnapshot(), msg)
+		}
+	}
+}
+
+func (b *MemoryBus) handlersSnapshot() []Handler {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return append([]Handler(nil), b.handlers...)
+}
+
+func dispatch(ctx context.Context, handlers []Handler, msg *Message) {
+	for _, handler := range handlers {
+		handler(ctx, msg)
+	}
+}
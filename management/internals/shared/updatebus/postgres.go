@@ -0,0 +1,169 @@
+package updatebus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// postgresChannel is the LISTEN/NOTIFY channel shared by all instances.
+	postgresChannel = "netbird_update_bus"
+	// postgresMaxPayload is the NOTIFY payload limit of PostgreSQL minus a safety margin.
+	postgresMaxPayload = 7900
+
+	postgresMaxConnections = 4
+	postgresMinRetryDelay  = time.Second
+	postgresMaxRetryDelay  = 30 * time.Second
+)
+
+// PostgresBus is a Bus using PostgreSQL LISTEN/NOTIFY. One connection is held for listening, publishing
+// uses the remaining connections of the pool.
+type PostgresBus struct {
+	instanceID string
+	pool       *pgxpool.Pool
+
+	mu       sync.RWMutex
+	handlers []Handler
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+var _ Bus = (*PostgresBus)(nil)
+
+// NewPostgresBus connects to the database in dsn and starts listening for messages of the other instances.
+// An empty instanceID is replaced by a random one.
+func NewPostgresBus(ctx context.Context, dsn, instanceID string) (*PostgresBus, error) {
+	config, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("parse update bus database config: %w", err)
+	}
+	config.MaxConns = postgresMaxConnections
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("create update bus connection pool: %w", err)
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("ping update bus database: %w", err)
+	}
+
+	if instanceID == "" {
+		instanceID = NewInstanceID()
+	}
+
+	listenCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	b := &PostgresBus{
+		instanceID: instanceID,
+		pool:       pool,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+	go b.listen(listenCtx)
+
+	log.WithContext(ctx).Infof("joined the postgres update bus as instance %s", instanceID)
+	return b, nil
+}
+
+// InstanceID returns the ID of this instance on the bus.
+func (b *PostgresBus) InstanceID() string {
+	return b.instanceID
+}
+
+// Publish notifies the other instances. Messages larger than the NOTIFY payload limit are rejected
+// with ErrMessageTooLarge.
+func (b *PostgresBus) Publish(ctx context.Context, msg *Message) error {
+	out := *msg
+	out.Source = b.instanceID
+
+	payload, err := json.Marshal(&out)
+	if err != nil {
+		return fmt.Errorf("encode update bus message: %w", err)
+	}
+	if len(payload) > postgresMaxPayload {
+		return ErrMessageTooLarge
+	}
+
+	if _, err := b.pool.Exec(ctx, "SELECT pg_notify($1, $2)", postgresChannel, string(payload)); err != nil {
+		return fmt.Errorf("publish update bus message: %w", err)
+	}
+	return nil
+}
+
+// Subscribe registers a handler for messages of the other instances.
+func (b *PostgresBus) Subscribe(handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+// Close stops listening and closes the connection pool.
+func (b *PostgresBus) Close() error {
+	b.cancel()
+	<-b.done
+	b.pool.Close()
+	return nil
+}
+
+// listen receives notifications until ctx is cancelled, reconnecting with a backoff when the connection fails.
+func (b *PostgresBus) listen(ctx context.Context) {
+	defer close(b.done)
+
+	delay := postgresMinRetryDelay
+	for {
+		err := b.receive(ctx, func() { delay = postgresMinRetryDelay })
+		if ctx.Err() != nil {
+			return
+		}
+		log.WithContext(ctx).Errorf("update bus listener failed, messages of other instances are missed until it reconnects in %s: %v", delay, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, postgresMaxRetryDelay)
+	}
+}
+
+func (b *PostgresBus) receive(ctx context.Context, onListening func()) error {
+	conn, err := b.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{postgresChannel}.Sanitize()); err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+	onListening()
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+
+		var msg Message
+		if err := json.Unmarshal([]byte(notification.Payload), &msg); err != nil {
+			log.WithContext(ctx).Warnf("ignoring malformed update bus message: %v", err)
+			continue
+		}
+		if !accepts(b.instanceID, &msg) {
+			continue
+		}
+
+		b.mu.RLock()
+		handlers := append([]Handler(nil), b.handlers...)
+		b.mu.RUnlock()
+		dispatch(ctx, handlers, &msg)
+	}
+}
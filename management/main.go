@@ -5,6 +5,8 @@ import (
 	// nolint:gosec
 	_ "net/http/pprof"
 	"os"
+	// embedded zoneinfo, so policy schedule timezones resolve on images without tzdata
+	_ "time/tzdata"
 
 	log "github.com/sirupsen/logrus"
 
@@ -0,0 +1,409 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// GetAccessRequests returns every access request of the account to approvers and the user's own
+// requests to everyone else
+func (am *DefaultAccountManager) GetAccessRequests(ctx context.Context, accountID, userID string) ([]*types.AccessRequest, error) {
+	user, err := am.getAccessRequestUser(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := am.Store.GetAccountAccessRequests(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	approver, err := am.isAccessRequestApprover(ctx, accountID, user)
+	if err != nil {
+		return nil, err
+	}
+	if approver {
+		return requests, nil
+	}
+
+	return slices.DeleteFunc(requests, func(r *types.AccessRequest) bool {
+		return r.UserID != userID
+	}), nil
+}
+
+// GetAccessRequest returns an access request to its requester and to approvers
+func (am *DefaultAccountManager) GetAccessRequest(ctx context.Context, accountID, userID, requestID string) (*types.AccessRequest, error) {
+	user, err := am.getAccessRequestUser(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := am.Store.GetAccessRequestByID(ctx, store.LockingStrengthNone, accountID, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request.UserID == userID {
+		return request, nil
+	}
+
+	approver, err := am.isAccessRequestApprover(ctx, accountID, user)
+	if err != nil {
+		return nil, err
+	}
+	if !approver {
+		return nil, status.NewAccessRequestNotFoundError(requestID)
+	}
+
+	return request, nil
+}
+
+// CreateAccessRequest stores a pending request of the user for temporary access to a group or a policy
+func (am *DefaultAccountManager) CreateAccessRequest(ctx context.Context, accountID, userID string, request *types.AccessRequest) (*types.AccessRequest, error) {
+	if _, err := am.getAccessRequestUser(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "invalid access request: %v", err)
+	}
+
+	newRequest := &types.AccessRequest{
+		ID:            xid.New().String(),
+		AccountID:     accountID,
+		UserID:        userID,
+		TargetType:    request.TargetType,
+		TargetID:      request.TargetID,
+		Justification: request.Justification,
+		Duration:      request.Duration,
+		Status:        types.AccessRequestStatusPending,
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err := validateAccessRequestTarget(ctx, transaction, accountID, newRequest); err != nil {
+			return err
+		}
+
+		requests, err := transaction.GetAccountAccessRequests(ctx, store.LockingStrengthNone, accountID)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		for _, r := range requests {
+			if r.UserID == userID && r.TargetType == newRequest.TargetType && r.TargetID == newRequest.TargetID &&
+				(r.Status == types.AccessRequestStatusPending || r.IsActive(now)) {
+				return status.Errorf(status.AlreadyExists, "an open access request %s for this %s already exists", r.ID, r.TargetType)
+			}
+		}
+
+		return transaction.CreateAccessRequest(ctx, newRequest)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, newRequest.ID, accountID, activity.AccessRequestCreated, newRequest.EventMeta())
+
+	return newRequest, nil
+}
+
+// ApproveAccessRequest grants a pending request for its duration. Requesters can't approve their own requests.
+func (am *DefaultAccountManager) ApproveAccessRequest(ctx context.Context, accountID, userID, requestID, comment string) (*types.AccessRequest, error) {
+	request, err := am.reviewAccessRequest(ctx, accountID, userID, requestID, func(request *types.AccessRequest) {
+		request.Approve(userID, comment, time.Now().UTC())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, request.ID, accountID, activity.AccessRequestApproved, request.EventMeta())
+
+	am.UpdateAccountPeers(ctx, accountID, accessRequestUpdateReason(request))
+	am.rescheduleAccessGrantExpiry(ctx, accountID)
+
+	return request, nil
+}
+
+// DenyAccessRequest rejects a pending request
+func (am *DefaultAccountManager) DenyAccessRequest(ctx context.Context, accountID, userID, requestID, comment string) (*types.AccessRequest, error) {
+	request, err := am.reviewAccessRequest(ctx, accountID, userID, requestID, func(request *types.AccessRequest) {
+		request.Deny(userID, comment, time.Now().UTC())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, request.ID, accountID, activity.AccessRequestDenied, request.EventMeta())
+
+	return request, nil
+}
+
+// RevokeAccessRequest withdraws a pending request or ends an active grant early. Requesters can revoke
+// their own requests, approvers can revoke any.
+func (am *DefaultAccountManager) RevokeAccessRequest(ctx context.Context, accountID, userID, requestID string) (*types.AccessRequest, error) {
+	user, err := am.getAccessRequestUser(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	approver, err := am.isAccessRequestApprover(ctx, accountID, user)
+	if err != nil {
+		return nil, err
+	}
+
+	var request *types.AccessRequest
+	var wasActive bool
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		request, err = transaction.GetAccessRequestByID(ctx, store.LockingStrengthUpdate, accountID, requestID)
+		if err != nil {
+			return err
+		}
+
+		if request.UserID != userID && !approver {
+			return status.NewAccessRequestNotFoundError(requestID)
+		}
+
+		now := time.Now().UTC()
+		wasActive = request.IsActive(now)
+		if request.Status != types.AccessRequestStatusPending && !wasActive {
+			return status.Errorf(status.PreconditionFailed, "access request is %s and can't be revoked", request.Status)
+		}
+
+		request.Revoke(userID, now)
+		if err = transaction.SaveAccessRequest(ctx, request); err != nil {
+			return err
+		}
+
+		if wasActive {
+			return transaction.IncrementNetworkSerial(ctx, accountID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, request.ID, accountID, activity.AccessRequestRevoked, request.EventMeta())
+
+	if wasActive {
+		am.UpdateAccountPeers(ctx, accountID, accessRequestUpdateReason(request))
+		am.rescheduleAccessGrantExpiry(ctx, accountID)
+	}
+
+	return request, nil
+}
+
+// reviewAccessRequest applies an approver's decision to a pending request
+func (am *DefaultAccountManager) reviewAccessRequest(ctx context.Context, accountID, userID, requestID string, decide func(request *types.AccessRequest)) (*types.AccessRequest, error) {
+	user, err := am.getAccessRequestUser(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	approver, err := am.isAccessRequestApprover(ctx, accountID, user)
+	if err != nil {
+		return nil, err
+	}
+	if !approver {
+		return nil, status.NewPermissionDeniedError()
+	}
+
+	var request *types.AccessRequest
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		request, err = transaction.GetAccessRequestByID(ctx, store.LockingStrengthUpdate, accountID, requestID)
+		if err != nil {
+			return err
+		}
+
+		if request.UserID == userID {
+			return status.Errorf(status.PermissionDenied, "users can't review their own access requests")
+		}
+		if request.Status != types.AccessRequestStatusPending {
+			return status.Errorf(status.PreconditionFailed, "access request is already %s", request.Status)
+		}
+
+		decide(request)
+		if err = transaction.SaveAccessRequest(ctx, request); err != nil {
+			return err
+		}
+
+		if request.Status == types.AccessRequestStatusApproved {
+			return transaction.IncrementNetworkSerial(ctx, accountID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// getAccessRequestUser returns the initiating user, any active user of the account can request access
+func (am *DefaultAccountManager) getAccessRequestUser(ctx context.Context, accountID, userID string) (*types.User, error) {
+	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthNone, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.AccountID != accountID || user.IsBlocked() {
+		return nil, status.NewPermissionDeniedError()
+	}
+	return user, nil
+}
+
+// isAccessRequestApprover reports whether the user can review access requests, which administrators and
+// members of the account's approver groups can
+func (am *DefaultAccountManager) isAccessRequestApprover(ctx context.Context, accountID string, user *types.User) (bool, error) {
+	allowed, _, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, user.Id, modules.Policies, operations.Update)
+	if err != nil {
+		return false, status.NewPermissionValidationError(err)
+	}
+	if allowed {
+		return true, nil
+	}
+
+	settings, err := am.Store.GetAccountSettings(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(user.AutoGroups, func(groupID string) bool {
+		return slices.Contains(settings.AccessRequestApproverGroups, groupID)
+	}), nil
+}
+
+// validateAccessRequestTarget checks that the requested group or policy exists and can be granted
+func validateAccessRequestTarget(ctx context.Context, transaction store.Store, accountID string, request *types.AccessRequest) error {
+	switch request.TargetType {
+	case types.AccessRequestTargetGroup:
+		group, err := transaction.GetGroupByID(ctx, store.LockingStrengthNone, accountID, request.TargetID)
+		if err != nil {
+			return err
+		}
+		if group.IsGroupAll() {
+			return status.Errorf(status.InvalidArgument, "access to the All group can't be requested")
+		}
+	case types.AccessRequestTargetPolicy:
+		policy, err := transaction.GetPolicyByID(ctx, store.LockingStrengthNone, accountID, request.TargetID)
+		if err != nil {
+			return err
+		}
+		if policy.Enabled {
+			return status.Errorf(status.InvalidArgument, "policy %s is already enabled", policy.Name)
+		}
+	}
+	return nil
+}
+
+func accessRequestUpdateReason(request *types.AccessRequest) types.UpdateReason {
+	if request.TargetType == types.AccessRequestTargetGroup {
+		return types.UpdateReason{Resource: types.UpdateResourceGroup, Operation: types.UpdateOperationUpdate}
+	}
+	return types.UpdateReason{Resource: types.UpdateResourcePolicy, Operation: types.UpdateOperationUpdate}
+}
+
+// rescheduleAccessGrantExpiry replaces the account's grant expiry job after its grants changed
+func (am *DefaultAccountManager) rescheduleAccessGrantExpiry(ctx context.Context, accountID string) {
+	am.accessGrantExpiry.Cancel(ctx, []string{accountID})
+	am.scheduleAccessGrantExpiry(ctx, accountID)
+}
+
+// scheduleAccessGrantExpiry schedules the expiry of the account's next access grant, unless such a job
+// is already scheduled
+func (am *DefaultAccountManager) scheduleAccessGrantExpiry(ctx context.Context, accountID string) {
+	if am.accessGrantExpiry.IsSchedulerRunning(accountID) {
+		log.WithContext(ctx).Tracef("access grant expiry job for account %s is already scheduled", accountID)
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	if nextRun, ok := am.expireAccessGrants(ctx, accountID); ok {
+		go am.accessGrantExpiry.Schedule(ctx, nextRun, accountID, am.accessGrantExpiryJob(ctx, accountID))
+	}
+}
+
+// scheduleAccessGrantExpiryOnStartup schedules the grant expiry jobs of all accounts that have
+// approved grants, so grants that elapse before the next grant change are still expired
+func (am *DefaultAccountManager) scheduleAccessGrantExpiryOnStartup(ctx context.Context) {
+	accountIDs, err := am.Store.GetAccountIDsWithApprovedAccessRequests(ctx)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed getting accounts with approved access requests: %v", err)
+		return
+	}
+
+	for _, accountID := range accountIDs {
+		am.scheduleAccessGrantExpiry(ctx, accountID)
+	}
+}
+
+// accessGrantExpiryJob expires the elapsed grants and returns the duration until the next one expires
+func (am *DefaultAccountManager) accessGrantExpiryJob(ctx context.Context, accountID string) func() (time.Duration, bool) {
+	return func() (time.Duration, bool) {
+		return am.expireAccessGrants(ctx, accountID)
+	}
+}
+
+// expireAccessGrants marks the elapsed grants of the account as expired, updates the peers if any did
+// and returns the duration until the next grant expires
+func (am *DefaultAccountManager) expireAccessGrants(ctx context.Context, accountID string) (time.Duration, bool) {
+	now := time.Now().UTC()
+	var expired []*types.AccessRequest
+	var next time.Time
+
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		requests, err := transaction.GetAccountAccessRequests(ctx, store.LockingStrengthUpdate, accountID)
+		if err != nil {
+			return err
+		}
+
+		for _, request := range requests {
+			if request.Status != types.AccessRequestStatusApproved || request.ExpiresAt == nil {
+				continue
+			}
+			if request.IsActive(now) {
+				if next.IsZero() || request.ExpiresAt.Before(next) {
+					next = *request.ExpiresAt
+				}
+				continue
+			}
+
+			request.Status = types.AccessRequestStatusExpired
+			if err = transaction.SaveAccessRequest(ctx, request); err != nil {
+				return err
+			}
+			expired = append(expired, request)
+		}
+
+		if len(expired) > 0 {
+			return transaction.IncrementNetworkSerial(ctx, accountID)
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed expiring access grants of account %s: %v", accountID, err)
+		return peerSchedulerRetryInterval, true
+	}
+
+	for _, request := range expired {
+		am.StoreEvent(ctx, activity.SystemInitiator, request.ID, accountID, activity.AccessRequestExpired, request.EventMeta())
+	}
+	if len(expired) > 0 {
+		log.WithContext(ctx).Debugf("expired %d access grants of account %s, updating peers", len(expired), accountID)
+		am.UpdateAccountPeers(ctx, accountID, accessRequestUpdateReason(expired[0]))
+	}
+
+	if next.IsZero() {
+		return 0, false
+	}
+	return next.Sub(now), true
+}
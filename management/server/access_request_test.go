@@ -0,0 +1,196 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/netbirdio/netbird/management/internals/controllers/network_map"
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/proto"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+func TestAccessRequest_Workflow(t *testing.T) {
+	manager, updateManager, account, peer1, _, _ := setupNetworkMapTest(t)
+	ctx := context.Background()
+	accountID := account.Id
+
+	const requesterID = "requester"
+	const approverID = "approver"
+	require.NoError(t, manager.CreateGroup(ctx, accountID, userID, &types.Group{ID: "approvers", Name: "approvers"}))
+	require.NoError(t, manager.Store.SaveUser(ctx, &types.User{Id: requesterID, AccountID: accountID, Role: types.UserRoleUser}))
+	require.NoError(t, manager.Store.SaveUser(ctx, &types.User{Id: approverID, AccountID: accountID, Role: types.UserRoleUser, AutoGroups: []string{"approvers"}}))
+
+	key, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+	requesterPeer, _, _, _, err := manager.AddPeer(ctx, accountID, "", requesterID, &nbpeer.Peer{
+		Key:  key.PublicKey().String(),
+		Meta: nbpeer.PeerSystemMeta{Hostname: "requester-peer"},
+	}, false)
+	require.NoError(t, err)
+
+	// the requester's peer only reaches peer1 through the granted group
+	policies, err := manager.Store.GetAccountPolicies(ctx, store.LockingStrengthNone, accountID)
+	require.NoError(t, err)
+	for _, p := range policies {
+		require.NoError(t, manager.DeletePolicy(ctx, accountID, p.ID, userID))
+	}
+	require.NoError(t, manager.CreateGroup(ctx, accountID, userID, &types.Group{ID: "ops", Name: "ops", Peers: []string{peer1.ID}}))
+	_, err = manager.SavePolicy(ctx, accountID, userID, &types.Policy{
+		Enabled: true,
+		Rules: []*types.PolicyRule{
+			{
+				Enabled:       true,
+				Sources:       []string{"ops"},
+				Destinations:  []string{"ops"},
+				Bidirectional: true,
+				Action:        types.PolicyTrafficActionAccept,
+			},
+		},
+	}, true)
+	require.NoError(t, err)
+
+	settings, err := manager.Store.GetAccountSettings(ctx, store.LockingStrengthNone, accountID)
+	require.NoError(t, err)
+	settings.AccessRequestApproverGroups = []string{"approvers"}
+	_, err = manager.UpdateAccountSettings(ctx, accountID, userID, settings)
+	require.NoError(t, err)
+
+	_, err = manager.CreateAccessRequest(ctx, accountID, requesterID, &types.AccessRequest{
+		TargetType: types.AccessRequestTargetGroup, TargetID: "ops", Duration: time.Hour,
+	})
+	assertStatusType(t, err, status.InvalidArgument)
+
+	request, err := manager.CreateAccessRequest(ctx, accountID, requesterID, &types.AccessRequest{
+		TargetType: types.AccessRequestTargetGroup, TargetID: "ops", Justification: "incident", Duration: time.Hour,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.AccessRequestStatusPending, request.Status)
+
+	_, err = manager.CreateAccessRequest(ctx, accountID, requesterID, &types.AccessRequest{
+		TargetType: types.AccessRequestTargetGroup, TargetID: "ops", Justification: "again", Duration: time.Hour,
+	})
+	assertStatusType(t, err, status.AlreadyExists)
+
+	_, err = manager.ApproveAccessRequest(ctx, accountID, requesterID, request.ID, "")
+	assertStatusType(t, err, status.PermissionDenied)
+
+	requests, err := manager.GetAccessRequests(ctx, accountID, approverID)
+	require.NoError(t, err)
+	assert.Len(t, requests, 1, "approvers see every request")
+
+	_, err = manager.GetAccessRequest(ctx, accountID, "other-user-without-access", request.ID)
+	assert.Error(t, err)
+
+	updMsg := updateManager.CreateChannel(ctx, peer1.ID)
+	t.Cleanup(func() {
+		updateManager.CloseChannel(ctx, peer1.ID)
+	})
+
+	approved, err := manager.ApproveAccessRequest(ctx, accountID, approverID, request.ID, "go ahead")
+	require.NoError(t, err)
+	assert.Equal(t, types.AccessRequestStatusApproved, approved.Status)
+	require.NotNil(t, approved.ExpiresAt)
+	waitForRemotePeer(t, updMsg, requesterPeer.Key, true)
+
+	revoked, err := manager.RevokeAccessRequest(ctx, accountID, approverID, request.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.AccessRequestStatusRevoked, revoked.Status)
+	waitForRemotePeer(t, updMsg, requesterPeer.Key, false)
+
+	hasEvent := func(expected activity.Activity) func() bool {
+		return func() bool {
+			events, err := manager.GetEvents(ctx, accountID, userID, activity.Filter{})
+			if err != nil {
+				return false
+			}
+			return slices.ContainsFunc(events, func(e *activity.Event) bool { return e.Activity == expected })
+		}
+	}
+	assert.Eventually(t, hasEvent(activity.AccessRequestCreated), time.Second, 10*time.Millisecond)
+	assert.Eventually(t, hasEvent(activity.AccessRequestApproved), time.Second, 10*time.Millisecond)
+	assert.Eventually(t, hasEvent(activity.AccessRequestRevoked), time.Second, 10*time.Millisecond)
+}
+
+func TestAccessRequest_PolicyGrantExpires(t *testing.T) {
+	manager, _, account, _, _, _ := setupNetworkMapTest(t)
+	ctx := context.Background()
+	accountID := account.Id
+
+	const requesterID = "requester"
+	require.NoError(t, manager.Store.SaveUser(ctx, &types.User{Id: requesterID, AccountID: accountID, Role: types.UserRoleUser}))
+
+	policy, err := manager.SavePolicy(ctx, accountID, userID, &types.Policy{
+		Enabled: false,
+		Rules: []*types.PolicyRule{
+			{
+				Enabled:       true,
+				Sources:       []string{account.Policies[0].Rules[0].Sources[0]},
+				Destinations:  []string{account.Policies[0].Rules[0].Destinations[0]},
+				Bidirectional: true,
+				Action:        types.PolicyTrafficActionAccept,
+			},
+		},
+	}, true)
+	require.NoError(t, err)
+
+	request, err := manager.CreateAccessRequest(ctx, accountID, requesterID, &types.AccessRequest{
+		TargetType: types.AccessRequestTargetPolicy, TargetID: policy.ID, Justification: "maintenance", Duration: time.Second,
+	})
+	require.NoError(t, err)
+
+	_, err = manager.ApproveAccessRequest(ctx, accountID, userID, request.ID, "")
+	require.NoError(t, err)
+
+	grantAccount, err := manager.Store.GetAccount(ctx, accountID)
+	require.NoError(t, err)
+	require.Len(t, grantAccount.AccessGrants, 1, "approved grants are loaded with the account")
+	grantAccount.ApplyAccessGrants(time.Now())
+	idx := slices.IndexFunc(grantAccount.Policies, func(p *types.Policy) bool { return p.ID == policy.ID })
+	require.GreaterOrEqual(t, idx, 0)
+	assert.True(t, grantAccount.Policies[idx].Enabled, "the granted policy is in effect")
+
+	assert.Eventually(t, func() bool {
+		stored, err := manager.Store.GetAccessRequestByID(ctx, store.LockingStrengthNone, accountID, request.ID)
+		return err == nil && stored.Status == types.AccessRequestStatusExpired
+	}, 5*time.Second, 50*time.Millisecond, "the grant expires on its own")
+
+	_, err = manager.RevokeAccessRequest(ctx, accountID, requesterID, request.ID)
+	assertStatusType(t, err, status.PreconditionFailed)
+}
+
+func assertStatusType(t *testing.T, err error, expected status.Type) {
+	t.Helper()
+	sErr, ok := status.FromError(err)
+	require.True(t, ok, "expected a status error, got %v", err)
+	assert.Equal(t, expected, sErr.Type())
+}
+
+// waitForRemotePeer waits for an update that includes or excludes the remote peer
+func waitForRemotePeer(t *testing.T, updMsg <-chan *network_map.UpdateMessage, peerKey string, included bool) {
+	t.Helper()
+	timeout := time.After(peerUpdateTimeout)
+	for {
+		select {
+		case msg := <-updMsg:
+			require.NotNil(t, msg)
+			found := slices.ContainsFunc(msg.Update.GetNetworkMap().GetRemotePeers(), func(p *proto.RemotePeerConfig) bool {
+				return p.GetWgPubKey() == peerKey
+			})
+			if found == included {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("timeout waiting for an update with remote peer included=%t", included)
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/service"
+	"github.com/netbirdio/netbird/management/internals/modules/workloadidentity"
 	"github.com/netbirdio/netbird/management/server/job"
 	"github.com/netbirdio/netbird/shared/auth"
 
@@ -103,6 +104,12 @@ type DefaultAccountManager struct {
 
 	peerInactivityExpiry Scheduler
 
+	// policySchedules pushes network map updates when a scheduled policy becomes active or inactive
+	policySchedules Scheduler
+
+	// accessGrantExpiry expires just-in-time access grants and pushes the network map updates
+	accessGrantExpiry Scheduler
+
 	// userDeleteFromIDPEnabled allows to delete user from IDP when user is deleted from account
 	userDeleteFromIDPEnabled bool
 
@@ -112,6 +119,9 @@ type DefaultAccountManager struct {
 
 	permissionsManager permissions.Manager
 
+	// workloadIdentityVerifier verifies the tokens of peers enrolling with a workload identity provider
+	workloadIdentityVerifier *workloadidentity.Verifier
+
 	disableDefaultPolicy bool
 }
 
@@ -221,6 +231,8 @@ func BuildManager(
 		eventStore:               eventStore,
 		peerLoginExpiry:          NewDefaultScheduler(),
 		peerInactivityExpiry:     NewDefaultScheduler(),
+		policySchedules:          NewDefaultScheduler(),
+		accessGrantExpiry:        NewDefaultScheduler(),
 		userDeleteFromIDPEnabled: userDeleteFromIDPEnabled,
 		integratedPeerValidator:  integratedPeerValidator,
 		metrics:                  metrics,
@@ -229,6 +241,7 @@ func BuildManager(
 		settingsManager:          settingsManager,
 		permissionsManager:       permissionsManager,
 		disableDefaultPolicy:     disableDefaultPolicy,
+		workloadIdentityVerifier: workloadidentity.NewVerifier(),
 	}
 
 	am.networkMapController.StartWarmup(ctx)
@@ -253,6 +266,9 @@ func BuildManager(
 	am.externalCacheManager = nbcache.NewUserDataCache(sharedCacheStore)
 	am.cacheManager = nbcache.NewAccountUserDataCache(am.loadAccount, sharedCacheStore)
 
+	go am.schedulePolicySchedulesOnStartup(ctx)
+	go am.scheduleAccessGrantExpiryOnStartup(ctx)
+
 	if !isNil(am.idpManager) && !IsEmbeddedIdp(am.idpManager) {
 		go func() {
 			err := am.warmupIDPCache(ctx, sharedCacheStore)
@@ -381,6 +397,16 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 
 		if newSettings.Extra == nil {
 			newSettings.Extra = oldSettings.Extra
+		} else if oldSettings.Extra != nil {
+			// the integrated validator fields are managed separately and the rules are optional in requests
+			newSettings.Extra.IntegratedValidator = oldSettings.Extra.IntegratedValidator
+			newSettings.Extra.IntegratedValidatorGroups = oldSettings.Extra.IntegratedValidatorGroups
+			if newSettings.Extra.PeerApprovalRules == nil {
+				newSettings.Extra.PeerApprovalRules = oldSettings.Extra.PeerApprovalRules
+			}
+		}
+		if newSettings.AccessRequestApproverGroups == nil {
+			newSettings.AccessRequestApproverGroups = oldSettings.AccessRequestApproverGroups
 		}
 
 		if err = transaction.SaveAccountSettings(ctx, accountID, newSettings); err != nil {
@@ -412,6 +438,7 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 	am.handleAutoUpdateAlwaysSettings(ctx, oldSettings, newSettings, userID, accountID)
 	am.handlePeerExposeSettings(ctx, oldSettings, newSettings, userID, accountID)
 	am.handleMetricsPushSettings(ctx, oldSettings, newSettings, userID, accountID)
+	am.handlePeerApprovalSettings(ctx, oldSettings, newSettings, userID, accountID)
 	if err = am.handleInactivityExpirationSettings(ctx, oldSettings, newSettings, userID, accountID); err != nil {
 		return nil, err
 	}
@@ -518,6 +545,18 @@ func (am *DefaultAccountManager) validateSettingsUpdate(ctx context.Context, tra
 		return err
 	}
 
+	if len(newSettings.AccessRequestApproverGroups) > 0 {
+		groups, err := transaction.GetGroupsByIDs(ctx, store.LockingStrengthNone, accountID, newSettings.AccessRequestApproverGroups)
+		if err != nil {
+			return err
+		}
+		for _, groupID := range newSettings.AccessRequestApproverGroups {
+			if _, ok := groups[groupID]; !ok {
+				return status.Errorf(status.InvalidArgument, "access request approver group %s does not exist", groupID)
+			}
+		}
+	}
+
 	return am.integratedPeerValidator.ValidateExtraSettings(ctx, newSettings.Extra, oldSettings.Extra, userID, accountID)
 }
 
@@ -576,6 +615,18 @@ func (am *DefaultAccountManager) handleMetricsPushSettings(ctx context.Context,
 	}
 }
 
+func (am *DefaultAccountManager) handlePeerApprovalSettings(ctx context.Context, oldSettings, newSettings *types.Settings, userID, accountID string) {
+	oldEnabled := oldSettings.Extra != nil && oldSettings.Extra.PeerApprovalEnabled
+	newEnabled := newSettings.Extra != nil && newSettings.Extra.PeerApprovalEnabled
+	if oldEnabled != newEnabled {
+		if newEnabled {
+			am.StoreEvent(ctx, userID, accountID, accountID, activity.AccountPeerApprovalEnabled, nil)
+		} else {
+			am.StoreEvent(ctx, userID, accountID, accountID, activity.AccountPeerApprovalDisabled, nil)
+		}
+	}
+}
+
 func (am *DefaultAccountManager) handlePeerLoginExpirationSettings(ctx context.Context, oldSettings, newSettings *types.Settings, userID, accountID string) {
 	if oldSettings.PeerLoginExpirationEnabled != newSettings.PeerLoginExpirationEnabled {
 		event := activity.AccountPeerLoginExpirationEnabled
@@ -1623,6 +1674,10 @@ func (am *DefaultAccountManager) SyncUserJWTGroups(ctx context.Context, userAuth
 		return nil
 	}
 
+	if err = am.syncUserJWTRole(ctx, userAuth); err != nil {
+		return fmt.Errorf("error syncing JWT role: %w", err)
+	}
+
 	var addNewGroups []string
 	var removeOldGroups []string
 	var hasChanges bool
@@ -1764,6 +1819,62 @@ func (am *DefaultAccountManager) SyncUserJWTGroups(ctx context.Context, userAuth
 	return nil
 }
 
+// syncUserJWTRole assigns the first custom role, by name, mapped to one of the user's JWT groups. Only regular
+// users and users holding a custom role are affected, so built-in roles granted manually are never downgraded.
+// A user whose JWT-mapped custom role no longer matches falls back to the regular user role.
+func (am *DefaultAccountManager) syncUserJWTRole(ctx context.Context, userAuth auth.UserAuth) error {
+	var user *types.User
+	var changed bool
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		user, err = transaction.GetUserByUserID(ctx, store.LockingStrengthUpdate, userAuth.UserId)
+		if err != nil {
+			return fmt.Errorf("error getting user: %w", err)
+		}
+
+		builtIn := types.StrRoleToUserRole(string(user.Role)) != types.UserRoleUnknown
+		if user.IsServiceUser || (builtIn && user.Role != types.UserRoleUser) {
+			return nil
+		}
+
+		customRoles, err := transaction.GetAccountCustomRoles(ctx, store.LockingStrengthNone, userAuth.AccountId)
+		if err != nil {
+			return fmt.Errorf("error getting custom roles: %w", err)
+		}
+
+		oldRole := user.Role
+		newRole := oldRole
+		for _, role := range customRoles {
+			if role.ID == string(oldRole) && len(role.JWTGroups) > 0 {
+				newRole = types.UserRoleUser
+			}
+		}
+		for _, role := range customRoles {
+			if role.MatchesJWTGroups(userAuth.Groups) {
+				newRole = types.UserRole(role.ID)
+				break
+			}
+		}
+
+		if newRole == oldRole {
+			return nil
+		}
+
+		user.Role = newRole
+		changed = true
+		return transaction.SaveUser(ctx, user)
+	})
+	if err != nil {
+		return err
+	}
+
+	if changed {
+		am.StoreEvent(ctx, user.Id, user.Id, userAuth.AccountId, activity.UserRoleUpdated, map[string]any{"role": user.Role, "jwt_sync": true})
+	}
+
+	return nil
+}
+
 // getAccountIDWithAuthorizationClaims retrieves an account ID using JWT Claims.
 // if domain is not private or domain is invalid, it will return the account ID by user ID.
 // if domain is of the PrivateCategory category, it will evaluate
@@ -103,6 +103,21 @@ func (mr *MockManagerMockRecorder) AddPeer(ctx, accountID, setupKey, userID, p,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPeer", reflect.TypeOf((*MockManager)(nil).AddPeer), ctx, accountID, setupKey, userID, p, temporary)
 }
 
+// ApproveAccessRequest mocks base method.
+func (m *MockManager) ApproveAccessRequest(ctx context.Context, accountID, userID, requestID, comment string) (*types.AccessRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveAccessRequest", ctx, accountID, userID, requestID, comment)
+	ret0, _ := ret[0].(*types.AccessRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveAccessRequest indicates an expected call of ApproveAccessRequest.
+func (mr *MockManagerMockRecorder) ApproveAccessRequest(ctx, accountID, userID, requestID, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveAccessRequest", reflect.TypeOf((*MockManager)(nil).ApproveAccessRequest), ctx, accountID, userID, requestID, comment)
+}
+
 // ApproveUser mocks base method.
 func (m *MockManager) ApproveUser(ctx context.Context, accountID, initiatorUserID, targetUserID string) (*types.UserInfo, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +160,21 @@ func (mr *MockManagerMockRecorder) BuildUserInfosForAccount(ctx, accountID, init
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildUserInfosForAccount", reflect.TypeOf((*MockManager)(nil).BuildUserInfosForAccount), ctx, accountID, initiatorUserID, accountUsers)
 }
 
+// CreateAccessRequest mocks base method.
+func (m *MockManager) CreateAccessRequest(ctx context.Context, accountID, userID string, request *types.AccessRequest) (*types.AccessRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessRequest", ctx, accountID, userID, request)
+	ret0, _ := ret[0].(*types.AccessRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccessRequest indicates an expected call of CreateAccessRequest.
+func (mr *MockManagerMockRecorder) CreateAccessRequest(ctx, accountID, userID, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessRequest", reflect.TypeOf((*MockManager)(nil).CreateAccessRequest), ctx, accountID, userID, request)
+}
+
 // CreateGroup mocks base method.
 func (m *MockManager) CreateGroup(ctx context.Context, accountID, userID string, group *types.Group) error {
 	m.ctrl.T.Helper()
@@ -204,18 +234,18 @@ func (mr *MockManagerMockRecorder) CreateNameServerGroup(ctx, accountID, name, d
 }
 
 // CreatePAT mocks base method.
-func (m *MockManager) CreatePAT(ctx context.Context, accountID, initiatorUserID, targetUserID, tokenName string, expiresIn int) (*types.PersonalAccessTokenGenerated, error) {
+func (m *MockManager) CreatePAT(ctx context.Context, accountID, initiatorUserID, targetUserID, tokenName string, expiresIn int, scopes []types.PATScope) (*types.PersonalAccessTokenGenerated, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePAT", ctx, accountID, initiatorUserID, targetUserID, tokenName, expiresIn)
+	ret := m.ctrl.Call(m, "CreatePAT", ctx, accountID, initiatorUserID, targetUserID, tokenName, expiresIn, scopes)
 	ret0, _ := ret[0].(*types.PersonalAccessTokenGenerated)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreatePAT indicates an expected call of CreatePAT.
-func (mr *MockManagerMockRecorder) CreatePAT(ctx, accountID, initiatorUserID, targetUserID, tokenName, expiresIn any) *gomock.Call {
+func (mr *MockManagerMockRecorder) CreatePAT(ctx, accountID, initiatorUserID, targetUserID, tokenName, expiresIn, scopes any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePAT", reflect.TypeOf((*MockManager)(nil).CreatePAT), ctx, accountID, initiatorUserID, targetUserID, tokenName, expiresIn)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePAT", reflect.TypeOf((*MockManager)(nil).CreatePAT), ctx, accountID, initiatorUserID, targetUserID, tokenName, expiresIn, scopes)
 }
 
 // CreatePeerJob mocks base method.
@@ -233,18 +263,18 @@ func (mr *MockManagerMockRecorder) CreatePeerJob(ctx, accountID, peerID, userID,
 }
 
 // CreateRoute mocks base method.
-func (m *MockManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute, skipAutoApply bool) (*route.Route, error) {
+func (m *MockManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute, skipAutoApply bool, distributionLabels map[string]string) (*route.Route, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRoute", ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupIDs, enabled, userID, keepRoute, skipAutoApply)
+	ret := m.ctrl.Call(m, "CreateRoute", ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupIDs, enabled, userID, keepRoute, skipAutoApply, distributionLabels)
 	ret0, _ := ret[0].(*route.Route)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateRoute indicates an expected call of CreateRoute.
-func (mr *MockManagerMockRecorder) CreateRoute(ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupIDs, enabled, userID, keepRoute, skipAutoApply any) *gomock.Call {
+func (mr *MockManagerMockRecorder) CreateRoute(ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupIDs, enabled, userID, keepRoute, skipAutoApply, distributionLabels any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoute", reflect.TypeOf((*MockManager)(nil).CreateRoute), ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupIDs, enabled, userID, keepRoute, skipAutoApply)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoute", reflect.TypeOf((*MockManager)(nil).CreateRoute), ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupIDs, enabled, userID, keepRoute, skipAutoApply, distributionLabels)
 }
 
 // CreateSetupKey mocks base method.
@@ -488,6 +518,21 @@ func (mr *MockManagerMockRecorder) DeleteUserInvite(ctx, accountID, initiatorUse
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserInvite", reflect.TypeOf((*MockManager)(nil).DeleteUserInvite), ctx, accountID, initiatorUserID, inviteID)
 }
 
+// DenyAccessRequest mocks base method.
+func (m *MockManager) DenyAccessRequest(ctx context.Context, accountID, userID, requestID, comment string) (*types.AccessRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenyAccessRequest", ctx, accountID, userID, requestID, comment)
+	ret0, _ := ret[0].(*types.AccessRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenyAccessRequest indicates an expected call of DenyAccessRequest.
+func (mr *MockManagerMockRecorder) DenyAccessRequest(ctx, accountID, userID, requestID, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyAccessRequest", reflect.TypeOf((*MockManager)(nil).DenyAccessRequest), ctx, accountID, userID, requestID, comment)
+}
+
 // ExpandAndUpdateAffected mocks base method.
 func (m *MockManager) ExpandAndUpdateAffected(ctx context.Context, accountID string, snap *affectedpeers.Snapshot, change affectedpeers.Change) {
 	m.ctrl.T.Helper()
@@ -530,6 +575,36 @@ func (mr *MockManagerMockRecorder) FindExistingPostureCheck(accountID, checks an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExistingPostureCheck", reflect.TypeOf((*MockManager)(nil).FindExistingPostureCheck), accountID, checks)
 }
 
+// GetAccessRequest mocks base method.
+func (m *MockManager) GetAccessRequest(ctx context.Context, accountID, userID, requestID string) (*types.AccessRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessRequest", ctx, accountID, userID, requestID)
+	ret0, _ := ret[0].(*types.AccessRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessRequest indicates an expected call of GetAccessRequest.
+func (mr *MockManagerMockRecorder) GetAccessRequest(ctx, accountID, userID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessRequest", reflect.TypeOf((*MockManager)(nil).GetAccessRequest), ctx, accountID, userID, requestID)
+}
+
+// GetAccessRequests mocks base method.
+func (m *MockManager) GetAccessRequests(ctx context.Context, accountID, userID string) ([]*types.AccessRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessRequests", ctx, accountID, userID)
+	ret0, _ := ret[0].([]*types.AccessRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessRequests indicates an expected call of GetAccessRequests.
+func (mr *MockManagerMockRecorder) GetAccessRequests(ctx, accountID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessRequests", reflect.TypeOf((*MockManager)(nil).GetAccessRequests), ctx, accountID, userID)
+}
+
 // GetAccount mocks base method.
 func (m *MockManager) GetAccount(ctx context.Context, accountID string) (*types.Account, error) {
 	m.ctrl.T.Helper()
@@ -727,18 +802,18 @@ func (mr *MockManagerMockRecorder) GetDNSSettings(ctx, accountID, userID any) *g
 }
 
 // GetEvents mocks base method.
-func (m *MockManager) GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error) {
+func (m *MockManager) GetEvents(ctx context.Context, accountID, userID string, filter activity.Filter) ([]*activity.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEvents", ctx, accountID, userID)
+	ret := m.ctrl.Call(m, "GetEvents", ctx, accountID, userID, filter)
 	ret0, _ := ret[0].([]*activity.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEvents indicates an expected call of GetEvents.
-func (mr *MockManagerMockRecorder) GetEvents(ctx, accountID, userID any) *gomock.Call {
+func (mr *MockManagerMockRecorder) GetEvents(ctx, accountID, userID, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockManager)(nil).GetEvents), ctx, accountID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockManager)(nil).GetEvents), ctx, accountID, userID, filter)
 }
 
 // GetExternalCacheManager mocks base method.
@@ -1411,6 +1486,21 @@ func (mr *MockManagerMockRecorder) RejectUser(ctx, accountID, initiatorUserID, t
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectUser", reflect.TypeOf((*MockManager)(nil).RejectUser), ctx, accountID, initiatorUserID, targetUserID)
 }
 
+// RevokeAccessRequest mocks base method.
+func (m *MockManager) RevokeAccessRequest(ctx context.Context, accountID, userID, requestID string) (*types.AccessRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccessRequest", ctx, accountID, userID, requestID)
+	ret0, _ := ret[0].(*types.AccessRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccessRequest indicates an expected call of RevokeAccessRequest.
+func (mr *MockManagerMockRecorder) RevokeAccessRequest(ctx, accountID, userID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccessRequest", reflect.TypeOf((*MockManager)(nil).RevokeAccessRequest), ctx, accountID, userID, requestID)
+}
+
 // SaveDNSSettings mocks base method.
 func (m *MockManager) SaveDNSSettings(ctx context.Context, accountID, userID string, dnsSettingsToSave *types.DNSSettings) error {
 	m.ctrl.T.Helper()
@@ -1555,6 +1645,21 @@ func (mr *MockManagerMockRecorder) SetServiceManager(serviceManager any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServiceManager", reflect.TypeOf((*MockManager)(nil).SetServiceManager), serviceManager)
 }
 
+// SimulateAccess mocks base method.
+func (m *MockManager) SimulateAccess(ctx context.Context, accountID, userID string, req *types.AccessSimulationRequest) ([]*types.AccessSimulationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateAccess", ctx, accountID, userID, req)
+	ret0, _ := ret[0].([]*types.AccessSimulationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateAccess indicates an expected call of SimulateAccess.
+func (mr *MockManagerMockRecorder) SimulateAccess(ctx, accountID, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateAccess", reflect.TypeOf((*MockManager)(nil).SimulateAccess), ctx, accountID, userID, req)
+}
+
 // StoreEvent mocks base method.
 func (m *MockManager) StoreEvent(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any) {
 	m.ctrl.T.Helper()
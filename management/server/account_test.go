@@ -30,6 +30,7 @@ import (
 	"github.com/netbirdio/netbird/management/internals/controllers/network_map"
 	"github.com/netbirdio/netbird/management/internals/controllers/network_map/controller"
 	"github.com/netbirdio/netbird/management/internals/controllers/network_map/update_channel"
+	"github.com/netbirdio/netbird/management/internals/modules/customroles"
 	"github.com/netbirdio/netbird/management/internals/modules/peers"
 	ephemeral_manager "github.com/netbirdio/netbird/management/internals/modules/peers/ephemeral/manager"
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/proxy"
@@ -51,6 +52,9 @@ import (
 	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
 	"github.com/netbirdio/netbird/management/server/posture"
 	"github.com/netbirdio/netbird/management/server/settings"
 	"github.com/netbirdio/netbird/management/server/store"
@@ -748,6 +752,39 @@ func TestDefaultAccountManager_SyncUserJWTGroups(t *testing.T) {
 	})
 }
 
+func TestDefaultAccountManager_SyncUserJWTRole(t *testing.T) {
+	ctx := context.Background()
+	manager, _, err := createManager(t)
+	require.NoError(t, err, "unable to create account manager")
+
+	ownerID := "owner-id"
+	accountID, err := manager.GetAccountIDByUserID(ctx, auth.UserAuth{UserId: ownerID, Domain: "test.domain"})
+	require.NoError(t, err, "create init user failed")
+
+	account, err := manager.Store.GetAccount(ctx, accountID)
+	require.NoError(t, err)
+	account.Settings.JWTGroupsEnabled = true
+	account.Settings.JWTGroupsClaimName = "idp-groups"
+	account.Users["member-id"] = types.NewRegularUser("member-id", "", "")
+	account.Users["member-id"].AccountID = accountID
+	require.NoError(t, manager.Store.SaveAccount(ctx, account))
+
+	role := customroles.NewRole(accountID, "Operators", "", roles.Permissions{modules.Routes: {operations.Read: true}}, []string{"ops"})
+	require.NoError(t, manager.Store.CreateCustomRole(ctx, role))
+
+	sync := func(userID string, groups ...string) types.UserRole {
+		t.Helper()
+		require.NoError(t, manager.SyncUserJWTGroups(ctx, auth.UserAuth{AccountId: accountID, UserId: userID, Groups: groups}))
+		user, err := manager.Store.GetUserByUserID(ctx, store.LockingStrengthNone, userID)
+		require.NoError(t, err)
+		return user.Role
+	}
+
+	assert.Equal(t, types.UserRole(role.ID), sync("member-id", "ops"), "mapped JWT group assigns the custom role")
+	assert.Equal(t, types.UserRoleUser, sync("member-id", "dev"), "unmapped JWT groups revert the custom role")
+	assert.Equal(t, types.UserRoleOwner, sync(ownerID, "ops"), "owners are never remapped")
+}
+
 func TestAccountManager_PrivateAccount(t *testing.T) {
 	manager, _, err := createManager(t)
 	if err != nil {
@@ -1546,7 +1583,7 @@ func getEvent(t *testing.T, accountID string, manager nbAccount.Manager, eventTy
 		case <-time.After(time.Second):
 			t.Fatal("no PeerAddedWithSetupKey event was generated")
 		default:
-			events, err := manager.GetEvents(context.Background(), accountID, userID)
+			events, err := manager.GetEvents(context.Background(), accountID, userID, activity.Filter{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1757,6 +1794,16 @@ func TestAccount_Copy(t *testing.T) {
 				AccountID: "account1",
 			},
 		},
+		AccessGrants: []*types.AccessRequest{
+			{
+				ID:         "grant1",
+				AccountID:  "account1",
+				UserID:     "user1",
+				TargetType: types.AccessRequestTargetGroup,
+				TargetID:   "group1",
+				Status:     types.AccessRequestStatusApproved,
+			},
+		},
 		PostureValidation: map[string]map[string]bool{"1": {"1": true}},
 	}
 	err := hasNilField(account)
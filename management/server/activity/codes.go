@@ -284,6 +284,67 @@ const (
 	// AgentNetworkSettingsDeleted indicates that a user deleted the Agent Network account settings, releasing the endpoint
 	AgentNetworkSettingsDeleted Activity = 142
 
+	// ServicePasskeyRegistered indicates that a service user registered a passkey on the proxy login page
+	ServicePasskeyRegistered Activity = 143
+	// ServiceSessionRevoked indicates that a user revoked a proxy session of a service
+	ServiceSessionRevoked Activity = 144
+	// ServiceSessionsRevoked indicates that a user revoked all proxy sessions of a service
+	ServiceSessionsRevoked Activity = 145
+
+	// EventStreamingIntegrationCreated indicates that a user created an event streaming integration
+	EventStreamingIntegrationCreated Activity = 146
+	// EventStreamingIntegrationUpdated indicates that a user updated an event streaming integration
+	EventStreamingIntegrationUpdated Activity = 147
+	// EventStreamingIntegrationDeleted indicates that a user deleted an event streaming integration
+	EventStreamingIntegrationDeleted Activity = 148
+
+	// PersonalAccessTokenScopeDenied indicates that a request made with a personal access token was denied by the token scopes
+	PersonalAccessTokenScopeDenied Activity = 149
+
+	// CustomRoleCreated indicates that a user created a custom role
+	CustomRoleCreated Activity = 150
+	// CustomRoleUpdated indicates that a user updated a custom role
+	CustomRoleUpdated Activity = 151
+	// CustomRoleDeleted indicates that a user deleted a custom role
+	CustomRoleDeleted Activity = 152
+
+	// SCIMIntegrationCreated indicates that a user created a SCIM provisioning integration
+	SCIMIntegrationCreated Activity = 153
+	// SCIMIntegrationUpdated indicates that a user updated a SCIM provisioning integration
+	SCIMIntegrationUpdated Activity = 154
+	// SCIMIntegrationDeleted indicates that a user deleted a SCIM provisioning integration
+	SCIMIntegrationDeleted Activity = 155
+	// SCIMIntegrationTokenRegenerated indicates that a user regenerated the token of a SCIM provisioning integration
+	SCIMIntegrationTokenRegenerated Activity = 156
+
+	// PeerRejected indicates that a user rejected a peer pending approval
+	PeerRejected Activity = 157
+
+	// AccessRequestCreated indicates that a user requested just-in-time access to a group or a policy
+	AccessRequestCreated Activity = 158
+	// AccessRequestApproved indicates that a user approved a just-in-time access request
+	AccessRequestApproved Activity = 159
+	// AccessRequestDenied indicates that a user denied a just-in-time access request
+	AccessRequestDenied Activity = 160
+	// AccessRequestRevoked indicates that a user withdrew an access request or ended a grant early
+	AccessRequestRevoked Activity = 161
+	// AccessRequestExpired indicates that a just-in-time access grant expired
+	AccessRequestExpired Activity = 162
+	// PeerLabelsUpdated indicates that a user updated the labels of a peer
+	PeerLabelsUpdated Activity = 163
+	// SetupKeyEnrollmentRejected indicates that a peer was not allowed to enroll because of the setup key restrictions
+	SetupKeyEnrollmentRejected Activity = 164
+	// WorkloadIdentityProviderCreated indicates that a user created a workload identity provider
+	WorkloadIdentityProviderCreated Activity = 165
+	// WorkloadIdentityProviderUpdated indicates that a user updated a workload identity provider
+	WorkloadIdentityProviderUpdated Activity = 166
+	// WorkloadIdentityProviderDeleted indicates that a user deleted a workload identity provider
+	WorkloadIdentityProviderDeleted Activity = 167
+	// PeerAddedWithWorkloadIdentity indicates that a new peer joined with a workload identity token
+	PeerAddedWithWorkloadIdentity Activity = 168
+	// WorkloadIdentityEnrollmentRejected indicates that a peer was not allowed to enroll with a workload identity token
+	WorkloadIdentityEnrollmentRejected Activity = 169
+
 	AccountDeleted Activity = 99999
 )
 
@@ -431,6 +492,43 @@ var activityMap = map[Activity]Code{
 	PeerServiceUnexposed:     {"Peer unexposed service", "service.peer.unexpose"},
 	PeerServiceExposeExpired: {"Peer exposed service expired", "service.peer.expose.expire"},
 
+	ServicePasskeyRegistered: {"Service passkey registered", "service.passkey.register"},
+	ServiceSessionRevoked:    {"Service session revoked", "service.session.revoke"},
+	ServiceSessionsRevoked:   {"Service sessions revoked", "service.session.revoke.all"},
+
+	EventStreamingIntegrationCreated: {"Event streaming integration created", "integration.event_streaming.create"},
+	EventStreamingIntegrationUpdated: {"Event streaming integration updated", "integration.event_streaming.update"},
+	EventStreamingIntegrationDeleted: {"Event streaming integration deleted", "integration.event_streaming.delete"},
+
+	PersonalAccessTokenScopeDenied: {"Personal access token denied by scope", "personal.access.token.scope.deny"},
+
+	CustomRoleCreated: {"Custom role created", "role.custom.create"},
+	CustomRoleUpdated: {"Custom role updated", "role.custom.update"},
+	CustomRoleDeleted: {"Custom role deleted", "role.custom.delete"},
+
+	SCIMIntegrationCreated:          {"SCIM integration created", "integration.scim.create"},
+	SCIMIntegrationUpdated:          {"SCIM integration updated", "integration.scim.update"},
+	SCIMIntegrationDeleted:          {"SCIM integration deleted", "integration.scim.delete"},
+	SCIMIntegrationTokenRegenerated: {"SCIM integration token regenerated", "integration.scim.token.regenerate"},
+
+	PeerRejected: {"Peer rejected", "peer.reject"},
+
+	AccessRequestCreated:  {"Access request created", "access.request.create"},
+	AccessRequestApproved: {"Access request approved", "access.request.approve"},
+	AccessRequestDenied:   {"Access request denied", "access.request.deny"},
+	AccessRequestRevoked:  {"Access request revoked", "access.request.revoke"},
+	AccessRequestExpired:  {"Access grant expired", "access.request.expire"},
+
+	PeerLabelsUpdated: {"Peer labels updated", "peer.labels.update"},
+
+	SetupKeyEnrollmentRejected: {"Peer enrollment with setup key rejected", "setupkey.peer.reject"},
+
+	WorkloadIdentityProviderCreated:    {"Workload identity provider created", "workload.identity.provider.create"},
+	WorkloadIdentityProviderUpdated:    {"Workload identity provider updated", "workload.identity.provider.update"},
+	WorkloadIdentityProviderDeleted:    {"Workload identity provider deleted", "workload.identity.provider.delete"},
+	PeerAddedWithWorkloadIdentity:      {"Peer added with workload identity", "workload.identity.peer.add"},
+	WorkloadIdentityEnrollmentRejected: {"Peer enrollment with workload identity rejected", "workload.identity.peer.reject"},
+
 	AccountPeerExposeEnabled:  {"Account peer expose enabled", "account.setting.peer.expose.enable"},
 	AccountPeerExposeDisabled: {"Account peer expose disabled", "account.setting.peer.expose.disable"},
 
@@ -486,3 +584,14 @@ func (a Activity) Message() string {
 func RegisterActivityMap(codes map[Activity]Code) {
 	maps.Copy(activityMap, codes)
 }
+
+// ActivitiesByStringCode returns the activities registered with the given string code
+func ActivitiesByStringCode(code string) []Activity {
+	var activities []Activity
+	for a, c := range activityMap {
+		if c.Code == code {
+			activities = append(activities, a)
+		}
+	}
+	return activities
+}
@@ -0,0 +1,48 @@
+package activity
+
+import (
+	"slices"
+	"time"
+)
+
+// Filter narrows down the events returned by Store.GetFiltered. Zero values match all events.
+type Filter struct {
+	// Activities matches events with any of the given activity codes
+	Activities []Activity
+	// InitiatorID matches events initiated by the given object (e.g., a user)
+	InitiatorID string
+	// TargetID matches events that affected the given object (e.g., a peer)
+	TargetID string
+	// From matches events with a timestamp at or after the given time
+	From *time.Time
+	// To matches events with a timestamp at or before the given time
+	To *time.Time
+	// Cursor matches events with an ID lower than the cursor. Events are returned newest first,
+	// so the ID of the last event of a page is the cursor of the next page.
+	Cursor uint64
+	// Limit is the maximum number of events returned
+	Limit int
+}
+
+// Matches returns true if the event passes all conditions of the filter except the limit
+func (f *Filter) Matches(event *Event) bool {
+	if len(f.Activities) > 0 && !slices.Contains(f.Activities, event.Activity) {
+		return false
+	}
+	if f.InitiatorID != "" && event.InitiatorID != f.InitiatorID {
+		return false
+	}
+	if f.TargetID != "" && event.TargetID != f.TargetID {
+		return false
+	}
+	if f.From != nil && event.Timestamp.Before(*f.From) {
+		return false
+	}
+	if f.To != nil && event.Timestamp.After(*f.To) {
+		return false
+	}
+	if f.Cursor != 0 && event.ID >= f.Cursor {
+		return false
+	}
+	return true
+}
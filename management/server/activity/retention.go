@@ -0,0 +1,54 @@
+package activity
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultRetentionCleanupInterval = 24 * time.Hour
+
+// StartRetentionCleanup starts a background goroutine that deletes events older than retentionDays,
+// once on start and then every interval, until the context is canceled.
+// A retentionDays of 0 or less keeps events indefinitely and no routine is started.
+func StartRetentionCleanup(ctx context.Context, store Store, retentionDays int, interval time.Duration) {
+	if retentionDays <= 0 {
+		log.WithContext(ctx).Debug("activity event retention disabled, events are kept indefinitely")
+		return
+	}
+
+	if interval <= 0 {
+		interval = defaultRetentionCleanupInterval
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		log.WithContext(ctx).Infof("starting activity event cleanup routine (retention: %d days, interval: %s)", retentionDays, interval)
+		cleanupOldEvents(ctx, store, retentionDays)
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.WithContext(ctx).Info("stopping activity event cleanup routine")
+				return
+			case <-ticker.C:
+				cleanupOldEvents(ctx, store, retentionDays)
+			}
+		}
+	}()
+}
+
+func cleanupOldEvents(ctx context.Context, store Store, retentionDays int) {
+	deleted, err := store.DeleteOlderThan(ctx, time.Now().AddDate(0, 0, -retentionDays))
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to cleanup old activity events: %v", err)
+		return
+	}
+
+	if deleted > 0 {
+		log.WithContext(ctx).Infof("cleaned up %d activity events older than %d days", deleted, retentionDays)
+	}
+}
@@ -3,6 +3,7 @@ package activity
 import (
 	"context"
 	"sync"
+	"time"
 )
 
 // Store provides an interface to store or stream events.
@@ -11,6 +12,14 @@ type Store interface {
 	Save(ctx context.Context, event *Event) (*Event, error)
 	// Get returns "limit" number of events from the "offset" index ordered descending or ascending by a timestamp
 	Get(ctx context.Context, accountID string, offset, limit int, descending bool) ([]*Event, error)
+	// GetAfter returns up to "limit" events of the account with an ID greater than afterID ordered ascending by ID
+	GetAfter(ctx context.Context, accountID string, afterID uint64, limit int) ([]*Event, error)
+	// GetLastID returns the ID of the most recent event of the account or 0 if it has none
+	GetLastID(ctx context.Context, accountID string) (uint64, error)
+	// GetFiltered returns the events of the account matching the filter ordered descending by ID
+	GetFiltered(ctx context.Context, accountID string, filter Filter) ([]*Event, error)
+	// DeleteOlderThan deletes the events of all accounts with a timestamp before the given time and returns their count
+	DeleteOlderThan(ctx context.Context, before time.Time) (int64, error)
 	// Close the sink flushing events if necessary
 	Close(ctx context.Context) error
 }
@@ -22,15 +31,15 @@ type InMemoryEventStore struct {
 	events []*Event
 }
 
-// Save sets the Event.ID to 1
+// Save assigns the next sequential Event.ID starting at 1
 func (store *InMemoryEventStore) Save(_ context.Context, event *Event) (*Event, error) {
 	store.mu.Lock()
 	defer store.mu.Unlock()
 	if store.events == nil {
 		store.events = make([]*Event, 0)
 	}
-	event.ID = store.nextID
 	store.nextID++
+	event.ID = store.nextID
 	store.events = append(store.events, event)
 	return event, nil
 }
@@ -48,6 +57,66 @@ func (store *InMemoryEventStore) Get(_ context.Context, accountID string, offset
 	return events, nil
 }
 
+// GetAfter returns up to "limit" events of the account with an ID greater than afterID in insertion order
+func (store *InMemoryEventStore) GetAfter(_ context.Context, accountID string, afterID uint64, limit int) ([]*Event, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	events := make([]*Event, 0)
+	for _, event := range store.events {
+		if len(events) >= limit {
+			break
+		}
+		if event.AccountID == accountID && event.ID > afterID {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// GetLastID returns the ID of the most recent event of the account
+func (store *InMemoryEventStore) GetLastID(_ context.Context, accountID string) (uint64, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	for i := len(store.events) - 1; i >= 0; i-- {
+		if store.events[i].AccountID == accountID {
+			return store.events[i].ID, nil
+		}
+	}
+	return 0, nil
+}
+
+// GetFiltered returns up to filter.Limit events of the account matching the filter, newest first
+func (store *InMemoryEventStore) GetFiltered(_ context.Context, accountID string, filter Filter) ([]*Event, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	events := make([]*Event, 0)
+	for i := len(store.events) - 1; i >= 0; i-- {
+		if filter.Limit > 0 && len(events) >= filter.Limit {
+			break
+		}
+		event := store.events[i]
+		if event.AccountID == accountID && filter.Matches(event) {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// DeleteOlderThan removes the events with a timestamp before the given time
+func (store *InMemoryEventStore) DeleteOlderThan(_ context.Context, before time.Time) (int64, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	kept := make([]*Event, 0, len(store.events))
+	for _, event := range store.events {
+		if !event.Timestamp.Before(before) {
+			kept = append(kept, event)
+		}
+	}
+	deleted := int64(len(store.events) - len(kept))
+	store.events = kept
+	return deleted, nil
+}
+
 // Close cleans up the event list
 func (store *InMemoryEventStore) Close(_ context.Context) error {
 	store.mu.Lock()
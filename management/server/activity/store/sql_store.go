@@ -51,13 +51,24 @@ type Store struct {
 
 // NewSqlStore creates a new Store with an event table if not exists.
 func NewSqlStore(ctx context.Context, dataDir string, encryptionKey string) (*Store, error) {
+	var storeEngine = types.SqliteStoreEngine
+	if engine, ok := os.LookupEnv(storeEngineEnv); ok {
+		storeEngine = types.Engine(engine)
+	}
+
+	return NewSqlStoreWithEngine(ctx, storeEngine, dataDir, "", encryptionKey)
+}
+
+// NewSqlStoreWithEngine creates a new Store on the given engine. For SQLite dsn is the database file, relative
+// paths are resolved against dataDir. An empty dsn falls back to the environment variables NewSqlStore uses.
+func NewSqlStoreWithEngine(ctx context.Context, storeEngine types.Engine, dataDir, dsn, encryptionKey string) (*Store, error) {
 	fieldEncrypt, err := crypt.NewFieldEncrypt(encryptionKey)
 	if err != nil {
 
 		return nil, err
 	}
 
-	db, err := initDatabase(ctx, dataDir)
+	db, err := initDatabase(ctx, storeEngine, dataDir, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("initialize database: %w", err)
 	}
@@ -66,7 +77,7 @@ func NewSqlStore(ctx context.Context, dataDir string, encryptionKey string) (*St
 		return nil, fmt.Errorf("events database migration: %w", err)
 	}
 
-	err = db.AutoMigrate(&activity.Event{}, &activity.DeletedUser{})
+	err = db.AutoMigrate(Models()...)
 	if err != nil {
 		return nil, fmt.Errorf("events auto migrate: %w", err)
 	}
@@ -139,16 +150,7 @@ func (store *Store) processResult(ctx context.Context, events []*eventWithNames)
 
 // Get returns "limit" number of events from index ordered descending or ascending by a timestamp
 func (store *Store) Get(ctx context.Context, accountID string, offset, limit int, descending bool) ([]*activity.Event, error) {
-	baseQuery := store.db.Model(&activity.Event{}).
-		Select(`
-      events.*,
-      u.name  AS initiator_name,
-      u.email AS initiator_email,
-      t.name  AS target_name,
-      t.email AS target_email
-    `).
-		Joins(`LEFT JOIN deleted_users u ON u.id = events.initiator_id`).
-		Joins(`LEFT JOIN deleted_users t ON t.id = events.target_id`)
+	baseQuery := store.eventsWithNamesQuery()
 
 	orderDir := "DESC"
 	if !descending {
@@ -165,6 +167,95 @@ func (store *Store) Get(ctx context.Context, accountID string, offset, limit int
 	return store.processResult(ctx, events)
 }
 
+// GetAfter returns up to "limit" events of the account with an ID greater than afterID ordered ascending by ID.
+// It is used to stream the activity log with a cursor.
+func (store *Store) GetAfter(ctx context.Context, accountID string, afterID uint64, limit int) ([]*activity.Event, error) {
+	var events []*eventWithNames
+	err := store.eventsWithNamesQuery().
+		Where("events.account_id = ? AND events.id > ?", accountID, afterID).
+		Order("events.id ASC").Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return store.processResult(ctx, events)
+}
+
+// GetLastID returns the ID of the most recent event of the account or 0 if it has none
+func (store *Store) GetLastID(_ context.Context, accountID string) (uint64, error) {
+	var lastID *uint64
+	err := store.db.Model(&activity.Event{}).
+		Where("account_id = ?", accountID).
+		Select("MAX(id)").
+		Scan(&lastID).Error
+	if err != nil {
+		return 0, err
+	}
+	if lastID == nil {
+		return 0, nil
+	}
+
+	return *lastID, nil
+}
+
+// GetFiltered returns up to filter.Limit events of the account matching the filter ordered descending by ID
+func (store *Store) GetFiltered(ctx context.Context, accountID string, filter activity.Filter) ([]*activity.Event, error) {
+	query := store.eventsWithNamesQuery().Where("events.account_id = ?", accountID)
+
+	if len(filter.Activities) > 0 {
+		query = query.Where("events.activity IN ?", filter.Activities)
+	}
+	if filter.InitiatorID != "" {
+		query = query.Where("events.initiator_id = ?", filter.InitiatorID)
+	}
+	if filter.TargetID != "" {
+		query = query.Where("events.target_id = ?", filter.TargetID)
+	}
+	if filter.From != nil {
+		query = query.Where("events.timestamp >= ?", filter.From.UTC())
+	}
+	if filter.To != nil {
+		query = query.Where("events.timestamp <= ?", filter.To.UTC())
+	}
+	if filter.Cursor != 0 {
+		query = query.Where("events.id < ?", filter.Cursor)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var events []*eventWithNames
+	if err := query.Order("events.id DESC").Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return store.processResult(ctx, events)
+}
+
+// DeleteOlderThan deletes the events of all accounts with a timestamp before the given time
+func (store *Store) DeleteOlderThan(_ context.Context, before time.Time) (int64, error) {
+	result := store.db.Where("timestamp < ?", before.UTC()).Delete(&activity.Event{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (store *Store) eventsWithNamesQuery() *gorm.DB {
+	return store.db.Model(&activity.Event{}).
+		Select(`
+      events.*,
+      u.name  AS initiator_name,
+      u.email AS initiator_email,
+      t.name  AS target_name,
+      t.email AS target_email
+    `).
+		Joins(`LEFT JOIN deleted_users u ON u.id = events.initiator_id`).
+		Joins(`LEFT JOIN deleted_users t ON t.id = events.target_id`)
+}
+
 // Save an event in the SQLite events table end encrypt the "email" element in meta map
 func (store *Store) Save(_ context.Context, event *activity.Event) (*activity.Event, error) {
 	eventCopy := event.Copy()
@@ -227,6 +318,16 @@ func (store *Store) saveDeletedUserEmailAndNameInEncrypted(event *activity.Event
 	return event.Meta, nil
 }
 
+// Models returns every model whose table is managed by the event store.
+func Models() []any {
+	return []any{&activity.Event{}, &activity.DeletedUser{}}
+}
+
+// GetDB returns the underlying database connection.
+func (store *Store) GetDB() *gorm.DB {
+	return store.db
+}
+
 // Close the Store
 func (store *Store) Close(_ context.Context) error {
 	if store.db != nil {
@@ -239,19 +340,17 @@ func (store *Store) Close(_ context.Context) error {
 	return nil
 }
 
-func initDatabase(ctx context.Context, dataDir string) (*gorm.DB, error) {
+func initDatabase(ctx context.Context, storeEngine types.Engine, dataDir, dsn string) (*gorm.DB, error) {
 	var dialector gorm.Dialector
-	var storeEngine = types.SqliteStoreEngine
-
-	if engine, ok := os.LookupEnv(storeEngineEnv); ok {
-		storeEngine = types.Engine(engine)
-	}
 
 	switch storeEngine {
 	case types.SqliteStoreEngine:
-		dbFile := eventSinkDB
-		if envFile, ok := os.LookupEnv("NB_ACTIVITY_EVENT_SQLITE_FILE"); ok && envFile != "" {
-			dbFile = envFile
+		dbFile := dsn
+		if dbFile == "" {
+			dbFile = eventSinkDB
+			if envFile, ok := os.LookupEnv("NB_ACTIVITY_EVENT_SQLITE_FILE"); ok && envFile != "" {
+				dbFile = envFile
+			}
 		}
 		connStr := dbFile
 		if !filepath.IsAbs(dbFile) {
@@ -259,9 +358,11 @@ func initDatabase(ctx context.Context, dataDir string) (*gorm.DB, error) {
 		}
 		dialector = sqlite.Open(connStr)
 	case types.PostgresStoreEngine:
-		dsn, ok := os.LookupEnv(postgresDsnEnv)
-		if !ok {
-			return nil, fmt.Errorf("%s environment variable not set", postgresDsnEnv)
+		if dsn == "" {
+			var ok bool
+			if dsn, ok = os.LookupEnv(postgresDsnEnv); !ok {
+				return nil, fmt.Errorf("%s environment variable not set", postgresDsnEnv)
+			}
 		}
 		dialector = postgres.Open(dsn)
 	default:
@@ -56,3 +56,107 @@ func TestNewSqlStore(t *testing.T) {
 	assert.Len(t, result, 5)
 	assert.True(t, result[0].Timestamp.After(result[len(result)-1].Timestamp))
 }
+
+func TestSqlStore_GetAfter(t *testing.T) {
+	key, _ := crypt.GenerateKey()
+	store, err := NewSqlStore(context.Background(), t.TempDir(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close(context.Background()) //nolint
+
+	lastID, err := store.GetLastID(context.Background(), "account_1")
+	assert.NoError(t, err)
+	assert.Zero(t, lastID)
+
+	for i := 0; i < 6; i++ {
+		accountID := "account_1"
+		if i%2 == 1 {
+			accountID = "account_2"
+		}
+		_, err = store.Save(context.Background(), &activity.Event{
+			Timestamp:   time.Now().UTC(),
+			Activity:    activity.PeerAddedByUser,
+			InitiatorID: "user_" + fmt.Sprint(i),
+			AccountID:   accountID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := store.GetAfter(context.Background(), "account_1", 0, 2)
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Less(t, result[0].ID, result[1].ID)
+
+	result, err = store.GetAfter(context.Background(), "account_1", result[1].ID, 10)
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "user_4", result[0].InitiatorID)
+
+	lastID, err = store.GetLastID(context.Background(), "account_1")
+	assert.NoError(t, err)
+	assert.Equal(t, result[0].ID, lastID)
+}
+
+func TestSqlStore_GetFiltered(t *testing.T) {
+	key, _ := crypt.GenerateKey()
+	store, err := NewSqlStore(context.Background(), t.TempDir(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close(context.Background()) //nolint
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 10; i++ {
+		code := activity.PolicyUpdated
+		if i%2 == 1 {
+			code = activity.PeerAddedByUser
+		}
+		_, err = store.Save(context.Background(), &activity.Event{
+			Timestamp:   start.Add(time.Duration(i) * 24 * time.Hour),
+			Activity:    code,
+			InitiatorID: "user_" + fmt.Sprint(i%3),
+			TargetID:    "target_" + fmt.Sprint(i%2),
+			AccountID:   "account_1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := store.GetFiltered(context.Background(), "account_1", activity.Filter{Activities: []activity.Activity{activity.PolicyUpdated}})
+	assert.NoError(t, err)
+	assert.Len(t, result, 5)
+	assert.Greater(t, result[0].ID, result[1].ID, "newest first")
+
+	result, err = store.GetFiltered(context.Background(), "account_1", activity.Filter{InitiatorID: "user_0", TargetID: "target_1"})
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+
+	from, to := start.Add(2*24*time.Hour), start.Add(5*24*time.Hour)
+	result, err = store.GetFiltered(context.Background(), "account_1", activity.Filter{From: &from, To: &to})
+	assert.NoError(t, err)
+	assert.Len(t, result, 4)
+
+	page, err := store.GetFiltered(context.Background(), "account_1", activity.Filter{Limit: 4})
+	assert.NoError(t, err)
+	assert.Len(t, page, 4)
+	next, err := store.GetFiltered(context.Background(), "account_1", activity.Filter{Limit: 4, Cursor: page[3].ID})
+	assert.NoError(t, err)
+	assert.Len(t, next, 4)
+	assert.Less(t, next[0].ID, page[3].ID)
+
+	result, err = store.GetFiltered(context.Background(), "account_2", activity.Filter{})
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+
+	deleted, err := store.DeleteOlderThan(context.Background(), start.Add(3*24*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	result, err = store.GetFiltered(context.Background(), "account_1", activity.Filter{})
+	assert.NoError(t, err)
+	assert.Len(t, result, 7)
+}
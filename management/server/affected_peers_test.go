@@ -237,6 +237,7 @@ func TestCollectGroupChange_RouteLinked(t *testing.T) {
 		userID,
 		false,
 		false,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -274,6 +275,7 @@ func TestCollectGroupChange_RouteWithDirectPeer(t *testing.T) {
 		userID,
 		false,
 		false,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -415,6 +417,7 @@ func TestCollectGroupChange_MultipleEntities(t *testing.T) {
 		userID,
 		false,
 		false,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -538,6 +541,7 @@ func TestResolveAffectedPeers_RoutePeerGroups(t *testing.T) {
 		userID,
 		false,
 		false,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -572,6 +576,7 @@ func TestResolveAffectedPeers_RouteWithDirectPeer(t *testing.T) {
 		userID,
 		false,
 		false,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -599,6 +604,7 @@ func TestResolveAffectedPeers_RouteWithAccessControlGroups(t *testing.T) {
 		userID,
 		false,
 		false,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -781,6 +787,7 @@ func TestResolveAffectedPeers_SharedGroupAcrossPolicyAndRoute(t *testing.T) {
 		userID,
 		false,
 		false,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -940,6 +947,7 @@ func TestAffectedPeers_IsolatedRouteAndPolicy(t *testing.T) {
 		userID,
 		false,
 		false,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -1154,6 +1162,7 @@ func TestAffectedPeers_RouteChange_UnrelatedPeerNoUpdate(t *testing.T) {
 			userID,
 			false,
 			false,
+			nil,
 		)
 		assert.NoError(t, err)
 
@@ -1471,6 +1480,7 @@ func TestAffectedPeers_IsolatedEntitiesOnlyAffectTheirPeers(t *testing.T) {
 		userID,
 		false,
 		false,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -1549,6 +1559,7 @@ func TestAffectedPeers_DeleteRoute_UnrelatedPeerNoUpdate(t *testing.T) {
 		userID,
 		false,
 		false,
+		nil,
 	)
 	require.NoError(t, err)
 
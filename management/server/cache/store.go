@@ -2,9 +2,11 @@ package cache
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/eko/gocache/lib/v4/store"
@@ -31,6 +33,23 @@ const (
 	DefaultStoreMaxConn = 1000
 )
 
+// SetIfAbsenter is implemented by the stores returned by NewStore.
+// SetIfAbsent stores value under key only if the key does not exist yet and
+// reports whether it was stored. The check and the write are atomic, also
+// across management instances sharing a redis store.
+type SetIfAbsenter interface {
+	SetIfAbsent(ctx context.Context, key string, value any, ttl time.Duration) (bool, error)
+}
+
+// GetAndDeleter is implemented by the stores returned by NewStore.
+// GetAndDelete removes the string value stored under key and returns it, or
+// reports false when the key does not exist. Concurrent calls for the same
+// key return the value to exactly one caller, also across management
+// instances sharing a redis store.
+type GetAndDeleter interface {
+	GetAndDelete(ctx context.Context, key string) (string, bool, error)
+}
+
 // NewStore creates a new cache store with the given max timeout and cleanup interval. It checks for the environment Variable RedisStoreEnvVar
 // to determine if a redis store should be used. If the environment variable is set, it will attempt to connect to the redis store.
 func NewStore(ctx context.Context, maxTimeout, cleanupInterval time.Duration, maxConn int) (store.StoreInterface, error) {
@@ -39,7 +58,56 @@ func NewStore(ctx context.Context, maxTimeout, cleanupInterval time.Duration, ma
 		return getRedisStore(ctx, redisAddr, maxConn)
 	}
 	goc := gocache.New(maxTimeout, cleanupInterval)
-	return gocache_store.NewGoCache(goc), nil
+	return &memoryStore{GoCacheStore: gocache_store.NewGoCache(goc), client: goc}, nil
+}
+
+type memoryStore struct {
+	*gocache_store.GoCacheStore
+	client *gocache.Cache
+
+	// getDelMu makes GetAndDelete atomic, go-cache has no get-and-delete operation
+	getDelMu sync.Mutex
+}
+
+func (s *memoryStore) SetIfAbsent(_ context.Context, key string, value any, ttl time.Duration) (bool, error) {
+	return s.client.Add(key, value, ttl) == nil, nil
+}
+
+func (s *memoryStore) GetAndDelete(_ context.Context, key string) (string, bool, error) {
+	s.getDelMu.Lock()
+	defer s.getDelMu.Unlock()
+
+	value, ok := s.client.Get(key)
+	if !ok {
+		return "", false, nil
+	}
+	s.client.Delete(key)
+
+	str, ok := value.(string)
+	if !ok {
+		return "", false, fmt.Errorf("value of %s is %T, not a string", key, value)
+	}
+	return str, true, nil
+}
+
+type redisStore struct {
+	*redis_store.RedisStore
+	client *redis.Client
+}
+
+func (s *redisStore) SetIfAbsent(ctx context.Context, key string, value any, ttl time.Duration) (bool, error) {
+	return s.client.SetNX(ctx, key, value, ttl).Result()
+}
+
+func (s *redisStore) GetAndDelete(ctx context.Context, key string) (string, bool, error) {
+	value, err := s.client.GetDel(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return value, true, nil
 }
 
 // GetAddrFromEnv returns the redis address from the environment variable RedisStoreEnvVar or its legacy counterpart.
@@ -74,5 +142,5 @@ func getRedisStore(ctx context.Context, redisEnvAddr string, maxConn int) (store
 
 	log.WithContext(subCtx).Infof("using redis cache at %s", redisEnvAddr)
 
-	return redis_store.NewRedis(redisClient), nil
+	return &redisStore{RedisStore: redis_store.NewRedis(redisClient), client: redisClient}, nil
 }
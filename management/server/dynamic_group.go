@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"slices"
+
+	log "github.com/sirupsen/logrus"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// resolveDynamicGroupPeers replaces the peers of a dynamic group with the account peers matching its rules.
+// Static groups are left untouched.
+func resolveDynamicGroupPeers(ctx context.Context, transaction store.Store, accountID string, group *types.Group) error {
+	if !group.IsDynamic() {
+		return nil
+	}
+
+	matcher, err := group.Rules.Matcher()
+	if err != nil {
+		return status.Errorf(status.InvalidArgument, "invalid dynamic group rules: %v", err)
+	}
+
+	peers, err := transaction.GetAccountPeers(ctx, store.LockingStrengthNone, accountID, "", "")
+	if err != nil {
+		return err
+	}
+
+	group.Peers = make([]string, 0)
+	for _, peer := range peers {
+		// embedded proxy peers are never group members
+		if peer.ProxyMeta.Embedded {
+			continue
+		}
+		if matcher.Matches(peer) {
+			group.Peers = append(group.Peers, peer.ID)
+		}
+	}
+
+	return nil
+}
+
+// applyDynamicGroupsToPeer adds the peer to the dynamic groups whose rules it matches and removes it from the ones
+// it no longer matches. With a nil diff every dynamic group is evaluated, which is what a newly added peer needs;
+// otherwise only the groups whose verdict the metadata change flips are touched. It returns the IDs of the groups
+// whose membership changed.
+func applyDynamicGroupsToPeer(ctx context.Context, transaction store.Store, accountID string, peer *nbpeer.Peer, diff *nbpeer.MetaDiff) ([]string, error) {
+	if peer.ProxyMeta.Embedded {
+		return nil, nil
+	}
+	if diff != nil && !diff.Updated() {
+		return nil, nil
+	}
+
+	groups, err := transaction.GetAccountGroups(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var changedGroupIDs []string
+	for _, group := range groups {
+		if !group.IsDynamic() {
+			continue
+		}
+
+		matcher, err := group.Rules.Matcher()
+		if err != nil {
+			log.WithContext(ctx).Warnf("skipping dynamic group %s with invalid rules: %v", group.ID, err)
+			continue
+		}
+
+		if diff != nil && !matcher.AffectedBy(diff, peer) {
+			continue
+		}
+
+		isMember := slices.Contains(group.Peers, peer.ID)
+		matches := matcher.Matches(peer)
+		switch {
+		case matches && !isMember:
+			if err = transaction.AddPeerToGroup(ctx, accountID, peer.ID, group.ID); err != nil {
+				return nil, status.Errorf(status.Internal, "failed to add peer %s to dynamic group %s: %v", peer.ID, group.ID, err)
+			}
+		case !matches && isMember:
+			if err = transaction.RemovePeerFromGroup(ctx, peer.ID, group.ID); err != nil {
+				return nil, status.Errorf(status.Internal, "failed to remove peer %s from dynamic group %s: %v", peer.ID, group.ID, err)
+			}
+		default:
+			continue
+		}
+
+		log.WithContext(ctx).Debugf("peer %s dynamic group %s membership changed to %t", peer.ID, group.ID, matches)
+		changedGroupIDs = append(changedGroupIDs, group.ID)
+	}
+
+	return changedGroupIDs, nil
+}
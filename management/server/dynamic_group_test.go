@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+func TestDynamicGroup_MembershipFollowsPeerAttributes(t *testing.T) {
+	manager, _, account, peer1, peer2, peer3 := setupNetworkMapTest(t)
+	ctx := context.Background()
+
+	group := &types.Group{
+		Name:   "build-servers",
+		Issued: types.GroupIssuedAPI,
+		Peers:  []string{peer3.ID},
+		Rules: &types.GroupRules{
+			Match: types.GroupRulesMatchAny,
+			Rules: []types.GroupRule{
+				{Attribute: types.GroupRuleAttributeHostname, Operator: types.GroupRuleOperatorGlob, Values: []string{"build-*"}},
+				{Attribute: types.GroupRuleAttributeHostname, Operator: types.GroupRuleOperatorEquals, Values: []string{peer1.Meta.Hostname}},
+			},
+		},
+	}
+	require.NoError(t, manager.CreateGroup(ctx, account.Id, userID, group))
+
+	groupPeers := func() []string {
+		stored, err := manager.Store.GetGroupByID(ctx, store.LockingStrengthNone, account.Id, group.ID)
+		require.NoError(t, err)
+		return stored.Peers
+	}
+	assert.ElementsMatch(t, []string{peer1.ID}, groupPeers(), "explicit peers are replaced by the rule matches")
+
+	err := manager.GroupAddPeer(ctx, account.Id, group.ID, peer2.ID)
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.PreconditionFailed, sErr.Type(), "peers can't be assigned to dynamic groups")
+
+	syncHostname := func(peer *nbpeer.Peer, hostname string) {
+		meta := peer.Meta
+		meta.Hostname = hostname
+		_, _, _, _, err := manager.SyncPeer(ctx, types.PeerSync{WireGuardPubKey: peer.Key, Meta: meta}, account.Id)
+		require.NoError(t, err)
+	}
+
+	syncHostname(peer2, "build-01")
+	assert.ElementsMatch(t, []string{peer1.ID, peer2.ID}, groupPeers(), "peer matching after a meta change joins the group")
+
+	syncHostname(peer2, "web-01")
+	assert.ElementsMatch(t, []string{peer1.ID}, groupPeers(), "peer no longer matching leaves the group")
+
+	key, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+	newPeer, _, _, _, err := manager.AddPeer(ctx, "", "", userID, &nbpeer.Peer{
+		Key:  key.PublicKey().String(),
+		Meta: nbpeer.PeerSystemMeta{Hostname: "build-02"},
+	}, false)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{peer1.ID, newPeer.ID}, groupPeers(), "new matching peers join on registration")
+
+	group.Rules = nil
+	group.Peers = []string{peer3.ID}
+	require.NoError(t, manager.UpdateGroup(ctx, account.Id, userID, group))
+	assert.ElementsMatch(t, []string{peer3.ID}, groupPeers(), "removing the rules turns the group static")
+}
+
+func TestDynamicGroup_SetupKeyAndLocationRules(t *testing.T) {
+	manager, _, account, peer1, _, _ := setupNetworkMapTest(t)
+	ctx := context.Background()
+
+	require.NotEmpty(t, peer1.SetupKeyID, "peers registered with a setup key record it")
+
+	group := &types.Group{
+		Name:   "office",
+		Issued: types.GroupIssuedAPI,
+		Rules: &types.GroupRules{
+			Match: types.GroupRulesMatchAll,
+			Rules: []types.GroupRule{
+				{Attribute: types.GroupRuleAttributeSetupKey, Operator: types.GroupRuleOperatorEquals, Values: []string{peer1.SetupKeyID}},
+				{Attribute: types.GroupRuleAttributeConnectionIP, Operator: types.GroupRuleOperatorInRange, Values: []string{"198.51.100.0/24"}},
+			},
+		},
+	}
+	require.NoError(t, manager.CreateGroup(ctx, account.Id, userID, group))
+
+	stored, err := manager.Store.GetGroupByID(ctx, store.LockingStrengthNone, account.Id, group.ID)
+	require.NoError(t, err)
+	assert.Empty(t, stored.Peers, "no peer connects from the office range yet")
+	require.NotNil(t, stored.Rules)
+	assert.Equal(t, group.Rules, stored.Rules)
+
+	peer, err := manager.Store.GetPeerByID(ctx, store.LockingStrengthUpdate, account.Id, peer1.ID)
+	require.NoError(t, err)
+	diff := peer.UpdateMetaIfNew(ctx, peer.Meta, &nbpeer.Location{ConnectionIP: net.ParseIP("198.51.100.7")})
+	require.NoError(t, manager.Store.SavePeer(ctx, account.Id, peer))
+
+	changed, err := applyDynamicGroupsToPeer(ctx, manager.Store, account.Id, peer, &diff)
+	require.NoError(t, err)
+	assert.Equal(t, []string{group.ID}, changed)
+
+	stored, err = manager.Store.GetGroupByID(ctx, store.LockingStrengthNone, account.Id, group.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{peer1.ID}, stored.Peers)
+}
+
+func TestDynamicGroup_Validation(t *testing.T) {
+	manager, _, account, _, _, _ := setupNetworkMapTest(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		group *types.Group
+	}{
+		{
+			name: "invalid regex",
+			group: &types.Group{Name: "regex", Issued: types.GroupIssuedAPI, Rules: &types.GroupRules{
+				Match: types.GroupRulesMatchAll,
+				Rules: []types.GroupRule{{Attribute: types.GroupRuleAttributeHostname, Operator: types.GroupRuleOperatorRegex, Values: []string{"("}}},
+			}},
+		},
+		{
+			name: "operator not supported by attribute",
+			group: &types.Group{Name: "operator", Issued: types.GroupIssuedAPI, Rules: &types.GroupRules{
+				Match: types.GroupRulesMatchAll,
+				Rules: []types.GroupRule{{Attribute: types.GroupRuleAttributeCountry, Operator: types.GroupRuleOperatorInRange, Values: []string{"10.0.0.0/8"}}},
+			}},
+		},
+		{
+			name: "integration group",
+			group: &types.Group{ID: "integration", Name: "integration", Issued: types.GroupIssuedIntegration, Rules: &types.GroupRules{
+				Match: types.GroupRulesMatchAll,
+				Rules: []types.GroupRule{{Attribute: types.GroupRuleAttributeOS, Operator: types.GroupRuleOperatorEquals, Values: []string{"linux"}}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := manager.CreateGroup(ctx, account.Id, userID, tt.group)
+			sErr, ok := status.FromError(err)
+			require.True(t, ok, "expected status error, got %v", err)
+			assert.Equal(t, status.InvalidArgument, sErr.Type())
+		})
+	}
+}
@@ -21,8 +21,11 @@ func isEnabled() bool {
 	return response == "" || response == "true"
 }
 
-// GetEvents returns a list of activity events of an account
-func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error) {
+// maxEventsLimit is the maximum number of activity events returned by a single GetEvents call
+const maxEventsLimit = 10000
+
+// GetEvents returns a list of activity events of an account matching the filter, newest first
+func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userID string, filter activity.Filter) ([]*activity.Event, error) {
 	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Events, operations.Read)
 	if err != nil {
 		return nil, status.NewPermissionValidationError(err)
@@ -31,7 +34,11 @@ func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userI
 		return nil, status.NewPermissionDeniedError()
 	}
 
-	events, err := am.eventStore.Get(ctx, accountID, 0, 10000, true)
+	if filter.Limit <= 0 || filter.Limit > maxEventsLimit {
+		filter.Limit = maxEventsLimit
+	}
+
+	events, err := am.eventStore.GetFiltered(ctx, accountID, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +138,9 @@ func (am *DefaultAccountManager) getEventsUserInfo(ctx context.Context, events [
 
 		if event.InitiatorID == activity.SystemInitiator ||
 			event.InitiatorID == accountId ||
-			event.Activity == activity.PeerAddedWithSetupKey {
+			event.Activity == activity.PeerAddedWithSetupKey ||
+			event.Activity == activity.PeerAddedWithWorkloadIdentity ||
+			event.Activity == activity.WorkloadIdentityEnrollmentRejected {
 			// @todo other events to be excluded if never initiated by a user
 			continue
 		}
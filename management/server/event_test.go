@@ -36,7 +36,7 @@ func TestDefaultAccountManager_GetEvents(t *testing.T) {
 	accountID := "accountID"
 
 	t.Run("get empty events list", func(t *testing.T) {
-		events, err := manager.GetEvents(context.Background(), accountID, userID)
+		events, err := manager.GetEvents(context.Background(), accountID, userID, activity.Filter{})
 		if err != nil {
 			return
 		}
@@ -46,7 +46,7 @@ func TestDefaultAccountManager_GetEvents(t *testing.T) {
 
 	t.Run("get events", func(t *testing.T) {
 		generateAndStoreEvents(t, manager, activity.PeerAddedByUser, userID, "peer", accountID, 10)
-		events, err := manager.GetEvents(context.Background(), accountID, userID)
+		events, err := manager.GetEvents(context.Background(), accountID, userID, activity.Filter{})
 		if err != nil {
 			return
 		}
@@ -55,9 +55,23 @@ func TestDefaultAccountManager_GetEvents(t *testing.T) {
 		_ = manager.eventStore.Close(context.Background()) //nolint
 	})
 
+	t.Run("get filtered events", func(t *testing.T) {
+		generateAndStoreEvents(t, manager, activity.PeerAddedByUser, userID, "peer", accountID, 3)
+		generateAndStoreEvents(t, manager, activity.PolicyUpdated, userID, "policy", accountID, 2)
+		events, err := manager.GetEvents(context.Background(), accountID, userID, activity.Filter{
+			Activities: []activity.Activity{activity.PolicyUpdated},
+			TargetID:   "policy",
+		})
+		if err != nil {
+			return
+		}
+		assert.Len(t, events, 2)
+		_ = manager.eventStore.Close(context.Background()) //nolint
+	})
+
 	t.Run("get events without duplicates", func(t *testing.T) {
 		generateAndStoreEvents(t, manager, activity.UserJoined, userID, "", accountID, 10)
-		events, err := manager.GetEvents(context.Background(), accountID, userID)
+		events, err := manager.GetEvents(context.Background(), accountID, userID, activity.Filter{})
 		if err != nil {
 			return
 		}
@@ -8,6 +8,7 @@ import (
 
 	agentNetworkTypes "github.com/netbirdio/netbird/management/internals/modules/agentnetwork/types"
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/service"
+	"github.com/netbirdio/netbird/management/internals/modules/workloadidentity"
 	"github.com/rs/xid"
 	log "github.com/sirupsen/logrus"
 
@@ -90,6 +91,10 @@ func (am *DefaultAccountManager) CreateGroup(ctx context.Context, accountID, use
 			return err
 		}
 
+		if err = resolveDynamicGroupPeers(ctx, transaction, accountID, newGroup); err != nil {
+			return err
+		}
+
 		newGroup.AccountID = accountID
 
 		events := am.prepareGroupEvents(ctx, transaction, accountID, userID, newGroup)
@@ -146,6 +151,10 @@ func (am *DefaultAccountManager) UpdateGroup(ctx context.Context, accountID, use
 			return err
 		}
 
+		if err = resolveDynamicGroupPeers(ctx, transaction, accountID, newGroup); err != nil {
+			return err
+		}
+
 		newGroup.AccountID = accountID
 
 		events := am.prepareGroupEvents(ctx, transaction, accountID, userID, newGroup)
@@ -332,6 +341,10 @@ func (am *DefaultAccountManager) updateSingleGroup(ctx context.Context, accountI
 			return err
 		}
 
+		if err := resolveDynamicGroupPeers(ctx, transaction, accountID, newGroup); err != nil {
+			return err
+		}
+
 		newGroup.AccountID = accountID
 
 		oldGroup, err := transaction.GetGroupByID(ctx, store.LockingStrengthNone, accountID, newGroup.ID)
@@ -540,6 +553,10 @@ func (am *DefaultAccountManager) GroupAddPeer(ctx context.Context, accountID, gr
 	change := affectedpeers.Change{OutputPeerIDs: []string{peerID}, LinkGroups: []string{groupID}}
 
 	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err := validateStaticGroupMembership(ctx, transaction, accountID, groupID); err != nil {
+			return err
+		}
+
 		if err := transaction.AddPeerToGroup(ctx, accountID, peerID, groupID); err != nil {
 			return err
 		}
@@ -610,6 +627,10 @@ func (am *DefaultAccountManager) GroupDeletePeer(ctx context.Context, accountID,
 	change := affectedpeers.Change{OutputPeerIDs: []string{peerID}, LinkGroups: []string{groupID}}
 
 	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err := validateStaticGroupMembership(ctx, transaction, accountID, groupID); err != nil {
+			return err
+		}
+
 		if err := transaction.RemovePeerFromGroup(ctx, peerID, groupID); err != nil {
 			return err
 		}
@@ -695,12 +716,40 @@ func validateNewGroup(ctx context.Context, transaction store.Store, accountID st
 		newGroup.ID = xid.New().String()
 	}
 
+	if newGroup.Rules != nil {
+		if newGroup.Issued != types.GroupIssuedAPI {
+			return status.Errorf(status.InvalidArgument, "only API groups can be dynamic")
+		}
+
+		if newGroup.IsGroupAll() {
+			return status.Errorf(status.InvalidArgument, "the %s group can't be dynamic", types.GroupAllName)
+		}
+
+		if err := newGroup.Rules.Validate(); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid dynamic group rules: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// validateStaticGroupMembership rejects direct membership changes of dynamic groups, whose peers are computed from their rules.
+func validateStaticGroupMembership(ctx context.Context, transaction store.Store, accountID, groupID string) error {
+	group, err := transaction.GetGroupByID(ctx, store.LockingStrengthNone, accountID, groupID)
+	if err != nil {
+		return err
+	}
+
+	if group.IsDynamic() {
+		return status.Errorf(status.PreconditionFailed, "peers of dynamic group %s are computed from its rules and can't be changed directly", group.Name)
+	}
+
 	return nil
 }
 
 func validateDeleteGroup(ctx context.Context, transaction store.Store, group *types.Group, userID string, flowGroups []string) error {
-	// disable a deleting integration group if the initiator is not an admin service user
-	if group.Issued == types.GroupIssuedIntegration {
+	// disable a deleting integration group if the initiator is not an admin service user or the integration itself
+	if group.Issued == types.GroupIssuedIntegration && userID != activity.SystemInitiator {
 		executingUser, err := transaction.GetUserByUserID(ctx, store.LockingStrengthNone, userID)
 		if err != nil {
 			return status.Errorf(status.Internal, "failed to get user")
@@ -754,6 +803,10 @@ func validateDeleteGroup(ctx context.Context, transaction store.Store, group *ty
 		return &GroupLinkError{"agent network policy", linkedPolicy.Name}
 	}
 
+	if isLinked, linkedProvider := isGroupLinkedToWorkloadIdentityProvider(ctx, transaction, group.AccountID, group.ID); isLinked {
+		return &GroupLinkError{"workload identity provider", linkedProvider.Name}
+	}
+
 	return checkGroupLinkedToSettings(ctx, transaction, group)
 }
 
@@ -925,6 +978,22 @@ func isGroupLinkedToAgentNetworkPolicy(ctx context.Context, transaction store.St
 	return false, nil
 }
 
+// isGroupLinkedToWorkloadIdentityProvider checks if a group is linked to any workload identity provider in the account.
+func isGroupLinkedToWorkloadIdentityProvider(ctx context.Context, transaction store.Store, accountID string, groupID string) (bool, *workloadidentity.Provider) {
+	providers, err := transaction.GetAccountWorkloadIdentityProviders(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("error retrieving workload identity providers while checking group linkage: %v", err)
+		return false, nil
+	}
+
+	for _, provider := range providers {
+		if slices.Contains(provider.AutoGroups, groupID) {
+			return true, provider
+		}
+	}
+	return false, nil
+}
+
 // areGroupChangesAffectPeers checks if any changes to the specified groups will affect peers.
 // It fetches each collection once and checks all groupIDs against them in memory.
 func areGroupChangesAffectPeers(ctx context.Context, transaction store.Store, accountID string, groupIDs []string) (bool, error) {
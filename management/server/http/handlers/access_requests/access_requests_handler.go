@@ -0,0 +1,215 @@
+package access_requests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/http/util"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// handler is a handler that manages just-in-time access requests of the account
+type handler struct {
+	accountManager account.Manager
+}
+
+func AddEndpoints(accountManager account.Manager, router *mux.Router) {
+	h := newHandler(accountManager)
+	router.HandleFunc("/access-requests", h.getAllAccessRequests).Methods("GET", "OPTIONS")
+	router.HandleFunc("/access-requests", h.createAccessRequest).Methods("POST", "OPTIONS")
+	router.HandleFunc("/access-requests/{requestId}", h.getAccessRequest).Methods("GET", "OPTIONS")
+	router.HandleFunc("/access-requests/{requestId}/approve", h.approveAccessRequest).Methods("POST", "OPTIONS")
+	router.HandleFunc("/access-requests/{requestId}/deny", h.denyAccessRequest).Methods("POST", "OPTIONS")
+	router.HandleFunc("/access-requests/{requestId}/revoke", h.revokeAccessRequest).Methods("POST", "OPTIONS")
+}
+
+// newHandler creates a new access requests handler
+func newHandler(accountManager account.Manager) *handler {
+	return &handler{
+		accountManager: accountManager,
+	}
+}
+
+// getAllAccessRequests lists the access requests visible to the user
+func (h *handler) getAllAccessRequests(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	requests, err := h.accountManager.GetAccessRequests(r.Context(), userAuth.AccountId, userAuth.UserId)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]*api.AccessRequest, 0, len(requests))
+	for _, request := range requests {
+		resp = append(resp, toAccessRequestResponse(request))
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// createAccessRequest handles a user's request for temporary access
+func (h *handler) createAccessRequest(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiAccessRequestsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	request, err := h.accountManager.CreateAccessRequest(r.Context(), userAuth.AccountId, userAuth.UserId, &types.AccessRequest{
+		TargetType:    types.AccessRequestTargetType(req.TargetType),
+		TargetID:      req.TargetId,
+		Justification: req.Justification,
+		Duration:      time.Duration(req.Duration) * time.Second,
+	})
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toAccessRequestResponse(request))
+}
+
+// getAccessRequest returns an access request identified by ID
+func (h *handler) getAccessRequest(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	requestID, ok := getRequestID(w, r)
+	if !ok {
+		return
+	}
+
+	request, err := h.accountManager.GetAccessRequest(r.Context(), userAuth.AccountId, userAuth.UserId, requestID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toAccessRequestResponse(request))
+}
+
+// approveAccessRequest grants a pending access request
+func (h *handler) approveAccessRequest(w http.ResponseWriter, r *http.Request) {
+	h.reviewAccessRequest(w, r, h.accountManager.ApproveAccessRequest)
+}
+
+// denyAccessRequest rejects a pending access request
+func (h *handler) denyAccessRequest(w http.ResponseWriter, r *http.Request) {
+	h.reviewAccessRequest(w, r, h.accountManager.DenyAccessRequest)
+}
+
+type reviewFunc func(ctx context.Context, accountID, userID, requestID, comment string) (*types.AccessRequest, error)
+
+// reviewAccessRequest applies an approver's decision with an optional comment
+func (h *handler) reviewAccessRequest(w http.ResponseWriter, r *http.Request, review reviewFunc) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	requestID, ok := getRequestID(w, r)
+	if !ok {
+		return
+	}
+
+	var req api.AccessRequestReview
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+			return
+		}
+	}
+
+	comment := ""
+	if req.Comment != nil {
+		comment = *req.Comment
+	}
+
+	request, err := review(r.Context(), userAuth.AccountId, userAuth.UserId, requestID, comment)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toAccessRequestResponse(request))
+}
+
+// revokeAccessRequest withdraws a pending access request or ends an active grant
+func (h *handler) revokeAccessRequest(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	requestID, ok := getRequestID(w, r)
+	if !ok {
+		return
+	}
+
+	request, err := h.accountManager.RevokeAccessRequest(r.Context(), userAuth.AccountId, userAuth.UserId, requestID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toAccessRequestResponse(request))
+}
+
+func getRequestID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	requestID := mux.Vars(r)["requestId"]
+	if len(requestID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid access request ID"), w)
+		return "", false
+	}
+	return requestID, true
+}
+
+func toAccessRequestResponse(request *types.AccessRequest) *api.AccessRequest {
+	resp := &api.AccessRequest{
+		Id:            request.ID,
+		UserId:        request.UserID,
+		TargetType:    api.AccessRequestTargetType(request.TargetType),
+		TargetId:      request.TargetID,
+		Justification: request.Justification,
+		Duration:      int(request.Duration.Seconds()),
+		Status:        api.AccessRequestStatus(request.Status),
+		CreatedAt:     request.CreatedAt,
+		ReviewedAt:    request.ReviewedAt,
+		ExpiresAt:     request.ExpiresAt,
+		RevokedAt:     request.RevokedAt,
+	}
+	if request.ReviewedBy != "" {
+		resp.ReviewedBy = &request.ReviewedBy
+	}
+	if request.ReviewComment != "" {
+		resp.ReviewComment = &request.ReviewComment
+	}
+	if request.RevokedBy != "" {
+		resp.RevokedBy = &request.RevokedBy
+	}
+	return resp
+}
@@ -240,9 +240,13 @@ func (h *handler) updateAccountRequestSettings(req api.PutApiAccountsAccountIdJS
 			FlowEnabled:              req.Settings.Extra.NetworkTrafficLogsEnabled,
 			FlowGroups:               req.Settings.Extra.NetworkTrafficLogsGroups,
 			FlowPacketCounterEnabled: req.Settings.Extra.NetworkTrafficPacketCounterEnabled,
+			PeerApprovalRules:        types.PeerApprovalRulesFromAPIRequest(req.Settings.Extra.PeerApprovalRules),
 		}
 	}
 
+	if req.Settings.AccessRequestApproverGroups != nil {
+		returnSettings.AccessRequestApproverGroups = *req.Settings.AccessRequestApproverGroups
+	}
 	if req.Settings.JwtGroupsEnabled != nil {
 		returnSettings.JWTGroupsEnabled = *req.Settings.JwtGroupsEnabled
 	}
@@ -438,6 +442,9 @@ func toAccountResponse(accountID string, settings *types.Settings, meta *types.A
 		LocalMfaEnabled:                 &settings.LocalMfaEnabled,
 	}
 
+	if len(settings.AccessRequestApproverGroups) > 0 {
+		apiSettings.AccessRequestApproverGroups = &settings.AccessRequestApproverGroups
+	}
 	if settings.NetworkRange.IsValid() {
 		networkRangeStr := settings.NetworkRange.String()
 		apiSettings.NetworkRange = &networkRangeStr
@@ -465,6 +472,10 @@ func toAccountResponse(accountID string, settings *types.Settings, meta *types.A
 			NetworkTrafficLogsGroups:           settings.Extra.FlowGroups,
 			NetworkTrafficPacketCounterEnabled: settings.Extra.FlowPacketCounterEnabled,
 		}
+		if len(settings.Extra.PeerApprovalRules) > 0 {
+			rules := types.PeerApprovalRulesToAPIResponse(settings.Extra.PeerApprovalRules)
+			apiSettings.Extra.PeerApprovalRules = &rules
+		}
 	}
 
 	return &api.Account{
@@ -3,6 +3,9 @@ package events
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	log "github.com/sirupsen/logrus"
@@ -12,6 +15,7 @@ import (
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
 	"github.com/netbirdio/netbird/shared/management/http/api"
 	"github.com/netbirdio/netbird/shared/management/http/util"
+	"github.com/netbirdio/netbird/shared/management/status"
 )
 
 // handler HTTP handler
@@ -41,7 +45,13 @@ func (h *handler) getAllEvents(w http.ResponseWriter, r *http.Request) {
 
 	accountID, userID := userAuth.AccountId, userAuth.UserId
 
-	accountEvents, err := h.accountManager.GetEvents(r.Context(), accountID, userID)
+	filter, err := parseFilter(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountEvents, err := h.accountManager.GetEvents(r.Context(), accountID, userID, filter)
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
@@ -55,6 +65,67 @@ func (h *handler) getAllEvents(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSONObject(r.Context(), w, events)
 }
 
+// parseFilter builds an activity filter from the query parameters of the request
+func parseFilter(r *http.Request) (activity.Filter, error) {
+	query := r.URL.Query()
+	filter := activity.Filter{
+		InitiatorID: query.Get("initiator_id"),
+		TargetID:    query.Get("target_id"),
+	}
+
+	for _, value := range query["activity_code"] {
+		for _, code := range strings.Split(value, ",") {
+			code = strings.TrimSpace(code)
+			if code == "" {
+				continue
+			}
+			activities := activity.ActivitiesByStringCode(code)
+			if len(activities) == 0 {
+				return filter, status.Errorf(status.InvalidArgument, "unknown activity code: %s", code)
+			}
+			filter.Activities = append(filter.Activities, activities...)
+		}
+	}
+
+	var err error
+	if filter.From, err = parseTime(query.Get("start_date"), "start_date"); err != nil {
+		return filter, err
+	}
+	if filter.To, err = parseTime(query.Get("end_date"), "end_date"); err != nil {
+		return filter, err
+	}
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return filter, status.Errorf(status.InvalidArgument, "start_date must not be after end_date")
+	}
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		filter.Cursor, err = strconv.ParseUint(cursor, 10, 64)
+		if err != nil || filter.Cursor == 0 {
+			return filter, status.Errorf(status.InvalidArgument, "invalid cursor: %s", cursor)
+		}
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		filter.Limit, err = strconv.Atoi(limit)
+		if err != nil || filter.Limit <= 0 {
+			return filter, status.Errorf(status.InvalidArgument, "invalid limit: %s", limit)
+		}
+	}
+
+	return filter, nil
+}
+
+func parseTime(value, name string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "invalid %s, expected RFC3339 format: %s", name, value)
+	}
+	return &t, nil
+}
+
 func toEventResponse(event *activity.Event) *api.Event {
 	meta := make(map[string]string)
 	if event.Meta != nil {
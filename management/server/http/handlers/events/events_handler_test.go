@@ -25,7 +25,7 @@ import (
 func initEventsTestData(account string, events ...*activity.Event) *handler {
 	return &handler{
 		accountManager: &mock_server.MockAccountManager{
-			GetEventsFunc: func(_ context.Context, accountID, userID string) ([]*activity.Event, error) {
+			GetEventsFunc: func(_ context.Context, accountID, userID string, _ activity.Filter) ([]*activity.Event, error) {
 				if accountID == account {
 					return events, nil
 				}
@@ -241,3 +241,40 @@ func TestEvents_GetEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestEvents_ParseFilter(t *testing.T) {
+	t.Run("all parameters", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet,
+			"/api/events/audit?activity_code=policy.update&activity_code=policy.add,policy.delete&initiator_id=user-1&target_id=policy-1"+
+				"&start_date=2026-01-01T00:00:00Z&end_date=2026-01-08T00:00:00Z&cursor=42&limit=50", nil)
+
+		filter, err := parseFilter(req)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []activity.Activity{activity.PolicyUpdated, activity.PolicyAdded, activity.PolicyRemoved}, filter.Activities)
+		assert.Equal(t, "user-1", filter.InitiatorID)
+		assert.Equal(t, "policy-1", filter.TargetID)
+		assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), *filter.From)
+		assert.Equal(t, time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC), *filter.To)
+		assert.Equal(t, uint64(42), filter.Cursor)
+		assert.Equal(t, 50, filter.Limit)
+	})
+
+	t.Run("no parameters", func(t *testing.T) {
+		filter, err := parseFilter(httptest.NewRequest(http.MethodGet, "/api/events/audit", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, activity.Filter{}, filter)
+	})
+
+	for name, query := range map[string]string{
+		"unknown activity code": "activity_code=policy.rename",
+		"invalid start date":    "start_date=yesterday",
+		"reversed time range":   "start_date=2026-01-08T00:00:00Z&end_date=2026-01-01T00:00:00Z",
+		"invalid cursor":        "cursor=abc",
+		"zero limit":            "limit=0",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseFilter(httptest.NewRequest(http.MethodGet, "/api/events/audit?"+query, nil))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -163,6 +163,7 @@ func (h *handler) updateGroup(w http.ResponseWriter, r *http.Request) {
 		Peers:                peers,
 		Resources:            resources,
 		Issued:               existingGroup.Issued,
+		Rules:                types.GroupRulesFromAPIRequest(req.Rules),
 		IntegrationReference: existingGroup.IntegrationReference,
 	}
 
@@ -224,6 +225,7 @@ func (h *handler) createGroup(w http.ResponseWriter, r *http.Request) {
 		Peers:     peers,
 		Resources: resources,
 		Issued:    types.GroupIssuedAPI,
+		Rules:     types.GroupRulesFromAPIRequest(req.Rules),
 	}
 
 	err = h.accountManager.CreateGroup(r.Context(), accountID, userID, &group)
@@ -314,6 +316,7 @@ func toGroupResponse(peers []*nbpeer.Peer, group *types.Group) *api.Group {
 		Id:     group.ID,
 		Name:   group.Name,
 		Issued: (*api.GroupIssued)(&group.Issued),
+		Rules:  group.Rules.ToAPIResponse(),
 	}
 
 	for _, pid := range group.Peers {
@@ -177,6 +177,8 @@ func toAPIResponse(idp *types.IdentityProvider) api.IdentityProvider {
 		Name:     idp.Name,
 		Issuer:   idp.Issuer,
 		ClientId: idp.ClientID,
+		Ldap:     toLDAPResponse(idp.LDAP),
+		Saml:     toSAMLResponse(idp.SAML),
 	}
 	if idp.ID != "" {
 		resp.Id = &idp.ID
@@ -185,12 +187,139 @@ func toAPIResponse(idp *types.IdentityProvider) api.IdentityProvider {
 	return resp
 }
 
+// toLDAPResponse converts LDAP settings to their API representation, the bind password is never returned
+func toLDAPResponse(cfg *types.IdentityProviderLDAPConfig) *api.IdentityProviderLDAPConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	resp := &api.IdentityProviderLDAPConfig{
+		Host:               cfg.Host,
+		StartTls:           &cfg.StartTLS,
+		InsecureNoSsl:      &cfg.InsecureNoSSL,
+		InsecureSkipVerify: &cfg.InsecureSkipVerify,
+		RootCa:             nonEmpty(cfg.RootCA),
+		BindDn:             nonEmpty(cfg.BindDN),
+		UserSearch: api.IdentityProviderLDAPUserSearch{
+			BaseDn:             cfg.UserSearch.BaseDN,
+			Filter:             nonEmpty(cfg.UserSearch.Filter),
+			UsernameAttributes: cfg.UserSearch.UsernameAttributes,
+			IdAttribute:        nonEmpty(cfg.UserSearch.IDAttribute),
+			EmailAttribute:     nonEmpty(cfg.UserSearch.EmailAttribute),
+			NameAttribute:      nonEmpty(cfg.UserSearch.NameAttribute),
+		},
+	}
+	if resp.UserSearch.UsernameAttributes == nil {
+		resp.UserSearch.UsernameAttributes = []string{}
+	}
+	if gs := cfg.GroupSearch; gs != nil {
+		resp.GroupSearch = &api.IdentityProviderLDAPGroupSearch{
+			BaseDn:         gs.BaseDN,
+			Filter:         nonEmpty(gs.Filter),
+			UserAttribute:  gs.UserAttribute,
+			GroupAttribute: gs.GroupAttribute,
+			NameAttribute:  gs.NameAttribute,
+		}
+	}
+	return resp
+}
+
+func toSAMLResponse(cfg *types.IdentityProviderSAMLConfig) *api.IdentityProviderSAMLConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	return &api.IdentityProviderSAMLConfig{
+		SsoUrl:                          cfg.SSOURL,
+		Ca:                              nonEmpty(cfg.CA),
+		InsecureSkipSignatureValidation: &cfg.InsecureSkipSignatureValidation,
+		EntityIssuer:                    nonEmpty(cfg.EntityIssuer),
+		SsoIssuer:                       nonEmpty(cfg.SSOIssuer),
+		UsernameAttribute:               cfg.UsernameAttribute,
+		EmailAttribute:                  cfg.EmailAttribute,
+		GroupsAttribute:                 nonEmpty(cfg.GroupsAttribute),
+		GroupsDelimiter:                 nonEmpty(cfg.GroupsDelimiter),
+		NameIdPolicyFormat:              nonEmpty(cfg.NameIDPolicyFormat),
+	}
+}
+
 func fromAPIRequest(req *api.IdentityProviderRequest) *types.IdentityProvider {
 	return &types.IdentityProvider{
 		Type:         types.IdentityProviderType(req.Type),
 		Name:         req.Name,
-		Issuer:       req.Issuer,
-		ClientID:     req.ClientId,
-		ClientSecret: req.ClientSecret,
+		Issuer:       valueOf(req.Issuer),
+		ClientID:     valueOf(req.ClientId),
+		ClientSecret: valueOf(req.ClientSecret),
+		LDAP:         fromLDAPRequest(req.Ldap),
+		SAML:         fromSAMLRequest(req.Saml),
+	}
+}
+
+func fromLDAPRequest(req *api.IdentityProviderLDAPConfig) *types.IdentityProviderLDAPConfig {
+	if req == nil {
+		return nil
+	}
+
+	cfg := &types.IdentityProviderLDAPConfig{
+		Host:               req.Host,
+		StartTLS:           valueOf(req.StartTls),
+		InsecureNoSSL:      valueOf(req.InsecureNoSsl),
+		InsecureSkipVerify: valueOf(req.InsecureSkipVerify),
+		RootCA:             valueOf(req.RootCa),
+		BindDN:             valueOf(req.BindDn),
+		BindPassword:       valueOf(req.BindPassword),
+		UserSearch: types.IdentityProviderLDAPUserSearch{
+			BaseDN:             req.UserSearch.BaseDn,
+			Filter:             valueOf(req.UserSearch.Filter),
+			UsernameAttributes: req.UserSearch.UsernameAttributes,
+			IDAttribute:        valueOf(req.UserSearch.IdAttribute),
+			EmailAttribute:     valueOf(req.UserSearch.EmailAttribute),
+			NameAttribute:      valueOf(req.UserSearch.NameAttribute),
+		},
+	}
+	if gs := req.GroupSearch; gs != nil {
+		cfg.GroupSearch = &types.IdentityProviderLDAPGroupSearch{
+			BaseDN:         gs.BaseDn,
+			Filter:         valueOf(gs.Filter),
+			UserAttribute:  gs.UserAttribute,
+			GroupAttribute: gs.GroupAttribute,
+			NameAttribute:  gs.NameAttribute,
+		}
+	}
+	return cfg
+}
+
+func fromSAMLRequest(req *api.IdentityProviderSAMLConfig) *types.IdentityProviderSAMLConfig {
+	if req == nil {
+		return nil
+	}
+
+	return &types.IdentityProviderSAMLConfig{
+		SSOURL:                          req.SsoUrl,
+		CA:                              valueOf(req.Ca),
+		InsecureSkipSignatureValidation: valueOf(req.InsecureSkipSignatureValidation),
+		EntityIssuer:                    valueOf(req.EntityIssuer),
+		SSOIssuer:                       valueOf(req.SsoIssuer),
+		UsernameAttribute:               req.UsernameAttribute,
+		EmailAttribute:                  req.EmailAttribute,
+		GroupsAttribute:                 valueOf(req.GroupsAttribute),
+		GroupsDelimiter:                 valueOf(req.GroupsDelimiter),
+		NameIDPolicyFormat:              valueOf(req.NameIdPolicyFormat),
+	}
+}
+
+// valueOf returns the value of an optional request field, or its zero value when it is not set
+func valueOf[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
+
+func nonEmpty(v string) *string {
+	if v == "" {
+		return nil
 	}
+	return &v
 }
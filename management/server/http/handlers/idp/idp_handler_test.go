@@ -17,6 +17,7 @@ import (
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
 	"github.com/netbirdio/netbird/management/server/mock_server"
 	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/management/server/util"
 	"github.com/netbirdio/netbird/shared/auth"
 	"github.com/netbirdio/netbird/shared/management/http/api"
 	"github.com/netbirdio/netbird/shared/management/status"
@@ -423,9 +424,9 @@ func TestFromAPIRequest(t *testing.T) {
 	req := &api.IdentityProviderRequest{
 		Name:         "New IDP",
 		Type:         api.IdentityProviderTypeOkta,
-		Issuer:       "https://dev-123456.okta.com",
-		ClientId:     "okta-client-id",
-		ClientSecret: "okta-client-secret",
+		Issuer:       util.ToPtr("https://dev-123456.okta.com"),
+		ClientId:     util.ToPtr("okta-client-id"),
+		ClientSecret: util.ToPtr("okta-client-secret"),
 	}
 
 	idp := fromAPIRequest(req)
@@ -436,3 +437,41 @@ func TestFromAPIRequest(t *testing.T) {
 	assert.Equal(t, "okta-client-id", idp.ClientID)
 	assert.Equal(t, "okta-client-secret", idp.ClientSecret)
 }
+
+func TestFromAPIRequest_LDAP(t *testing.T) {
+	req := &api.IdentityProviderRequest{
+		Name: "Active Directory",
+		Type: api.IdentityProviderTypeLdap,
+		Ldap: &api.IdentityProviderLDAPConfig{
+			Host:         "ad.example.com:636",
+			BindDn:       util.ToPtr("cn=netbird,dc=example,dc=com"),
+			BindPassword: util.ToPtr("bind-secret"),
+			UserSearch: api.IdentityProviderLDAPUserSearch{
+				BaseDn:             "ou=users,dc=example,dc=com",
+				UsernameAttributes: []string{"sAMAccountName"},
+			},
+			GroupSearch: &api.IdentityProviderLDAPGroupSearch{
+				BaseDn:         "ou=groups,dc=example,dc=com",
+				UserAttribute:  "DN",
+				GroupAttribute: "member",
+				NameAttribute:  "cn",
+			},
+		},
+	}
+
+	idp := fromAPIRequest(req)
+
+	assert.Equal(t, types.IdentityProviderTypeLDAP, idp.Type)
+	require.NotNil(t, idp.LDAP)
+	assert.Equal(t, "ad.example.com:636", idp.LDAP.Host)
+	assert.Equal(t, "bind-secret", idp.LDAP.BindPassword)
+	assert.Equal(t, []string{"sAMAccountName"}, idp.LDAP.UserSearch.UsernameAttributes)
+	require.NotNil(t, idp.LDAP.GroupSearch)
+	assert.Equal(t, "member", idp.LDAP.GroupSearch.GroupAttribute)
+	assert.NoError(t, idp.Validate())
+
+	response := toAPIResponse(idp)
+	require.NotNil(t, response.Ldap)
+	assert.Nil(t, response.Ldap.BindPassword, "the bind password must never be returned")
+	assert.Equal(t, "cn", response.Ldap.GroupSearch.NameAttribute)
+}
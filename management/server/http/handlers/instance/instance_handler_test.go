@@ -367,7 +367,7 @@ func TestSetup_PAT_MissingExpireIn_DefaultsToOneDay(t *testing.T) {
 			assert.Equal(t, "u1", userAuth.UserId)
 			return "acc-1", nil
 		},
-		CreatePATFunc: func(_ context.Context, accountID, initiator, target, name string, expiresIn int) (*types.PersonalAccessTokenGenerated, error) {
+		CreatePATFunc: func(_ context.Context, accountID, initiator, target, name string, expiresIn int, _ []types.PATScope) (*types.PersonalAccessTokenGenerated, error) {
 			assert.Equal(t, "acc-1", accountID)
 			assert.Equal(t, "u1", initiator)
 			assert.Equal(t, "u1", target)
@@ -430,7 +430,7 @@ func TestSetup_PAT_Success(t *testing.T) {
 			gotAccountArgs.email = userAuth.Email
 			return "acc-1", nil
 		},
-		CreatePATFunc: func(_ context.Context, accountID, initiator, target, name string, expiresIn int) (*types.PersonalAccessTokenGenerated, error) {
+		CreatePATFunc: func(_ context.Context, accountID, initiator, target, name string, expiresIn int, _ []types.PATScope) (*types.PersonalAccessTokenGenerated, error) {
 			assert.Equal(t, "acc-1", accountID)
 			assert.Equal(t, "owner-id", initiator)
 			assert.Equal(t, "owner-id", target)
@@ -524,7 +524,7 @@ func TestSetup_PAT_CreatePATFails_Rollback(t *testing.T) {
 		GetAccountIDByUserIdFunc: func(_ context.Context, _ auth.UserAuth) (string, error) {
 			return "acc-1", nil
 		},
-		CreatePATFunc: func(_ context.Context, _, _, _, _ string, _ int) (*types.PersonalAccessTokenGenerated, error) {
+		CreatePATFunc: func(_ context.Context, _, _, _, _ string, _ int, _ []types.PATScope) (*types.PersonalAccessTokenGenerated, error) {
 			return nil, status.Errorf(status.Internal, "token store unavailable")
 		},
 		GetStoreFunc: func() nbstore.Store {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/netip"
+	"time"
 
 	"github.com/gorilla/mux"
 	log "github.com/sirupsen/logrus"
@@ -200,6 +201,10 @@ func (h *Handler) updatePeer(ctx context.Context, accountID, userID, peerID stri
 		InactivityExpirationEnabled: req.InactivityExpirationEnabled,
 	}
 
+	if req.Labels != nil {
+		update.Labels = *req.Labels
+	}
+
 	if req.ApprovalRequired != nil {
 		// todo: looks like that we reset all status property, is it right?
 		update.Status = &nbpeer.PeerStatus{
@@ -318,6 +323,12 @@ func (h *Handler) GetAllPeers(w http.ResponseWriter, r *http.Request) {
 	nameFilter := r.URL.Query().Get("name")
 	ipFilter := r.URL.Query().Get("ip")
 
+	labelFilter, err := parseLabelFilter(r.URL.Query()["label"])
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
 	accountID, userID := userAuth.AccountId, userAuth.UserId
 
 	peers, err := h.accountManager.GetPeers(r.Context(), accountID, userID, nameFilter, ipFilter)
@@ -341,6 +352,9 @@ func (h *Handler) GetAllPeers(w http.ResponseWriter, r *http.Request) {
 		if peer.ProxyMeta.Embedded {
 			continue
 		}
+		if len(labelFilter) > 0 && !peer.MatchesLabels(labelFilter) {
+			continue
+		}
 		respBody = append(respBody, toPeerListItemResponse(peer, grpsInfoMap[peer.ID], dnsDomain, 0))
 	}
 
@@ -367,6 +381,22 @@ func (h *Handler) setApprovalRequiredFlag(respBody []*api.PeerBatch, validPeersM
 	}
 }
 
+// parseLabelFilter parses the key=value label query parameters into a selector the listed peers must match
+func parseLabelFilter(labels []string) (map[string]string, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	selector := make(map[string]string, len(labels))
+	for _, label := range labels {
+		key, value, err := nbpeer.ParseLabel(label)
+		if err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid label filter %q: %v", label, err)
+		}
+		selector[key] = value
+	}
+	return selector, nil
+}
+
 func parseIPv6(s *string) (netip.Addr, error) {
 	if s == nil {
 		return netip.Addr{}, fmt.Errorf("IPv6 address is nil")
@@ -444,6 +474,7 @@ func (h *Handler) GetAccessiblePeers(w http.ResponseWriter, r *http.Request) {
 
 	dnsDomain := h.networkMapController.GetDNSDomain(account.Settings)
 
+	account.ApplyEffectiveAccess(time.Now())
 	netMap := account.GetPeerNetworkMapFromComponents(ctx, peerID, dns.CustomZone{}, nil, validPeers, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), nil, account.GetActiveGroupUsers())
 
 	util.WriteJSONObject(ctx, w, toAccessiblePeers(netMap, account.Peers, dnsDomain))
@@ -604,6 +635,8 @@ func toSinglePeerResponse(peer *nbpeer.Peer, groupsInfo []api.GroupMinimum, dnsD
 		SerialNumber:                peer.Meta.SystemSerialNumber,
 		InactivityExpirationEnabled: peer.InactivityExpirationEnabled,
 		Ephemeral:                   peer.Ephemeral,
+		Labels:                      toLabelsResponse(peer.Labels),
+		ClientLabels:                toLabelsResponse(peer.Meta.Labels),
 		LocalFlags: &api.PeerLocalFlags{
 			BlockInbound:          &peer.Meta.Flags.BlockInbound,
 			BlockLanAccess:        &peer.Meta.Flags.BlockLANAccess,
@@ -659,6 +692,8 @@ func toPeerListItemResponse(peer *nbpeer.Peer, groupsInfo []api.GroupMinimum, dn
 		SerialNumber:                peer.Meta.SystemSerialNumber,
 		InactivityExpirationEnabled: peer.InactivityExpirationEnabled,
 		Ephemeral:                   peer.Ephemeral,
+		Labels:                      toLabelsResponse(peer.Labels),
+		ClientLabels:                toLabelsResponse(peer.Meta.Labels),
 		LocalFlags: &api.PeerLocalFlags{
 			BlockInbound:          &peer.Meta.Flags.BlockInbound,
 			BlockLanAccess:        &peer.Meta.Flags.BlockLANAccess,
@@ -696,6 +731,14 @@ func toSingleJobResponse(job *types.Job) (*api.JobResponse, error) {
 	}, nil
 }
 
+func toLabelsResponse(labels map[string]string) *api.Labels {
+	if len(labels) == 0 {
+		return nil
+	}
+	apiLabels := api.Labels(labels)
+	return &apiLabels
+}
+
 func fqdn(peer *nbpeer.Peer, dnsDomain string) string {
 	fqdn := peer.FQDN(dnsDomain)
 	if fqdn == "" {
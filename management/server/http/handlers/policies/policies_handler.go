@@ -3,6 +3,7 @@ package policies
 import (
 	"encoding/json"
 	"net/http"
+	"net/netip"
 	"strconv"
 
 	"github.com/gorilla/mux"
@@ -25,6 +26,7 @@ func AddEndpoints(accountManager account.Manager, locationManager geolocation.Ge
 	policiesHandler := newHandler(accountManager)
 	router.HandleFunc("/policies", policiesHandler.getAllPolicies).Methods("GET", "OPTIONS")
 	router.HandleFunc("/policies", policiesHandler.createPolicy).Methods("POST", "OPTIONS")
+	router.HandleFunc("/policies/simulate", policiesHandler.simulateAccess).Methods("POST", "OPTIONS")
 	router.HandleFunc("/policies/{policyId}", policiesHandler.updatePolicy).Methods("PUT", "OPTIONS")
 	router.HandleFunc("/policies/{policyId}", policiesHandler.getPolicy).Methods("GET", "OPTIONS")
 	router.HandleFunc("/policies/{policyId}", policiesHandler.deletePolicy).Methods("DELETE", "OPTIONS")
@@ -153,6 +155,9 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		hasDestinations := rule.Destinations != nil
 		hasDestinationResource := rule.DestinationResource != nil
 
+		hasSourceLabels := rule.SourceLabels != nil && len(*rule.SourceLabels) > 0
+		hasDestinationLabels := rule.DestinationLabels != nil && len(*rule.DestinationLabels) > 0
+
 		if hasSources && hasSourceResource {
 			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "specify either sources or  source resources, not both"), w)
 			return
@@ -163,7 +168,12 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 			return
 		}
 
-		if !(hasSources || hasSourceResource) || !(hasDestinations || hasDestinationResource) {
+		if (hasSourceLabels && hasSourceResource) || (hasDestinationLabels && hasDestinationResource) {
+			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "label selectors can't be combined with resources"), w)
+			return
+		}
+
+		if !(hasSources || hasSourceResource || hasSourceLabels) || !(hasDestinations || hasDestinationResource || hasDestinationLabels) {
 			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "specify either sources or source resources and destinations or destination resources"), w)
 			return
 		}
@@ -197,6 +207,14 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 			pr.DestinationResource = *destinationResource
 		}
 
+		if hasSourceLabels {
+			pr.SourceLabels = *rule.SourceLabels
+		}
+
+		if hasDestinationLabels {
+			pr.DestinationLabels = *rule.DestinationLabels
+		}
+
 		pr.Enabled = rule.Enabled
 		if rule.Description != nil {
 			pr.Description = *rule.Description
@@ -281,6 +299,8 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		policy.SourcePostureChecks = *req.SourcePostureChecks
 	}
 
+	policy.Schedule = toPolicySchedule(req.Schedule)
+
 	policy, err := h.accountManager.SavePolicy(r.Context(), accountID, userID, policy, create)
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
@@ -364,6 +384,61 @@ func (h *handler) getPolicy(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSONObject(r.Context(), w, resp)
 }
 
+// simulateAccess evaluates whether a connection would be allowed by the account's policies
+func (h *handler) simulateAccess(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiPoliciesSimulateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	simulation := &types.AccessSimulationRequest{}
+	if req.SourcePeerId != nil {
+		simulation.SourcePeerID = *req.SourcePeerId
+	}
+	if req.SourceUserId != nil {
+		simulation.SourceUserID = *req.SourceUserId
+	}
+	if req.DestinationPeerId != nil {
+		simulation.DestinationPeerID = *req.DestinationPeerId
+	}
+	if req.DestinationResourceId != nil {
+		simulation.DestinationResourceID = *req.DestinationResourceId
+	}
+	if req.DestinationAddress != nil {
+		address, err := netip.ParseAddr(*req.DestinationAddress)
+		if err != nil {
+			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid destination address %s", *req.DestinationAddress), w)
+			return
+		}
+		simulation.DestinationAddress = address
+	}
+	if req.Protocol != nil {
+		simulation.Protocol = types.PolicyRuleProtocolType(*req.Protocol)
+	}
+	if req.Port != nil {
+		if *req.Port < 1 || *req.Port > 65535 {
+			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid port %d", *req.Port), w)
+			return
+		}
+		simulation.Port = uint16(*req.Port)
+	}
+
+	results, err := h.accountManager.SimulateAccess(r.Context(), userAuth.AccountId, userAuth.UserId, simulation)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toPolicySimulationResponse(results))
+}
+
 func toPolicyResponse(groups []*types.Group, policy *types.Policy) *api.Policy {
 	groupsMap := make(map[string]*types.Group)
 	for _, group := range groups {
@@ -377,6 +452,7 @@ func toPolicyResponse(groups []*types.Group, policy *types.Policy) *api.Policy {
 		Description:         &policy.Description,
 		Enabled:             policy.Enabled,
 		SourcePostureChecks: policy.SourcePostureChecks,
+		Schedule:            toPolicyScheduleResponse(policy.Schedule),
 	}
 	for _, r := range policy.Rules {
 		rID := r.ID
@@ -403,6 +479,16 @@ func toPolicyResponse(groups []*types.Group, policy *types.Policy) *api.Policy {
 			rule.Ports = &portsCopy
 		}
 
+		if len(r.SourceLabels) != 0 {
+			sourceLabels := api.Labels(r.SourceLabels)
+			rule.SourceLabels = &sourceLabels
+		}
+
+		if len(r.DestinationLabels) != 0 {
+			destinationLabels := api.Labels(r.DestinationLabels)
+			rule.DestinationLabels = &destinationLabels
+		}
+
 		if len(r.PortRanges) != 0 {
 			portRanges := make([]api.RulePortRange, 0, len(r.PortRanges))
 			for _, portRange := range r.PortRanges {
@@ -457,3 +543,89 @@ func toPolicyResponse(groups []*types.Group, policy *types.Policy) *api.Policy {
 	}
 	return ap
 }
+
+func toPolicySchedule(req *api.PolicySchedule) *types.PolicySchedule {
+	if req == nil {
+		return nil
+	}
+
+	schedule := &types.PolicySchedule{
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	}
+	if req.Timezone != nil {
+		schedule.Timezone = *req.Timezone
+	}
+	if req.Windows != nil {
+		for _, w := range *req.Windows {
+			window := types.PolicyScheduleWindow{Start: w.Start, End: w.End}
+			if w.Days != nil {
+				for _, day := range *w.Days {
+					window.Days = append(window.Days, string(day))
+				}
+			}
+			schedule.Windows = append(schedule.Windows, window)
+		}
+	}
+	return schedule
+}
+
+func toPolicyScheduleResponse(schedule *types.PolicySchedule) *api.PolicySchedule {
+	if schedule == nil {
+		return nil
+	}
+
+	resp := &api.PolicySchedule{
+		StartDate: schedule.StartDate,
+		EndDate:   schedule.EndDate,
+	}
+	if schedule.Timezone != "" {
+		resp.Timezone = &schedule.Timezone
+	}
+	if len(schedule.Windows) > 0 {
+		windows := make([]api.PolicyScheduleWindow, 0, len(schedule.Windows))
+		for _, w := range schedule.Windows {
+			window := api.PolicyScheduleWindow{Start: w.Start, End: w.End}
+			if len(w.Days) > 0 {
+				days := make([]api.PolicyScheduleWindowDays, 0, len(w.Days))
+				for _, day := range w.Days {
+					days = append(days, api.PolicyScheduleWindowDays(day))
+				}
+				window.Days = &days
+			}
+			windows = append(windows, window)
+		}
+		resp.Windows = &windows
+	}
+	return resp
+}
+
+func toPolicySimulationResponse(results []*types.AccessSimulationResult) *api.PolicySimulationResult {
+	resp := &api.PolicySimulationResult{
+		Allowed: len(results) > 0,
+		Results: make([]api.PolicySimulationPeerResult, 0, len(results)),
+	}
+	for _, result := range results {
+		peerResult := api.PolicySimulationPeerResult{
+			SourcePeerId:    result.SourcePeerID,
+			DestinationType: api.PolicySimulationPeerResultDestinationType(result.DestinationType),
+			DestinationId:   result.DestinationID,
+			Allowed:         result.Allowed,
+			MatchedRules:    make([]api.PolicySimulationRule, 0, len(result.MatchedRules)),
+			FailedChecks:    make([]string, 0, len(result.FailedChecks)),
+		}
+		for _, rule := range result.MatchedRules {
+			peerResult.MatchedRules = append(peerResult.MatchedRules, api.PolicySimulationRule{
+				PolicyId:   rule.PolicyID,
+				PolicyName: rule.PolicyName,
+				RuleId:     rule.RuleID,
+				RuleName:   rule.RuleName,
+				Action:     api.PolicySimulationRuleAction(rule.Action),
+			})
+		}
+		peerResult.FailedChecks = append(peerResult.FailedChecks, result.FailedChecks...)
+		resp.Allowed = resp.Allowed && result.Allowed
+		resp.Results = append(resp.Results, peerResult)
+	}
+	return resp
+}
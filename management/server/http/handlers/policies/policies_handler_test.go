@@ -308,3 +308,85 @@ func TestPoliciesWritePolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestPoliciesSimulateAccess(t *testing.T) {
+	var received *types.AccessSimulationRequest
+	p := &handler{
+		accountManager: &mock_server.MockAccountManager{
+			SimulateAccessFunc: func(_ context.Context, _, _ string, req *types.AccessSimulationRequest) ([]*types.AccessSimulationResult, error) {
+				received = req
+				return []*types.AccessSimulationResult{
+					{
+						SourcePeerID:    "peer-a",
+						DestinationType: types.AccessSimulationDestinationResource,
+						DestinationID:   "resource",
+						Allowed:         true,
+						MatchedRules:    []types.AccessSimulationRule{{PolicyID: "policy", RuleID: "rule", Action: types.PolicyTrafficActionAccept}},
+					},
+					{
+						SourcePeerID:    "peer-b",
+						DestinationType: types.AccessSimulationDestinationResource,
+						DestinationID:   "resource",
+						FailedChecks:    []string{"source peer peer-b is not approved"},
+					},
+				}, nil
+			},
+		},
+	}
+
+	tt := []struct {
+		name           string
+		requestBody    string
+		expectedStatus int
+	}{
+		{
+			name:           "simulate by user and address",
+			requestBody:    `{"source_user_id":"user","destination_address":"10.0.0.10","protocol":"tcp","port":443}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid address",
+			requestBody:    `{"source_peer_id":"peer-a","destination_address":"not-an-ip"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "invalid port",
+			requestBody:    `{"source_peer_id":"peer-a","destination_peer_id":"peer-b","protocol":"tcp","port":70000}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/api/policies/simulate", bytes.NewBufferString(tc.requestBody))
+			req = nbcontext.SetUserAuthInRequest(req, auth.UserAuth{
+				UserId:    "test_user",
+				Domain:    "hotmail.com",
+				AccountId: "test_id",
+			})
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/policies/simulate", p.simulateAccess).Methods("POST")
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code, recorder.Body.String())
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, "user", received.SourceUserID)
+			assert.Equal(t, "10.0.0.10", received.DestinationAddress.String())
+			assert.Equal(t, types.PolicyRuleProtocolTCP, received.Protocol)
+			assert.Equal(t, uint16(443), received.Port)
+
+			var got api.PolicySimulationResult
+			assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+			assert.False(t, got.Allowed, "the connection isn't allowed from every source peer")
+			assert.Len(t, got.Results, 2)
+			assert.True(t, got.Results[0].Allowed)
+			assert.Len(t, got.Results[0].MatchedRules, 1)
+			assert.Equal(t, []string{"source peer peer-b is not approved"}, got.Results[1].FailedChecks)
+		})
+	}
+}
@@ -103,7 +103,7 @@ func (h *AuthCallbackHandler) handleCallback(w http.ResponseWriter, r *http.Requ
 	// Group validation is performed by the proxy via ValidateSession gRPC call.
 	// This allows the proxy to show 403 pages directly without redirect dance.
 
-	sessionToken, err := h.proxyService.GenerateSessionToken(r.Context(), redirectURL.Hostname(), userID, auth.MethodOIDC)
+	sessionToken, err := h.proxyService.GenerateSessionToken(r.Context(), redirectURL.Hostname(), userID, auth.MethodOIDC, nbgrpc.SessionOrigin{IP: clientIP, UserAgent: r.UserAgent()})
 	if err != nil {
 		log.WithError(err).Error("Failed to create session token")
 		redirectURL.Scheme = "https"
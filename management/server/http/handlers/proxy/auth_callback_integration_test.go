@@ -442,6 +442,18 @@ func (m *testServiceManager) DeleteAccountCluster(_ context.Context, _, _, _ str
 	return nil
 }
 
+func (m *testServiceManager) RegisterWebAuthnCredential(_ context.Context, _, _, _ string, _ *service.WebAuthnCredential) error {
+	return nil
+}
+
+func (m *testServiceManager) UpdateWebAuthnSignCount(_ context.Context, _, _, _ string, _ []byte, _ uint32) error {
+	return nil
+}
+
+func (m *testServiceManager) RevokeSessions(_ context.Context, _, _ string, _ []*service.RevokedSession) error {
+	return nil
+}
+
 func (m *testServiceManager) SetCertificateIssuedAt(_ context.Context, _, _ string) error {
 	return nil
 }
@@ -126,6 +126,11 @@ func (h *handler) createRoute(w http.ResponseWriter, r *http.Request) {
 		accessControlGroupIds = *req.AccessControlGroups
 	}
 
+	var distributionLabels map[string]string
+	if req.DistributionLabels != nil {
+		distributionLabels = *req.DistributionLabels
+	}
+
 	// Set default skipAutoApply value for exit nodes (0.0.0.0/0 routes)
 	skipAutoApply := false
 	if req.SkipAutoApply != nil {
@@ -135,7 +140,7 @@ func (h *handler) createRoute(w http.ResponseWriter, r *http.Request) {
 	}
 
 	newRoute, err := h.accountManager.CreateRoute(r.Context(), accountID, newPrefix, networkType, domains, peerId, peerGroupIds,
-		req.Description, route.NetID(req.NetworkId), req.Masquerade, req.Metric, req.Groups, accessControlGroupIds, req.Enabled, userID, req.KeepRoute, skipAutoApply)
+		req.Description, route.NetID(req.NetworkId), req.Masquerade, req.Metric, req.Groups, accessControlGroupIds, req.Enabled, userID, req.KeepRoute, skipAutoApply, distributionLabels)
 
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
@@ -271,6 +276,10 @@ func (h *handler) updateRoute(w http.ResponseWriter, r *http.Request) {
 		newRoute.AccessControlGroups = *req.AccessControlGroups
 	}
 
+	if req.DistributionLabels != nil {
+		newRoute.DistributionLabels = *req.DistributionLabels
+	}
+
 	err = h.accountManager.SaveRoute(r.Context(), accountID, userID, newRoute)
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
@@ -369,5 +378,9 @@ func toRouteResponse(serverRoute *route.Route) (*api.Route, error) {
 	if len(serverRoute.AccessControlGroups) > 0 {
 		route.AccessControlGroups = &serverRoute.AccessControlGroups
 	}
+	if len(serverRoute.DistributionLabels) > 0 {
+		distributionLabels := api.Labels(serverRoute.DistributionLabels)
+		route.DistributionLabels = &distributionLabels
+	}
 	return route, nil
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/netbirdio/netbird/management/server/account"
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/shared/management/http/api"
 	"github.com/netbirdio/netbird/shared/management/http/util"
@@ -98,16 +99,46 @@ func (h *handler) createSetupKey(w http.ResponseWriter, r *http.Request) {
 		allowExtraDNSLabels = *req.AllowExtraDnsLabels
 	}
 
+	if req.Labels != nil {
+		if err = nbpeer.ValidateLabels(*req.Labels); err != nil {
+			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid labels: %v", err), w)
+			return
+		}
+	}
+
+	restrictions := types.SetupKeyRestrictionsFromAPIRequest(req.Restrictions)
+	if err = restrictions.Validate(); err != nil {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid restrictions: %v", err), w)
+		return
+	}
+
 	setupKey, err := h.accountManager.CreateSetupKey(r.Context(), accountID, req.Name, types.SetupKeyType(req.Type), expiresIn,
 		req.AutoGroups, req.UsageLimit, userID, ephemeral, allowExtraDNSLabels)
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
 	}
+	plainKey := setupKey.Key
+
+	if (req.Labels != nil && len(*req.Labels) > 0) || !restrictions.IsEmpty() {
+		keyToSave := &types.SetupKey{
+			Id:           setupKey.Id,
+			AutoGroups:   setupKey.AutoGroups,
+			Restrictions: restrictions,
+		}
+		if req.Labels != nil {
+			keyToSave.Labels = *req.Labels
+		}
+		setupKey, err = h.accountManager.SaveSetupKey(r.Context(), accountID, keyToSave, userID)
+		if err != nil {
+			util.WriteError(r.Context(), err, w)
+			return
+		}
+	}
 
 	apiSetupKeys := ToResponseBody(setupKey)
 	// for the creation we need to send the plain key
-	apiSetupKeys.Key = setupKey.Key
+	apiSetupKeys.Key = plainKey
 
 	util.WriteJSONObject(r.Context(), w, apiSetupKeys)
 }
@@ -169,6 +200,10 @@ func (h *handler) updateSetupKey(w http.ResponseWriter, r *http.Request) {
 	newKey.AutoGroups = req.AutoGroups
 	newKey.Revoked = req.Revoked
 	newKey.Id = keyID
+	if req.Labels != nil {
+		newKey.Labels = *req.Labels
+	}
+	newKey.Restrictions = types.SetupKeyRestrictionsFromAPIRequest(req.Restrictions)
 
 	newKey, err = h.accountManager.SaveSetupKey(r.Context(), accountID, newKey, userID)
 	if err != nil {
@@ -264,5 +299,15 @@ func ToResponseBody(key *types.SetupKey) *api.SetupKey {
 		UsageLimit:          key.UsageLimit,
 		Ephemeral:           key.Ephemeral,
 		AllowExtraDnsLabels: key.AllowExtraDNSLabels,
+		Labels:              labelsResponse(key.Labels),
+		Restrictions:        types.SetupKeyRestrictionsToAPIResponse(key.Restrictions),
+	}
+}
+
+func labelsResponse(labels map[string]string) *api.Labels {
+	if len(labels) == 0 {
+		return nil
 	}
+	apiLabels := api.Labels(labels)
+	return &apiLabels
 }
@@ -168,6 +168,15 @@ func TestSetupKeysHandlers(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedBody:   false,
 		},
+		{
+			name:        "Create Setup Key With Invalid Restrictions",
+			requestType: http.MethodPost,
+			requestPath: "/api/setup-keys",
+			requestBody: bytes.NewBuffer(
+				[]byte(fmt.Sprintf("{\"name\":\"%s\",\"type\":\"reusable\",\"expires_in\":86400,\"usage_limit\":0,\"restrictions\":{\"allowed_cidrs\":[\"10.0.0.1\"]}}", newSetupKeyName))),
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody:   false,
+		},
 		{
 			name:        "Update Setup Key",
 			requestType: http.MethodPut,
@@ -3,11 +3,14 @@ package users
 import (
 	"encoding/json"
 	"net/http"
+	"slices"
 
 	"github.com/gorilla/mux"
 
 	"github.com/netbirdio/netbird/management/server/account"
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/shared/management/http/api"
 	"github.com/netbirdio/netbird/shared/management/http/util"
@@ -118,7 +121,7 @@ func (h *patHandler) createToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pat, err := h.accountManager.CreatePAT(r.Context(), accountID, userID, targetUserID, req.Name, req.ExpiresIn)
+	pat, err := h.accountManager.CreatePAT(r.Context(), accountID, userID, targetUserID, req.Name, req.ExpiresIn, fromPATScopesRequest(req.Scopes))
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
@@ -166,9 +169,55 @@ func toPATResponse(pat *types.PersonalAccessToken) *api.PersonalAccessToken {
 		ExpirationDate: pat.GetExpirationDate(),
 		Id:             pat.ID,
 		LastUsed:       pat.LastUsed,
+		Scopes:         toPATScopesResponse(pat.Scopes),
 	}
 }
 
+func toPATScopesResponse(scopes []types.PATScope) *[]api.PersonalAccessTokenScope {
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	apiScopes := make([]api.PersonalAccessTokenScope, 0, len(scopes))
+	for _, scope := range scopes {
+		apiScope := api.PersonalAccessTokenScope{
+			Module:     string(scope.Module),
+			Operations: make([]api.PersonalAccessTokenScopeOperations, 0, len(scope.Operations)),
+		}
+		for _, operation := range scope.Operations {
+			apiScope.Operations = append(apiScope.Operations, api.PersonalAccessTokenScopeOperations(operation))
+		}
+		if len(scope.ResourceIDs) > 0 {
+			resourceIDs := slices.Clone(scope.ResourceIDs)
+			apiScope.ResourceIds = &resourceIDs
+		}
+		apiScopes = append(apiScopes, apiScope)
+	}
+	return &apiScopes
+}
+
+func fromPATScopesRequest(apiScopes *[]api.PersonalAccessTokenScope) []types.PATScope {
+	if apiScopes == nil {
+		return nil
+	}
+
+	scopes := make([]types.PATScope, 0, len(*apiScopes))
+	for _, apiScope := range *apiScopes {
+		scope := types.PATScope{
+			Module:     modules.Module(apiScope.Module),
+			Operations: make([]operations.Operation, 0, len(apiScope.Operations)),
+		}
+		for _, operation := range apiScope.Operations {
+			scope.Operations = append(scope.Operations, operations.Operation(operation))
+		}
+		if apiScope.ResourceIds != nil {
+			scope.ResourceIDs = slices.Clone(*apiScope.ResourceIds)
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes
+}
+
 func toPATGeneratedResponse(pat *types.PersonalAccessTokenGenerated) *api.PersonalAccessTokenGenerated {
 	return &api.PersonalAccessTokenGenerated{
 		PlainToken:          pat.PlainToken,
@@ -85,8 +85,12 @@ func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
 
 	userRole := types.StrRoleToUserRole(req.Role)
 	if userRole == types.UserRoleUnknown {
-		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid user role"), w)
-		return
+		if req.Role == "" || req.Role == string(types.UserRoleUnknown) {
+			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid user role"), w)
+			return
+		}
+		// any other role is resolved as a custom role ID by the account manager
+		userRole = types.UserRole(req.Role)
 	}
 
 	newUser, err := h.accountManager.SaveUser(r.Context(), accountID, userID, &types.User{
@@ -207,6 +207,7 @@ func (m *AuthMiddleware) checkPATFromRequest(r *http.Request, authHeaderParts []
 		Domain:         accDomain,
 		DomainCategory: accCategory,
 		IsPAT:          true,
+		PATID:          pat.ID,
 	}
 
 	if impersonate, ok := r.URL.Query()["account"]; ok && len(impersonate) == 1 {
@@ -218,6 +219,7 @@ func (m *AuthMiddleware) checkPATFromRequest(r *http.Request, authHeaderParts []
 
 	// propagates ctx change to upstream middleware
 	*r = *nbcontext.SetUserAuthInRequest(r, userAuth)
+	*r = *r.WithContext(types.WithAuthenticatedPAT(r.Context(), pat))
 	return nil
 }
 
@@ -694,6 +694,7 @@ func TestAuthMiddleware_Handler_Child(t *testing.T) {
 				Domain:         testAccount.Domain,
 				DomainCategory: testAccount.DomainCategory,
 				IsPAT:          true,
+				PATID:          tokenID,
 			},
 		},
 		{
@@ -706,6 +707,7 @@ func TestAuthMiddleware_Handler_Child(t *testing.T) {
 				Domain:         testAccount.Domain,
 				DomainCategory: testAccount.DomainCategory,
 				IsPAT:          true,
+				PATID:          tokenID,
 			},
 		},
 		{
@@ -765,6 +767,8 @@ func TestAuthMiddleware_Handler_Child(t *testing.T) {
 				if tc.expectedUserAuth != nil {
 					assert.NoError(t, err)
 					assert.Equal(t, *tc.expectedUserAuth, userAuth)
+					_, carriesPAT := types.AuthenticatedPATFromContext(r.Context(), tokenID)
+					assert.Equal(t, tc.expectedUserAuth.IsPAT, carriesPAT, "the authenticated token is carried in the context")
 				} else {
 					assert.Error(t, err)
 					assert.Empty(t, userAuth)
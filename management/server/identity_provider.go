@@ -258,6 +258,8 @@ func connectorConfigToIdentityProvider(conn *dex.ConnectorConfig, accountID stri
 		Issuer:       conn.Issuer,
 		ClientID:     conn.ClientID,
 		ClientSecret: conn.ClientSecret,
+		LDAP:         ldapConnectorToIdentityProvider(conn.LDAP),
+		SAML:         samlConnectorToIdentityProvider(conn.SAML),
 	}
 }
 
@@ -270,11 +272,135 @@ func identityProviderToConnectorConfig(idpConfig *types.IdentityProvider) *dex.C
 		Issuer:       idpConfig.Issuer,
 		ClientID:     idpConfig.ClientID,
 		ClientSecret: idpConfig.ClientSecret,
+		LDAP:         identityProviderToLDAPConnector(idpConfig.LDAP),
+		SAML:         identityProviderToSAMLConnector(idpConfig.SAML),
 	}
 }
 
+// ldapConnectorToIdentityProvider converts dex LDAP connector settings to identity provider settings.
+// Only the first user matcher of the group search is exposed.
+func ldapConnectorToIdentityProvider(conn *dex.LDAPConnectorConfig) *types.IdentityProviderLDAPConfig {
+	if conn == nil {
+		return nil
+	}
+
+	cfg := &types.IdentityProviderLDAPConfig{
+		Host:               conn.Host,
+		StartTLS:           conn.StartTLS,
+		InsecureNoSSL:      conn.InsecureNoSSL,
+		InsecureSkipVerify: conn.InsecureSkipVerify,
+		RootCA:             string(conn.RootCAData),
+		BindDN:             conn.BindDN,
+		BindPassword:       conn.BindPW,
+		UserSearch: types.IdentityProviderLDAPUserSearch{
+			BaseDN:             conn.UserSearch.BaseDN,
+			Filter:             conn.UserSearch.Filter,
+			UsernameAttributes: conn.UserSearch.Username,
+			IDAttribute:        conn.UserSearch.IDAttr,
+			EmailAttribute:     conn.UserSearch.EmailAttr,
+			NameAttribute:      conn.UserSearch.NameAttr,
+		},
+	}
+
+	if conn.GroupSearch.BaseDN != "" {
+		cfg.GroupSearch = &types.IdentityProviderLDAPGroupSearch{
+			BaseDN:        conn.GroupSearch.BaseDN,
+			Filter:        conn.GroupSearch.Filter,
+			NameAttribute: conn.GroupSearch.NameAttr,
+		}
+		if len(conn.GroupSearch.UserMatchers) > 0 {
+			cfg.GroupSearch.UserAttribute = conn.GroupSearch.UserMatchers[0].UserAttr
+			cfg.GroupSearch.GroupAttribute = conn.GroupSearch.UserMatchers[0].GroupAttr
+		}
+	}
+
+	return cfg
+}
+
+// identityProviderToLDAPConnector converts identity provider LDAP settings to dex connector settings
+func identityProviderToLDAPConnector(cfg *types.IdentityProviderLDAPConfig) *dex.LDAPConnectorConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	conn := &dex.LDAPConnectorConfig{
+		Host:               cfg.Host,
+		StartTLS:           cfg.StartTLS,
+		InsecureNoSSL:      cfg.InsecureNoSSL,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+		BindDN:             cfg.BindDN,
+		BindPW:             cfg.BindPassword,
+		UserSearch: dex.LDAPUserSearch{
+			BaseDN:    cfg.UserSearch.BaseDN,
+			Filter:    cfg.UserSearch.Filter,
+			Username:  cfg.UserSearch.UsernameAttributes,
+			IDAttr:    cfg.UserSearch.IDAttribute,
+			EmailAttr: cfg.UserSearch.EmailAttribute,
+			NameAttr:  cfg.UserSearch.NameAttribute,
+		},
+	}
+	if cfg.RootCA != "" {
+		conn.RootCAData = []byte(cfg.RootCA)
+	}
+
+	if gs := cfg.GroupSearch; gs != nil {
+		conn.GroupSearch = dex.LDAPGroupSearch{
+			BaseDN:       gs.BaseDN,
+			Filter:       gs.Filter,
+			UserMatchers: []dex.LDAPUserMatcher{{UserAttr: gs.UserAttribute, GroupAttr: gs.GroupAttribute}},
+			NameAttr:     gs.NameAttribute,
+		}
+	}
+
+	return conn
+}
+
+// samlConnectorToIdentityProvider converts dex SAML connector settings to identity provider settings
+func samlConnectorToIdentityProvider(conn *dex.SAMLConnectorConfig) *types.IdentityProviderSAMLConfig {
+	if conn == nil {
+		return nil
+	}
+
+	return &types.IdentityProviderSAMLConfig{
+		SSOURL:                          conn.SSOURL,
+		CA:                              string(conn.CAData),
+		InsecureSkipSignatureValidation: conn.InsecureSkipSignatureValidation,
+		EntityIssuer:                    conn.EntityIssuer,
+		SSOIssuer:                       conn.SSOIssuer,
+		UsernameAttribute:               conn.UsernameAttr,
+		EmailAttribute:                  conn.EmailAttr,
+		GroupsAttribute:                 conn.GroupsAttr,
+		GroupsDelimiter:                 conn.GroupsDelim,
+		NameIDPolicyFormat:              conn.NameIDPolicyFormat,
+	}
+}
+
+// identityProviderToSAMLConnector converts identity provider SAML settings to dex connector settings
+func identityProviderToSAMLConnector(cfg *types.IdentityProviderSAMLConfig) *dex.SAMLConnectorConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	conn := &dex.SAMLConnectorConfig{
+		SSOURL:                          cfg.SSOURL,
+		InsecureSkipSignatureValidation: cfg.InsecureSkipSignatureValidation,
+		EntityIssuer:                    cfg.EntityIssuer,
+		SSOIssuer:                       cfg.SSOIssuer,
+		UsernameAttr:                    cfg.UsernameAttribute,
+		EmailAttr:                       cfg.EmailAttribute,
+		GroupsAttr:                      cfg.GroupsAttribute,
+		GroupsDelim:                     cfg.GroupsDelimiter,
+		NameIDPolicyFormat:              cfg.NameIDPolicyFormat,
+	}
+	if cfg.CA != "" {
+		conn.CAData = []byte(cfg.CA)
+	}
+
+	return conn
+}
+
 // generateIdentityProviderID generates a unique ID for an identity provider.
-// For specific provider types (okta, zitadel, entra, google, pocketid, microsoft, adfs),
+// For specific provider types (okta, zitadel, entra, google, pocketid, microsoft, adfs, ldap, saml),
 // the ID is prefixed with the type name. Generic OIDC providers get no prefix.
 func generateIdentityProviderID(idpType types.IdentityProviderType) string {
 	id := xid.New().String()
@@ -298,6 +424,10 @@ func generateIdentityProviderID(idpType types.IdentityProviderType) string {
 		return "keycloak-" + id
 	case types.IdentityProviderTypeADFS:
 		return "adfs-" + id
+	case types.IdentityProviderTypeLDAP:
+		return "ldap-" + id
+	case types.IdentityProviderTypeSAML:
+		return "saml-" + id
 	default:
 		// Generic OIDC - no prefix
 		return id
@@ -91,7 +91,7 @@ func TestSetupOwner_PATFeatureEnabled_MissingExpireDefaultsToOneDay(t *testing.T
 				assert.Equal(t, "owner-id", userAuth.UserId)
 				return "acc-1", nil
 			},
-			CreatePATFunc: func(_ context.Context, accountID, initiatorUserID, targetUserID, tokenName string, expiresIn int) (*types.PersonalAccessTokenGenerated, error) {
+			CreatePATFunc: func(_ context.Context, accountID, initiatorUserID, targetUserID, tokenName string, expiresIn int, _ []types.PATScope) (*types.PersonalAccessTokenGenerated, error) {
 				assert.Equal(t, "acc-1", accountID)
 				assert.Equal(t, "owner-id", initiatorUserID)
 				assert.Equal(t, "owner-id", targetUserID)
@@ -208,7 +208,7 @@ func TestSetupOwner_CreatePATFails_RollsBackSetupAccountAndUser(t *testing.T) {
 				assert.Equal(t, "owner-id", userAuth.UserId)
 				return "acc-1", nil
 			},
-			CreatePATFunc: func(_ context.Context, accountID, initiatorUserID, targetUserID, tokenName string, expiresIn int) (*types.PersonalAccessTokenGenerated, error) {
+			CreatePATFunc: func(_ context.Context, accountID, initiatorUserID, targetUserID, tokenName string, expiresIn int, _ []types.PATScope) (*types.PersonalAccessTokenGenerated, error) {
 				assert.Equal(t, "acc-1", accountID)
 				assert.Equal(t, "owner-id", initiatorUserID)
 				assert.Equal(t, "owner-id", targetUserID)
@@ -254,7 +254,7 @@ func TestSetupOwner_CreatePATFails_AccountAlreadyGoneStillRollsBackUser(t *testi
 			GetAccountIDByUserIdFunc: func(_ context.Context, _ auth.UserAuth) (string, error) {
 				return "acc-1", nil
 			},
-			CreatePATFunc: func(_ context.Context, _, _, _, _ string, _ int) (*types.PersonalAccessTokenGenerated, error) {
+			CreatePATFunc: func(_ context.Context, _, _, _, _ string, _ int, _ []types.PATScope) (*types.PersonalAccessTokenGenerated, error) {
 				return nil, errors.New("token failure")
 			},
 			GetStoreFunc: func() nbstore.Store {
@@ -296,7 +296,7 @@ func TestSetupOwner_CreatePATFails_AccountRollbackFailureStopsBeforeUserRollback
 			GetAccountIDByUserIdFunc: func(_ context.Context, _ auth.UserAuth) (string, error) {
 				return "acc-1", nil
 			},
-			CreatePATFunc: func(_ context.Context, _, _, _, _ string, _ int) (*types.PersonalAccessTokenGenerated, error) {
+			CreatePATFunc: func(_ context.Context, _, _, _, _ string, _ int, _ []types.PATScope) (*types.PersonalAccessTokenGenerated, error) {
 				return nil, errors.New("token failure")
 			},
 			GetStoreFunc: func() nbstore.Store {
@@ -2,8 +2,10 @@ package validator
 
 import (
 	"context"
+	"slices"
 
 	cachestore "github.com/eko/gocache/lib/v4/store"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/management/internals/modules/peers"
 	"github.com/netbirdio/netbird/management/server/activity"
@@ -11,40 +13,111 @@ import (
 	"github.com/netbirdio/netbird/management/server/settings"
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/shared/management/proto"
+	"github.com/netbirdio/netbird/shared/management/status"
 )
 
-type IntegratedValidatorImpl struct{}
+// pendingApprovalReason is reported for peers that wait for an administrator to approve them
+const pendingApprovalReason = "pending approval"
 
-func NewIntegratedValidator(_ context.Context, _ peers.Manager, _ settings.Manager, _ activity.Store, _ cachestore.StoreInterface) (*IntegratedValidatorImpl, error) {
-	return &IntegratedValidatorImpl{}, nil
+// IntegratedValidatorImpl implements the built-in peer approval workflow. When peer approval is enabled
+// in the account extra settings, new peers are kept pending until an administrator approves them,
+// unless they join one of the IntegratedValidatorGroups or match one of the auto-approval rules.
+// Pending peers are excluded from the network map.
+type IntegratedValidatorImpl struct {
+	peersManager peers.Manager
 }
 
-func (v *IntegratedValidatorImpl) ValidateExtraSettings(context.Context, *types.ExtraSettings, *types.ExtraSettings, string, string) error {
+func NewIntegratedValidator(_ context.Context, peersManager peers.Manager, _ settings.Manager, _ activity.Store, _ cachestore.StoreInterface) (*IntegratedValidatorImpl, error) {
+	return &IntegratedValidatorImpl{
+		peersManager: peersManager,
+	}, nil
+}
+
+func (v *IntegratedValidatorImpl) ValidateExtraSettings(_ context.Context, newExtraSettings *types.ExtraSettings, _ *types.ExtraSettings, _ string, _ string) error {
+	if newExtraSettings == nil {
+		return nil
+	}
+	if err := types.ValidatePeerApprovalRules(newExtraSettings.PeerApprovalRules); err != nil {
+		return status.Errorf(status.InvalidArgument, "%v", err)
+	}
 	return nil
 }
 
-func (v *IntegratedValidatorImpl) ValidatePeer(_ context.Context, update *nbpeer.Peer, _ *nbpeer.Peer, _ string, _ string, _ string, _ []string, _ *types.ExtraSettings) (*nbpeer.Peer, bool, error) {
-	return update, false, nil
+// ValidatePeer reports whether the update changes the approval state of the peer.
+// Requiring approval is only possible while peer approval is enabled.
+func (v *IntegratedValidatorImpl) ValidatePeer(_ context.Context, update *nbpeer.Peer, peer *nbpeer.Peer, _ string, _ string, _ string, _ []string, extraSettings *types.ExtraSettings) (*nbpeer.Peer, bool, error) {
+	if update.Status == nil || isPending(peer) == update.Status.RequiresApproval {
+		return update, false, nil
+	}
+
+	if update.Status.RequiresApproval && !approvalEnabled(extraSettings) {
+		return nil, false, status.Errorf(status.PreconditionFailed, "peer approval is not enabled for this account")
+	}
+
+	return update, true, nil
 }
 
-func (v *IntegratedValidatorImpl) PreparePeer(_ context.Context, _ string, peer *nbpeer.Peer, _ []string, _ *types.ExtraSettings, _ bool) *nbpeer.Peer {
-	return peer.Copy()
+// PreparePeer marks a new peer as pending approval unless it is exempt
+func (v *IntegratedValidatorImpl) PreparePeer(ctx context.Context, accountID string, peer *nbpeer.Peer, peersGroup []string, extraSettings *types.ExtraSettings, temporary bool) *nbpeer.Peer {
+	prepared := peer.Copy()
+	if temporary || !approvalEnabled(extraSettings) {
+		return prepared
+	}
+
+	if slices.ContainsFunc(peersGroup, func(groupID string) bool {
+		return slices.Contains(extraSettings.IntegratedValidatorGroups, groupID)
+	}) {
+		return prepared
+	}
+
+	for _, rule := range extraSettings.PeerApprovalRules {
+		if rule.Matches(prepared) {
+			log.WithContext(ctx).Debugf("peer %s of account %s auto-approved by %s rule", prepared.ID, accountID, rule.Attribute)
+			return prepared
+		}
+	}
+
+	if prepared.Status == nil {
+		prepared.Status = &nbpeer.PeerStatus{}
+	}
+	prepared.Status.RequiresApproval = true
+
+	return prepared
 }
 
-func (v *IntegratedValidatorImpl) IsNotValidPeer(_ context.Context, _ string, _ *nbpeer.Peer, _ []string, _ *types.ExtraSettings) (bool, bool, error) {
-	return false, false, nil
+func (v *IntegratedValidatorImpl) IsNotValidPeer(_ context.Context, _ string, peer *nbpeer.Peer, _ []string, extraSettings *types.ExtraSettings) (bool, bool, error) {
+	return approvalEnabled(extraSettings) && isPending(peer), false, nil
 }
 
-func (v *IntegratedValidatorImpl) GetValidatedPeers(_ context.Context, _ string, _ []*types.Group, peers []*nbpeer.Peer, _ *types.ExtraSettings) (map[string]struct{}, error) {
+func (v *IntegratedValidatorImpl) GetValidatedPeers(_ context.Context, _ string, _ []*types.Group, peers []*nbpeer.Peer, extraSettings *types.ExtraSettings) (map[string]struct{}, error) {
+	enabled := approvalEnabled(extraSettings)
 	validatedPeers := make(map[string]struct{})
 	for _, p := range peers {
+		if enabled && isPending(p) {
+			continue
+		}
 		validatedPeers[p.ID] = struct{}{}
 	}
 	return validatedPeers, nil
 }
 
-func (v *IntegratedValidatorImpl) GetInvalidPeers(_ context.Context, _ string, _ *types.ExtraSettings) (map[string]string, error) {
-	return make(map[string]string), nil
+func (v *IntegratedValidatorImpl) GetInvalidPeers(ctx context.Context, accountID string, extraSettings *types.ExtraSettings) (map[string]string, error) {
+	invalidPeers := make(map[string]string)
+	if !approvalEnabled(extraSettings) || v.peersManager == nil {
+		return invalidPeers, nil
+	}
+
+	accountPeers, err := v.peersManager.GetAllPeers(ctx, accountID, activity.SystemInitiator)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range accountPeers {
+		if isPending(p) {
+			invalidPeers[p.ID] = pendingApprovalReason
+		}
+	}
+	return invalidPeers, nil
 }
 
 func (v *IntegratedValidatorImpl) PeerDeleted(_ context.Context, _, _ string, _ *types.ExtraSettings) error {
@@ -60,3 +133,11 @@ func (v *IntegratedValidatorImpl) Stop(_ context.Context) {
 func (v *IntegratedValidatorImpl) ValidateFlowResponse(_ context.Context, _ string, flowResponse *proto.PKCEAuthorizationFlow) *proto.PKCEAuthorizationFlow {
 	return flowResponse
 }
+
+func approvalEnabled(extraSettings *types.ExtraSettings) bool {
+	return extraSettings != nil && extraSettings.PeerApprovalEnabled
+}
+
+func isPending(peer *nbpeer.Peer) bool {
+	return peer.Status != nil && peer.Status.RequiresApproval
+}
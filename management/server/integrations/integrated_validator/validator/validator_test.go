@@ -0,0 +1,127 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/netbirdio/netbird/management/internals/modules/peers"
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func newPeer(id string, pending bool) *nbpeer.Peer {
+	return &nbpeer.Peer{
+		ID:     id,
+		UserID: "user-1",
+		Meta:   nbpeer.PeerSystemMeta{GoOS: "linux", SystemSerialNumber: "SN-" + id},
+		Status: &nbpeer.PeerStatus{RequiresApproval: pending},
+	}
+}
+
+func TestPreparePeer(t *testing.T) {
+	v := &IntegratedValidatorImpl{}
+	ctx := context.Background()
+	extra := &types.ExtraSettings{
+		PeerApprovalEnabled:       true,
+		IntegratedValidatorGroups: []string{"trusted"},
+		PeerApprovalRules: []types.PeerApprovalRule{
+			{Attribute: types.PeerApprovalRuleAttributeSerialNumber, Values: []string{"SN-known"}},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		peer      *nbpeer.Peer
+		groups    []string
+		extra     *types.ExtraSettings
+		temporary bool
+		pending   bool
+	}{
+		{name: "approval disabled", peer: newPeer("a", false), extra: &types.ExtraSettings{}, pending: false},
+		{name: "no extra settings", peer: newPeer("a", false), pending: false},
+		{name: "pending", peer: newPeer("a", false), extra: extra, pending: true},
+		{name: "temporary peer", peer: newPeer("a", false), extra: extra, temporary: true, pending: false},
+		{name: "validator group", peer: newPeer("a", false), groups: []string{"other", "trusted"}, extra: extra, pending: false},
+		{name: "matching rule", peer: newPeer("known", false), extra: extra, pending: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prepared := v.PreparePeer(ctx, "account", tt.peer, tt.groups, tt.extra, tt.temporary)
+			assert.Equal(t, tt.pending, prepared.Status.RequiresApproval)
+			assert.False(t, tt.peer.Status.RequiresApproval, "the original peer is left untouched")
+		})
+	}
+}
+
+func TestValidatePeer(t *testing.T) {
+	v := &IntegratedValidatorImpl{}
+	ctx := context.Background()
+	enabled := &types.ExtraSettings{PeerApprovalEnabled: true}
+
+	update := newPeer("a", false)
+	update.Status = nil
+	_, changed, err := v.ValidatePeer(ctx, update, newPeer("a", true), "user", "account", "", nil, enabled)
+	require.NoError(t, err)
+	assert.False(t, changed, "updates without a status keep the approval state")
+
+	_, changed, err = v.ValidatePeer(ctx, newPeer("a", false), newPeer("a", true), "user", "account", "", nil, enabled)
+	require.NoError(t, err)
+	assert.True(t, changed, "approving a pending peer")
+
+	_, changed, err = v.ValidatePeer(ctx, newPeer("a", true), newPeer("a", false), "user", "account", "", nil, enabled)
+	require.NoError(t, err)
+	assert.True(t, changed, "revoking the approval")
+
+	_, _, err = v.ValidatePeer(ctx, newPeer("a", true), newPeer("a", false), "user", "account", "", nil, &types.ExtraSettings{})
+	assert.Error(t, err, "approval can't be required while the workflow is disabled")
+}
+
+func TestValidatedAndInvalidPeers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	peersManager := peers.NewMockManager(ctrl)
+	accountPeers := []*nbpeer.Peer{newPeer("approved", false), newPeer("pending", true)}
+	peersManager.EXPECT().GetAllPeers(gomock.Any(), "account", activity.SystemInitiator).Return(accountPeers, nil)
+
+	v := &IntegratedValidatorImpl{peersManager: peersManager}
+	ctx := context.Background()
+	enabled := &types.ExtraSettings{PeerApprovalEnabled: true}
+
+	valid, err := v.GetValidatedPeers(ctx, "account", nil, accountPeers, enabled)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]struct{}{"approved": {}}, valid)
+
+	valid, err = v.GetValidatedPeers(ctx, "account", nil, accountPeers, &types.ExtraSettings{})
+	require.NoError(t, err)
+	assert.Len(t, valid, 2, "pending flags are ignored while the workflow is disabled")
+
+	invalid, err := v.GetInvalidPeers(ctx, "account", enabled)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"pending": pendingApprovalReason}, invalid)
+
+	notValid, _, err := v.IsNotValidPeer(ctx, "account", accountPeers[1], nil, enabled)
+	require.NoError(t, err)
+	assert.True(t, notValid)
+
+	notValid, _, err = v.IsNotValidPeer(ctx, "account", accountPeers[1], nil, nil)
+	require.NoError(t, err)
+	assert.False(t, notValid)
+}
+
+func TestValidateExtraSettings(t *testing.T) {
+	v := &IntegratedValidatorImpl{}
+	ctx := context.Background()
+
+	assert.NoError(t, v.ValidateExtraSettings(ctx, nil, nil, "user", "account"))
+	assert.NoError(t, v.ValidateExtraSettings(ctx, &types.ExtraSettings{PeerApprovalRules: []types.PeerApprovalRule{
+		{Attribute: types.PeerApprovalRuleAttributeUser, Values: []string{"user-1"}},
+	}}, nil, "user", "account"))
+	assert.Error(t, v.ValidateExtraSettings(ctx, &types.ExtraSettings{PeerApprovalRules: []types.PeerApprovalRule{
+		{Attribute: types.PeerApprovalRuleAttributeUser},
+	}}, nil, "user", "account"))
+}
@@ -0,0 +1,133 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/golang/protobuf/proto" // nolint
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/internals/shared/updatebus"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/shared/management/proto"
+)
+
+var (
+	// ErrPeerNotConnected is returned by SendJob when no instance holds a job stream of the peer.
+	ErrPeerNotConnected = errors.New("peer not connected")
+	// ErrPeerHasPendingJob is returned by SendJob when the peer did not answer its previous job yet.
+	ErrPeerHasPendingJob = errors.New("peer already has pending job")
+)
+
+// SetUpdateBus lets the manager send jobs to peers connected to other management instances and accept
+// jobs from them for the peers connected to this one.
+func (jm *Manager) SetUpdateBus(bus updatebus.Bus) {
+	jm.bus = bus
+	bus.Subscribe(jm.handleBusMessage)
+}
+
+// sendRemoteJob hands the job to the instance holding the job stream of the peer and waits for it to
+// confirm the job was queued. The job response is handled by that instance.
+func (jm *Manager) sendRemoteJob(ctx context.Context, accountID, peerID string, req *proto.JobRequest) error {
+	peer, err := jm.Store.GetPeerByID(ctx, store.LockingStrengthNone, accountID, peerID)
+	if err != nil {
+		return err
+	}
+	if peer.Status == nil || !peer.Status.Connected {
+		return fmt.Errorf("peer %s has no channel: %w", peerID, ErrPeerNotConnected)
+	}
+
+	payload, err := pb.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to encode job request: %w", err)
+	}
+
+	requestID := string(req.ID)
+	result := make(chan string, 1)
+	jm.mu.Lock()
+	jm.remoteResults[requestID] = result
+	jm.mu.Unlock()
+	defer func() {
+		jm.mu.Lock()
+		delete(jm.remoteResults, requestID)
+		jm.mu.Unlock()
+	}()
+
+	err = jm.bus.Publish(ctx, &updatebus.Message{
+		Type:      updatebus.MessageJobDispatch,
+		AccountID: accountID,
+		PeerIDs:   []string{peerID},
+		RequestID: requestID,
+		Payload:   payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish job: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(jm.remoteWait):
+		return fmt.Errorf("no instance accepted the job for peer %s: %w", peerID, ErrPeerNotConnected)
+	case errMsg := <-result:
+		switch errMsg {
+		case "":
+			return nil
+		case ErrPeerHasPendingJob.Error():
+			return ErrPeerHasPendingJob
+		default:
+			return errors.New(errMsg)
+		}
+	}
+}
+
+func (jm *Manager) handleBusMessage(ctx context.Context, msg *updatebus.Message) {
+	switch msg.Type {
+	case updatebus.MessageJobDispatch:
+		if len(msg.PeerIDs) != 1 {
+			return
+		}
+		jm.mu.RLock()
+		ch, ok := jm.jobChannels[msg.PeerIDs[0]]
+		jm.mu.RUnlock()
+		if !ok {
+			// another instance holds the stream of the peer, or none does and the sender times out
+			return
+		}
+		go jm.handleRemoteJob(ctx, ch, msg)
+	case updatebus.MessageJobResult:
+		jm.mu.RLock()
+		result, ok := jm.remoteResults[msg.RequestID]
+		jm.mu.RUnlock()
+		if !ok {
+			return
+		}
+		select {
+		case result <- msg.Error:
+		default:
+		}
+	}
+}
+
+func (jm *Manager) handleRemoteJob(ctx context.Context, ch *Channel, msg *updatebus.Message) {
+	reply := &updatebus.Message{
+		Type:      updatebus.MessageJobResult,
+		Target:    msg.Source,
+		AccountID: msg.AccountID,
+		PeerIDs:   msg.PeerIDs,
+		RequestID: msg.RequestID,
+	}
+
+	req := &proto.JobRequest{}
+	if err := pb.Unmarshal(msg.Payload, req); err != nil {
+		reply.Error = fmt.Sprintf("invalid job request: %v", err)
+	} else if err := jm.sendLocalJob(ctx, msg.AccountID, msg.PeerIDs[0], ch, req); err != nil {
+		reply.Error = err.Error()
+	}
+
+	if err := jm.bus.Publish(ctx, reply); err != nil {
+		log.WithContext(ctx).Errorf("failed to publish the result of job %s for peer %s: %v", msg.RequestID, msg.PeerIDs[0], err)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/management/internals/modules/peers"
+	"github.com/netbirdio/netbird/management/internals/shared/updatebus"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/telemetry"
 	"github.com/netbirdio/netbird/management/server/types"
@@ -29,18 +30,25 @@ type Manager struct {
 	metrics      telemetry.AppMetrics
 	Store        store.Store
 	peersManager peers.Manager
+
+	// bus routes jobs to peers connected to other management instances, nil when running a single instance.
+	bus           updatebus.Bus
+	remoteWait    time.Duration
+	remoteResults map[string]chan string // request ID → error of a job dispatched to another instance
 }
 
 func NewJobManager(metrics telemetry.AppMetrics, store store.Store, peersManager peers.Manager) *Manager {
 
 	return &Manager{
-		jobChannels:  make(map[string]*Channel),
-		pending:      make(map[string]*Event),
-		responseWait: 5 * time.Minute,
-		metrics:      metrics,
-		mu:           &sync.RWMutex{},
-		Store:        store,
-		peersManager: peersManager,
+		jobChannels:   make(map[string]*Channel),
+		pending:       make(map[string]*Event),
+		responseWait:  5 * time.Minute,
+		metrics:       metrics,
+		mu:            &sync.RWMutex{},
+		Store:         store,
+		peersManager:  peersManager,
+		remoteWait:    5 * time.Second,
+		remoteResults: make(map[string]chan string),
 	}
 }
 
@@ -64,13 +72,27 @@ func (jm *Manager) CreateJobChannel(ctx context.Context, accountID, peerID strin
 	return ch
 }
 
-// SendJob sends a job to a peer and tracks it as pending
+// SendJob sends a job to a peer and tracks it as pending. Jobs for peers connected to another instance
+// are routed over the update bus when one is set.
 func (jm *Manager) SendJob(ctx context.Context, accountID, peerID string, req *proto.JobRequest) error {
 	jm.mu.RLock()
 	ch, ok := jm.jobChannels[peerID]
 	jm.mu.RUnlock()
 	if !ok {
-		return fmt.Errorf("peer %s has no channel", peerID)
+		if jm.bus != nil {
+			return jm.sendRemoteJob(ctx, accountID, peerID, req)
+		}
+		return fmt.Errorf("peer %s has no channel: %w", peerID, ErrPeerNotConnected)
+	}
+
+	return jm.sendLocalJob(ctx, accountID, peerID, ch, req)
+}
+
+func (jm *Manager) sendLocalJob(ctx context.Context, accountID, peerID string, ch *Channel, req *proto.JobRequest) error {
+	// todo: the pending check and the registration below are not atomic, two concurrent jobs for the same
+	// peer can both pass the check
+	if jm.IsPeerHasPendingJobs(peerID) {
+		return ErrPeerHasPendingJob
 	}
 
 	event := &Event{
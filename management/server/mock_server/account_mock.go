@@ -61,12 +61,19 @@ type MockAccountManager struct {
 	SavePolicyFunc                        func(ctx context.Context, accountID, userID string, policy *types.Policy, create bool) (*types.Policy, error)
 	DeletePolicyFunc                      func(ctx context.Context, accountID, policyID, userID string) error
 	ListPoliciesFunc                      func(ctx context.Context, accountID, userID string) ([]*types.Policy, error)
+	SimulateAccessFunc                    func(ctx context.Context, accountID, userID string, req *types.AccessSimulationRequest) ([]*types.AccessSimulationResult, error)
+	GetAccessRequestsFunc                 func(ctx context.Context, accountID, userID string) ([]*types.AccessRequest, error)
+	GetAccessRequestFunc                  func(ctx context.Context, accountID, userID, requestID string) (*types.AccessRequest, error)
+	CreateAccessRequestFunc               func(ctx context.Context, accountID, userID string, request *types.AccessRequest) (*types.AccessRequest, error)
+	ApproveAccessRequestFunc              func(ctx context.Context, accountID, userID, requestID, comment string) (*types.AccessRequest, error)
+	DenyAccessRequestFunc                 func(ctx context.Context, accountID, userID, requestID, comment string) (*types.AccessRequest, error)
+	RevokeAccessRequestFunc               func(ctx context.Context, accountID, userID, requestID string) (*types.AccessRequest, error)
 	GetUsersFromAccountFunc               func(ctx context.Context, accountID, userID string) (map[string]*types.UserInfo, error)
 	UpdatePeerMetaFunc                    func(ctx context.Context, peerID string, meta nbpeer.PeerSystemMeta) error
 	UpdatePeerFunc                        func(ctx context.Context, accountID, userID string, peer *nbpeer.Peer) (*nbpeer.Peer, error)
 	UpdatePeerIPFunc                      func(ctx context.Context, accountID, userID, peerID string, newIP netip.Addr) error
 	UpdatePeerIPv6Func                    func(ctx context.Context, accountID, userID, peerID string, newIPv6 netip.Addr) error
-	CreateRouteFunc                       func(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peer string, peerGroups []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool, isSelected bool) (*route.Route, error)
+	CreateRouteFunc                       func(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peer string, peerGroups []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool, isSelected bool, distributionLabels map[string]string) (*route.Route, error)
 	GetRouteFunc                          func(ctx context.Context, accountID string, routeID route.ID, userID string) (*route.Route, error)
 	SaveRouteFunc                         func(ctx context.Context, accountID string, userID string, route *route.Route) error
 	DeleteRouteFunc                       func(ctx context.Context, accountID string, routeID route.ID, userID string) error
@@ -79,7 +86,7 @@ type MockAccountManager struct {
 	DeleteUserFunc                        func(ctx context.Context, accountID string, initiatorUserID string, targetUserID string) error
 	DeleteRegularUsersFunc                func(ctx context.Context, accountID, initiatorUserID string, targetUserIDs []string, userInfos map[string]*types.UserInfo) error
 	UpdateUserPasswordFunc                func(ctx context.Context, accountID, currentUserID, targetUserID string, oldPassword, newPassword string) error
-	CreatePATFunc                         func(ctx context.Context, accountID string, initiatorUserID string, targetUserId string, tokenName string, expiresIn int) (*types.PersonalAccessTokenGenerated, error)
+	CreatePATFunc                         func(ctx context.Context, accountID string, initiatorUserID string, targetUserId string, tokenName string, expiresIn int, scopes []types.PATScope) (*types.PersonalAccessTokenGenerated, error)
 	DeletePATFunc                         func(ctx context.Context, accountID string, initiatorUserID string, targetUserId string, tokenID string) error
 	GetPATFunc                            func(ctx context.Context, accountID string, initiatorUserID string, targetUserId string, tokenID string) (*types.PersonalAccessToken, error)
 	GetAllPATsFunc                        func(ctx context.Context, accountID string, initiatorUserID string, targetUserId string) ([]*types.PersonalAccessToken, error)
@@ -93,7 +100,7 @@ type MockAccountManager struct {
 	DeleteAccountFunc                     func(ctx context.Context, accountID, userID string) error
 	GetDNSDomainFunc                      func(settings *types.Settings) string
 	StoreEventFunc                        func(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any)
-	GetEventsFunc                         func(ctx context.Context, accountID, userID string) ([]*activity.Event, error)
+	GetEventsFunc                         func(ctx context.Context, accountID, userID string, filter activity.Filter) ([]*activity.Event, error)
 	GetDNSSettingsFunc                    func(ctx context.Context, accountID, userID string) (*types.DNSSettings, error)
 	SaveDNSSettingsFunc                   func(ctx context.Context, accountID, userID string, dnsSettingsToSave *types.DNSSettings) error
 	GetPeerFunc                           func(ctx context.Context, accountID, peerID, userID string) (*nbpeer.Peer, error)
@@ -369,9 +376,9 @@ func (am *MockAccountManager) DeleteAccount(ctx context.Context, accountID, user
 }
 
 // CreatePAT mock implementation of GetPAT from server.AccountManager interface
-func (am *MockAccountManager) CreatePAT(ctx context.Context, accountID string, initiatorUserID string, targetUserID string, name string, expiresIn int) (*types.PersonalAccessTokenGenerated, error) {
+func (am *MockAccountManager) CreatePAT(ctx context.Context, accountID string, initiatorUserID string, targetUserID string, name string, expiresIn int, scopes []types.PATScope) (*types.PersonalAccessTokenGenerated, error) {
 	if am.CreatePATFunc != nil {
-		return am.CreatePATFunc(ctx, accountID, initiatorUserID, targetUserID, name, expiresIn)
+		return am.CreatePATFunc(ctx, accountID, initiatorUserID, targetUserID, name, expiresIn, scopes)
 	}
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePAT is not implemented")
 }
@@ -527,6 +534,62 @@ func (am *MockAccountManager) ListPolicies(ctx context.Context, accountID, userI
 	return nil, status.Errorf(codes.Unimplemented, "method ListPolicies is not implemented")
 }
 
+// SimulateAccess mock implementation of SimulateAccess from server.AccountManager interface
+func (am *MockAccountManager) SimulateAccess(ctx context.Context, accountID, userID string, req *types.AccessSimulationRequest) ([]*types.AccessSimulationResult, error) {
+	if am.SimulateAccessFunc != nil {
+		return am.SimulateAccessFunc(ctx, accountID, userID, req)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAccess is not implemented")
+}
+
+// GetAccessRequests mock implementation of GetAccessRequests from server.AccountManager interface
+func (am *MockAccountManager) GetAccessRequests(ctx context.Context, accountID, userID string) ([]*types.AccessRequest, error) {
+	if am.GetAccessRequestsFunc != nil {
+		return am.GetAccessRequestsFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessRequests is not implemented")
+}
+
+// GetAccessRequest mock implementation of GetAccessRequest from server.AccountManager interface
+func (am *MockAccountManager) GetAccessRequest(ctx context.Context, accountID, userID, requestID string) (*types.AccessRequest, error) {
+	if am.GetAccessRequestFunc != nil {
+		return am.GetAccessRequestFunc(ctx, accountID, userID, requestID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessRequest is not implemented")
+}
+
+// CreateAccessRequest mock implementation of CreateAccessRequest from server.AccountManager interface
+func (am *MockAccountManager) CreateAccessRequest(ctx context.Context, accountID, userID string, request *types.AccessRequest) (*types.AccessRequest, error) {
+	if am.CreateAccessRequestFunc != nil {
+		return am.CreateAccessRequestFunc(ctx, accountID, userID, request)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessRequest is not implemented")
+}
+
+// ApproveAccessRequest mock implementation of ApproveAccessRequest from server.AccountManager interface
+func (am *MockAccountManager) ApproveAccessRequest(ctx context.Context, accountID, userID, requestID, comment string) (*types.AccessRequest, error) {
+	if am.ApproveAccessRequestFunc != nil {
+		return am.ApproveAccessRequestFunc(ctx, accountID, userID, requestID, comment)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest is not implemented")
+}
+
+// DenyAccessRequest mock implementation of DenyAccessRequest from server.AccountManager interface
+func (am *MockAccountManager) DenyAccessRequest(ctx context.Context, accountID, userID, requestID, comment string) (*types.AccessRequest, error) {
+	if am.DenyAccessRequestFunc != nil {
+		return am.DenyAccessRequestFunc(ctx, accountID, userID, requestID, comment)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method DenyAccessRequest is not implemented")
+}
+
+// RevokeAccessRequest mock implementation of RevokeAccessRequest from server.AccountManager interface
+func (am *MockAccountManager) RevokeAccessRequest(ctx context.Context, accountID, userID, requestID string) (*types.AccessRequest, error) {
+	if am.RevokeAccessRequestFunc != nil {
+		return am.RevokeAccessRequestFunc(ctx, accountID, userID, requestID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccessRequest is not implemented")
+}
+
 // UpdatePeerMeta mock implementation of UpdatePeerMeta from server.AccountManager interface
 func (am *MockAccountManager) UpdatePeerMeta(ctx context.Context, peerID string, meta nbpeer.PeerSystemMeta) error {
 	if am.UpdatePeerMetaFunc != nil {
@@ -573,9 +636,9 @@ func (am *MockAccountManager) UpdatePeerIPv6(ctx context.Context, accountID, use
 }
 
 // CreateRoute mock implementation of CreateRoute from server.AccountManager interface
-func (am *MockAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupID []string, enabled bool, userID string, keepRoute bool, isSelected bool) (*route.Route, error) {
+func (am *MockAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupID []string, enabled bool, userID string, keepRoute bool, isSelected bool, distributionLabels map[string]string) (*route.Route, error) {
 	if am.CreateRouteFunc != nil {
-		return am.CreateRouteFunc(ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupID, enabled, userID, keepRoute, isSelected)
+		return am.CreateRouteFunc(ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupID, enabled, userID, keepRoute, isSelected, distributionLabels)
 	}
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRoute is not implemented")
 }
@@ -822,9 +885,9 @@ func (am *MockAccountManager) GetDNSDomain(settings *types.Settings) string {
 }
 
 // GetEvents mocks GetEvents of the AccountManager interface
-func (am *MockAccountManager) GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error) {
+func (am *MockAccountManager) GetEvents(ctx context.Context, accountID, userID string, filter activity.Filter) ([]*activity.Event, error) {
 	if am.GetEventsFunc != nil {
-		return am.GetEventsFunc(ctx, accountID, userID)
+		return am.GetEventsFunc(ctx, accountID, userID, filter)
 	}
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvents is not implemented")
 }
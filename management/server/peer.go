@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	b64 "encoding/base64"
+	"errors"
 	"fmt"
 	"net"
 	"net/netip"
@@ -17,6 +18,7 @@ import (
 
 	nbdns "github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/management/server/idp"
+	"github.com/netbirdio/netbird/management/server/job"
 	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
 	"github.com/netbirdio/netbird/management/server/permissions/modules"
 	"github.com/netbirdio/netbird/management/server/permissions/operations"
@@ -240,7 +242,7 @@ func (am *DefaultAccountManager) resolvePeerLocation(ctx context.Context, peer *
 
 // UpdatePeer updates peer. Only Peer.Name, Peer.SSHEnabled, Peer.LoginExpirationEnabled and Peer.InactivityExpirationEnabled can be updated.
 func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, userID string, update *nbpeer.Peer) (*nbpeer.Peer, error) {
-	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Peers, operations.Update)
+	allowed, ctx, err := am.permissionsManager.ValidateUserResourcePermissions(ctx, accountID, userID, modules.Peers, operations.Update, update.ID)
 	if err != nil {
 		return nil, status.NewPermissionValidationError(err)
 	}
@@ -255,6 +257,8 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 	var sshChanged bool
 	var loginExpirationChanged bool
 	var inactivityExpirationChanged bool
+	var approvalChanged bool
+	var labelsChanged bool
 	var dnsDomain string
 
 	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
@@ -279,11 +283,22 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 
 		dnsDomain = am.networkMapController.GetDNSDomain(settings)
 
-		update, _, err = am.integratedPeerValidator.ValidatePeer(ctx, update, peer, userID, accountID, dnsDomain, peerGroupList, settings.Extra)
+		var requiresUpdate bool
+		update, requiresUpdate, err = am.integratedPeerValidator.ValidatePeer(ctx, update, peer, userID, accountID, dnsDomain, peerGroupList, settings.Extra)
 		if err != nil {
 			return err
 		}
 
+		if requiresUpdate && update.Status != nil {
+			if peer.Status == nil {
+				peer.Status = &nbpeer.PeerStatus{}
+			}
+			if peer.Status.RequiresApproval != update.Status.RequiresApproval {
+				peer.Status.RequiresApproval = update.Status.RequiresApproval
+				approvalChanged = true
+			}
+		}
+
 		if peer.Name != update.Name {
 			var newLabel string
 
@@ -329,6 +344,14 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 			inactivityExpirationChanged = true
 		}
 
+		if update.Labels != nil && !maps.Equal(peer.Labels, update.Labels) {
+			if err = nbpeer.ValidateLabels(update.Labels); err != nil {
+				return status.Errorf(status.InvalidArgument, "invalid peer labels: %v", err)
+			}
+			peer.Labels = update.Labels
+			labelsChanged = true
+		}
+
 		if err = transaction.IncrementNetworkSerial(ctx, accountID); err != nil {
 			return fmt.Errorf("failed to increment network serial: %w", err)
 		}
@@ -351,6 +374,14 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 		am.StoreEvent(ctx, userID, peer.ID, accountID, activity.PeerRenamed, peer.EventMeta(dnsDomain))
 	}
 
+	if approvalChanged {
+		event := activity.PeerApproved
+		if peer.Status.RequiresApproval {
+			event = activity.PeerApprovalRevoked
+		}
+		am.StoreEvent(ctx, userID, peer.ID, accountID, event, peer.EventMeta(dnsDomain))
+	}
+
 	if loginExpirationChanged {
 		event := activity.PeerLoginExpirationEnabled
 		if !peer.LoginExpirationEnabled {
@@ -376,6 +407,12 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 		}
 	}
 
+	if labelsChanged {
+		am.StoreEvent(ctx, userID, peer.ID, accountID, activity.PeerLabelsUpdated, peer.EventMeta(dnsDomain))
+		// label selectors are resolved at network map calculation and not tracked by the affected peers resolver
+		am.BufferUpdateAccountPeers(ctx, accountID, types.UpdateReason{Resource: types.UpdateResourcePeer, Operation: types.UpdateOperationUpdate})
+	}
+
 	changedPeerIDs := []string{peer.ID}
 	affectedPeerIDs := am.resolveAffectedPeersForPeerChanges(ctx, am.Store, accountID, changedPeerIDs)
 	affectedPeerIDs = append(affectedPeerIDs, peer.ID)
@@ -387,6 +424,18 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 	return peer, nil
 }
 
+// sendJobError maps a job manager error to the status error returned to the API.
+func sendJobError(err error) error {
+	switch {
+	case errors.Is(err, job.ErrPeerNotConnected):
+		return status.Errorf(status.BadRequest, "peer not connected")
+	case errors.Is(err, job.ErrPeerHasPendingJob):
+		return status.Errorf(status.BadRequest, "peer already has pending job")
+	default:
+		return status.Errorf(status.Internal, "failed to send job: %v", err)
+	}
+}
+
 func (am *DefaultAccountManager) CreatePeerJob(ctx context.Context, accountID, peerID, userID string, job *types.Job) error {
 	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.RemoteJobs, operations.Create)
 	if err != nil {
@@ -410,26 +459,15 @@ func (am *DefaultAccountManager) CreatePeerJob(ctx context.Context, accountID, p
 		return status.Errorf(status.PreconditionFailed, "peer version %s does not meet the minimum required version %s for remote jobs", p.Meta.WtVersion, remoteJobsMinVer)
 	}
 
-	if !am.jobManager.IsPeerConnected(peerID) {
-		return status.Errorf(status.BadRequest, "peer not connected")
-	}
-
-	// check if already has pending jobs
-	// todo: The job checks here are not protected. The user can run this function from multiple threads,
-	// and each thread can think there is no job yet. This means entries in the pending job map will be overwritten,
-	// and only one will be kept, but potentially another one will overwrite it in the queue.
-	if am.jobManager.IsPeerHasPendingJobs(peerID) {
-		return status.Errorf(status.BadRequest, "peer already has pending job")
-	}
-
 	jobStream, err := job.ToStreamJobRequest()
 	if err != nil {
 		return status.Errorf(status.BadRequest, "invalid job request %v", err)
 	}
 
-	// try sending job first
+	// try sending job first, the job manager checks that the peer is connected to this or another
+	// instance and has no pending job
 	if err := am.jobManager.SendJob(ctx, accountID, peerID, jobStream); err != nil {
-		return status.Errorf(status.Internal, "failed to send job: %v", err)
+		return sendJobError(err)
 	}
 
 	var peer *nbpeer.Peer
@@ -517,7 +555,7 @@ func (am *DefaultAccountManager) GetPeerJobByID(ctx context.Context, accountID,
 
 // DeletePeer removes peer from the account by its IP
 func (am *DefaultAccountManager) DeletePeer(ctx context.Context, accountID, peerID, userID string) error {
-	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Peers, operations.Delete)
+	allowed, ctx, err := am.permissionsManager.ValidateUserResourcePermissions(ctx, accountID, userID, modules.Peers, operations.Delete, peerID)
 	if err != nil {
 		return status.NewPermissionValidationError(err)
 	}
@@ -645,11 +683,21 @@ type peerAddAuthConfig struct {
 	SetupKeyID          string
 	SetupKeyName        string
 	GroupsToAdd         []string
+	Labels              map[string]string
 	AllowExtraDNSLabels bool
 	Ephemeral           bool
+
+	WorkloadIdentityProviderName string
+	WorkloadIdentitySubject      string
+}
+
+// workloadIdentityLogin is the workload identity token a peer enrolls with instead of a setup key
+type workloadIdentityLogin struct {
+	ProviderID string
+	Token      string
 }
 
-func (am *DefaultAccountManager) processPeerAddAuth(ctx context.Context, accountID, userID, encodedHashedKey string, peer *nbpeer.Peer, temporary, addedByUser, addedBySetupKey bool, opEvent *activity.Event) (*peerAddAuthConfig, error) {
+func (am *DefaultAccountManager) processPeerAddAuth(ctx context.Context, accountID, userID, encodedHashedKey string, workloadIdentity *workloadIdentityLogin, peer *nbpeer.Peer, temporary, addedByUser, addedBySetupKey bool, opEvent *activity.Event) (*peerAddAuthConfig, error) {
 	config := &peerAddAuthConfig{
 		AccountID: accountID,
 		Ephemeral: peer.Ephemeral,
@@ -664,6 +712,10 @@ func (am *DefaultAccountManager) processPeerAddAuth(ctx context.Context, account
 		if err := am.handleSetupKeyAddedPeer(ctx, encodedHashedKey, peer, opEvent, config); err != nil {
 			return nil, err
 		}
+	case workloadIdentity != nil:
+		if err := am.handleWorkloadIdentityAddedPeer(ctx, workloadIdentity, peer, opEvent, config); err != nil {
+			return nil, err
+		}
 	default:
 		if peer.ProxyMeta.Embedded {
 			log.WithContext(ctx).Debugf("adding peer for proxy embedded, accountID: %s", accountID)
@@ -721,6 +773,21 @@ func (am *DefaultAccountManager) handleSetupKeyAddedPeer(ctx context.Context, en
 		return status.Errorf(status.PreconditionFailed, "couldn't add peer: setup key doesn't allow extra DNS labels")
 	}
 
+	reason, err := checkSetupKeyRestrictions(ctx, am.Store, sk, peer)
+	if err != nil {
+		return err
+	}
+	if reason != nil {
+		am.StoreEvent(ctx, sk.Id, sk.Id, sk.AccountID, activity.SetupKeyEnrollmentRejected, map[string]any{
+			"name":          sk.Name,
+			"reason":        reason.Error(),
+			"hostname":      peer.Meta.Hostname,
+			"os":            peer.Meta.GoOS,
+			"connection_ip": peer.Location.ConnectionIP.String(),
+		})
+		return status.Errorf(status.PermissionDenied, "couldn't add peer: %v", reason)
+	}
+
 	opEvent.InitiatorID = sk.Id
 	opEvent.Activity = activity.PeerAddedWithSetupKey
 	config.GroupsToAdd = sk.AutoGroups
@@ -728,11 +795,66 @@ func (am *DefaultAccountManager) handleSetupKeyAddedPeer(ctx context.Context, en
 	config.SetupKeyID = sk.Id
 	config.SetupKeyName = sk.Name
 	config.AllowExtraDNSLabels = sk.AllowExtraDNSLabels
+	config.Labels = sk.Labels
 	config.AccountID = sk.AccountID
 
 	return nil
 }
 
+func (am *DefaultAccountManager) handleWorkloadIdentityAddedPeer(ctx context.Context, workloadIdentity *workloadIdentityLogin, peer *nbpeer.Peer, opEvent *activity.Event, config *peerAddAuthConfig) error {
+	provider, err := am.Store.GetWorkloadIdentityProvider(ctx, store.LockingStrengthNone, workloadIdentity.ProviderID)
+	if err != nil {
+		return status.Errorf(status.NotFound, "couldn't add peer: workload identity provider is invalid")
+	}
+
+	if !provider.Enabled {
+		return status.Errorf(status.NotFound, "couldn't add peer: workload identity provider is invalid")
+	}
+
+	claims, err := am.workloadIdentityVerifier.Verify(ctx, provider, workloadIdentity.Token)
+	if err != nil {
+		log.WithContext(ctx).Debugf("workload identity token rejected by provider %s: %v", provider.ID, err)
+		am.StoreEvent(ctx, provider.ID, provider.ID, provider.AccountID, activity.WorkloadIdentityEnrollmentRejected, map[string]any{
+			"name":          provider.Name,
+			"reason":        err.Error(),
+			"hostname":      peer.Meta.Hostname,
+			"os":            peer.Meta.GoOS,
+			"connection_ip": peer.Location.ConnectionIP.String(),
+		})
+		return status.Errorf(status.PermissionDenied, "couldn't add peer: %v", err)
+	}
+
+	opEvent.InitiatorID = provider.ID
+	opEvent.Activity = activity.PeerAddedWithWorkloadIdentity
+	config.GroupsToAdd = provider.AutoGroups
+	config.Ephemeral = provider.Ephemeral
+	config.AccountID = provider.AccountID
+	config.WorkloadIdentityProviderName = provider.Name
+	config.WorkloadIdentitySubject, _ = claims["sub"].(string)
+
+	return nil
+}
+
+// checkSetupKeyRestrictions returns the reason the peer is not allowed to enroll with the setup key, or a nil reason
+// when it is.
+func checkSetupKeyRestrictions(ctx context.Context, s store.Store, sk *types.SetupKey, peer *nbpeer.Peer) (reason error, err error) {
+	if sk.Restrictions.IsEmpty() {
+		return nil, nil
+	}
+	if reason = sk.Restrictions.Check(peer); reason != nil {
+		return reason, nil
+	}
+	if sk.Restrictions.MaxPeersPerHostname == 0 {
+		return nil, nil
+	}
+
+	enrolled, err := s.CountSetupKeyPeersByHostname(ctx, sk.AccountID, sk.Id, peer.Meta.Hostname)
+	if err != nil {
+		return nil, err
+	}
+	return sk.Restrictions.CheckHostnameLimit(peer.Meta.Hostname, int(enrolled)), nil
+}
+
 // AddPeer adds a new peer to the Store.
 // Each Account has a list of pre-authorized SetupKey and if no Account has a given key err with a code status.PermissionDenied
 // will be returned, meaning the setup key is invalid or not found.
@@ -741,7 +863,12 @@ func (am *DefaultAccountManager) handleSetupKeyAddedPeer(ctx context.Context, en
 // Each new Peer will be assigned a new next net.IP from the Account.Network and Account.Network.LastIP will be updated (IP's are not reused).
 // The peer property is just a placeholder for the Peer properties to pass further
 func (am *DefaultAccountManager) AddPeer(ctx context.Context, accountID, setupKey, userID string, peer *nbpeer.Peer, temporary bool) (*nbpeer.Peer, *types.Network, []*posture.Checks, bool, error) {
-	if setupKey == "" && userID == "" && !peer.ProxyMeta.Embedded {
+	return am.addPeer(ctx, accountID, setupKey, userID, nil, peer, temporary)
+}
+
+// addPeer adds a new peer like AddPeer does, additionally accepting a workload identity token instead of a setup key.
+func (am *DefaultAccountManager) addPeer(ctx context.Context, accountID, setupKey, userID string, workloadIdentity *workloadIdentityLogin, peer *nbpeer.Peer, temporary bool) (*nbpeer.Peer, *types.Network, []*posture.Checks, bool, error) {
+	if setupKey == "" && userID == "" && workloadIdentity == nil && !peer.ProxyMeta.Embedded {
 		// no auth method provided => reject access
 		return nil, nil, nil, false, status.ErrNoAuthMethodProvided
 	}
@@ -768,7 +895,7 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, accountID, setupKe
 
 	var newPeer *nbpeer.Peer
 
-	peerAddConfig, err := am.processPeerAddAuth(ctx, accountID, userID, encodedHashedKey, peer, temporary, addedByUser, addedBySetupKey, opEvent)
+	peerAddConfig, err := am.processPeerAddAuth(ctx, accountID, userID, encodedHashedKey, workloadIdentity, peer, temporary, addedByUser, addedBySetupKey, opEvent)
 	if err != nil {
 		return nil, nil, nil, false, err
 	}
@@ -796,6 +923,7 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, accountID, setupKe
 		Meta:                        peer.Meta,
 		Name:                        peer.Meta.Hostname,
 		UserID:                      userID,
+		SetupKeyID:                  peerAddConfig.SetupKeyID,
 		Status:                      &nbpeer.PeerStatus{Connected: false, LastSeen: registrationTime},
 		SSHEnabled:                  false,
 		SSHKey:                      peer.SSHKey,
@@ -808,6 +936,7 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, accountID, setupKe
 		InactivityExpirationEnabled: addedByUser && !temporary,
 		ExtraDNSLabels:              peer.ExtraDNSLabels,
 		AllowExtraDNSLabels:         peerAddConfig.AllowExtraDNSLabels,
+		Labels:                      peerAddConfig.Labels,
 	}
 	settings, err := am.Store.GetAccountSettings(ctx, store.LockingStrengthNone, accountID)
 	if err != nil {
@@ -906,6 +1035,14 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, accountID, setupKe
 				}
 			}
 
+			dynamicGroupIDs, err := applyDynamicGroupsToPeer(ctx, transaction, accountID, newPeer, nil)
+			if err != nil {
+				return fmt.Errorf("failed applying dynamic groups: %w", err)
+			}
+			if err = am.reconcileIPv6ForGroupChanges(ctx, transaction, accountID, dynamicGroupIDs); err != nil {
+				return err
+			}
+
 			switch {
 			case addedByUser:
 				err := transaction.SaveUserLastLogin(ctx, accountID, userID, newPeer.GetLastLogin())
@@ -923,6 +1060,15 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, accountID, setupKe
 					return status.Errorf(status.PreconditionFailed, "couldn't add peer: setup key is invalid")
 				}
 
+				// the hostname limit is checked again while the key is locked to not exceed it with concurrent registrations
+				reason, err := checkSetupKeyRestrictions(ctx, transaction, sk, peer)
+				if err != nil {
+					return err
+				}
+				if reason != nil {
+					return status.Errorf(status.PermissionDenied, "couldn't add peer: %v", reason)
+				}
+
 				err = transaction.IncrementSetupKeyUsage(ctx, peerAddConfig.SetupKeyID)
 				if err != nil {
 					return fmt.Errorf("failed to increment setup key usage: %w", err)
@@ -959,7 +1105,11 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, accountID, setupKe
 
 	opEvent.TargetID = newPeer.ID
 	opEvent.Meta = newPeer.EventMeta(am.networkMapController.GetDNSDomain(settings))
-	if !addedByUser {
+	switch {
+	case opEvent.Activity == activity.PeerAddedWithWorkloadIdentity:
+		opEvent.Meta["workload_identity_provider"] = peerAddConfig.WorkloadIdentityProviderName
+		opEvent.Meta["workload_identity_subject"] = peerAddConfig.WorkloadIdentitySubject
+	case !addedByUser:
 		opEvent.Meta["setup_key_name"] = peerAddConfig.SetupKeyName
 	}
 	requiresApproval := newPeer.Status != nil && newPeer.Status.RequiresApproval
@@ -981,6 +1131,10 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, accountID, setupKe
 	if err := am.networkMapController.OnPeersAdded(ctx, accountID, changedPeerIDs, affectedPeerIDs); err != nil {
 		log.WithContext(ctx).Errorf("failed to update network map cache for peer %s: %v", newPeer.ID, err)
 	}
+	if len(newPeer.Labels) > 0 {
+		// the peer may be selected by the label selectors of policies and routes
+		am.BufferUpdateAccountPeers(ctx, accountID, types.UpdateReason{Resource: types.UpdateResourcePeer, Operation: types.UpdateOperationCreate})
+	}
 
 	return newPeer, network, postureChecks, enableSSH, nil
 }
@@ -1004,6 +1158,8 @@ func (am *DefaultAccountManager) SyncPeer(ctx context.Context, sync types.PeerSy
 	var peer *nbpeer.Peer
 	var ipv6CapabilityChanged bool
 	var metaDiff nbpeer.MetaDiff
+	var dynamicGroupsSnap *affectedpeers.Snapshot
+	var dynamicGroupsChange affectedpeers.Change
 	var err error
 
 	settings, err := am.Store.GetAccountSettings(ctx, store.LockingStrengthNone, accountID)
@@ -1043,12 +1199,35 @@ func (am *DefaultAccountManager) SyncPeer(ctx context.Context, sync types.PeerSy
 				return err
 			}
 		}
-		return nil
+
+		dynamicGroupIDs, err := applyDynamicGroupsToPeer(ctx, transaction, accountID, peer, &metaDiff)
+		if err != nil {
+			return err
+		}
+		if len(dynamicGroupIDs) == 0 {
+			return nil
+		}
+
+		if err = am.reconcileIPv6ForGroupChanges(ctx, transaction, accountID, dynamicGroupIDs); err != nil {
+			return err
+		}
+
+		// like GroupAddPeer and GroupDeletePeer: the peer and the opposite side of the changed groups refresh
+		dynamicGroupsChange = affectedpeers.Change{OutputPeerIDs: []string{peer.ID}, LinkGroups: dynamicGroupIDs}
+		if dynamicGroupsSnap, err = affectedpeers.Load(ctx, transaction, accountID, dynamicGroupsChange); err != nil {
+			return err
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, accountID)
 	})
 	if err != nil {
 		return nil, nil, nil, 0, err
 	}
 
+	if dynamicGroupsSnap != nil {
+		am.ExpandAndUpdateAffected(ctx, accountID, dynamicGroupsSnap, dynamicGroupsChange)
+	}
+
 	peerGroupIDs, err := getPeerGroupIDs(ctx, am.Store, accountID, peer.ID)
 	if err != nil {
 		return nil, nil, nil, 0, err
@@ -1139,7 +1318,12 @@ func (am *DefaultAccountManager) handlePeerLoginNotFound(ctx context.Context, lo
 			ExtraDNSLabels: login.ExtraDNSLabels,
 		}
 
-		return am.AddPeer(ctx, "", login.SetupKey, login.UserID, newPeer, false)
+		var workloadIdentity *workloadIdentityLogin
+		if login.WorkloadIdentityProviderID != "" {
+			workloadIdentity = &workloadIdentityLogin{ProviderID: login.WorkloadIdentityProviderID, Token: login.WorkloadIdentityToken}
+		}
+
+		return am.addPeer(ctx, "", login.SetupKey, login.UserID, workloadIdentity, newPeer, false)
 	}
 
 	log.WithContext(ctx).Errorf("failed while logging in peer %s: %v", login.WireGuardPubKey, err)
@@ -1498,7 +1682,7 @@ func (am *DefaultAccountManager) GetPeer(ctx context.Context, accountID, peerID,
 		return nil, err
 	}
 
-	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Peers, operations.Read)
+	allowed, ctx, err := am.permissionsManager.ValidateUserResourcePermissions(ctx, accountID, userID, modules.Peers, operations.Read, peerID)
 	if err != nil {
 		return nil, status.NewPermissionValidationError(err)
 	}
@@ -1812,8 +1996,13 @@ func deletePeers(ctx context.Context, am *DefaultAccountManager, transaction sto
 			return nil, err
 		}
 		if !(peer.ProxyMeta.Embedded || peer.Meta.KernelVersion == "wasm") {
+			event := activity.PeerRemovedByUser
+			// removing a peer that is still waiting for approval rejects it
+			if peer.Status != nil && peer.Status.RequiresApproval && userID != activity.SystemInitiator {
+				event = activity.PeerRejected
+			}
 			peerDeletedEvents = append(peerDeletedEvents, func() {
-				am.StoreEvent(ctx, userID, peer.ID, accountID, activity.PeerRemovedByUser, peer.EventMeta(dnsDomain))
+				am.StoreEvent(ctx, userID, peer.ID, accountID, event, peer.EventMeta(dnsDomain))
 			})
 		}
 	}
@@ -0,0 +1,61 @@
+package peer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// MaxLabels is the maximum number of labels a peer, setup key or selector can have
+	MaxLabels = 64
+
+	maxLabelKeyLength   = 63
+	maxLabelValueLength = 63
+)
+
+var (
+	labelKeyRegex   = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]*[A-Za-z0-9])?$`)
+	labelValueRegex = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?)?$`)
+)
+
+// ValidateLabels checks that the keys and values of the labels are well-formed. Keys are made of
+// alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-' and may be empty.
+func ValidateLabels(labels map[string]string) error {
+	if len(labels) > MaxLabels {
+		return fmt.Errorf("too many labels, at most %d are allowed", MaxLabels)
+	}
+	for key, value := range labels {
+		if len(key) > maxLabelKeyLength || !labelKeyRegex.MatchString(key) {
+			return fmt.Errorf("invalid label key %q", key)
+		}
+		if len(value) > maxLabelValueLength || !labelValueRegex.MatchString(value) {
+			return fmt.Errorf("invalid value %q of label %q", value, key)
+		}
+	}
+	return nil
+}
+
+// ParseLabel parses a label in the key=value form. A missing value is parsed as an empty value.
+func ParseLabel(label string) (string, string, error) {
+	key, value, _ := strings.Cut(label, "=")
+	if err := ValidateLabels(map[string]string{key: value}); err != nil {
+		return "", "", err
+	}
+	return key, value, nil
+}
+
+// MatchesLabels reports whether the labels set through the API or the setup key contain every key/value
+// pair of the selector. The labels reported by the client are not matched, a peer could claim any of them.
+// An empty selector matches no peer.
+func (p *Peer) MatchesLabels(selector map[string]string) bool {
+	if len(selector) == 0 {
+		return false
+	}
+	for key, value := range selector {
+		if v, ok := p.Labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package peer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		wantErr bool
+	}{
+		{"nil", nil, false},
+		{"valid", map[string]string{"env": "prod", "team.io/owner": "payments_1", "flag": ""}, false},
+		{"empty key", map[string]string{"": "prod"}, true},
+		{"key with space", map[string]string{"my env": "prod"}, true},
+		{"key ends with dash", map[string]string{"env-": "prod"}, true},
+		{"value with slash", map[string]string{"env": "prod/eu"}, true},
+		{"long value", map[string]string{"env": strings.Repeat("a", 64)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLabels(tt.labels)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	tooMany := make(map[string]string, MaxLabels+1)
+	for i := 0; i <= MaxLabels; i++ {
+		tooMany[strings.Repeat("k", i+1)] = "v"
+	}
+	assert.Error(t, ValidateLabels(tooMany))
+}
+
+func TestParseLabel(t *testing.T) {
+	key, value, err := ParseLabel("env=prod")
+	assert.NoError(t, err)
+	assert.Equal(t, "env", key)
+	assert.Equal(t, "prod", value)
+
+	key, value, err = ParseLabel("canary")
+	assert.NoError(t, err)
+	assert.Equal(t, "canary", key)
+	assert.Empty(t, value)
+
+	_, _, err = ParseLabel("=prod")
+	assert.Error(t, err)
+}
+
+func TestPeer_MatchesLabels(t *testing.T) {
+	p := &Peer{
+		Labels: map[string]string{"env": "prod"},
+		Meta:   PeerSystemMeta{Labels: map[string]string{"env": "dev", "team": "payments"}},
+	}
+
+	assert.True(t, p.MatchesLabels(map[string]string{"env": "prod"}))
+	assert.False(t, p.MatchesLabels(map[string]string{"env": "prod", "team": "payments"}), "client labels are not matched")
+	assert.False(t, p.MatchesLabels(map[string]string{"team": "payments"}), "client labels are not matched")
+	assert.False(t, p.MatchesLabels(map[string]string{"env": "dev"}))
+	assert.False(t, p.MatchesLabels(map[string]string{"env": "prod", "region": "eu"}))
+	assert.False(t, p.MatchesLabels(nil))
+}
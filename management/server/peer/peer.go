@@ -3,6 +3,7 @@ package peer
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/netip"
 	"slices"
@@ -49,6 +50,8 @@ type Peer struct {
 	Status *PeerStatus `gorm:"embedded;embeddedPrefix:peer_status_"`
 	// The user ID that registered the peer
 	UserID string
+	// SetupKeyID is the ID of the setup key the peer was registered with, empty for peers added by a user
+	SetupKeyID string
 	// SSHKey is a public SSH key of the peer
 	SSHKey string
 	// SSHEnabled indicates whether SSH server is enabled on the peer
@@ -72,6 +75,10 @@ type Peer struct {
 	ExtraDNSLabels []string `gorm:"serializer:json"`
 	// AllowExtraDNSLabels indicates whether the peer allows extra DNS labels to be used for resolving the peer
 	AllowExtraDNSLabels bool
+
+	// Labels are the key/value labels set through the API or by the setup key the peer was registered with.
+	// Only they are matched by label selectors, the labels reported by the client in Meta.Labels are informational.
+	Labels map[string]string `gorm:"serializer:json"`
 }
 
 type ProxyMeta struct {
@@ -175,6 +182,8 @@ type PeerSystemMeta struct { //nolint:revive
 	Files              []File      `gorm:"serializer:json"`
 	Capabilities       []int32     `gorm:"serializer:json"`
 	SyncMessageVersion int
+	// Labels are the key/value labels configured in the local config of the client
+	Labels map[string]string `gorm:"serializer:json"`
 }
 
 func (p PeerSystemMeta) isEqual(other PeerSystemMeta) bool {
@@ -291,6 +300,7 @@ func (p *Peer) Copy() *Peer {
 		DNSLabel:                    p.DNSLabel,
 		Status:                      peerStatus,
 		UserID:                      p.UserID,
+		SetupKeyID:                  p.SetupKeyID,
 		SSHKey:                      p.SSHKey,
 		SSHEnabled:                  p.SSHEnabled,
 		LoginExpirationEnabled:      p.LoginExpirationEnabled,
@@ -302,6 +312,7 @@ func (p *Peer) Copy() *Peer {
 		InactivityExpirationEnabled: p.InactivityExpirationEnabled,
 		ExtraDNSLabels:              slices.Clone(p.ExtraDNSLabels),
 		AllowExtraDNSLabels:         p.AllowExtraDNSLabels,
+		Labels:                      maps.Clone(p.Labels),
 	}
 }
 
@@ -449,6 +460,9 @@ func diffMeta(oldMeta, newMeta PeerSystemMeta, oldLocation, newLocation Location
 	if oldMeta.SyncMessageVersion != newMeta.SyncMessageVersion {
 		add("sync_meta_version", fmt.Sprintf("%d", oldMeta.SyncMessageVersion), fmt.Sprintf("%d", newMeta.SyncMessageVersion))
 	}
+	if !maps.Equal(oldMeta.Labels, newMeta.Labels) {
+		add("labels", oldMeta.Labels, newMeta.Labels)
+	}
 
 	if !oldLocation.equal(newLocation) {
 		add("connection_ip", oldLocation.ConnectionIP, newLocation.ConnectionIP)
@@ -81,7 +81,7 @@ func populateAll(t *testing.T, v reflect.Value) int {
 }
 
 // setNonZero assigns a deterministic non-zero value to a field based on its kind,
-// recursing into nested structs and populating one element of slice fields.
+// recursing into nested structs and populating one element of slice and map fields.
 func setNonZero(t *testing.T, field reflect.Value) {
 	t.Helper()
 
@@ -107,6 +107,14 @@ func setNonZero(t *testing.T, field reflect.Value) {
 		s := reflect.MakeSlice(field.Type(), 1, 1)
 		setNonZero(t, s.Index(0))
 		field.Set(s)
+	case reflect.Map:
+		m := reflect.MakeMapWithSize(field.Type(), 1)
+		key := reflect.New(field.Type().Key()).Elem()
+		value := reflect.New(field.Type().Elem()).Elem()
+		setNonZero(t, key)
+		setNonZero(t, value)
+		m.SetMapIndex(key, value)
+		field.Set(m)
 	default:
 		t.Fatalf("unhandled field kind %s; extend setNonZero", field.Kind())
 	}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/netbirdio/netbird/management/internals/modules/peers"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/integrations/integrated_validator/validator"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+func TestPeerApproval_Workflow(t *testing.T) {
+	manager, _, account, _, _, _ := setupNetworkMapTest(t)
+	ctx := context.Background()
+
+	iv, err := validator.NewIntegratedValidator(ctx, peers.NewManager(manager.Store, manager.permissionsManager), nil, nil, nil)
+	require.NoError(t, err)
+	manager.integratedPeerValidator = iv
+
+	settings, err := manager.Store.GetAccountSettings(ctx, store.LockingStrengthNone, account.Id)
+	require.NoError(t, err)
+	settings.Extra = &types.ExtraSettings{
+		PeerApprovalEnabled: true,
+		PeerApprovalRules: []types.PeerApprovalRule{
+			{Attribute: types.PeerApprovalRuleAttributeOS, Values: []string{"windows"}},
+		},
+	}
+	_, err = manager.UpdateAccountSettings(ctx, account.Id, userID, settings)
+	require.NoError(t, err)
+
+	addPeer := func(goos string) *nbpeer.Peer {
+		key, err := wgtypes.GeneratePrivateKey()
+		require.NoError(t, err)
+		peer, _, _, _, err := manager.AddPeer(ctx, "", "", userID, &nbpeer.Peer{
+			Key:  key.PublicKey().String(),
+			Meta: nbpeer.PeerSystemMeta{Hostname: goos + "-host", GoOS: goos},
+		}, false)
+		require.NoError(t, err)
+		return peer
+	}
+
+	pending := addPeer("linux")
+	assert.True(t, pending.Status.RequiresApproval, "new peers wait for approval")
+	autoApproved := addPeer("windows")
+	assert.False(t, autoApproved.Status.RequiresApproval, "peers matching a rule are approved automatically")
+
+	validPeers, invalidPeers, err := manager.GetValidatedPeers(ctx, account.Id)
+	require.NoError(t, err)
+	assert.NotContains(t, validPeers, pending.ID)
+	assert.Contains(t, validPeers, autoApproved.ID)
+	assert.Equal(t, "pending approval", invalidPeers[pending.ID])
+
+	update := pending.Copy()
+	update.Status = &nbpeer.PeerStatus{RequiresApproval: false}
+	approved, err := manager.UpdatePeer(ctx, account.Id, userID, update)
+	require.NoError(t, err)
+	assert.False(t, approved.Status.RequiresApproval)
+
+	stored, err := manager.Store.GetPeerByID(ctx, store.LockingStrengthNone, account.Id, pending.ID)
+	require.NoError(t, err)
+	assert.False(t, stored.Status.RequiresApproval, "approval is persisted")
+
+	rejected := addPeer("linux")
+	require.True(t, rejected.Status.RequiresApproval)
+	require.NoError(t, manager.DeletePeer(ctx, account.Id, rejected.ID, userID))
+
+	// events are stored asynchronously
+	hasEvent := func(expected activity.Activity) func() bool {
+		return func() bool {
+			events, err := manager.GetEvents(ctx, account.Id, userID, activity.Filter{})
+			if err != nil {
+				return false
+			}
+			for _, event := range events {
+				if event.Activity == expected {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	assert.Eventually(t, hasEvent(activity.AccountPeerApprovalEnabled), time.Second, 10*time.Millisecond)
+	assert.Eventually(t, hasEvent(activity.PeerApproved), time.Second, 10*time.Millisecond)
+	assert.Eventually(t, hasEvent(activity.PeerRejected), time.Second, 10*time.Millisecond)
+}
+
+func TestPeerApproval_ValidatorGroupsAndSettings(t *testing.T) {
+	manager, _, account, peer1, _, _ := setupNetworkMapTest(t)
+	ctx := context.Background()
+
+	iv, err := validator.NewIntegratedValidator(ctx, peers.NewManager(manager.Store, manager.permissionsManager), nil, nil, nil)
+	require.NoError(t, err)
+	manager.integratedPeerValidator = iv
+
+	update := peer1.Copy()
+	update.Status = &nbpeer.PeerStatus{RequiresApproval: true}
+	_, err = manager.UpdatePeer(ctx, account.Id, userID, update)
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.PreconditionFailed, sErr.Type(), "approval can't be required while the workflow is disabled")
+
+	settings, err := manager.Store.GetAccountSettings(ctx, store.LockingStrengthNone, account.Id)
+	require.NoError(t, err)
+	settings.Extra = &types.ExtraSettings{
+		PeerApprovalEnabled: true,
+		PeerApprovalRules:   []types.PeerApprovalRule{{Attribute: "hostname", Values: []string{"x"}}},
+	}
+	_, err = manager.UpdateAccountSettings(ctx, account.Id, userID, settings)
+	sErr, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.InvalidArgument, sErr.Type())
+
+	group := &types.Group{ID: "trusted", Name: "trusted", Issued: types.GroupIssuedAPI}
+	require.NoError(t, manager.CreateGroup(ctx, account.Id, userID, group))
+	require.NoError(t, manager.UpdateIntegratedValidator(ctx, account.Id, userID, "built-in", []string{group.ID}))
+
+	settings, err = manager.Store.GetAccountSettings(ctx, store.LockingStrengthNone, account.Id)
+	require.NoError(t, err)
+	settings.Extra = &types.ExtraSettings{PeerApprovalEnabled: true}
+	_, err = manager.UpdateAccountSettings(ctx, account.Id, userID, settings)
+	require.NoError(t, err)
+
+	settings, err = manager.Store.GetAccountSettings(ctx, store.LockingStrengthNone, account.Id)
+	require.NoError(t, err)
+	assert.Equal(t, []string{group.ID}, settings.Extra.IntegratedValidatorGroups, "settings updates keep the validator groups")
+
+	prepared := iv.PreparePeer(ctx, account.Id, &nbpeer.Peer{ID: "new", Status: &nbpeer.PeerStatus{}}, []string{group.ID}, settings.Extra, false)
+	assert.False(t, prepared.Status.RequiresApproval, "peers joining a validator group are exempt")
+}
@@ -2,11 +2,17 @@ package server
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/sha256"
 	b64 "encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"net/netip"
 	"os"
 	"runtime"
@@ -16,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"go.uber.org/mock/gomock"
 	"github.com/rs/xid"
 	log "github.com/sirupsen/logrus"
@@ -30,6 +37,7 @@ import (
 	"github.com/netbirdio/netbird/management/internals/controllers/network_map/update_channel"
 	"github.com/netbirdio/netbird/management/internals/modules/peers"
 	ephemeral_manager "github.com/netbirdio/netbird/management/internals/modules/peers/ephemeral/manager"
+	"github.com/netbirdio/netbird/management/internals/modules/workloadidentity"
 	"github.com/netbirdio/netbird/management/internals/server/config"
 	"github.com/netbirdio/netbird/management/internals/shared/grpc"
 	nbcache "github.com/netbirdio/netbird/management/server/cache"
@@ -2040,7 +2048,7 @@ func TestPeerAccountPeersUpdate(t *testing.T) {
 		_, err := manager.CreateRoute(
 			context.Background(), account.Id, route.Network, route.NetworkType, route.Domains, route.Peer,
 			route.PeerGroups, route.Description, route.NetID, route.Masquerade, route.Metric,
-			route.Groups, []string{}, true, userID, route.KeepRoute, route.SkipAutoApply,
+			route.Groups, []string{}, true, userID, route.KeepRoute, route.SkipAutoApply, nil,
 		)
 		require.NoError(t, err)
 
@@ -2686,6 +2694,156 @@ func TestHandleSetupKeyAddedPeer(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, config.AllowExtraDNSLabels)
 	})
+
+	t.Run("restricted setup key", func(t *testing.T) {
+		setupKey, err := manager.CreateSetupKey(context.Background(), account.Id, "restricted-key", types.SetupKeyReusable, time.Hour, []string{}, 0, adminUser.Id, false, false)
+		require.NoError(t, err)
+		plainKey := setupKey.Key
+
+		_, err = manager.SaveSetupKey(context.Background(), account.Id, &types.SetupKey{
+			Id:         setupKey.Id,
+			AutoGroups: []string{},
+			Restrictions: &types.SetupKeyRestrictions{
+				AllowedCIDRs:        []string{"203.0.113.0/24"},
+				HostnamePattern:     "web-[0-9]+",
+				AllowedOS:           []string{"linux"},
+				MaxPeersPerHostname: 1,
+			},
+		}, adminUser.Id)
+		require.NoError(t, err)
+
+		hashedKey := sha256.Sum256([]byte(strings.ToUpper(plainKey)))
+		encodedHashedKey := b64.StdEncoding.EncodeToString(hashedKey[:])
+
+		newPeer := func(ip, hostname, goOS string) *nbpeer.Peer {
+			return &nbpeer.Peer{
+				Meta:     nbpeer.PeerSystemMeta{Hostname: hostname, GoOS: goOS},
+				Location: nbpeer.Location{ConnectionIP: net.ParseIP(ip)},
+			}
+		}
+
+		rejected := []struct {
+			peer   *nbpeer.Peer
+			reason string
+		}{
+			{peer: newPeer("198.51.100.1", "web-1", "linux"), reason: "not in the allowed ranges"},
+			{peer: newPeer("203.0.113.10", "db-1", "linux"), reason: "doesn't match the hostname pattern"},
+			{peer: newPeer("203.0.113.10", "web-1", "windows"), reason: "is not allowed by the setup key"},
+		}
+		for _, tt := range rejected {
+			err = manager.handleSetupKeyAddedPeer(context.Background(), encodedHashedKey, tt.peer, &activity.Event{}, &peerAddAuthConfig{})
+			sErr, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, status.PermissionDenied, sErr.Type())
+			assert.Contains(t, err.Error(), tt.reason)
+		}
+
+		err = manager.handleSetupKeyAddedPeer(context.Background(), encodedHashedKey, newPeer("203.0.113.10", "web-1", "linux"), &activity.Event{}, &peerAddAuthConfig{})
+		require.NoError(t, err)
+
+		err = manager.Store.AddPeerToAccount(context.Background(), &nbpeer.Peer{
+			ID:         "restricted-peer",
+			AccountID:  account.Id,
+			Key:        "restricted-peer-key",
+			SetupKeyID: setupKey.Id,
+			IP:         netip.MustParseAddr("100.64.0.10"),
+			DNSLabel:   "web-1",
+			Meta:       nbpeer.PeerSystemMeta{Hostname: "web-1"},
+			Status:     &nbpeer.PeerStatus{},
+		})
+		require.NoError(t, err)
+
+		err = manager.handleSetupKeyAddedPeer(context.Background(), encodedHashedKey, newPeer("203.0.113.10", "web-1", "linux"), &activity.Event{}, &peerAddAuthConfig{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already enrolled 1 peers with hostname")
+
+		err = manager.handleSetupKeyAddedPeer(context.Background(), encodedHashedKey, newPeer("203.0.113.10", "web-2", "linux"), &activity.Event{}, &peerAddAuthConfig{})
+		require.NoError(t, err)
+	})
+}
+
+func TestLoginPeerWithWorkloadIdentity(t *testing.T) {
+	manager, _, err := createManager(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	account := newAccountWithId(ctx, "test-account", "owner", "", "", "", false)
+	account.Groups["group-ci"] = &types.Group{ID: "group-ci", AccountID: account.Id, Name: "CI"}
+	require.NoError(t, manager.Store.SaveAccount(ctx, account))
+
+	// local stand-in for the token issuer of a CI system
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "ci-key",
+			"n":   b64.RawURLEncoding.EncodeToString(signingKey.N.Bytes()),
+			"e":   b64.RawURLEncoding.EncodeToString(big.NewInt(int64(signingKey.E)).Bytes()),
+		}}})
+	}))
+	defer jwksServer.Close()
+
+	provider := workloadidentity.NewProvider(account.Id, &workloadidentity.Provider{
+		Name:       "CI",
+		Enabled:    true,
+		Issuer:     "https://ci.example.com",
+		JWKSURL:    jwksServer.URL,
+		Audiences:  []string{"netbird"},
+		ClaimRules: []workloadidentity.ClaimRule{{Claim: "project_path", Values: []string{"infra/*"}}},
+		AutoGroups: []string{"group-ci"},
+		Ephemeral:  true,
+	})
+	require.NoError(t, manager.Store.CreateWorkloadIdentityProvider(ctx, provider))
+
+	signToken := func(project string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":          provider.Issuer,
+			"aud":          "netbird",
+			"sub":          "project_path:" + project,
+			"iat":          time.Now().Unix(),
+			"exp":          time.Now().Add(5 * time.Minute).Unix(),
+			"project_path": project,
+		})
+		token.Header["kid"] = "ci-key"
+		signed, err := token.SignedString(signingKey)
+		require.NoError(t, err)
+		return signed
+	}
+
+	login := func(token string) (*nbpeer.Peer, error) {
+		key, err := wgtypes.GeneratePrivateKey()
+		require.NoError(t, err)
+		peer, _, _, _, err := manager.LoginPeer(ctx, types.PeerLogin{
+			WireGuardPubKey:            key.PublicKey().String(),
+			Meta:                       nbpeer.PeerSystemMeta{Hostname: "runner", GoOS: "linux"},
+			ConnectionIP:               net.IP{203, 0, 113, 10},
+			WorkloadIdentityProviderID: provider.ID,
+			WorkloadIdentityToken:      token,
+		})
+		return peer, err
+	}
+
+	peer, err := login(signToken("infra/deploy"))
+	require.NoError(t, err)
+	assert.True(t, peer.Ephemeral)
+	assert.Equal(t, account.Id, peer.AccountID)
+
+	group, err := manager.Store.GetGroupByID(ctx, store.LockingStrengthNone, account.Id, "group-ci")
+	require.NoError(t, err)
+	assert.Contains(t, group.Peers, peer.ID)
+
+	_, err = login(signToken("web/frontend"))
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.PermissionDenied, sErr.Type())
+	assert.Contains(t, err.Error(), "project_path")
+
+	provider.Enabled = false
+	require.NoError(t, manager.Store.UpdateWorkloadIdentityProvider(ctx, provider))
+	_, err = login(signToken("infra/deploy"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "workload identity provider is invalid")
 }
 
 func TestProcessPeerAddAuth(t *testing.T) {
@@ -2711,7 +2869,7 @@ func TestProcessPeerAddAuth(t *testing.T) {
 		opEvent := &activity.Event{Timestamp: time.Now()}
 		peer := &nbpeer.Peer{Ephemeral: false}
 
-		config, err := manager.processPeerAddAuth(context.Background(), account.Id, regularUser.Id, "", peer, false, true, false, opEvent)
+		config, err := manager.processPeerAddAuth(context.Background(), account.Id, regularUser.Id, "", nil, peer, false, true, false, opEvent)
 		require.NoError(t, err)
 		assert.Equal(t, account.Id, config.AccountID)
 		assert.False(t, config.Ephemeral)
@@ -2730,7 +2888,7 @@ func TestProcessPeerAddAuth(t *testing.T) {
 		opEvent := &activity.Event{Timestamp: time.Now()}
 		peer := &nbpeer.Peer{Ephemeral: false}
 
-		config, err := manager.processPeerAddAuth(context.Background(), account.Id, "", encodedHashedKey, peer, false, false, true, opEvent)
+		config, err := manager.processPeerAddAuth(context.Background(), account.Id, "", encodedHashedKey, nil, peer, false, false, true, opEvent)
 		require.NoError(t, err)
 		assert.Equal(t, account.Id, config.AccountID)
 		assert.True(t, config.Ephemeral) // setupKey.Ephemeral is true
@@ -2747,7 +2905,7 @@ func TestProcessPeerAddAuth(t *testing.T) {
 		opEvent := &activity.Event{Timestamp: time.Now()}
 		peer := &nbpeer.Peer{Ephemeral: false}
 
-		config, err := manager.processPeerAddAuth(context.Background(), account.Id, regularUser.Id, "", peer, true, true, false, opEvent)
+		config, err := manager.processPeerAddAuth(context.Background(), account.Id, regularUser.Id, "", nil, peer, true, true, false, opEvent)
 		require.Error(t, err) // Will fail permission check but that's expected
 		_ = config            // avoid unused warning
 	})
@@ -2759,7 +2917,7 @@ func TestProcessPeerAddAuth(t *testing.T) {
 			ProxyMeta: nbpeer.ProxyMeta{Embedded: true},
 		}
 
-		config, err := manager.processPeerAddAuth(context.Background(), account.Id, "", "", peer, false, false, false, opEvent)
+		config, err := manager.processPeerAddAuth(context.Background(), account.Id, "", "", nil, peer, false, false, false, opEvent)
 		require.NoError(t, err)
 		assert.Equal(t, account.Id, config.AccountID)
 		assert.False(t, config.Ephemeral)
@@ -20,15 +20,19 @@ import (
 
 type Manager interface {
 	ValidateUserPermissions(ctx context.Context, accountID, userID string, module modules.Module, operation operations.Operation) (bool, context.Context, error)
+	ValidateUserResourcePermissions(ctx context.Context, accountID, userID string, module modules.Module, operation operations.Operation, resourceID string) (bool, context.Context, error)
 	ValidateRoleModuleAccess(ctx context.Context, accountID string, role roles.RolePermissions, module modules.Module, operation operations.Operation) bool
 	ValidateAccountAccess(ctx context.Context, accountID string, user *types.User, allowOwnerAndAdmin bool) (context.Context, error)
 
-	GetPermissionsByRole(ctx context.Context, role types.UserRole) (roles.Permissions, error)
+	ValidateRoleGrant(ctx context.Context, accountID string, role types.UserRole, permissions roles.Permissions) (bool, error)
+
+	GetPermissionsByRole(ctx context.Context, accountID string, role types.UserRole) (roles.Permissions, error)
 	SetAccountManager(accountManager account.Manager)
 }
 
 type managerImpl struct {
-	store store.Store
+	store          store.Store
+	accountManager account.Manager
 }
 
 func NewManager(store store.Store) Manager {
@@ -43,6 +47,20 @@ func (m *managerImpl) ValidateUserPermissions(
 	userID string,
 	module modules.Module,
 	operation operations.Operation,
+) (bool, context.Context, error) {
+	return m.ValidateUserResourcePermissions(ctx, accountID, userID, module, operation, "")
+}
+
+// ValidateUserResourcePermissions validates the user permissions like ValidateUserPermissions for an operation on
+// a single resource of the module. Requests authenticated with a scoped personal access token are additionally
+// limited to the token scopes, which may restrict a module to specific resource IDs.
+func (m *managerImpl) ValidateUserResourcePermissions(
+	ctx context.Context,
+	accountID string,
+	userID string,
+	module modules.Module,
+	operation operations.Operation,
+	resourceID string,
 ) (bool, context.Context, error) {
 	if userID == activity.SystemInitiator {
 		return true, ctx, nil
@@ -70,16 +88,70 @@ func (m *managerImpl) ValidateUserPermissions(
 		return false, ctx, err
 	}
 
-	if operation == operations.Read && user.IsServiceUser {
-		return true, ctxEnriched, nil // this should be replaced by proper granular access role
+	if operation != operations.Read || !user.IsServiceUser { // service users read access should be replaced by proper granular access role
+		role, err := m.resolveRole(ctx, accountID, user.Role)
+		if err != nil {
+			return false, ctxEnriched, err
+		}
+
+		if !m.ValidateRoleModuleAccess(ctx, accountID, role, module, operation) {
+			return false, ctxEnriched, nil
+		}
 	}
 
-	role, ok := roles.RolesMap[user.Role]
-	if !ok {
-		return false, ctxEnriched, status.NewUserRoleNotFoundError(string(user.Role))
+	allowed, err := m.validatePATScope(ctx, accountID, userID, module, operation, resourceID)
+	if err != nil {
+		return false, ctxEnriched, err
 	}
 
-	return m.ValidateRoleModuleAccess(ctx, accountID, role, module, operation), ctxEnriched, nil
+	return allowed, ctxEnriched, nil
+}
+
+// validatePATScope checks the scopes of the personal access token the request was authenticated with, if any.
+// Denials are recorded as activity events so token owners can spot missing grants.
+func (m *managerImpl) validatePATScope(
+	ctx context.Context,
+	accountID string,
+	userID string,
+	module modules.Module,
+	operation operations.Operation,
+	resourceID string,
+) (bool, error) {
+	pat, err := AuthenticatedPAT(ctx, m.store, userID)
+	if err != nil {
+		return false, err
+	}
+
+	if pat == nil || pat.ScopeAllows(module, operation, resourceID) {
+		return true, nil
+	}
+
+	log.WithContext(ctx).Debugf("personal access token %s of user %s is not scoped for %s on %s", pat.ID, userID, operation, module)
+	if m.accountManager != nil {
+		meta := map[string]any{"name": pat.Name, "module": string(module), "operation": string(operation)}
+		if resourceID != "" {
+			meta["resource_id"] = resourceID
+		}
+		m.accountManager.StoreEvent(ctx, userID, pat.ID, accountID, activity.PersonalAccessTokenScopeDenied, meta)
+	}
+
+	return false, nil
+}
+
+// AuthenticatedPAT returns the personal access token of the user the request was authenticated with, or nil when
+// the request was not authenticated with one of the user's tokens. The token is taken from the request context and
+// only looked up in the store when the context doesn't carry it.
+func AuthenticatedPAT(ctx context.Context, s store.Store, userID string) (*types.PersonalAccessToken, error) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(ctx)
+	if err != nil || !userAuth.IsPAT || userAuth.PATID == "" || userAuth.UserId != userID {
+		return nil, nil
+	}
+
+	if pat, ok := types.AuthenticatedPATFromContext(ctx, userAuth.PATID); ok {
+		return pat, nil
+	}
+
+	return s.GetPATByID(ctx, store.LockingStrengthNone, userID, userAuth.PATID)
 }
 
 // ValidateRoleModuleAccess resolves an operation against the role's explicit
@@ -128,10 +200,47 @@ func (m *managerImpl) ValidateAccountAccess(ctx context.Context, accountID strin
 	return ctx, nil
 }
 
-func (m *managerImpl) GetPermissionsByRole(ctx context.Context, role types.UserRole) (roles.Permissions, error) {
-	roleMap, ok := roles.RolesMap[role]
-	if !ok {
-		return roles.Permissions{}, status.NewUserRoleNotFoundError(string(role))
+// ValidateRoleGrant reports whether the role holds every operation the given permissions grant, so that a role
+// can't be used to hand out more access than its holder has.
+func (m *managerImpl) ValidateRoleGrant(ctx context.Context, accountID string, role types.UserRole, permissions roles.Permissions) (bool, error) {
+	holder, err := m.resolveRole(ctx, accountID, role)
+	if err != nil {
+		return false, err
+	}
+
+	for module, ops := range permissions {
+		for operation, allowed := range ops {
+			if allowed && !m.ValidateRoleModuleAccess(ctx, accountID, holder, module, operation) {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// resolveRole returns the built-in role with the given name or, failing that, the account custom role with
+// the given ID.
+func (m *managerImpl) resolveRole(ctx context.Context, accountID string, role types.UserRole) (roles.RolePermissions, error) {
+	if rolePermissions, ok := roles.RolesMap[role]; ok {
+		return rolePermissions, nil
+	}
+
+	customRole, err := m.store.GetCustomRoleByID(ctx, store.LockingStrengthNone, accountID, string(role))
+	if err != nil {
+		if sErr, ok := status.FromError(err); ok && sErr.Type() == status.NotFound {
+			return roles.RolePermissions{}, status.NewUserRoleNotFoundError(string(role))
+		}
+		return roles.RolePermissions{}, err
+	}
+
+	return customRole.RolePermissions(), nil
+}
+
+func (m *managerImpl) GetPermissionsByRole(ctx context.Context, accountID string, role types.UserRole) (roles.Permissions, error) {
+	roleMap, err := m.resolveRole(ctx, accountID, role)
+	if err != nil {
+		return roles.Permissions{}, err
 	}
 
 	permissions := roles.Permissions{}
@@ -148,5 +257,5 @@ func (m *managerImpl) GetPermissionsByRole(ctx context.Context, role types.UserR
 }
 
 func (m *managerImpl) SetAccountManager(accountManager account.Manager) {
-	// no-op
+	m.accountManager = accountManager
 }
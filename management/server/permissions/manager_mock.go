@@ -46,18 +46,18 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 }
 
 // GetPermissionsByRole mocks base method.
-func (m *MockManager) GetPermissionsByRole(ctx context.Context, role types.UserRole) (roles.Permissions, error) {
+func (m *MockManager) GetPermissionsByRole(ctx context.Context, accountID string, role types.UserRole) (roles.Permissions, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPermissionsByRole", ctx, role)
+	ret := m.ctrl.Call(m, "GetPermissionsByRole", ctx, accountID, role)
 	ret0, _ := ret[0].(roles.Permissions)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPermissionsByRole indicates an expected call of GetPermissionsByRole.
-func (mr *MockManagerMockRecorder) GetPermissionsByRole(ctx, role any) *gomock.Call {
+func (mr *MockManagerMockRecorder) GetPermissionsByRole(ctx, accountID, role any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermissionsByRole", reflect.TypeOf((*MockManager)(nil).GetPermissionsByRole), ctx, role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermissionsByRole", reflect.TypeOf((*MockManager)(nil).GetPermissionsByRole), ctx, accountID, role)
 }
 
 // SetAccountManager mocks base method.
@@ -87,6 +87,21 @@ func (mr *MockManagerMockRecorder) ValidateAccountAccess(ctx, accountID, user, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAccountAccess", reflect.TypeOf((*MockManager)(nil).ValidateAccountAccess), ctx, accountID, user, allowOwnerAndAdmin)
 }
 
+// ValidateRoleGrant mocks base method.
+func (m *MockManager) ValidateRoleGrant(ctx context.Context, accountID string, role types.UserRole, permissions roles.Permissions) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateRoleGrant", ctx, accountID, role, permissions)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateRoleGrant indicates an expected call of ValidateRoleGrant.
+func (mr *MockManagerMockRecorder) ValidateRoleGrant(ctx, accountID, role, permissions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRoleGrant", reflect.TypeOf((*MockManager)(nil).ValidateRoleGrant), ctx, accountID, role, permissions)
+}
+
 // ValidateRoleModuleAccess mocks base method.
 func (m *MockManager) ValidateRoleModuleAccess(ctx context.Context, accountID string, role roles.RolePermissions, module modules.Module, operation operations.Operation) bool {
 	m.ctrl.T.Helper()
@@ -116,3 +131,19 @@ func (mr *MockManagerMockRecorder) ValidateUserPermissions(ctx, accountID, userI
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUserPermissions", reflect.TypeOf((*MockManager)(nil).ValidateUserPermissions), ctx, accountID, userID, module, operation)
 }
+
+// ValidateUserResourcePermissions mocks base method.
+func (m *MockManager) ValidateUserResourcePermissions(ctx context.Context, accountID, userID string, module modules.Module, operation operations.Operation, resourceID string) (bool, context.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateUserResourcePermissions", ctx, accountID, userID, module, operation, resourceID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(context.Context)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ValidateUserResourcePermissions indicates an expected call of ValidateUserResourcePermissions.
+func (mr *MockManagerMockRecorder) ValidateUserResourcePermissions(ctx, accountID, userID, module, operation, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUserResourcePermissions", reflect.TypeOf((*MockManager)(nil).ValidateUserResourcePermissions), ctx, accountID, userID, module, operation, resourceID)
+}
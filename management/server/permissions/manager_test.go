@@ -7,9 +7,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/netbirdio/netbird/management/internals/modules/customroles"
 	"github.com/netbirdio/netbird/management/server/permissions/modules"
 	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/permissions/roles"
+	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
 )
 
@@ -123,7 +125,7 @@ func TestGetPermissionsByRoleIncludesSubmodules(t *testing.T) {
 	manager := NewManager(nil)
 	ctx := context.Background()
 
-	permissions, err := manager.GetPermissionsByRole(ctx, types.UserRoleAuditor)
+	permissions, err := manager.GetPermissionsByRole(ctx, "account", types.UserRoleAuditor)
 	require.NoError(t, err, "auditor role must resolve")
 
 	usage, ok := permissions[modules.AgentNetworkUsage]
@@ -131,9 +133,60 @@ func TestGetPermissionsByRoleIncludesSubmodules(t *testing.T) {
 	assert.True(t, usage[operations.Read], "auditor should read the usage submodule")
 	assert.False(t, usage[operations.Update], "auditor should not update the usage submodule")
 
-	adminPermissions, err := manager.GetPermissionsByRole(ctx, types.UserRoleAdmin)
+	adminPermissions, err := manager.GetPermissionsByRole(ctx, "account", types.UserRoleAdmin)
 	require.NoError(t, err, "admin role must resolve")
 	providers, ok := adminPermissions[modules.AgentNetworkProviders]
 	require.True(t, ok, "permissions map should contain the providers submodule")
 	assert.True(t, providers[operations.Delete], "admin should delete on the providers submodule")
 }
+
+func TestCustomRoleResolution(t *testing.T) {
+	ctx := context.Background()
+	testStore, cleanup, err := store.NewTestStoreFromSQL(ctx, "", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	customRole := customroles.NewRole("account", "Agent Operator", "", roles.Permissions{
+		modules.AgentNetwork: {operations.Read: true, operations.Update: true},
+		modules.Routes:       {operations.Read: true},
+	}, nil)
+	require.NoError(t, testStore.CreateCustomRole(ctx, customRole))
+
+	manager := NewManager(testStore)
+
+	t.Run("custom role permissions cascade to submodules", func(t *testing.T) {
+		permissions, err := manager.GetPermissionsByRole(ctx, "account", types.UserRole(customRole.ID))
+		require.NoError(t, err)
+		assert.True(t, permissions[modules.AgentNetworkBudgets][operations.Update], "parent grant should cover the budgets submodule")
+		assert.True(t, permissions[modules.Routes][operations.Read])
+		assert.False(t, permissions[modules.Peers][operations.Read], "modules without grants should not be allowed")
+	})
+
+	t.Run("custom role of another account is not resolved", func(t *testing.T) {
+		_, err := manager.GetPermissionsByRole(ctx, "other-account", types.UserRole(customRole.ID))
+		require.Error(t, err)
+	})
+
+	t.Run("grant within holder permissions", func(t *testing.T) {
+		ok, err := manager.ValidateRoleGrant(ctx, "account", types.UserRole(customRole.ID), roles.Permissions{
+			modules.AgentNetworkProviders: {operations.Update: true},
+			modules.Routes:                {operations.Read: true, operations.Delete: false},
+		})
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("grant exceeding holder permissions", func(t *testing.T) {
+		ok, err := manager.ValidateRoleGrant(ctx, "account", types.UserRole(customRole.ID), roles.Permissions{
+			modules.Routes: {operations.Delete: true},
+		})
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		ok, err = manager.ValidateRoleGrant(ctx, "account", types.UserRoleNetworkAdmin, roles.Permissions{
+			modules.Users: {operations.Update: true},
+		})
+		require.NoError(t, err)
+		assert.False(t, ok, "network admins can't grant user management")
+	})
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	_ "embed"
+	"time"
 
 	"github.com/rs/xid"
 	log "github.com/sirupsen/logrus"
@@ -14,13 +15,17 @@ import (
 
 	"github.com/netbirdio/netbird/management/server/activity"
 	"github.com/netbirdio/netbird/management/server/affectedpeers"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/posture"
 	"github.com/netbirdio/netbird/shared/management/status"
 )
 
+// policyScheduleTransitionDelay is added to a policy schedule boundary before the peers are updated
+const policyScheduleTransitionDelay = time.Second
+
 // GetPolicy from the store
 func (am *DefaultAccountManager) GetPolicy(ctx context.Context, accountID, policyID, userID string) (*types.Policy, error) {
-	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Policies, operations.Read)
+	allowed, ctx, err := am.permissionsManager.ValidateUserResourcePermissions(ctx, accountID, userID, modules.Policies, operations.Read, policyID)
 	if err != nil {
 		return nil, status.NewPermissionValidationError(err)
 	}
@@ -37,7 +42,7 @@ func (am *DefaultAccountManager) SavePolicy(ctx context.Context, accountID, user
 	if !create {
 		operation = operations.Update
 	}
-	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Policies, operation)
+	allowed, ctx, err := am.permissionsManager.ValidateUserResourcePermissions(ctx, accountID, userID, modules.Policies, operation, policy.ID)
 	if err != nil {
 		return nil, status.NewPermissionValidationError(err)
 	}
@@ -102,14 +107,23 @@ func (am *DefaultAccountManager) SavePolicy(ctx context.Context, accountID, user
 
 	am.StoreEvent(ctx, userID, policy.ID, accountID, action, policy.EventMeta())
 
-	am.ExpandAndUpdateAffected(ctx, accountID, snap, change)
+	// peers selected by labels are not tracked by the affected peers resolver
+	if policy.HasLabelSelectors() || (existingPolicy != nil && existingPolicy.HasLabelSelectors()) {
+		am.BufferUpdateAccountPeers(ctx, accountID, types.UpdateReason{Resource: types.UpdateResourcePolicy, Operation: types.UpdateOperationUpdate})
+	} else {
+		am.ExpandAndUpdateAffected(ctx, accountID, snap, change)
+	}
+
+	if policy.Schedule != nil || (existingPolicy != nil && existingPolicy.Schedule != nil) {
+		am.reschedulePolicySchedules(ctx, accountID)
+	}
 
 	return policy, nil
 }
 
 // DeletePolicy from the store
 func (am *DefaultAccountManager) DeletePolicy(ctx context.Context, accountID, policyID, userID string) error {
-	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Policies, operations.Delete)
+	allowed, ctx, err := am.permissionsManager.ValidateUserResourcePermissions(ctx, accountID, userID, modules.Policies, operations.Delete, policyID)
 	if err != nil {
 		return status.NewPermissionValidationError(err)
 	}
@@ -145,7 +159,15 @@ func (am *DefaultAccountManager) DeletePolicy(ctx context.Context, accountID, po
 
 	am.StoreEvent(ctx, userID, policyID, accountID, activity.PolicyRemoved, policy.EventMeta())
 
-	am.ExpandAndUpdateAffected(ctx, accountID, snap, change)
+	if policy.HasLabelSelectors() {
+		am.BufferUpdateAccountPeers(ctx, accountID, types.UpdateReason{Resource: types.UpdateResourcePolicy, Operation: types.UpdateOperationDelete})
+	} else {
+		am.ExpandAndUpdateAffected(ctx, accountID, snap, change)
+	}
+
+	if policy.Schedule != nil {
+		am.reschedulePolicySchedules(ctx, accountID)
+	}
 
 	return nil
 }
@@ -163,6 +185,40 @@ func (am *DefaultAccountManager) ListPolicies(ctx context.Context, accountID, us
 	return am.Store.GetAccountPolicies(ctx, store.LockingStrengthNone, accountID)
 }
 
+// SimulateAccess evaluates whether a connection would be allowed by the account's policies, posture checks,
+// peer state and routes the same way the network map is calculated.
+func (am *DefaultAccountManager) SimulateAccess(ctx context.Context, accountID, userID string, req *types.AccessSimulationRequest) ([]*types.AccessSimulationResult, error) {
+	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Policies, operations.Read)
+	if err != nil {
+		return nil, status.NewPermissionValidationError(err)
+	}
+	if !allowed {
+		return nil, status.NewPermissionDeniedError()
+	}
+
+	if req.Protocol != "" && req.Protocol != types.PolicyRuleProtocolTCP && req.Protocol != types.PolicyRuleProtocolUDP &&
+		req.Protocol != types.PolicyRuleProtocolICMP && req.Protocol != types.PolicyRuleProtocolALL {
+		return nil, status.Errorf(status.InvalidArgument, "unsupported protocol %q", req.Protocol)
+	}
+	if req.Port != 0 && req.Protocol != types.PolicyRuleProtocolTCP && req.Protocol != types.PolicyRuleProtocolUDP {
+		return nil, status.Errorf(status.InvalidArgument, "a port can only be set for tcp or udp")
+	}
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	validatedPeers, _, err := am.GetValidatedPeers(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account.ApplyEffectiveAccess(time.Now())
+
+	return account.SimulateAccess(ctx, req, validatedPeers)
+}
+
 // validatePolicy validates the policy and its rules. For updates it returns
 // the existing policy loaded from the store so callers can avoid a second read.
 func validatePolicy(ctx context.Context, transaction store.Store, accountID string, policy *types.Policy) (*types.Policy, error) {
@@ -200,6 +256,12 @@ func validatePolicy(ctx context.Context, transaction store.Store, accountID stri
 		return nil, err
 	}
 
+	if policy.Schedule != nil {
+		if err = policy.Schedule.Validate(); err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid policy schedule: %v", err)
+		}
+	}
+
 	for i, rule := range policy.Rules {
 		ruleCopy := rule.Copy()
 		if ruleCopy.ID == "" {
@@ -207,6 +269,13 @@ func validatePolicy(ctx context.Context, transaction store.Store, accountID stri
 			ruleCopy.PolicyID = policy.ID
 		}
 
+		if err = nbpeer.ValidateLabels(ruleCopy.SourceLabels); err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid source labels: %v", err)
+		}
+		if err = nbpeer.ValidateLabels(ruleCopy.DestinationLabels); err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid destination labels: %v", err)
+		}
+
 		ruleCopy.Sources = getValidGroupIDs(groups, ruleCopy.Sources)
 		ruleCopy.Destinations = getValidGroupIDs(groups, ruleCopy.Destinations)
 		policy.Rules[i] = ruleCopy
@@ -219,6 +288,67 @@ func validatePolicy(ctx context.Context, transaction store.Store, accountID stri
 	return existingPolicy, nil
 }
 
+// reschedulePolicySchedules replaces the account's policy schedule job after its scheduled policies changed
+func (am *DefaultAccountManager) reschedulePolicySchedules(ctx context.Context, accountID string) {
+	am.policySchedules.Cancel(ctx, []string{accountID})
+	am.schedulePolicySchedules(ctx, accountID)
+}
+
+// schedulePolicySchedules schedules a network map update at the next moment one of the account's
+// scheduled policies becomes active or inactive, unless such a job is already scheduled
+func (am *DefaultAccountManager) schedulePolicySchedules(ctx context.Context, accountID string) {
+	if am.policySchedules.IsSchedulerRunning(accountID) {
+		log.WithContext(ctx).Tracef("policy schedule job for account %s is already scheduled", accountID)
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	if nextRun, ok := am.getNextPolicyScheduleTransition(ctx, accountID); ok {
+		go am.policySchedules.Schedule(ctx, nextRun, accountID, am.policyScheduleJob(ctx, accountID))
+	}
+}
+
+// schedulePolicySchedulesOnStartup schedules the policy schedule jobs of all accounts that have
+// scheduled policies, so their transitions are applied without waiting for a policy change
+func (am *DefaultAccountManager) schedulePolicySchedulesOnStartup(ctx context.Context) {
+	accountIDs, err := am.Store.GetAccountIDsWithScheduledPolicies(ctx)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed getting accounts with scheduled policies: %v", err)
+		return
+	}
+
+	for _, accountID := range accountIDs {
+		am.schedulePolicySchedules(ctx, accountID)
+	}
+}
+
+// policyScheduleJob updates the account peers so they pick up the policies that became active or
+// inactive and returns the duration until the next transition
+func (am *DefaultAccountManager) policyScheduleJob(ctx context.Context, accountID string) func() (time.Duration, bool) {
+	return func() (time.Duration, bool) {
+		log.WithContext(ctx).Debugf("policy schedule transition for account %s, updating peers", accountID)
+		am.UpdateAccountPeers(ctx, accountID, types.UpdateReason{Resource: types.UpdateResourcePolicy, Operation: types.UpdateOperationUpdate})
+		return am.getNextPolicyScheduleTransition(ctx, accountID)
+	}
+}
+
+// getNextPolicyScheduleTransition returns the duration until the next scheduled policy of the account
+// becomes active or inactive
+func (am *DefaultAccountManager) getNextPolicyScheduleTransition(ctx context.Context, accountID string) (time.Duration, bool) {
+	policies, err := am.Store.GetAccountPolicies(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed getting policies for account %s: %v", accountID, err)
+		return peerSchedulerRetryInterval, true
+	}
+
+	now := time.Now()
+	next, ok := types.NextPolicyScheduleTransition(policies, now)
+	if !ok {
+		return 0, false
+	}
+	// run just after the boundary, so the policies are evaluated in their new state
+	return next.Sub(now) + policyScheduleTransitionDelay, true
+}
+
 // getValidPostureCheckIDs filters and returns only the valid posture check IDs from the provided list.
 func getValidPostureCheckIDs(postureChecks map[string]*posture.Checks, postureChecksIds []string) []string {
 	validIDs := make([]string, 0, len(postureChecksIds))
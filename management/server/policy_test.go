@@ -8,11 +8,15 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slices"
 
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/proto"
+	"github.com/netbirdio/netbird/shared/management/status"
 )
 
 func TestAccount_getPeersByPolicy(t *testing.T) {
@@ -1419,3 +1423,119 @@ func TestPolicyAccountPeersUpdate(t *testing.T) {
 	})
 
 }
+
+func TestPolicySchedule_PeersUpdatedAtBoundary(t *testing.T) {
+	manager, updateManager, account, peer1, _, peer3 := setupNetworkMapTest(t)
+	ctx := context.Background()
+
+	// drop the default policy so the peers are only connected by the scheduled one
+	for _, policy := range account.Policies {
+		require.NoError(t, manager.DeletePolicy(ctx, account.Id, policy.ID, userID))
+	}
+
+	err := manager.CreateGroup(ctx, account.Id, userID, &types.Group{
+		ID:    "scheduled",
+		Name:  "Scheduled",
+		Peers: []string{peer1.ID, peer3.ID},
+	})
+	require.NoError(t, err)
+
+	newPolicy := func(schedule *types.PolicySchedule) *types.Policy {
+		return &types.Policy{
+			AccountID: account.Id,
+			Enabled:   true,
+			Schedule:  schedule,
+			Rules: []*types.PolicyRule{
+				{
+					Enabled:       true,
+					Sources:       []string{"scheduled"},
+					Destinations:  []string{"scheduled"},
+					Bidirectional: true,
+					Action:        types.PolicyTrafficActionAccept,
+				},
+			},
+		}
+	}
+
+	_, err = manager.SavePolicy(ctx, account.Id, userID, newPolicy(&types.PolicySchedule{Timezone: "Nowhere/Unknown"}), true)
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.InvalidArgument, sErr.Type())
+
+	updMsg := updateManager.CreateChannel(ctx, peer1.ID)
+	t.Cleanup(func() {
+		updateManager.CloseChannel(ctx, peer1.ID)
+	})
+
+	startDate := time.Now().Add(2 * time.Second)
+	policy, err := manager.SavePolicy(ctx, account.Id, userID, newPolicy(&types.PolicySchedule{StartDate: &startDate}), true)
+	require.NoError(t, err)
+
+	stored, err := manager.Store.GetPolicyByID(ctx, store.LockingStrengthNone, account.Id, policy.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored.Schedule)
+	assert.True(t, startDate.Equal(*stored.Schedule.StartDate), "the schedule is persisted")
+	assert.Eventually(t, func() bool {
+		return manager.policySchedules.IsSchedulerRunning(account.Id)
+	}, time.Second, 10*time.Millisecond, "the start date is scheduled")
+
+	timeout := time.After(startDate.Sub(time.Now()) + peerUpdateTimeout)
+	for {
+		select {
+		case msg := <-updMsg:
+			require.NotNil(t, msg)
+			connected := slices.ContainsFunc(msg.Update.GetNetworkMap().GetRemotePeers(), func(p *proto.RemotePeerConfig) bool {
+				return p.GetWgPubKey() == peer3.Key
+			})
+			if time.Now().Before(startDate) {
+				require.False(t, connected, "the policy is inactive before its start date")
+				continue
+			}
+			if connected {
+				return
+			}
+		case <-timeout:
+			t.Fatal("timeout waiting for the schedule boundary update")
+		}
+	}
+}
+
+func TestSimulateAccess(t *testing.T) {
+	manager, _, account, peer1, peer2, _ := setupNetworkMapTest(t)
+	ctx := context.Background()
+
+	results, err := manager.SimulateAccess(ctx, account.Id, userID, &types.AccessSimulationRequest{
+		SourcePeerID:       peer1.ID,
+		DestinationAddress: peer2.IP,
+		Protocol:           types.PolicyRuleProtocolTCP,
+		Port:               443,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.True(t, results[0].Allowed)
+	assert.Equal(t, types.AccessSimulationDestinationPeer, results[0].DestinationType)
+	assert.Equal(t, peer2.ID, results[0].DestinationID)
+	require.Len(t, results[0].MatchedRules, 1)
+	assert.Equal(t, account.Policies[0].ID, results[0].MatchedRules[0].PolicyID)
+
+	policies, err := manager.Store.GetAccountPolicies(ctx, store.LockingStrengthNone, account.Id)
+	require.NoError(t, err)
+	for _, p := range policies {
+		require.NoError(t, manager.DeletePolicy(ctx, account.Id, p.ID, userID))
+	}
+
+	results, err = manager.SimulateAccess(ctx, account.Id, userID, &types.AccessSimulationRequest{SourcePeerID: peer1.ID, DestinationPeerID: peer2.ID})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.False(t, results[0].Allowed, "no policy connects the peers")
+	assert.Empty(t, results[0].MatchedRules)
+
+	_, err = manager.SimulateAccess(ctx, account.Id, userID, &types.AccessSimulationRequest{
+		SourcePeerID: peer1.ID, DestinationPeerID: peer2.ID, Protocol: types.PolicyRuleProtocolICMP, Port: 80,
+	})
+	assertStatusType(t, err, status.InvalidArgument)
+
+	require.NoError(t, manager.Store.SaveUser(ctx, &types.User{Id: "regular", AccountID: account.Id, Role: types.UserRoleUser}))
+	_, err = manager.SimulateAccess(ctx, account.Id, "regular", &types.AccessSimulationRequest{SourcePeerID: peer1.ID, DestinationPeerID: peer2.ID})
+	assertStatusType(t, err, status.PermissionDenied)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/netbirdio/netbird/management/server/activity"
 	"github.com/netbirdio/netbird/management/server/affectedpeers"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/permissions/modules"
 	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/store"
@@ -134,7 +135,7 @@ func getRouteDescriptor(prefix netip.Prefix, domains domain.List) string {
 }
 
 // CreateRoute creates and saves a new route
-func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool, skipAutoApply bool) (*route.Route, error) {
+func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool, skipAutoApply bool, distributionLabels map[string]string) (*route.Route, error) {
 	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Routes, operations.Create)
 	if err != nil {
 		return nil, status.NewPermissionValidationError(err)
@@ -169,6 +170,7 @@ func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID stri
 			Groups:              groups,
 			AccessControlGroups: accessControlGroupIDs,
 			SkipAutoApply:       skipAutoApply,
+			DistributionLabels:  distributionLabels,
 		}
 
 		if err = validateRoute(ctx, transaction, accountID, newRoute); err != nil {
@@ -194,7 +196,7 @@ func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID stri
 
 	am.StoreEvent(ctx, userID, string(newRoute.ID), accountID, activity.RouteCreated, newRoute.EventMeta())
 
-	am.ExpandAndUpdateAffected(ctx, accountID, snap, change)
+	am.updateRoutePeers(ctx, accountID, snap, change, types.UpdateOperationCreate)
 
 	return newRoute, nil
 }
@@ -243,7 +245,7 @@ func (am *DefaultAccountManager) SaveRoute(ctx context.Context, accountID, userI
 
 	am.StoreEvent(ctx, userID, string(routeToSave.ID), accountID, activity.RouteUpdated, routeToSave.EventMeta())
 
-	am.ExpandAndUpdateAffected(ctx, accountID, snap, change)
+	am.updateRoutePeers(ctx, accountID, snap, change, types.UpdateOperationUpdate)
 
 	return nil
 }
@@ -286,11 +288,23 @@ func (am *DefaultAccountManager) DeleteRoute(ctx context.Context, accountID stri
 
 	am.StoreEvent(ctx, userID, string(rt.ID), accountID, activity.RouteRemoved, rt.EventMeta())
 
-	am.ExpandAndUpdateAffected(ctx, accountID, snap, change)
+	am.updateRoutePeers(ctx, accountID, snap, change, types.UpdateOperationDelete)
 
 	return nil
 }
 
+// updateRoutePeers updates the peers affected by the route change. The peers a route is distributed to
+// by labels are not tracked by the affected peers resolver, so such changes update every account peer.
+func (am *DefaultAccountManager) updateRoutePeers(ctx context.Context, accountID string, snap *affectedpeers.Snapshot, change affectedpeers.Change, operation types.UpdateOperation) {
+	for _, r := range change.Routes {
+		if len(r.DistributionLabels) > 0 {
+			am.BufferUpdateAccountPeers(ctx, accountID, types.UpdateReason{Resource: types.UpdateResourceRoute, Operation: operation})
+			return
+		}
+	}
+	am.ExpandAndUpdateAffected(ctx, accountID, snap, change)
+}
+
 // ListRoutes returns a list of routes from account
 func (am *DefaultAccountManager) ListRoutes(ctx context.Context, accountID, userID string) ([]*route.Route, error) {
 	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Routes, operations.Read)
@@ -361,6 +375,15 @@ func validateRouteGroups(ctx context.Context, transaction store.Store, accountID
 		}
 	}
 
+	if len(routeToSave.DistributionLabels) > 0 {
+		if err = nbpeer.ValidateLabels(routeToSave.DistributionLabels); err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid distribution labels: %v", err)
+		}
+		if len(routeToSave.Groups) == 0 {
+			return groupsMap, nil
+		}
+	}
+
 	if err = validateGroups(routeToSave.Groups, groupsMap); err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/management/server/activity"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/permissions/modules"
 	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/store"
@@ -105,7 +106,7 @@ func (am *DefaultAccountManager) SaveSetupKey(ctx context.Context, accountID str
 		return nil, status.Errorf(status.InvalidArgument, "provided setup key to update is nil")
 	}
 
-	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.SetupKeys, operations.Update)
+	allowed, ctx, err := am.permissionsManager.ValidateUserResourcePermissions(ctx, accountID, userID, modules.SetupKeys, operations.Update, keyToSave.Id)
 	if err != nil {
 		return nil, status.NewPermissionValidationError(err)
 	}
@@ -131,10 +132,25 @@ func (am *DefaultAccountManager) SaveSetupKey(ctx context.Context, accountID str
 			return status.Errorf(status.InvalidArgument, "can't un-revoke a revoked setup key")
 		}
 
-		// only auto groups, revoked status (from false to true) can be updated
+		// only auto groups, labels, restrictions and revoked status (from false to true) can be updated
 		newKey = oldKey.Copy()
 		newKey.AutoGroups = keyToSave.AutoGroups
 		newKey.Revoked = keyToSave.Revoked
+		if keyToSave.Labels != nil {
+			if err = nbpeer.ValidateLabels(keyToSave.Labels); err != nil {
+				return status.Errorf(status.InvalidArgument, "invalid labels: %v", err)
+			}
+			newKey.Labels = keyToSave.Labels
+		}
+		if keyToSave.Restrictions != nil {
+			if err = keyToSave.Restrictions.Validate(); err != nil {
+				return status.Errorf(status.InvalidArgument, "invalid restrictions: %v", err)
+			}
+			newKey.Restrictions = keyToSave.Restrictions
+			if newKey.Restrictions.IsEmpty() {
+				newKey.Restrictions = nil
+			}
+		}
 		newKey.UpdatedAt = time.Now().UTC()
 
 		addedGroups := util.Difference(newKey.AutoGroups, oldKey.AutoGroups)
@@ -175,7 +191,7 @@ func (am *DefaultAccountManager) ListSetupKeys(ctx context.Context, accountID, u
 
 // GetSetupKey looks up a SetupKey by KeyID, returns NotFound error if not found.
 func (am *DefaultAccountManager) GetSetupKey(ctx context.Context, accountID, userID, keyID string) (*types.SetupKey, error) {
-	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.SetupKeys, operations.Read)
+	allowed, ctx, err := am.permissionsManager.ValidateUserResourcePermissions(ctx, accountID, userID, modules.SetupKeys, operations.Read, keyID)
 	if err != nil {
 		return nil, status.NewPermissionValidationError(err)
 	}
@@ -198,7 +214,7 @@ func (am *DefaultAccountManager) GetSetupKey(ctx context.Context, accountID, use
 
 // DeleteSetupKey removes the setup key from the account
 func (am *DefaultAccountManager) DeleteSetupKey(ctx context.Context, accountID, userID, keyID string) error {
-	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.SetupKeys, operations.Delete)
+	allowed, ctx, err := am.permissionsManager.ValidateUserResourcePermissions(ctx, accountID, userID, modules.SetupKeys, operations.Delete, keyID)
 	if err != nil {
 		return status.NewPermissionValidationError(err)
 	}
@@ -31,12 +31,17 @@ import (
 	"gorm.io/gorm/logger"
 
 	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/internals/modules/customroles"
+	"github.com/netbirdio/netbird/management/internals/modules/eventstreaming"
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/accesslogs"
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/domain"
+	"github.com/netbirdio/netbird/management/internals/modules/scim"
+	"github.com/netbirdio/netbird/management/internals/modules/workloadidentity"
 
 	agentNetworkTypes "github.com/netbirdio/netbird/management/internals/modules/agentnetwork/types"
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/proxy"
 	rpservice "github.com/netbirdio/netbird/management/internals/modules/reverseproxy/service"
+	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/sessions"
 	"github.com/netbirdio/netbird/management/internals/modules/zones"
 	"github.com/netbirdio/netbird/management/internals/modules/zones/records"
 	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
@@ -131,27 +136,33 @@ func NewSqlStore(ctx context.Context, db *gorm.DB, storeEngine types.Engine, met
 	if err := migratePreAuto(ctx, db); err != nil {
 		return nil, fmt.Errorf("migratePreAuto: %w", err)
 	}
-	err = db.AutoMigrate(
+	err = db.AutoMigrate(Models()...)
+	if err != nil {
+		return nil, fmt.Errorf("auto migratePreAuto: %w", err)
+	}
+	if err := migratePostAuto(ctx, db); err != nil {
+		return nil, fmt.Errorf("migratePostAuto: %w", err)
+	}
+
+	return &SqlStore{db: db, storeEngine: storeEngine, metrics: metrics, installationPK: 1, transactionTimeout: transactionTimeout}, nil
+}
+
+// Models returns every model whose table is managed by the SQL store, in the order they are auto migrated.
+func Models() []any {
+	return []any{
 		&types.SetupKey{}, &nbpeer.Peer{}, &types.User{}, &types.PersonalAccessToken{}, &types.ProxyAccessToken{},
 		&types.Group{}, &types.GroupPeer{},
 		&types.Account{}, &types.Policy{}, &types.PolicyRule{}, &route.Route{}, &nbdns.NameServerGroup{},
 		&installation{}, &types.ExtraSettings{}, &posture.Checks{}, &nbpeer.NetworkAddress{},
 		&networkTypes.Network{}, &routerTypes.NetworkRouter{}, &resourceTypes.NetworkResource{}, &types.AccountOnboarding{},
 		&types.Job{}, &zones.Zone{}, &records.Record{}, &types.UserInviteRecord{}, &rpservice.Service{}, &rpservice.Target{}, &domain.Domain{},
-		&accesslogs.AccessLogEntry{}, &proxy.Proxy{},
+		&accesslogs.AccessLogEntry{}, &proxy.Proxy{}, &sessions.Session{}, &eventstreaming.Integration{},
+		&customroles.Role{}, &scim.Integration{}, &scim.SyncLog{}, &types.AccessRequest{}, &workloadidentity.Provider{},
 		&agentNetworkTypes.Provider{}, &agentNetworkTypes.Policy{}, &agentNetworkTypes.Guardrail{}, &agentNetworkTypes.Settings{},
 		&agentNetworkTypes.Consumption{}, &agentNetworkTypes.AccountBudgetRule{},
 		&agentNetworkTypes.AgentNetworkAccessLog{}, &agentNetworkTypes.AgentNetworkAccessLogGroup{},
 		&agentNetworkTypes.AgentNetworkUsage{}, &agentNetworkTypes.AgentNetworkUsageGroup{},
-	)
-	if err != nil {
-		return nil, fmt.Errorf("auto migratePreAuto: %w", err)
 	}
-	if err := migratePostAuto(ctx, db); err != nil {
-		return nil, fmt.Errorf("migratePostAuto: %w", err)
-	}
-
-	return &SqlStore{db: db, storeEngine: storeEngine, metrics: metrics, installationPK: 1, transactionTimeout: transactionTimeout}, nil
 }
 
 func GetKeyQueryCondition(s *SqlStore) string {
@@ -684,6 +695,7 @@ func groupUpsertColumns() clause.Set {
 		"integration_ref_id",
 		"integration_ref_integration_type",
 		"resources",
+		"rules",
 	})
 }
 
@@ -1248,6 +1260,7 @@ func (s *SqlStore) getAccountGorm(ctx context.Context, accountID string) (*types
 		Preload("Onboarding").
 		Preload("Services.Targets").
 		Preload("Domains").
+		Preload("AccessGrants", "status = ?", types.AccessRequestStatusApproved).
 		Take(&account, idQueryCondition, accountID)
 	if result.Error != nil {
 		log.WithContext(ctx).Errorf("error when getting account %s from the store: %s", accountID, result.Error)
@@ -1479,6 +1492,17 @@ func (s *SqlStore) getAccountPgx(ctx context.Context, accountID string) (*types.
 		account.NetworkResources = resources
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		grants, err := s.getAccessGrants(ctx, accountID)
+		if err != nil {
+			errChan <- err
+			return
+		}
+		account.AccessGrants = grants
+	}()
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -1654,10 +1678,11 @@ func (s *SqlStore) getAccount(ctx context.Context, accountID string) (*types.Acc
 			settings_network_range_v6, settings_ipv6_enabled_groups, settings_lazy_connection_enabled,
 			settings_local_mfa_enabled, settings_metrics_push_enabled, settings_agent_network_only,
 			settings_dashboard_features, settings_auto_update_version, settings_auto_update_always,
-			settings_peer_expose_enabled, settings_peer_expose_groups,
+			settings_peer_expose_enabled, settings_peer_expose_groups, settings_access_request_approver_groups,
 			-- Embedded ExtraSettings
 			settings_extra_peer_approval_enabled, settings_extra_user_approval_required,
-			settings_extra_integrated_validator, settings_extra_integrated_validator_groups
+			settings_extra_integrated_validator, settings_extra_integrated_validator_groups,
+			settings_extra_peer_approval_rules
 		FROM accounts WHERE id = $1`
 
 	var (
@@ -1684,10 +1709,12 @@ func (s *SqlStore) getAccount(ctx context.Context, accountID string) (*types.Acc
 		autoUpdateAlways                 sql.NullBool
 		peerExposeEnabled                sql.NullBool
 		peerExposeGroups                 sql.NullString
+		accessRequestApproverGroups      sql.NullString
 		sExtraPeerApprovalEnabled        sql.NullBool
 		sExtraUserApprovalRequired       sql.NullBool
 		sExtraIntegratedValidator        sql.NullString
 		sExtraIntegratedValidatorGroups  sql.NullString
+		sExtraPeerApprovalRules          sql.NullString
 		networkNet                       sql.NullString
 		networkNetV6                     sql.NullString
 		dnsSettingsDisabledGroups        sql.NullString
@@ -1708,9 +1735,10 @@ func (s *SqlStore) getAccount(ctx context.Context, accountID string) (*types.Acc
 		&sNetworkRangeV6, &sIPv6EnabledGroups, &sLazyConnectionEnabled,
 		&sLocalMFAEnabled, &sMetricsPushEnabled, &sAgentNetworkOnly,
 		&sDashboardFeatures, &autoUpdateVersion, &autoUpdateAlways,
-		&peerExposeEnabled, &peerExposeGroups,
+		&peerExposeEnabled, &peerExposeGroups, &accessRequestApproverGroups,
 		&sExtraPeerApprovalEnabled, &sExtraUserApprovalRequired,
 		&sExtraIntegratedValidator, &sExtraIntegratedValidatorGroups,
+		&sExtraPeerApprovalRules,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -1812,6 +1840,9 @@ func (s *SqlStore) getAccount(ctx context.Context, accountID string) (*types.Acc
 	if peerExposeGroups.Valid {
 		_ = json.Unmarshal([]byte(peerExposeGroups.String), &account.Settings.PeerExposeGroups)
 	}
+	if accessRequestApproverGroups.Valid {
+		_ = json.Unmarshal([]byte(accessRequestApproverGroups.String), &account.Settings.AccessRequestApproverGroups)
+	}
 
 	if sExtraPeerApprovalEnabled.Valid {
 		account.Settings.Extra.PeerApprovalEnabled = sExtraPeerApprovalEnabled.Bool
@@ -1825,12 +1856,15 @@ func (s *SqlStore) getAccount(ctx context.Context, accountID string) (*types.Acc
 	if sExtraIntegratedValidatorGroups.Valid {
 		_ = json.Unmarshal([]byte(sExtraIntegratedValidatorGroups.String), &account.Settings.Extra.IntegratedValidatorGroups)
 	}
+	if sExtraPeerApprovalRules.Valid {
+		_ = json.Unmarshal([]byte(sExtraPeerApprovalRules.String), &account.Settings.Extra.PeerApprovalRules)
+	}
 	return &account, nil
 }
 
 func (s *SqlStore) getSetupKeys(ctx context.Context, accountID string) ([]types.SetupKey, error) {
 	const query = `SELECT id, account_id, key, key_secret, name, type, created_at, expires_at, updated_at, 
-	revoked, used_times, last_used, auto_groups, usage_limit, ephemeral, allow_extra_dns_labels FROM setup_keys WHERE account_id = $1`
+	revoked, used_times, last_used, auto_groups, usage_limit, ephemeral, allow_extra_dns_labels, labels, restrictions FROM setup_keys WHERE account_id = $1`
 	rows, err := s.pool.Query(ctx, query, accountID)
 	if err != nil {
 		return nil, err
@@ -1838,13 +1872,13 @@ func (s *SqlStore) getSetupKeys(ctx context.Context, accountID string) ([]types.
 
 	keys, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (types.SetupKey, error) {
 		var sk types.SetupKey
-		var autoGroups []byte
+		var autoGroups, labels, restrictions []byte
 		var skCreatedAt, expiresAt, updatedAt, lastUsed sql.NullTime
 		var revoked, ephemeral, allowExtraDNSLabels sql.NullBool
 		var usedTimes, usageLimit sql.NullInt64
 
 		err := row.Scan(&sk.Id, &sk.AccountID, &sk.Key, &sk.KeySecret, &sk.Name, &sk.Type, &skCreatedAt,
-			&expiresAt, &updatedAt, &revoked, &usedTimes, &lastUsed, &autoGroups, &usageLimit, &ephemeral, &allowExtraDNSLabels)
+			&expiresAt, &updatedAt, &revoked, &usedTimes, &lastUsed, &autoGroups, &usageLimit, &ephemeral, &allowExtraDNSLabels, &labels, &restrictions)
 
 		if err == nil {
 			if expiresAt.Valid {
@@ -1882,6 +1916,12 @@ func (s *SqlStore) getSetupKeys(ctx context.Context, accountID string) ([]types.
 			} else {
 				sk.AutoGroups = []string{}
 			}
+			if labels != nil {
+				_ = json.Unmarshal(labels, &sk.Labels)
+			}
+			if restrictions != nil {
+				_ = json.Unmarshal(restrictions, &sk.Restrictions)
+			}
 		}
 		return sk, err
 	})
@@ -1892,13 +1932,14 @@ func (s *SqlStore) getSetupKeys(ctx context.Context, accountID string) ([]types.
 }
 
 func (s *SqlStore) getPeers(ctx context.Context, accountID string) ([]nbpeer.Peer, error) {
-	const query = `SELECT id, account_id, key, ip, name, dns_label, user_id, ssh_key, ssh_enabled, login_expiration_enabled,
+	const query = `SELECT id, account_id, key, ip, name, dns_label, user_id, setup_key_id, ssh_key, ssh_enabled, login_expiration_enabled,
 	inactivity_expiration_enabled, last_login, created_at, ephemeral, extra_dns_labels, allow_extra_dns_labels, meta_hostname,
 	meta_go_os, meta_kernel, meta_core, meta_platform, meta_os, meta_os_version, meta_wt_version, meta_ui_version,
 	meta_kernel_version, meta_network_addresses, meta_system_serial_number, meta_system_product_name, meta_system_manufacturer,
 	meta_environment, meta_flags, meta_files, meta_capabilities, peer_status_last_seen, peer_status_session_started_at,
 	peer_status_connected, peer_status_login_expired, peer_status_requires_approval, location_connection_ip,
-	location_country_code, location_city_name, location_geo_name_id, proxy_meta_embedded, proxy_meta_cluster, ipv6, meta_sync_message_version
+	location_country_code, location_city_name, location_geo_name_id, proxy_meta_embedded, proxy_meta_cluster, ipv6, meta_sync_message_version,
+	labels, meta_labels
 	FROM peers WHERE account_id = $1`
 	rows, err := s.pool.Query(ctx, query, accountID)
 	if err != nil {
@@ -1915,24 +1956,29 @@ func (s *SqlStore) getPeers(ctx context.Context, accountID string) ([]nbpeer.Pee
 			peerStatusSessionStartedAt                                                                      sql.NullInt64
 			peerStatusConnected, peerStatusLoginExpired, peerStatusRequiresApproval, proxyEmbedded          sql.NullBool
 			ip, extraDNS, netAddr, env, flags, files, capabilities, connIP, ipv6                            []byte
+			labels, metaLabels                                                                              []byte
 			metaHostname, metaGoOS, metaKernel, metaCore, metaPlatform                                      sql.NullString
 			metaOS, metaOSVersion, metaWtVersion, metaUIVersion, metaKernelVersion                          sql.NullString
 			metaSystemSerialNumber, metaSystemProductName, metaSystemManufacturer                           sql.NullString
 			locationCountryCode, locationCityName, proxyCluster                                             sql.NullString
 			locationGeoNameID                                                                               sql.NullInt64
 			metaSyncMessageVersion                                                                          sql.NullInt32
+			setupKeyID                                                                                      sql.NullString
 		)
 
-		err := row.Scan(&p.ID, &p.AccountID, &p.Key, &ip, &p.Name, &p.DNSLabel, &p.UserID, &p.SSHKey, &sshEnabled,
+		err := row.Scan(&p.ID, &p.AccountID, &p.Key, &ip, &p.Name, &p.DNSLabel, &p.UserID, &setupKeyID, &p.SSHKey, &sshEnabled,
 			&loginExpirationEnabled, &inactivityExpirationEnabled, &lastLogin, &createdAt, &ephemeral, &extraDNS,
 			&allowExtraDNSLabels, &metaHostname, &metaGoOS, &metaKernel, &metaCore, &metaPlatform,
 			&metaOS, &metaOSVersion, &metaWtVersion, &metaUIVersion, &metaKernelVersion, &netAddr,
 			&metaSystemSerialNumber, &metaSystemProductName, &metaSystemManufacturer, &env, &flags, &files, &capabilities,
 			&peerStatusLastSeen, &peerStatusSessionStartedAt, &peerStatusConnected, &peerStatusLoginExpired,
 			&peerStatusRequiresApproval, &connIP, &locationCountryCode, &locationCityName, &locationGeoNameID,
-			&proxyEmbedded, &proxyCluster, &ipv6, &metaSyncMessageVersion)
+			&proxyEmbedded, &proxyCluster, &ipv6, &metaSyncMessageVersion, &labels, &metaLabels)
 
 		if err == nil {
+			if setupKeyID.Valid {
+				p.SetupKeyID = setupKeyID.String
+			}
 			if lastLogin.Valid {
 				p.LastLogin = &lastLogin.Time
 			}
@@ -2053,6 +2099,12 @@ func (s *SqlStore) getPeers(ctx context.Context, accountID string) ([]nbpeer.Pee
 			if metaSyncMessageVersion.Valid {
 				p.Meta.SyncMessageVersion = int(metaSyncMessageVersion.Int32)
 			}
+			if labels != nil {
+				_ = json.Unmarshal(labels, &p.Labels)
+			}
+			if metaLabels != nil {
+				_ = json.Unmarshal(metaLabels, &p.Meta.Labels)
+			}
 		}
 		return p, err
 	})
@@ -2108,17 +2160,17 @@ func (s *SqlStore) getUsers(ctx context.Context, accountID string) ([]types.User
 }
 
 func (s *SqlStore) getGroups(ctx context.Context, accountID string) ([]*types.Group, error) {
-	const query = `SELECT id, account_id, public_id, name, issued, resources, integration_ref_id, integration_ref_integration_type FROM groups WHERE account_id = $1`
+	const query = `SELECT id, account_id, public_id, name, issued, resources, rules, integration_ref_id, integration_ref_integration_type FROM groups WHERE account_id = $1`
 	rows, err := s.pool.Query(ctx, query, accountID)
 	if err != nil {
 		return nil, err
 	}
 	groups, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*types.Group, error) {
 		var g types.Group
-		var resources []byte
+		var resources, rules []byte
 		var refID sql.NullInt64
 		var refType sql.NullString
-		err := row.Scan(&g.ID, &g.AccountID, &g.PublicID, &g.Name, &g.Issued, &resources, &refID, &refType)
+		err := row.Scan(&g.ID, &g.AccountID, &g.PublicID, &g.Name, &g.Issued, &resources, &rules, &refID, &refType)
 		if err == nil {
 			if refID.Valid {
 				g.IntegrationReference.ID = int(refID.Int64)
@@ -2131,6 +2183,9 @@ func (s *SqlStore) getGroups(ctx context.Context, accountID string) ([]*types.Gr
 			} else {
 				g.Resources = []types.Resource{}
 			}
+			if rules != nil {
+				_ = json.Unmarshal(rules, &g.Rules)
+			}
 			g.GroupPeers = []types.GroupPeer{}
 			g.Peers = []string{}
 		}
@@ -2143,16 +2198,16 @@ func (s *SqlStore) getGroups(ctx context.Context, accountID string) ([]*types.Gr
 }
 
 func (s *SqlStore) getPolicies(ctx context.Context, accountID string) ([]*types.Policy, error) {
-	const query = `SELECT id, account_id, public_id, name, description, enabled, source_posture_checks FROM policies WHERE account_id = $1`
+	const query = `SELECT id, account_id, public_id, name, description, enabled, source_posture_checks, schedule FROM policies WHERE account_id = $1`
 	rows, err := s.pool.Query(ctx, query, accountID)
 	if err != nil {
 		return nil, err
 	}
 	policies, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*types.Policy, error) {
 		var p types.Policy
-		var checks []byte
+		var checks, schedule []byte
 		var enabled sql.NullBool
-		err := row.Scan(&p.ID, &p.AccountID, &p.PublicID, &p.Name, &p.Description, &enabled, &checks)
+		err := row.Scan(&p.ID, &p.AccountID, &p.PublicID, &p.Name, &p.Description, &enabled, &checks, &schedule)
 		if err == nil {
 			if enabled.Valid {
 				p.Enabled = enabled.Bool
@@ -2160,6 +2215,9 @@ func (s *SqlStore) getPolicies(ctx context.Context, accountID string) ([]*types.
 			if checks != nil {
 				_ = json.Unmarshal(checks, &p.SourcePostureChecks)
 			}
+			if schedule != nil {
+				_ = json.Unmarshal(schedule, &p.Schedule)
+			}
 		}
 		return &p, err
 	})
@@ -2170,17 +2228,17 @@ func (s *SqlStore) getPolicies(ctx context.Context, accountID string) ([]*types.
 }
 
 func (s *SqlStore) getRoutes(ctx context.Context, accountID string) ([]route.Route, error) {
-	const query = `SELECT id, account_id, public_id, network, domains, keep_route, net_id, description, peer, peer_groups, network_type, masquerade, metric, enabled, groups, access_control_groups, skip_auto_apply FROM routes WHERE account_id = $1`
+	const query = `SELECT id, account_id, public_id, network, domains, keep_route, net_id, description, peer, peer_groups, network_type, masquerade, metric, enabled, groups, access_control_groups, skip_auto_apply, distribution_labels FROM routes WHERE account_id = $1`
 	rows, err := s.pool.Query(ctx, query, accountID)
 	if err != nil {
 		return nil, err
 	}
 	routes, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (route.Route, error) {
 		var r route.Route
-		var network, domains, peerGroups, groups, accessGroups []byte
+		var network, domains, peerGroups, groups, accessGroups, distributionLabels []byte
 		var keepRoute, masquerade, enabled, skipAutoApply sql.NullBool
 		var metric sql.NullInt64
-		err := row.Scan(&r.ID, &r.AccountID, &r.PublicID, &network, &domains, &keepRoute, &r.NetID, &r.Description, &r.Peer, &peerGroups, &r.NetworkType, &masquerade, &metric, &enabled, &groups, &accessGroups, &skipAutoApply)
+		err := row.Scan(&r.ID, &r.AccountID, &r.PublicID, &network, &domains, &keepRoute, &r.NetID, &r.Description, &r.Peer, &peerGroups, &r.NetworkType, &masquerade, &metric, &enabled, &groups, &accessGroups, &skipAutoApply, &distributionLabels)
 		if err == nil {
 			if keepRoute.Valid {
 				r.KeepRoute = keepRoute.Bool
@@ -2212,6 +2270,9 @@ func (s *SqlStore) getRoutes(ctx context.Context, accountID string) ([]route.Rou
 			if accessGroups != nil {
 				_ = json.Unmarshal(accessGroups, &r.AccessControlGroups)
 			}
+			if distributionLabels != nil {
+				_ = json.Unmarshal(distributionLabels, &r.DistributionLabels)
+			}
 		}
 		return r, err
 	})
@@ -2287,6 +2348,36 @@ func (s *SqlStore) getPostureChecks(ctx context.Context, accountID string) ([]*p
 	return checks, nil
 }
 
+func (s *SqlStore) getAccessGrants(ctx context.Context, accountID string) ([]*types.AccessRequest, error) {
+	const query = `SELECT id, account_id, user_id, target_type, target_id, justification, duration, status,
+		created_at, reviewed_by, reviewed_at, review_comment, expires_at, revoked_by, revoked_at
+		FROM access_requests WHERE account_id = $1 AND status = $2`
+	rows, err := s.pool.Query(ctx, query, accountID, types.AccessRequestStatusApproved)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*types.AccessRequest, error) {
+		var r types.AccessRequest
+		var reviewedAt, expiresAt, revokedAt sql.NullTime
+		err := row.Scan(&r.ID, &r.AccountID, &r.UserID, &r.TargetType, &r.TargetID, &r.Justification, &r.Duration, &r.Status,
+			&r.CreatedAt, &r.ReviewedBy, &reviewedAt, &r.ReviewComment, &expiresAt, &r.RevokedBy, &revokedAt)
+		if reviewedAt.Valid {
+			r.ReviewedAt = &reviewedAt.Time
+		}
+		if expiresAt.Valid {
+			r.ExpiresAt = &expiresAt.Time
+		}
+		if revokedAt.Valid {
+			r.RevokedAt = &revokedAt.Time
+		}
+		return &r, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return grants, nil
+}
+
 // serviceSelectColumns and targetSelectColumns are the column lists the Postgres
 // pgx read path scans. They must stay in sync with the rpservice.Service and
 // rpservice.Target gorm models; TestPgxServiceColumnsMatchGorm enforces this.
@@ -2294,13 +2385,13 @@ const serviceSelectColumns = `id, account_id, name, domain, enabled, auth, restr
 	meta_created_at, meta_certificate_issued_at, meta_last_renewed_at, meta_status, proxy_cluster,
 	pass_host_header, rewrite_redirects, session_private_key, session_public_key,
 	mode, listen_port, port_auto_assigned, source, source_peer, terminated,
-	private, access_groups`
+	private, access_groups, authorization_rules, error_pages, maintenance, connection_limits`
 
 const targetSelectColumns = `id, account_id, service_id, path, host, port, protocol,
 	target_id, target_type, enabled, proxy_protocol,
 	skip_tls_verify, request_timeout, session_idle_timeout, path_rewrite, custom_headers,
 	direct_upstream, middlewares, capture_max_request_bytes, capture_max_response_bytes,
-	capture_content_types, agent_network, disable_access_log`
+	capture_content_types, agent_network, disable_access_log, mirror_target, mirror_percent`
 
 func (s *SqlStore) getServices(ctx context.Context, accountID string) ([]*rpservice.Service, error) {
 	const serviceQuery = `SELECT ` + serviceSelectColumns + ` FROM services WHERE account_id = $1`
@@ -2344,7 +2435,7 @@ func scanService(row pgx.CollectableRow) (*rpservice.Service, error) {
 	var s rpservice.Service
 	var auth []byte
 	var restrictions []byte
-	var accessGroups []byte
+	var accessGroups, authorizationRules, errorPages, maintenance, connectionLimits []byte
 	var createdAt, certIssuedAt, lastRenewedAt sql.NullTime
 	var status, proxyCluster, sessionPrivateKey, sessionPublicKey sql.NullString
 	var mode, source, sourcePeer sql.NullString
@@ -2375,6 +2466,10 @@ func scanService(row pgx.CollectableRow) (*rpservice.Service, error) {
 		&terminated,
 		&private,
 		&accessGroups,
+		&authorizationRules,
+		&errorPages,
+		&maintenance,
+		&connectionLimits,
 	)
 	if err != nil {
 		return nil, err
@@ -2398,6 +2493,30 @@ func scanService(row pgx.CollectableRow) (*rpservice.Service, error) {
 		}
 	}
 
+	if len(authorizationRules) > 0 {
+		if err := json.Unmarshal(authorizationRules, &s.AuthorizationRules); err != nil {
+			return nil, fmt.Errorf("unmarshal authorization_rules: %w", err)
+		}
+	}
+
+	if len(errorPages) > 0 {
+		if err := json.Unmarshal(errorPages, &s.ErrorPages); err != nil {
+			return nil, fmt.Errorf("unmarshal error_pages: %w", err)
+		}
+	}
+
+	if len(maintenance) > 0 {
+		if err := json.Unmarshal(maintenance, &s.Maintenance); err != nil {
+			return nil, fmt.Errorf("unmarshal maintenance: %w", err)
+		}
+	}
+
+	if len(connectionLimits) > 0 {
+		if err := json.Unmarshal(connectionLimits, &s.ConnectionLimits); err != nil {
+			return nil, fmt.Errorf("unmarshal connection_limits: %w", err)
+		}
+	}
+
 	if private.Valid {
 		s.Private = private.Bool
 	}
@@ -2470,9 +2589,9 @@ func (s *SqlStore) getServiceTargets(ctx context.Context, serviceIDs []string) (
 func scanTarget(row pgx.CollectableRow) (*rpservice.Target, error) {
 	var t rpservice.Target
 	var path sql.NullString
-	var pathRewrite sql.NullString
+	var pathRewrite, mirrorTarget sql.NullString
 	var proxyProtocol, skipTLSVerify, directUpstream, agentNetwork, disableAccessLog sql.NullBool
-	var requestTimeout, sessionIdleTimeout, captureMaxRequestBytes, captureMaxResponseBytes sql.NullInt64
+	var requestTimeout, sessionIdleTimeout, captureMaxRequestBytes, captureMaxResponseBytes, mirrorPercent sql.NullInt64
 	var customHeaders, middlewares, captureContentTypes []byte
 	err := row.Scan(
 		&t.ID,
@@ -2498,6 +2617,8 @@ func scanTarget(row pgx.CollectableRow) (*rpservice.Target, error) {
 		&captureContentTypes,
 		&agentNetwork,
 		&disableAccessLog,
+		&mirrorTarget,
+		&mirrorPercent,
 	)
 	if err != nil {
 		return nil, err
@@ -2516,6 +2637,8 @@ func scanTarget(row pgx.CollectableRow) (*rpservice.Target, error) {
 	t.Options.CaptureMaxResponseBytes = captureMaxResponseBytes.Int64
 	t.Options.AgentNetwork = agentNetwork.Bool
 	t.Options.DisableAccessLog = disableAccessLog.Bool
+	t.Options.MirrorTarget = mirrorTarget.String
+	t.Options.MirrorPercent = int(mirrorPercent.Int64)
 
 	if len(customHeaders) > 0 {
 		if err := json.Unmarshal(customHeaders, &t.Options.CustomHeaders); err != nil {
@@ -2654,7 +2777,7 @@ func (s *SqlStore) getPersonalAccessTokens(ctx context.Context, userIDs []string
 	if len(userIDs) == 0 {
 		return nil, nil
 	}
-	const query = `SELECT id, user_id, name, hashed_token, expiration_date, created_by, created_at, last_used FROM personal_access_tokens WHERE user_id = ANY($1)`
+	const query = `SELECT id, user_id, name, hashed_token, expiration_date, scopes, created_by, created_at, last_used FROM personal_access_tokens WHERE user_id = ANY($1)`
 	rows, err := s.pool.Query(ctx, query, userIDs)
 	if err != nil {
 		return nil, err
@@ -2662,8 +2785,12 @@ func (s *SqlStore) getPersonalAccessTokens(ctx context.Context, userIDs []string
 	pats, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (types.PersonalAccessToken, error) {
 		var pat types.PersonalAccessToken
 		var expirationDate, lastUsed, createdAt sql.NullTime
-		err := row.Scan(&pat.ID, &pat.UserID, &pat.Name, &pat.HashedToken, &expirationDate, &pat.CreatedBy, &createdAt, &lastUsed)
+		var scopes []byte
+		err := row.Scan(&pat.ID, &pat.UserID, &pat.Name, &pat.HashedToken, &expirationDate, &scopes, &pat.CreatedBy, &createdAt, &lastUsed)
 		if err == nil {
+			if len(scopes) > 0 {
+				_ = json.Unmarshal(scopes, &pat.Scopes)
+			}
 			if expirationDate.Valid {
 				pat.ExpirationDate = &expirationDate.Time
 			}
@@ -2686,17 +2813,17 @@ func (s *SqlStore) getPolicyRules(ctx context.Context, policyIDs []string) ([]*t
 	if len(policyIDs) == 0 {
 		return nil, nil
 	}
-	const query = `SELECT id, policy_id, name, description, enabled, action, destinations, destination_resource, sources, source_resource, bidirectional, protocol, ports, port_ranges, authorized_groups, authorized_user FROM policy_rules WHERE policy_id = ANY($1)`
+	const query = `SELECT id, policy_id, name, description, enabled, action, destinations, destination_resource, sources, source_resource, bidirectional, protocol, ports, port_ranges, authorized_groups, authorized_user, source_labels, destination_labels FROM policy_rules WHERE policy_id = ANY($1)`
 	rows, err := s.pool.Query(ctx, query, policyIDs)
 	if err != nil {
 		return nil, err
 	}
 	rules, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*types.PolicyRule, error) {
 		var r types.PolicyRule
-		var dest, destRes, sources, sourceRes, ports, portRanges, authorizedGroups []byte
+		var dest, destRes, sources, sourceRes, ports, portRanges, authorizedGroups, sourceLabels, destLabels []byte
 		var enabled, bidirectional sql.NullBool
 		var authorizedUser sql.NullString
-		err := row.Scan(&r.ID, &r.PolicyID, &r.Name, &r.Description, &enabled, &r.Action, &dest, &destRes, &sources, &sourceRes, &bidirectional, &r.Protocol, &ports, &portRanges, &authorizedGroups, &authorizedUser)
+		err := row.Scan(&r.ID, &r.PolicyID, &r.Name, &r.Description, &enabled, &r.Action, &dest, &destRes, &sources, &sourceRes, &bidirectional, &r.Protocol, &ports, &portRanges, &authorizedGroups, &authorizedUser, &sourceLabels, &destLabels)
 		if err == nil {
 			if enabled.Valid {
 				r.Enabled = enabled.Bool
@@ -2728,6 +2855,12 @@ func (s *SqlStore) getPolicyRules(ctx context.Context, policyIDs []string) ([]*t
 			if authorizedUser.Valid {
 				r.AuthorizedUser = authorizedUser.String
 			}
+			if sourceLabels != nil {
+				_ = json.Unmarshal(sourceLabels, &r.SourceLabels)
+			}
+			if destLabels != nil {
+				_ = json.Unmarshal(destLabels, &r.DestinationLabels)
+			}
 		}
 		return &r, err
 	})
@@ -3060,11 +3193,19 @@ func (s *SqlStore) GetStoreEngine() types.Engine {
 
 // NewSqliteStore creates a new SQLite store.
 func NewSqliteStore(ctx context.Context, dataDir string, metrics telemetry.AppMetrics, skipMigration bool) (*SqlStore, error) {
-	storeFile := storeSqliteFileName
+	return newSqliteStoreFromFile(ctx, dataDir, sqliteStoreFile(), metrics, skipMigration)
+}
+
+// sqliteStoreFile returns the SQLite database file name, optionally followed by URI query parameters.
+func sqliteStoreFile() string {
 	if envFile, ok := os.LookupEnv("NB_STORE_ENGINE_SQLITE_FILE"); ok && envFile != "" {
-		storeFile = envFile
+		return envFile
 	}
+	return storeSqliteFileName
+}
 
+// newSqliteStoreFromFile opens the SQLite database in storeFile, relative paths are resolved against dataDir.
+func newSqliteStoreFromFile(ctx context.Context, dataDir, storeFile string, metrics telemetry.AppMetrics, skipMigration bool) (*SqlStore, error) {
 	// Separate file path from any SQLite URI query parameters (e.g., "store.db?mode=rwc")
 	filePath, query, hasQuery := strings.Cut(storeFile, "?")
 
@@ -3536,6 +3677,20 @@ func (s *SqlStore) GetAccountPeers(ctx context.Context, lockStrength LockingStre
 	return peers, nil
 }
 
+// CountSetupKeyPeersByHostname counts the peers of the account registered with the setup key that have the hostname.
+func (s *SqlStore) CountSetupKeyPeersByHostname(ctx context.Context, accountID, setupKeyID, hostname string) (int64, error) {
+	var count int64
+	result := s.db.Model(&nbpeer.Peer{}).
+		Where("account_id = ? AND setup_key_id = ? AND meta_hostname = ?", accountID, setupKeyID, hostname).
+		Count(&count)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to count peers of setup key %s: %s", setupKeyID, result.Error)
+		return 0, status.Errorf(status.Internal, "failed to count setup key peers")
+	}
+
+	return count, nil
+}
+
 // GetUserPeers retrieves peers for a user.
 func (s *SqlStore) GetUserPeers(ctx context.Context, lockStrength LockingStrength, accountID, userID string) ([]*nbpeer.Peer, error) {
 	tx := s.db
@@ -4081,6 +4236,97 @@ func (s *SqlStore) DeletePolicy(ctx context.Context, accountID, policyID string)
 	})
 }
 
+// GetAccountIDsWithScheduledPolicies returns the IDs of the accounts that have at least one policy with a schedule
+func (s *SqlStore) GetAccountIDsWithScheduledPolicies(ctx context.Context) ([]string, error) {
+	var accountIDs []string
+
+	result := s.db.
+		Model(&types.Policy{}).
+		Where("schedule IS NOT NULL AND schedule <> ?", "null").
+		Distinct("account_id").
+		Pluck("account_id", &accountIDs)
+
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get accounts with scheduled policies from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get accounts with scheduled policies from store")
+	}
+
+	return accountIDs, nil
+}
+
+// GetAccountIDsWithApprovedAccessRequests returns the IDs of the accounts that have at least one approved access request
+func (s *SqlStore) GetAccountIDsWithApprovedAccessRequests(ctx context.Context) ([]string, error) {
+	var accountIDs []string
+
+	result := s.db.
+		Model(&types.AccessRequest{}).
+		Where("status = ?", types.AccessRequestStatusApproved).
+		Distinct("account_id").
+		Pluck("account_id", &accountIDs)
+
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get accounts with approved access requests from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get accounts with approved access requests from store")
+	}
+
+	return accountIDs, nil
+}
+
+func (s *SqlStore) GetAccountAccessRequests(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.AccessRequest, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var requests []*types.AccessRequest
+	result := tx.Order("created_at desc").Find(&requests, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get access requests from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get access requests from store")
+	}
+
+	return requests, nil
+}
+
+func (s *SqlStore) GetAccessRequestByID(ctx context.Context, lockStrength LockingStrength, accountID, requestID string) (*types.AccessRequest, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var request *types.AccessRequest
+	result := tx.Take(&request, accountAndIDQueryCondition, accountID, requestID)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.NewAccessRequestNotFoundError(requestID)
+		}
+		log.WithContext(ctx).Errorf("failed to get access request from store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get access request from store")
+	}
+
+	return request, nil
+}
+
+func (s *SqlStore) CreateAccessRequest(ctx context.Context, request *types.AccessRequest) error {
+	result := s.db.Create(request)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to create access request in store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to create access request in store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) SaveAccessRequest(ctx context.Context, request *types.AccessRequest) error {
+	result := s.db.Select("*").Save(request)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save access request to store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save access request to store")
+	}
+
+	return nil
+}
+
 func (s *SqlStore) GetPolicyRulesByResourceID(ctx context.Context, lockStrength LockingStrength, accountID string, resourceID string) ([]*types.PolicyRule, error) {
 	tx := s.db
 	if lockStrength != LockingStrengthNone {
@@ -6143,6 +6389,226 @@ func (s *SqlStore) DeleteOldAccessLogs(ctx context.Context, olderThan time.Time)
 	return result.RowsAffected, nil
 }
 
+// CreateProxySession stores a session issued by the reverse proxy
+func (s *SqlStore) CreateProxySession(ctx context.Context, session *sessions.Session) error {
+	result := s.db.Create(session)
+	if result.Error != nil {
+		log.WithContext(ctx).WithFields(log.Fields{
+			"service_id": session.ServiceID,
+			"method":     session.Method,
+		}).Errorf("failed to create proxy session in store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to create proxy session in store")
+	}
+	return nil
+}
+
+// GetServiceProxySessions returns the sessions of a service that are still
+// valid at activeAt, newest first
+func (s *SqlStore) GetServiceProxySessions(ctx context.Context, lockStrength LockingStrength, accountID, serviceID string, activeAt time.Time) ([]*sessions.Session, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var result []*sessions.Session
+	err := tx.
+		Where("account_id = ? AND service_id = ? AND expires_at > ?", accountID, serviceID, activeAt).
+		Order("issued_at DESC").
+		Find(&result).Error
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get proxy sessions from store: %v", err)
+		return nil, status.Errorf(status.Internal, "failed to get proxy sessions from store")
+	}
+
+	return result, nil
+}
+
+// GetProxySessionByID returns a single session of a service
+func (s *SqlStore) GetProxySessionByID(ctx context.Context, lockStrength LockingStrength, accountID, serviceID, sessionID string) (*sessions.Session, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var session *sessions.Session
+	result := tx.Take(&session, "account_id = ? AND service_id = ? AND id = ?", accountID, serviceID, sessionID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(status.NotFound, "proxy session %s not found", sessionID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get proxy session from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get proxy session from store")
+	}
+
+	return session, nil
+}
+
+// RevokeProxySessions marks the given sessions of a service as revoked.
+// Sessions that are already revoked keep their original revocation time.
+func (s *SqlStore) RevokeProxySessions(ctx context.Context, accountID, serviceID string, sessionIDs []string, revokedAt time.Time) error {
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	result := s.db.Model(&sessions.Session{}).
+		Where("account_id = ? AND service_id = ? AND id IN ? AND revoked_at IS NULL", accountID, serviceID, sessionIDs).
+		Update("revoked_at", revokedAt)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to revoke proxy sessions in store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to revoke proxy sessions in store")
+	}
+
+	return nil
+}
+
+// DeleteExpiredProxySessions deletes all sessions that expired before the specified time
+func (s *SqlStore) DeleteExpiredProxySessions(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	result := s.db.
+		Where("expires_at < ?", expiredBefore).
+		Delete(&sessions.Session{})
+
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete expired proxy sessions: %v", result.Error)
+		return 0, status.Errorf(status.Internal, "failed to delete expired proxy sessions")
+	}
+
+	return result.RowsAffected, nil
+}
+
+// CreateEventStreamingIntegration stores a new integration and sets its ID
+func (s *SqlStore) CreateEventStreamingIntegration(ctx context.Context, integration *eventstreaming.Integration) error {
+	integrationCopy := integration.Copy()
+	if err := integrationCopy.EncryptSensitiveData(s.fieldEncrypt); err != nil {
+		return fmt.Errorf("encrypt integration data: %w", err)
+	}
+
+	result := s.db.Create(integrationCopy)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to create event streaming integration in store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to create event streaming integration in store")
+	}
+
+	integration.ID = integrationCopy.ID
+	integration.CreatedAt = integrationCopy.CreatedAt
+	integration.UpdatedAt = integrationCopy.UpdatedAt
+	return nil
+}
+
+// UpdateEventStreamingIntegration saves the settings of an integration. The
+// delivery state is owned by the streamer and left untouched.
+func (s *SqlStore) UpdateEventStreamingIntegration(ctx context.Context, integration *eventstreaming.Integration) error {
+	integrationCopy := integration.Copy()
+	if err := integrationCopy.EncryptSensitiveData(s.fieldEncrypt); err != nil {
+		return fmt.Errorf("encrypt integration data: %w", err)
+	}
+
+	result := s.db.Model(integrationCopy).
+		Where(accountAndIDQueryCondition, integrationCopy.AccountID, integrationCopy.ID).
+		Select("enabled", "config", "updated_at").
+		Updates(integrationCopy)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to update event streaming integration in store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to update event streaming integration in store")
+	}
+	if result.RowsAffected == 0 {
+		return status.Errorf(status.NotFound, "event streaming integration %d not found", integration.ID)
+	}
+
+	integration.UpdatedAt = integrationCopy.UpdatedAt
+	return nil
+}
+
+// DeleteEventStreamingIntegration deletes an integration of an account
+func (s *SqlStore) DeleteEventStreamingIntegration(ctx context.Context, accountID string, integrationID int64) error {
+	result := s.db.Delete(&eventstreaming.Integration{}, accountAndIDQueryCondition, accountID, integrationID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete event streaming integration from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete event streaming integration from store")
+	}
+	if result.RowsAffected == 0 {
+		return status.Errorf(status.NotFound, "event streaming integration %d not found", integrationID)
+	}
+
+	return nil
+}
+
+// GetEventStreamingIntegrationByID returns an integration of an account
+func (s *SqlStore) GetEventStreamingIntegrationByID(ctx context.Context, lockStrength LockingStrength, accountID string, integrationID int64) (*eventstreaming.Integration, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var integration *eventstreaming.Integration
+	result := tx.Take(&integration, accountAndIDQueryCondition, accountID, integrationID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(status.NotFound, "event streaming integration %d not found", integrationID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get event streaming integration from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get event streaming integration from store")
+	}
+
+	if err := integration.DecryptSensitiveData(s.fieldEncrypt); err != nil {
+		return nil, fmt.Errorf("decrypt integration data: %w", err)
+	}
+
+	return integration, nil
+}
+
+// GetAccountEventStreamingIntegrations returns all integrations of an account
+func (s *SqlStore) GetAccountEventStreamingIntegrations(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*eventstreaming.Integration, error) {
+	return s.getEventStreamingIntegrations(ctx, lockStrength, accountIDCondition, accountID)
+}
+
+// GetEnabledEventStreamingIntegrations returns the enabled integrations of all accounts
+func (s *SqlStore) GetEnabledEventStreamingIntegrations(ctx context.Context, lockStrength LockingStrength) ([]*eventstreaming.Integration, error) {
+	return s.getEventStreamingIntegrations(ctx, lockStrength, "enabled = ?", true)
+}
+
+func (s *SqlStore) getEventStreamingIntegrations(ctx context.Context, lockStrength LockingStrength, query string, args ...any) ([]*eventstreaming.Integration, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var integrations []*eventstreaming.Integration
+	if err := tx.Where(query, args...).Order("id").Find(&integrations).Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get event streaming integrations from store: %v", err)
+		return nil, status.Errorf(status.Internal, "failed to get event streaming integrations from store")
+	}
+
+	for _, integration := range integrations {
+		if err := integration.DecryptSensitiveData(s.fieldEncrypt); err != nil {
+			return nil, fmt.Errorf("decrypt integration data: %w", err)
+		}
+	}
+
+	return integrations, nil
+}
+
+// UpdateEventStreamingDeliveryState saves the delivery progress of an integration
+func (s *SqlStore) UpdateEventStreamingDeliveryState(ctx context.Context, integrationID int64, state eventstreaming.DeliveryState) error {
+	result := s.db.Model(&eventstreaming.Integration{}).
+		Where(idQueryCondition, integrationID).
+		Updates(map[string]any{
+			"state_cursor":               state.Cursor,
+			"state_last_delivery_at":     state.LastDeliveryAt,
+			"state_last_attempt_at":      state.LastAttemptAt,
+			"state_last_error":           state.LastError,
+			"state_consecutive_failures": state.ConsecutiveFailures,
+			"state_next_retry_at":        state.NextRetryAt,
+		})
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to update event streaming delivery state in store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to update event streaming delivery state in store")
+	}
+
+	return nil
+}
+
 // applyAccessLogFilters applies filter conditions to the query
 func (s *SqlStore) applyAccessLogFilters(query *gorm.DB, filter accesslogs.AccessLogFilter) *gorm.DB {
 	if filter.Search != nil {
@@ -6677,3 +7143,296 @@ func (s *SqlStore) GetRoutingPeerNetworks(_ context.Context, accountID, peerID s
 
 	return names, nil
 }
+
+func (s *SqlStore) CreateCustomRole(ctx context.Context, role *customroles.Role) error {
+	result := s.db.Create(role)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to create custom role to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to create custom role to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) UpdateCustomRole(ctx context.Context, role *customroles.Role) error {
+	result := s.db.Select("*").Save(role)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to update custom role to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to update custom role to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) DeleteCustomRole(ctx context.Context, accountID, roleID string) error {
+	result := s.db.Delete(&customroles.Role{}, accountAndIDQueryCondition, accountID, roleID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete custom role from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete custom role from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewCustomRoleNotFoundError(roleID)
+	}
+
+	return nil
+}
+
+func (s *SqlStore) GetCustomRoleByID(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) (*customroles.Role, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var role *customroles.Role
+	result := tx.Take(&role, accountAndIDQueryCondition, accountID, roleID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewCustomRoleNotFoundError(roleID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get custom role from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get custom role from store")
+	}
+
+	return role, nil
+}
+
+func (s *SqlStore) GetAccountCustomRoles(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*customroles.Role, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var customRoles []*customroles.Role
+	result := tx.Order("name").Find(&customRoles, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get custom roles from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get custom roles from store")
+	}
+
+	return customRoles, nil
+}
+
+func (s *SqlStore) CreateWorkloadIdentityProvider(ctx context.Context, provider *workloadidentity.Provider) error {
+	result := s.db.Create(provider)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to create workload identity provider to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to create workload identity provider to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) UpdateWorkloadIdentityProvider(ctx context.Context, provider *workloadidentity.Provider) error {
+	result := s.db.Select("*").Save(provider)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to update workload identity provider to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to update workload identity provider to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) DeleteWorkloadIdentityProvider(ctx context.Context, accountID, providerID string) error {
+	result := s.db.Delete(&workloadidentity.Provider{}, accountAndIDQueryCondition, accountID, providerID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete workload identity provider from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete workload identity provider from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewWorkloadIdentityProviderNotFoundError(providerID)
+	}
+
+	return nil
+}
+
+func (s *SqlStore) GetWorkloadIdentityProviderByID(ctx context.Context, lockStrength LockingStrength, accountID, providerID string) (*workloadidentity.Provider, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var provider *workloadidentity.Provider
+	result := tx.Take(&provider, accountAndIDQueryCondition, accountID, providerID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewWorkloadIdentityProviderNotFoundError(providerID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get workload identity provider from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get workload identity provider from store")
+	}
+
+	return provider, nil
+}
+
+func (s *SqlStore) GetAccountWorkloadIdentityProviders(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*workloadidentity.Provider, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var providers []*workloadidentity.Provider
+	result := tx.Order("name").Find(&providers, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get workload identity providers from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get workload identity providers from store")
+	}
+
+	return providers, nil
+}
+
+func (s *SqlStore) GetWorkloadIdentityProvider(ctx context.Context, lockStrength LockingStrength, providerID string) (*workloadidentity.Provider, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var provider *workloadidentity.Provider
+	result := tx.Take(&provider, idQueryCondition, providerID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(status.PreconditionFailed, "workload identity provider not found")
+		}
+
+		log.WithContext(ctx).Errorf("failed to get workload identity provider from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get workload identity provider from store")
+	}
+
+	return provider, nil
+}
+
+func (s *SqlStore) CreateSCIMIntegration(ctx context.Context, integration *scim.Integration) error {
+	result := s.db.Create(integration)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to create SCIM integration to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to create SCIM integration to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) UpdateSCIMIntegration(ctx context.Context, integration *scim.Integration) error {
+	result := s.db.Select("*").Save(integration)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to update SCIM integration to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to update SCIM integration to store")
+	}
+
+	return nil
+}
+
+// DeleteSCIMIntegration deletes the integration together with its sync logs
+func (s *SqlStore) DeleteSCIMIntegration(ctx context.Context, accountID string, integrationID int64) error {
+	return s.transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&scim.Integration{}, accountAndIDQueryCondition, accountID, integrationID)
+		if result.Error != nil {
+			log.WithContext(ctx).Errorf("failed to delete SCIM integration from store: %v", result.Error)
+			return status.Errorf(status.Internal, "failed to delete SCIM integration from store")
+		}
+
+		if result.RowsAffected == 0 {
+			return status.Errorf(status.NotFound, "SCIM integration %d not found", integrationID)
+		}
+
+		result = tx.Delete(&scim.SyncLog{}, "account_id = ? AND integration_id = ?", accountID, integrationID)
+		if result.Error != nil {
+			log.WithContext(ctx).Errorf("failed to delete SCIM sync logs from store: %v", result.Error)
+			return status.Errorf(status.Internal, "failed to delete SCIM sync logs from store")
+		}
+
+		return nil
+	})
+}
+
+func (s *SqlStore) GetSCIMIntegrationByID(ctx context.Context, lockStrength LockingStrength, accountID string, integrationID int64) (*scim.Integration, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var integration *scim.Integration
+	result := tx.Take(&integration, accountAndIDQueryCondition, accountID, integrationID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(status.NotFound, "SCIM integration %d not found", integrationID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get SCIM integration from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM integration from store")
+	}
+
+	return integration, nil
+}
+
+func (s *SqlStore) GetSCIMIntegrationByHashedToken(ctx context.Context, hashedToken string) (*scim.Integration, error) {
+	var integration *scim.Integration
+	result := s.db.Take(&integration, "hashed_token = ?", hashedToken)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(status.NotFound, "SCIM integration not found")
+		}
+
+		log.WithContext(ctx).Errorf("failed to get SCIM integration by token from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM integration from store")
+	}
+
+	return integration, nil
+}
+
+// UpdateSCIMIntegrationLastSynced only touches the sync timestamp so provisioning requests never overwrite
+// concurrent changes to the integration settings
+func (s *SqlStore) UpdateSCIMIntegrationLastSynced(ctx context.Context, integrationID int64, syncedAt time.Time) error {
+	result := s.db.Model(&scim.Integration{}).Where(idQueryCondition, integrationID).Update("last_synced_at", syncedAt)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to update SCIM integration sync time in store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to update SCIM integration sync time in store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) GetAccountSCIMIntegrations(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*scim.Integration, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var integrations []*scim.Integration
+	result := tx.Order("id").Find(&integrations, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get SCIM integrations from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM integrations from store")
+	}
+
+	return integrations, nil
+}
+
+func (s *SqlStore) CreateSCIMSyncLog(ctx context.Context, syncLog *scim.SyncLog) error {
+	result := s.db.Create(syncLog)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to create SCIM sync log to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to create SCIM sync log to store")
+	}
+
+	return nil
+}
+
+// GetSCIMSyncLogs returns the latest sync logs of an integration, newest first
+func (s *SqlStore) GetSCIMSyncLogs(ctx context.Context, lockStrength LockingStrength, accountID string, integrationID int64, limit int) ([]*scim.SyncLog, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var syncLogs []*scim.SyncLog
+	result := tx.Order("id DESC").Limit(limit).Find(&syncLogs, "account_id = ? AND integration_id = ?", accountID, integrationID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get SCIM sync logs from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM sync logs from store")
+	}
+
+	return syncLogs, nil
+}
@@ -2041,6 +2041,64 @@ func TestSqlStore_DeletePolicy(t *testing.T) {
 	require.Nil(t, policy)
 }
 
+func TestSqlStore_GetAccountIDsWithScheduledPolicies(t *testing.T) {
+	store, cleanup, err := NewTestStoreFromSQL(context.Background(), "../testdata/store.sql", t.TempDir())
+	t.Cleanup(cleanup)
+	require.NoError(t, err)
+
+	accountID := "bf1c8084-ba50-4ce7-9439-34653001fc3b"
+	policyID := "cs1tnh0hhcjnqoiuebf0"
+
+	accountIDs, err := store.GetAccountIDsWithScheduledPolicies(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, accountIDs)
+
+	policy, err := store.GetPolicyByID(context.Background(), LockingStrengthNone, accountID, policyID)
+	require.NoError(t, err)
+
+	policy.Schedule = &types.PolicySchedule{StartDate: util.ToPtr(time.Now().Add(time.Hour))}
+	err = store.SavePolicy(context.Background(), policy)
+	require.NoError(t, err)
+
+	accountIDs, err = store.GetAccountIDsWithScheduledPolicies(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{accountID}, accountIDs)
+}
+
+func TestSqlStore_GetAccountIDsWithApprovedAccessRequests(t *testing.T) {
+	store, cleanup, err := NewTestStoreFromSQL(context.Background(), "../testdata/store.sql", t.TempDir())
+	t.Cleanup(cleanup)
+	require.NoError(t, err)
+
+	accountID := "bf1c8084-ba50-4ce7-9439-34653001fc3b"
+
+	request := &types.AccessRequest{
+		ID:         "access-request",
+		AccountID:  accountID,
+		UserID:     "edafee4e-63fb-11ec-90d6-0242ac120003",
+		TargetType: types.AccessRequestTargetGroup,
+		TargetID:   "cfefqs706sqkneg59g4g",
+		Duration:   time.Hour,
+		Status:     types.AccessRequestStatusPending,
+		CreatedAt:  time.Now().UTC(),
+	}
+	err = store.CreateAccessRequest(context.Background(), request)
+	require.NoError(t, err)
+
+	accountIDs, err := store.GetAccountIDsWithApprovedAccessRequests(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, accountIDs)
+
+	request.Status = types.AccessRequestStatusApproved
+	request.ExpiresAt = util.ToPtr(time.Now().UTC().Add(time.Hour))
+	err = store.SaveAccessRequest(context.Background(), request)
+	require.NoError(t, err)
+
+	accountIDs, err = store.GetAccountIDsWithApprovedAccessRequests(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{accountID}, accountIDs)
+}
+
 func TestSqlStore_GetDNSSettings(t *testing.T) {
 	store, cleanup, err := NewTestStoreFromSQL(context.Background(), "../testdata/store.sql", t.TempDir())
 	t.Cleanup(cleanup)
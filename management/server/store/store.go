@@ -25,10 +25,15 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/internals/modules/customroles"
+	"github.com/netbirdio/netbird/management/internals/modules/eventstreaming"
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/accesslogs"
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/domain"
 	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/proxy"
 	rpservice "github.com/netbirdio/netbird/management/internals/modules/reverseproxy/service"
+	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/sessions"
+	"github.com/netbirdio/netbird/management/internals/modules/scim"
+	"github.com/netbirdio/netbird/management/internals/modules/workloadidentity"
 	"github.com/netbirdio/netbird/management/internals/modules/zones"
 	"github.com/netbirdio/netbird/management/internals/modules/zones/records"
 	"github.com/netbirdio/netbird/management/server/telemetry"
@@ -139,6 +144,12 @@ type Store interface {
 	CreatePolicy(ctx context.Context, policy *types.Policy) error
 	SavePolicy(ctx context.Context, policy *types.Policy) error
 	DeletePolicy(ctx context.Context, accountID, policyID string) error
+	GetAccountIDsWithScheduledPolicies(ctx context.Context) ([]string, error)
+	GetAccountAccessRequests(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.AccessRequest, error)
+	GetAccessRequestByID(ctx context.Context, lockStrength LockingStrength, accountID, requestID string) (*types.AccessRequest, error)
+	CreateAccessRequest(ctx context.Context, request *types.AccessRequest) error
+	SaveAccessRequest(ctx context.Context, request *types.AccessRequest) error
+	GetAccountIDsWithApprovedAccessRequests(ctx context.Context) ([]string, error)
 
 	GetPostureCheckByChecksDefinition(accountID string, checks *posture.ChecksDefinition) (*posture.Checks, error)
 	GetAccountPostureChecks(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*posture.Checks, error)
@@ -160,6 +171,7 @@ type Store interface {
 	GetPeerByPeerPubKey(ctx context.Context, lockStrength LockingStrength, peerKey string) (*nbpeer.Peer, error)
 	GetAccountPeers(ctx context.Context, lockStrength LockingStrength, accountID, nameFilter, ipFilter string) ([]*nbpeer.Peer, error)
 	GetUserPeers(ctx context.Context, lockStrength LockingStrength, accountID, userID string) ([]*nbpeer.Peer, error)
+	CountSetupKeyPeersByHostname(ctx context.Context, accountID, setupKeyID, hostname string) (int64, error)
 	GetPeerByID(ctx context.Context, lockStrength LockingStrength, accountID string, peerID string) (*nbpeer.Peer, error)
 	GetPeersByIDs(ctx context.Context, lockStrength LockingStrength, accountID string, peerIDs []string) (map[string]*nbpeer.Peer, error)
 	GetPeersByGroupIDs(ctx context.Context, accountID string, groupIDs []string) ([]*nbpeer.Peer, error)
@@ -309,6 +321,43 @@ type Store interface {
 	CreateAccessLog(ctx context.Context, log *accesslogs.AccessLogEntry) error
 	GetAccountAccessLogs(ctx context.Context, lockStrength LockingStrength, accountID string, filter accesslogs.AccessLogFilter) ([]*accesslogs.AccessLogEntry, int64, error)
 	DeleteOldAccessLogs(ctx context.Context, olderThan time.Time) (int64, error)
+	CreateProxySession(ctx context.Context, session *sessions.Session) error
+	GetServiceProxySessions(ctx context.Context, lockStrength LockingStrength, accountID, serviceID string, activeAt time.Time) ([]*sessions.Session, error)
+	GetProxySessionByID(ctx context.Context, lockStrength LockingStrength, accountID, serviceID, sessionID string) (*sessions.Session, error)
+	RevokeProxySessions(ctx context.Context, accountID, serviceID string, sessionIDs []string, revokedAt time.Time) error
+	DeleteExpiredProxySessions(ctx context.Context, expiredBefore time.Time) (int64, error)
+
+	CreateEventStreamingIntegration(ctx context.Context, integration *eventstreaming.Integration) error
+	UpdateEventStreamingIntegration(ctx context.Context, integration *eventstreaming.Integration) error
+	DeleteEventStreamingIntegration(ctx context.Context, accountID string, integrationID int64) error
+	GetEventStreamingIntegrationByID(ctx context.Context, lockStrength LockingStrength, accountID string, integrationID int64) (*eventstreaming.Integration, error)
+	GetAccountEventStreamingIntegrations(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*eventstreaming.Integration, error)
+	GetEnabledEventStreamingIntegrations(ctx context.Context, lockStrength LockingStrength) ([]*eventstreaming.Integration, error)
+	UpdateEventStreamingDeliveryState(ctx context.Context, integrationID int64, state eventstreaming.DeliveryState) error
+
+	CreateCustomRole(ctx context.Context, role *customroles.Role) error
+	UpdateCustomRole(ctx context.Context, role *customroles.Role) error
+	DeleteCustomRole(ctx context.Context, accountID, roleID string) error
+	GetCustomRoleByID(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) (*customroles.Role, error)
+	GetAccountCustomRoles(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*customroles.Role, error)
+
+	CreateWorkloadIdentityProvider(ctx context.Context, provider *workloadidentity.Provider) error
+	UpdateWorkloadIdentityProvider(ctx context.Context, provider *workloadidentity.Provider) error
+	DeleteWorkloadIdentityProvider(ctx context.Context, accountID, providerID string) error
+	GetWorkloadIdentityProviderByID(ctx context.Context, lockStrength LockingStrength, accountID, providerID string) (*workloadidentity.Provider, error)
+	GetAccountWorkloadIdentityProviders(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*workloadidentity.Provider, error)
+	// GetWorkloadIdentityProvider looks a provider up by its ID alone, as peers enrolling with it don't know their account
+	GetWorkloadIdentityProvider(ctx context.Context, lockStrength LockingStrength, providerID string) (*workloadidentity.Provider, error)
+
+	CreateSCIMIntegration(ctx context.Context, integration *scim.Integration) error
+	UpdateSCIMIntegration(ctx context.Context, integration *scim.Integration) error
+	DeleteSCIMIntegration(ctx context.Context, accountID string, integrationID int64) error
+	GetSCIMIntegrationByID(ctx context.Context, lockStrength LockingStrength, accountID string, integrationID int64) (*scim.Integration, error)
+	GetSCIMIntegrationByHashedToken(ctx context.Context, hashedToken string) (*scim.Integration, error)
+	UpdateSCIMIntegrationLastSynced(ctx context.Context, integrationID int64, syncedAt time.Time) error
+	GetAccountSCIMIntegrations(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*scim.Integration, error)
+	CreateSCIMSyncLog(ctx context.Context, syncLog *scim.SyncLog) error
+	GetSCIMSyncLogs(ctx context.Context, lockStrength LockingStrength, accountID string, integrationID int64, limit int) ([]*scim.SyncLog, error)
 	CreateAgentNetworkAccessLog(ctx context.Context, entry *agentNetworkTypes.AgentNetworkAccessLog, groups []agentNetworkTypes.AgentNetworkAccessLogGroup) error
 	CreateAgentNetworkUsage(ctx context.Context, usage *agentNetworkTypes.AgentNetworkUsage, groups []agentNetworkTypes.AgentNetworkUsageGroup) error
 	GetAgentNetworkAccessLogs(ctx context.Context, lockStrength LockingStrength, accountID string, filter agentNetworkTypes.AgentNetworkAccessLogFilter) ([]*agentNetworkTypes.AgentNetworkAccessLog, int64, error)
@@ -0,0 +1,417 @@
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// maxInsertPlaceholders keeps a single INSERT statement well below the bind parameter limits of the supported engines.
+const maxInsertPlaceholders = 30000
+
+// checksumModulus bounds the order independent table checksum to the size of a SHA-256 digest.
+var checksumModulus = new(big.Int).Lsh(big.NewInt(1), sha256.Size*8)
+
+// TableMigrationResult describes a table copied by CopyTables.
+type TableMigrationResult struct {
+	Table    string
+	Rows     int64
+	Checksum string
+}
+
+// ResolveDSN returns the connection string of the given store engine. For SQLite it is the database file,
+// relative paths are resolved against dataDir. An empty dsn falls back to the environment variables NewStore uses.
+func ResolveDSN(engine types.Engine, dataDir, dsn string) (string, error) {
+	switch engine {
+	case types.SqliteStoreEngine:
+		file := dsn
+		if file == "" {
+			file = sqliteStoreFile()
+		}
+		if filePath, _, _ := strings.Cut(file, "?"); !filepath.IsAbs(filePath) {
+			file = filepath.Join(dataDir, file)
+		}
+		return file, nil
+	case types.PostgresStoreEngine:
+		if dsn != "" {
+			return dsn, nil
+		}
+		if dsn, ok := lookupDSNEnv(postgresDsnEnv, postgresDsnEnvLegacy); ok && dsn != "" {
+			return dsn, nil
+		}
+		return "", fmt.Errorf("%s is not set", postgresDsnEnv)
+	case types.MysqlStoreEngine:
+		if dsn != "" {
+			return dsn, nil
+		}
+		if dsn, ok := lookupDSNEnv(mysqlDsnEnv, mysqlDsnEnvLegacy); ok && dsn != "" {
+			return dsn, nil
+		}
+		return "", fmt.Errorf("%s is not set", mysqlDsnEnv)
+	default:
+		return "", fmt.Errorf("unsupported store engine %s", engine)
+	}
+}
+
+// OpenSqlStore opens the store of the given engine with a connection string returned by ResolveDSN.
+func OpenSqlStore(ctx context.Context, engine types.Engine, dsn string, skipMigration bool) (*SqlStore, error) {
+	switch engine {
+	case types.SqliteStoreEngine:
+		return newSqliteStoreFromFile(ctx, "", dsn, nil, skipMigration)
+	case types.PostgresStoreEngine:
+		return NewPostgresqlStore(ctx, dsn, nil, skipMigration)
+	case types.MysqlStoreEngine:
+		return NewMysqlStore(ctx, dsn, nil, skipMigration)
+	default:
+		return nil, fmt.Errorf("unsupported store engine %s", engine)
+	}
+}
+
+// EnsureStoreNotInUse returns an error when another process uses the store NewStore opens with the same engine
+// and data directory. It must be called before the store is opened, as the sessions of the caller count as well.
+func EnsureStoreNotInUse(ctx context.Context, engine types.Engine, dataDir string) error {
+	engine = getStoreEngine(ctx, dataDir, engine)
+	dsn, err := ResolveDSN(engine, dataDir, "")
+	if err != nil {
+		return err
+	}
+	return EnsureNotInUse(ctx, engine, dsn)
+}
+
+// EnsureNotInUse returns an error when another process, such as a running management server, uses the database.
+// SQLite files are checked against the open file descriptors of other processes, which is only possible on Linux.
+// PostgreSQL and MySQL databases are checked for any other client session.
+func EnsureNotInUse(ctx context.Context, engine types.Engine, dsn string) error {
+	switch engine {
+	case types.SqliteStoreEngine:
+		filePath, _, _ := strings.Cut(dsn, "?")
+		pids, err := processesUsingFile(filePath)
+		if err != nil {
+			return fmt.Errorf("check open handles of %s: %w", filePath, err)
+		}
+		if len(pids) > 0 {
+			return fmt.Errorf("%s is opened by process %v, stop the management server first", filePath, pids)
+		}
+		return nil
+	case types.PostgresStoreEngine:
+		return ensureNoOtherSessions(ctx, postgres.Open(dsn),
+			"SELECT COUNT(*) FROM pg_stat_activity WHERE datname = current_database() AND pid <> pg_backend_pid()")
+	case types.MysqlStoreEngine:
+		return ensureNoOtherSessions(ctx, mysql.Open(dsn+"?charset=utf8&parseTime=True&loc=Local"),
+			"SELECT COUNT(*) FROM information_schema.PROCESSLIST WHERE DB = DATABASE() AND ID <> CONNECTION_ID()")
+	default:
+		return fmt.Errorf("unsupported store engine %s", engine)
+	}
+}
+
+func ensureNoOtherSessions(ctx context.Context, dialector gorm.Dialector, query string) error {
+	db, err := gorm.Open(dialector, getGormConfig())
+	if err != nil {
+		return fmt.Errorf("open database: %w", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("get db: %w", err)
+	}
+	defer func() {
+		if err := sqlDB.Close(); err != nil {
+			log.WithContext(ctx).Debugf("close database: %v", err)
+		}
+	}()
+
+	var sessions int64
+	if err := db.WithContext(ctx).Raw(query).Scan(&sessions).Error; err != nil {
+		return fmt.Errorf("count database sessions: %w", err)
+	}
+	if sessions > 0 {
+		return fmt.Errorf("found %d other sessions connected to the database, stop the management server first", sessions)
+	}
+	return nil
+}
+
+// processesUsingFile returns the IDs of other processes holding the file open. Without /proc it returns none.
+func processesUsingFile(path string) ([]int, error) {
+	target, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if resolved, err := filepath.EvalSymlinks(target); err == nil {
+		target = resolved
+	}
+
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, nil //nolint:nilerr
+	}
+
+	var pids []int
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil || pid == os.Getpid() {
+			continue
+		}
+		fdDir := filepath.Join("/proc", entry.Name(), "fd")
+		fds, err := os.ReadDir(fdDir)
+		if err != nil {
+			// the process exited or belongs to another user
+			continue
+		}
+		for _, fd := range fds {
+			if link, err := os.Readlink(filepath.Join(fdDir, fd.Name())); err == nil && link == target {
+				pids = append(pids, pid)
+				break
+			}
+		}
+	}
+	return pids, nil
+}
+
+// EnsureEmpty returns an error listing the tables of models that already hold rows in db.
+func EnsureEmpty(ctx context.Context, db *gorm.DB, models []any) error {
+	var tables []string
+	for _, model := range models {
+		sch, err := parseModel(db, model)
+		if err != nil {
+			return err
+		}
+		var count int64
+		if err := db.WithContext(ctx).Table(sch.Table).Count(&count).Error; err != nil {
+			return fmt.Errorf("count rows of %s: %w", sch.Table, err)
+		}
+		if count > 0 {
+			tables = append(tables, sch.Table)
+		}
+	}
+	if len(tables) > 0 {
+		return fmt.Errorf("destination is not empty, tables with rows: %s", strings.Join(tables, ", "))
+	}
+	return nil
+}
+
+// CopyTables copies every row of the models tables from src to dst in batches of batchSize rows. Tables are
+// copied in foreign key dependency order. After each table the row count and an order independent checksum of
+// both sides are compared, report is called for every verified table.
+func CopyTables(ctx context.Context, src, dst *gorm.DB, models []any, batchSize int, report func(TableMigrationResult)) ([]TableMigrationResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("batch size must be positive")
+	}
+
+	if reorderer, ok := dst.Migrator().(interface {
+		ReorderModels(values []any, autoAdd bool) []any
+	}); ok {
+		models = reorderer.ReorderModels(models, false)
+	}
+
+	results := make([]TableMigrationResult, 0, len(models))
+	for _, model := range models {
+		result, err := copyTable(ctx, src, dst, model, batchSize)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+		if report != nil {
+			report(result)
+		}
+	}
+	return results, nil
+}
+
+func copyTable(ctx context.Context, src, dst *gorm.DB, model any, batchSize int) (TableMigrationResult, error) {
+	sch, err := parseModel(src, model)
+	if err != nil {
+		return TableMigrationResult{}, err
+	}
+	fields := columnFields(sch)
+	insertBatch := max(1, min(batchSize, maxInsertPlaceholders/max(1, len(fields))))
+
+	copied, srcSum, err := scanTable(ctx, src, sch, model, fields, batchSize, func(rows []map[string]any) error {
+		return dst.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return tx.Session(&gorm.Session{SkipHooks: true}).Table(sch.Table).CreateInBatches(&rows, insertBatch).Error
+		})
+	})
+	if err != nil {
+		return TableMigrationResult{}, fmt.Errorf("copy %s: %w", sch.Table, err)
+	}
+
+	if dst.Dialector.Name() == "postgres" {
+		if err := resetSequences(ctx, dst, sch); err != nil {
+			return TableMigrationResult{}, fmt.Errorf("reset sequences of %s: %w", sch.Table, err)
+		}
+	}
+
+	var dstCount int64
+	if err := dst.WithContext(ctx).Table(sch.Table).Count(&dstCount).Error; err != nil {
+		return TableMigrationResult{}, fmt.Errorf("count rows of %s: %w", sch.Table, err)
+	}
+	if dstCount != copied {
+		return TableMigrationResult{}, fmt.Errorf("row count mismatch in %s: copied %d rows, destination has %d", sch.Table, copied, dstCount)
+	}
+
+	_, dstSum, err := scanTable(ctx, dst, sch, model, fields, batchSize, nil)
+	if err != nil {
+		return TableMigrationResult{}, fmt.Errorf("verify %s: %w", sch.Table, err)
+	}
+	if srcSum.Cmp(dstSum) != 0 {
+		return TableMigrationResult{}, fmt.Errorf("checksum mismatch in %s: source %s, destination %s", sch.Table, formatChecksum(srcSum), formatChecksum(dstSum))
+	}
+
+	return TableMigrationResult{Table: sch.Table, Rows: copied, Checksum: formatChecksum(srcSum)}, nil
+}
+
+// scanTable reads the table in primary key order and returns the number of rows and their checksum.
+// When handle is set it receives every batch as column values ready to be inserted.
+func scanTable(ctx context.Context, db *gorm.DB, sch *schema.Schema, model any, fields []*schema.Field, batchSize int, handle func([]map[string]any) error) (int64, *big.Int, error) {
+	order := clause.OrderBy{}
+	orderColumns := sch.PrimaryFieldDBNames
+	if len(orderColumns) == 0 {
+		for _, field := range fields {
+			orderColumns = append(orderColumns, field.DBName)
+		}
+	}
+	for _, column := range orderColumns {
+		order.Columns = append(order.Columns, clause.OrderByColumn{Column: clause.Column{Name: column}})
+	}
+
+	sliceType := reflect.SliceOf(reflect.TypeOf(model))
+	sum := new(big.Int)
+	var total int64
+
+	for offset := 0; ; offset += batchSize {
+		batch := reflect.New(sliceType)
+		err := db.WithContext(ctx).Session(&gorm.Session{SkipHooks: true}).
+			Order(order).Limit(batchSize).Offset(offset).
+			Find(batch.Interface()).Error
+		if err != nil {
+			return 0, nil, err
+		}
+
+		n := batch.Elem().Len()
+		if n == 0 {
+			break
+		}
+
+		rows := make([]map[string]any, n)
+		for i := 0; i < n; i++ {
+			rv := reflect.Indirect(batch.Elem().Index(i))
+			row := make(map[string]any, len(fields))
+			hash := sha256.New()
+			for _, field := range fields {
+				value, _ := field.ValueOf(ctx, rv)
+				canonical, err := canonicalValue(value)
+				if err != nil {
+					return 0, nil, fmt.Errorf("column %s: %w", field.DBName, err)
+				}
+				row[field.DBName] = value
+				hash.Write([]byte(field.DBName))
+				hash.Write([]byte{0})
+				hash.Write(canonical)
+				hash.Write([]byte{0})
+			}
+			rows[i] = row
+			sum.Add(sum, new(big.Int).SetBytes(hash.Sum(nil)))
+		}
+		sum.Mod(sum, checksumModulus)
+		total += int64(n)
+
+		if handle != nil {
+			if err := handle(rows); err != nil {
+				return 0, nil, err
+			}
+		}
+
+		if n < batchSize {
+			break
+		}
+	}
+
+	return total, sum, nil
+}
+
+// canonicalValue encodes a column value independently of the engine it was read from. Timestamps are compared
+// in UTC at millisecond precision, the finest precision every supported engine keeps.
+func canonicalValue(value any) ([]byte, error) {
+	if rv := reflect.ValueOf(value); !rv.IsValid() || (rv.Kind() == reflect.Pointer && rv.IsNil()) {
+		return []byte("null"), nil
+	}
+
+	if valuer, ok := value.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return nil, err
+		}
+		value = v
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return []byte("null"), nil
+	case time.Time:
+		return []byte(v.UTC().Round(time.Millisecond).Format(time.RFC3339Nano)), nil
+	case *time.Time:
+		return []byte(v.UTC().Round(time.Millisecond).Format(time.RFC3339Nano)), nil
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return json.Marshal(v)
+	}
+}
+
+// resetSequences moves the PostgreSQL sequences of auto increment columns past the copied rows,
+// explicitly inserted IDs do not advance them.
+func resetSequences(ctx context.Context, db *gorm.DB, sch *schema.Schema) error {
+	for _, field := range sch.Fields {
+		if !field.AutoIncrement || field.DBName == "" {
+			continue
+		}
+		err := db.WithContext(ctx).Exec("SELECT setval(pg_get_serial_sequence(?, ?), COALESCE(MAX(?), 0) + 1, false) FROM ?",
+			sch.Table, field.DBName, clause.Column{Name: field.DBName}, clause.Table{Name: sch.Table}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseModel(db *gorm.DB, model any) (*schema.Schema, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return nil, fmt.Errorf("parse model %T: %w", model, err)
+	}
+	return stmt.Schema, nil
+}
+
+// columnFields returns the fields of the schema that are stored in a table column.
+func columnFields(sch *schema.Schema) []*schema.Field {
+	fields := make([]*schema.Field, 0, len(sch.DBNames))
+	for _, name := range sch.DBNames {
+		if field := sch.FieldsByDBName[name]; !field.IgnoreMigration {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func formatChecksum(sum *big.Int) string {
+	return hex.EncodeToString(sum.FillBytes(make([]byte, sha256.Size)))
+}
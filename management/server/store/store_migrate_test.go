@@ -0,0 +1,117 @@
+package store
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func TestCopyTables(t *testing.T) {
+	if engine := getStoreEngineFromEnv(); engine != "" && engine != types.SqliteStoreEngine {
+		t.Skip("copies between sqlite stores")
+	}
+	if runtime.GOOS == "windows" {
+		t.Skip("The SQLite store is not properly supported by Windows yet")
+	}
+
+	ctx := context.Background()
+	s, cleanUp, err := NewTestStoreFromSQL(ctx, "../testdata/store.sql", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanUp)
+	src := s.(*SqlStore)
+
+	// an empty string must survive the copy even though the column defaults to api
+	require.NoError(t, src.db.Model(&types.User{}).Where(idQueryCondition, "edafee4e-63fb-11ec-90d6-0242ac120003").Update("issued", "").Error)
+
+	dst, err := NewSqliteStore(ctx, t.TempDir(), nil, false)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = dst.Close(ctx) })
+
+	require.NoError(t, EnsureEmpty(ctx, dst.db, Models()))
+
+	var reported []string
+	results, err := CopyTables(ctx, src.db, dst.db, Models(), 1, func(result TableMigrationResult) {
+		reported = append(reported, result.Table)
+	})
+	require.NoError(t, err)
+	require.Len(t, results, len(Models()))
+	assert.Len(t, reported, len(results))
+
+	rows := map[string]int64{}
+	for _, result := range results {
+		rows[result.Table] = result.Rows
+		assert.Len(t, result.Checksum, 64)
+	}
+	assert.EqualValues(t, 2, rows["accounts"])
+	assert.EqualValues(t, 3, rows["users"])
+	assert.EqualValues(t, 1, rows["installations"])
+
+	accountID := "bf1c8084-ba50-4ce7-9439-34653001fc3b"
+	want, err := src.GetAccount(ctx, accountID)
+	require.NoError(t, err)
+	got, err := dst.GetAccount(ctx, accountID)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	user, err := dst.GetUserByUserID(ctx, LockingStrengthNone, "edafee4e-63fb-11ec-90d6-0242ac120003")
+	require.NoError(t, err)
+	assert.Empty(t, user.Issued)
+	assert.Equal(t, src.GetInstallationID(), dst.GetInstallationID())
+
+	err = EnsureEmpty(ctx, dst.db, Models())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "accounts")
+
+	_, err = CopyTables(ctx, src.db, dst.db, Models(), 0, nil)
+	assert.Error(t, err, "batch size must be positive")
+}
+
+func TestCanonicalValue(t *testing.T) {
+	utc := time.Date(2026, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	local := utc.In(time.FixedZone("CET", 3600)).Truncate(time.Microsecond)
+
+	a, err := canonicalValue(utc)
+	require.NoError(t, err)
+	b, err := canonicalValue(&local)
+	require.NoError(t, err)
+	assert.Equal(t, a, b, "timestamps compare in UTC at millisecond precision")
+
+	var nilTime *time.Time
+	null, err := canonicalValue(nilTime)
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(null))
+
+	number, err := canonicalValue(uint64(42))
+	require.NoError(t, err)
+	assert.Equal(t, "42", string(number))
+}
+
+func TestResolveDSN(t *testing.T) {
+	t.Setenv("NB_STORE_ENGINE_SQLITE_FILE", "")
+	t.Setenv(postgresDsnEnv, "")
+	t.Setenv(postgresDsnEnvLegacy, "")
+
+	dsn, err := ResolveDSN(types.SqliteStoreEngine, "/var/lib/netbird", "")
+	require.NoError(t, err)
+	assert.Equal(t, "/var/lib/netbird/store.db", dsn)
+
+	dsn, err = ResolveDSN(types.SqliteStoreEngine, "/var/lib/netbird", "/tmp/other.db")
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/other.db", dsn)
+
+	dsn, err = ResolveDSN(types.PostgresStoreEngine, "", "host=db")
+	require.NoError(t, err)
+	assert.Equal(t, "host=db", dsn)
+
+	_, err = ResolveDSN(types.PostgresStoreEngine, "", "")
+	assert.Error(t, err)
+
+	_, err = ResolveDSN(types.FileStoreEngine, "", "")
+	assert.Error(t, err)
+}
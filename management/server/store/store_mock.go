@@ -18,10 +18,15 @@ import (
 
 	dns "github.com/netbirdio/netbird/dns"
 	types "github.com/netbirdio/netbird/management/internals/modules/agentnetwork/types"
+	customroles "github.com/netbirdio/netbird/management/internals/modules/customroles"
+	eventstreaming "github.com/netbirdio/netbird/management/internals/modules/eventstreaming"
 	accesslogs "github.com/netbirdio/netbird/management/internals/modules/reverseproxy/accesslogs"
 	domain "github.com/netbirdio/netbird/management/internals/modules/reverseproxy/domain"
 	proxy "github.com/netbirdio/netbird/management/internals/modules/reverseproxy/proxy"
 	service "github.com/netbirdio/netbird/management/internals/modules/reverseproxy/service"
+	sessions "github.com/netbirdio/netbird/management/internals/modules/reverseproxy/sessions"
+	scim "github.com/netbirdio/netbird/management/internals/modules/scim"
+	workloadidentity "github.com/netbirdio/netbird/management/internals/modules/workloadidentity"
 	zones "github.com/netbirdio/netbird/management/internals/modules/zones"
 	records "github.com/netbirdio/netbird/management/internals/modules/zones/records"
 	types0 "github.com/netbirdio/netbird/management/server/networks/resources/types"
@@ -246,6 +251,21 @@ func (mr *MockStoreMockRecorder) CountProxiesByAccountID(ctx, accountID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountProxiesByAccountID", reflect.TypeOf((*MockStore)(nil).CountProxiesByAccountID), ctx, accountID)
 }
 
+// CountSetupKeyPeersByHostname mocks base method.
+func (m *MockStore) CountSetupKeyPeersByHostname(ctx context.Context, accountID, setupKeyID, hostname string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSetupKeyPeersByHostname", ctx, accountID, setupKeyID, hostname)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSetupKeyPeersByHostname indicates an expected call of CountSetupKeyPeersByHostname.
+func (mr *MockStoreMockRecorder) CountSetupKeyPeersByHostname(ctx, accountID, setupKeyID, hostname any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSetupKeyPeersByHostname", reflect.TypeOf((*MockStore)(nil).CountSetupKeyPeersByHostname), ctx, accountID, setupKeyID, hostname)
+}
+
 // CreateAccessLog mocks base method.
 func (m *MockStore) CreateAccessLog(ctx context.Context, log *accesslogs.AccessLogEntry) error {
 	m.ctrl.T.Helper()
@@ -260,6 +280,20 @@ func (mr *MockStoreMockRecorder) CreateAccessLog(ctx, log any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessLog", reflect.TypeOf((*MockStore)(nil).CreateAccessLog), ctx, log)
 }
 
+// CreateAccessRequest mocks base method.
+func (m *MockStore) CreateAccessRequest(ctx context.Context, request *types3.AccessRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessRequest", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAccessRequest indicates an expected call of CreateAccessRequest.
+func (mr *MockStoreMockRecorder) CreateAccessRequest(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessRequest", reflect.TypeOf((*MockStore)(nil).CreateAccessRequest), ctx, request)
+}
+
 // CreateAgentNetworkAccessLog mocks base method.
 func (m *MockStore) CreateAgentNetworkAccessLog(ctx context.Context, entry *types.AgentNetworkAccessLog, groups []types.AgentNetworkAccessLogGroup) error {
 	m.ctrl.T.Helper()
@@ -317,6 +351,20 @@ func (mr *MockStoreMockRecorder) CreateCustomDomain(ctx, accountID, domainName,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomDomain", reflect.TypeOf((*MockStore)(nil).CreateCustomDomain), ctx, accountID, domainName, targetCluster, validated)
 }
 
+// CreateCustomRole mocks base method.
+func (m *MockStore) CreateCustomRole(ctx context.Context, role *customroles.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomRole", ctx, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCustomRole indicates an expected call of CreateCustomRole.
+func (mr *MockStoreMockRecorder) CreateCustomRole(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRole", reflect.TypeOf((*MockStore)(nil).CreateCustomRole), ctx, role)
+}
+
 // CreateDNSRecord mocks base method.
 func (m *MockStore) CreateDNSRecord(ctx context.Context, record *records.Record) error {
 	m.ctrl.T.Helper()
@@ -331,6 +379,20 @@ func (mr *MockStoreMockRecorder) CreateDNSRecord(ctx, record any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDNSRecord", reflect.TypeOf((*MockStore)(nil).CreateDNSRecord), ctx, record)
 }
 
+// CreateEventStreamingIntegration mocks base method.
+func (m *MockStore) CreateEventStreamingIntegration(ctx context.Context, integration *eventstreaming.Integration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventStreamingIntegration", ctx, integration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEventStreamingIntegration indicates an expected call of CreateEventStreamingIntegration.
+func (mr *MockStoreMockRecorder) CreateEventStreamingIntegration(ctx, integration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventStreamingIntegration", reflect.TypeOf((*MockStore)(nil).CreateEventStreamingIntegration), ctx, integration)
+}
+
 // CreateGroup mocks base method.
 func (m *MockStore) CreateGroup(ctx context.Context, group *types3.Group) error {
 	m.ctrl.T.Helper()
@@ -401,6 +463,48 @@ func (mr *MockStoreMockRecorder) CreatePolicy(ctx, policy any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicy", reflect.TypeOf((*MockStore)(nil).CreatePolicy), ctx, policy)
 }
 
+// CreateProxySession mocks base method.
+func (m *MockStore) CreateProxySession(ctx context.Context, session *sessions.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProxySession", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateProxySession indicates an expected call of CreateProxySession.
+func (mr *MockStoreMockRecorder) CreateProxySession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProxySession", reflect.TypeOf((*MockStore)(nil).CreateProxySession), ctx, session)
+}
+
+// CreateSCIMIntegration mocks base method.
+func (m *MockStore) CreateSCIMIntegration(ctx context.Context, integration *scim.Integration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSCIMIntegration", ctx, integration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSCIMIntegration indicates an expected call of CreateSCIMIntegration.
+func (mr *MockStoreMockRecorder) CreateSCIMIntegration(ctx, integration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSCIMIntegration", reflect.TypeOf((*MockStore)(nil).CreateSCIMIntegration), ctx, integration)
+}
+
+// CreateSCIMSyncLog mocks base method.
+func (m *MockStore) CreateSCIMSyncLog(ctx context.Context, syncLog *scim.SyncLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSCIMSyncLog", ctx, syncLog)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSCIMSyncLog indicates an expected call of CreateSCIMSyncLog.
+func (mr *MockStoreMockRecorder) CreateSCIMSyncLog(ctx, syncLog any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSCIMSyncLog", reflect.TypeOf((*MockStore)(nil).CreateSCIMSyncLog), ctx, syncLog)
+}
+
 // CreateService mocks base method.
 func (m *MockStore) CreateService(ctx context.Context, arg1 *service.Service) error {
 	m.ctrl.T.Helper()
@@ -415,6 +519,20 @@ func (mr *MockStoreMockRecorder) CreateService(ctx, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateService", reflect.TypeOf((*MockStore)(nil).CreateService), ctx, arg1)
 }
 
+// CreateWorkloadIdentityProvider mocks base method.
+func (m *MockStore) CreateWorkloadIdentityProvider(ctx context.Context, provider *workloadidentity.Provider) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkloadIdentityProvider", ctx, provider)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWorkloadIdentityProvider indicates an expected call of CreateWorkloadIdentityProvider.
+func (mr *MockStoreMockRecorder) CreateWorkloadIdentityProvider(ctx, provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkloadIdentityProvider", reflect.TypeOf((*MockStore)(nil).CreateWorkloadIdentityProvider), ctx, provider)
+}
+
 // CreateZone mocks base method.
 func (m *MockStore) CreateZone(ctx context.Context, zone *zones.Zone) error {
 	m.ctrl.T.Helper()
@@ -541,6 +659,20 @@ func (mr *MockStoreMockRecorder) DeleteCustomDomain(ctx, accountID, domainID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomDomain", reflect.TypeOf((*MockStore)(nil).DeleteCustomDomain), ctx, accountID, domainID)
 }
 
+// DeleteCustomRole mocks base method.
+func (m *MockStore) DeleteCustomRole(ctx context.Context, accountID, roleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomRole", ctx, accountID, roleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomRole indicates an expected call of DeleteCustomRole.
+func (mr *MockStoreMockRecorder) DeleteCustomRole(ctx, accountID, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRole", reflect.TypeOf((*MockStore)(nil).DeleteCustomRole), ctx, accountID, roleID)
+}
+
 // DeleteDNSRecord mocks base method.
 func (m *MockStore) DeleteDNSRecord(ctx context.Context, accountID, zoneID, recordID string) error {
 	m.ctrl.T.Helper()
@@ -555,6 +687,35 @@ func (mr *MockStoreMockRecorder) DeleteDNSRecord(ctx, accountID, zoneID, recordI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDNSRecord", reflect.TypeOf((*MockStore)(nil).DeleteDNSRecord), ctx, accountID, zoneID, recordID)
 }
 
+// DeleteEventStreamingIntegration mocks base method.
+func (m *MockStore) DeleteEventStreamingIntegration(ctx context.Context, accountID string, integrationID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventStreamingIntegration", ctx, accountID, integrationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventStreamingIntegration indicates an expected call of DeleteEventStreamingIntegration.
+func (mr *MockStoreMockRecorder) DeleteEventStreamingIntegration(ctx, accountID, integrationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventStreamingIntegration", reflect.TypeOf((*MockStore)(nil).DeleteEventStreamingIntegration), ctx, accountID, integrationID)
+}
+
+// DeleteExpiredProxySessions mocks base method.
+func (m *MockStore) DeleteExpiredProxySessions(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredProxySessions", ctx, expiredBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredProxySessions indicates an expected call of DeleteExpiredProxySessions.
+func (mr *MockStoreMockRecorder) DeleteExpiredProxySessions(ctx, expiredBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredProxySessions", reflect.TypeOf((*MockStore)(nil).DeleteExpiredProxySessions), ctx, expiredBefore)
+}
+
 // DeleteGroup mocks base method.
 func (m *MockStore) DeleteGroup(ctx context.Context, accountID, groupID string) error {
 	m.ctrl.T.Helper()
@@ -753,6 +914,20 @@ func (mr *MockStoreMockRecorder) DeleteRoute(ctx, accountID, routeID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoute", reflect.TypeOf((*MockStore)(nil).DeleteRoute), ctx, accountID, routeID)
 }
 
+// DeleteSCIMIntegration mocks base method.
+func (m *MockStore) DeleteSCIMIntegration(ctx context.Context, accountID string, integrationID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSCIMIntegration", ctx, accountID, integrationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSCIMIntegration indicates an expected call of DeleteSCIMIntegration.
+func (mr *MockStoreMockRecorder) DeleteSCIMIntegration(ctx, accountID, integrationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSCIMIntegration", reflect.TypeOf((*MockStore)(nil).DeleteSCIMIntegration), ctx, accountID, integrationID)
+}
+
 // DeleteService mocks base method.
 func (m *MockStore) DeleteService(ctx context.Context, accountID, serviceID string) error {
 	m.ctrl.T.Helper()
@@ -851,6 +1026,20 @@ func (mr *MockStoreMockRecorder) DeleteUserInvite(ctx, inviteID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserInvite", reflect.TypeOf((*MockStore)(nil).DeleteUserInvite), ctx, inviteID)
 }
 
+// DeleteWorkloadIdentityProvider mocks base method.
+func (m *MockStore) DeleteWorkloadIdentityProvider(ctx context.Context, accountID, providerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkloadIdentityProvider", ctx, accountID, providerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkloadIdentityProvider indicates an expected call of DeleteWorkloadIdentityProvider.
+func (mr *MockStoreMockRecorder) DeleteWorkloadIdentityProvider(ctx, accountID, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkloadIdentityProvider", reflect.TypeOf((*MockStore)(nil).DeleteWorkloadIdentityProvider), ctx, accountID, providerID)
+}
+
 // DeleteZone mocks base method.
 func (m *MockStore) DeleteZone(ctx context.Context, accountID, zoneID string) error {
 	m.ctrl.T.Helper()
@@ -937,6 +1126,21 @@ func (mr *MockStoreMockRecorder) ExecuteInTransaction(ctx, f any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteInTransaction", reflect.TypeOf((*MockStore)(nil).ExecuteInTransaction), ctx, f)
 }
 
+// GetAccessRequestByID mocks base method.
+func (m *MockStore) GetAccessRequestByID(ctx context.Context, lockStrength LockingStrength, accountID, requestID string) (*types3.AccessRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessRequestByID", ctx, lockStrength, accountID, requestID)
+	ret0, _ := ret[0].(*types3.AccessRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessRequestByID indicates an expected call of GetAccessRequestByID.
+func (mr *MockStoreMockRecorder) GetAccessRequestByID(ctx, lockStrength, accountID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessRequestByID", reflect.TypeOf((*MockStore)(nil).GetAccessRequestByID), ctx, lockStrength, accountID, requestID)
+}
+
 // GetAccount mocks base method.
 func (m *MockStore) GetAccount(ctx context.Context, accountID string) (*types3.Account, error) {
 	m.ctrl.T.Helper()
@@ -968,6 +1172,51 @@ func (mr *MockStoreMockRecorder) GetAccountAccessLogs(ctx, lockStrength, account
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountAccessLogs", reflect.TypeOf((*MockStore)(nil).GetAccountAccessLogs), ctx, lockStrength, accountID, filter)
 }
 
+// GetAccountAccessRequests mocks base method.
+func (m *MockStore) GetAccountAccessRequests(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types3.AccessRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountAccessRequests", ctx, lockStrength, accountID)
+	ret0, _ := ret[0].([]*types3.AccessRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountAccessRequests indicates an expected call of GetAccountAccessRequests.
+func (mr *MockStoreMockRecorder) GetAccountAccessRequests(ctx, lockStrength, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountAccessRequests", reflect.TypeOf((*MockStore)(nil).GetAccountAccessRequests), ctx, lockStrength, accountID)
+}
+
+// GetAccountIDsWithApprovedAccessRequests mocks base method.
+func (m *MockStore) GetAccountIDsWithApprovedAccessRequests(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountIDsWithApprovedAccessRequests", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountIDsWithApprovedAccessRequests indicates an expected call of GetAccountIDsWithApprovedAccessRequests.
+func (mr *MockStoreMockRecorder) GetAccountIDsWithApprovedAccessRequests(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountIDsWithApprovedAccessRequests", reflect.TypeOf((*MockStore)(nil).GetAccountIDsWithApprovedAccessRequests), ctx)
+}
+
+// GetAccountIDsWithScheduledPolicies mocks base method.
+func (m *MockStore) GetAccountIDsWithScheduledPolicies(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountIDsWithScheduledPolicies", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountIDsWithScheduledPolicies indicates an expected call of GetAccountIDsWithScheduledPolicies.
+func (mr *MockStoreMockRecorder) GetAccountIDsWithScheduledPolicies(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountIDsWithScheduledPolicies", reflect.TypeOf((*MockStore)(nil).GetAccountIDsWithScheduledPolicies), ctx)
+}
+
 // GetAccountAgentNetworkBudgetRules mocks base method.
 func (m *MockStore) GetAccountAgentNetworkBudgetRules(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.AccountBudgetRule, error) {
 	m.ctrl.T.Helper()
@@ -1118,6 +1367,21 @@ func (mr *MockStoreMockRecorder) GetAccountCreatedBy(ctx, lockStrength, accountI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountCreatedBy", reflect.TypeOf((*MockStore)(nil).GetAccountCreatedBy), ctx, lockStrength, accountID)
 }
 
+// GetAccountCustomRoles mocks base method.
+func (m *MockStore) GetAccountCustomRoles(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*customroles.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountCustomRoles", ctx, lockStrength, accountID)
+	ret0, _ := ret[0].([]*customroles.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountCustomRoles indicates an expected call of GetAccountCustomRoles.
+func (mr *MockStoreMockRecorder) GetAccountCustomRoles(ctx, lockStrength, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountCustomRoles", reflect.TypeOf((*MockStore)(nil).GetAccountCustomRoles), ctx, lockStrength, accountID)
+}
+
 // GetAccountDNSSettings mocks base method.
 func (m *MockStore) GetAccountDNSSettings(ctx context.Context, lockStrength LockingStrength, accountID string) (*types3.DNSSettings, error) {
 	m.ctrl.T.Helper()
@@ -1149,6 +1413,21 @@ func (mr *MockStoreMockRecorder) GetAccountDomainAndCategory(ctx, lockStrength,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountDomainAndCategory", reflect.TypeOf((*MockStore)(nil).GetAccountDomainAndCategory), ctx, lockStrength, accountID)
 }
 
+// GetAccountEventStreamingIntegrations mocks base method.
+func (m *MockStore) GetAccountEventStreamingIntegrations(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*eventstreaming.Integration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountEventStreamingIntegrations", ctx, lockStrength, accountID)
+	ret0, _ := ret[0].([]*eventstreaming.Integration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountEventStreamingIntegrations indicates an expected call of GetAccountEventStreamingIntegrations.
+func (mr *MockStoreMockRecorder) GetAccountEventStreamingIntegrations(ctx, lockStrength, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountEventStreamingIntegrations", reflect.TypeOf((*MockStore)(nil).GetAccountEventStreamingIntegrations), ctx, lockStrength, accountID)
+}
+
 // GetAccountGroupPeers mocks base method.
 func (m *MockStore) GetAccountGroupPeers(ctx context.Context, lockStrength LockingStrength, accountID string) (map[string]map[string]struct{}, error) {
 	m.ctrl.T.Helper()
@@ -1434,6 +1713,21 @@ func (mr *MockStoreMockRecorder) GetAccountRoutes(ctx, lockStrength, accountID a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountRoutes", reflect.TypeOf((*MockStore)(nil).GetAccountRoutes), ctx, lockStrength, accountID)
 }
 
+// GetAccountSCIMIntegrations mocks base method.
+func (m *MockStore) GetAccountSCIMIntegrations(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*scim.Integration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountSCIMIntegrations", ctx, lockStrength, accountID)
+	ret0, _ := ret[0].([]*scim.Integration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountSCIMIntegrations indicates an expected call of GetAccountSCIMIntegrations.
+func (mr *MockStoreMockRecorder) GetAccountSCIMIntegrations(ctx, lockStrength, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSCIMIntegrations", reflect.TypeOf((*MockStore)(nil).GetAccountSCIMIntegrations), ctx, lockStrength, accountID)
+}
+
 // GetAccountServices mocks base method.
 func (m *MockStore) GetAccountServices(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*service.Service, error) {
 	m.ctrl.T.Helper()
@@ -1509,6 +1803,21 @@ func (mr *MockStoreMockRecorder) GetAccountUsers(ctx, lockStrength, accountID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountUsers", reflect.TypeOf((*MockStore)(nil).GetAccountUsers), ctx, lockStrength, accountID)
 }
 
+// GetAccountWorkloadIdentityProviders mocks base method.
+func (m *MockStore) GetAccountWorkloadIdentityProviders(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*workloadidentity.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountWorkloadIdentityProviders", ctx, lockStrength, accountID)
+	ret0, _ := ret[0].([]*workloadidentity.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountWorkloadIdentityProviders indicates an expected call of GetAccountWorkloadIdentityProviders.
+func (mr *MockStoreMockRecorder) GetAccountWorkloadIdentityProviders(ctx, lockStrength, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountWorkloadIdentityProviders", reflect.TypeOf((*MockStore)(nil).GetAccountWorkloadIdentityProviders), ctx, lockStrength, accountID)
+}
+
 // GetAccountZones mocks base method.
 func (m *MockStore) GetAccountZones(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*zones.Zone, error) {
 	m.ctrl.T.Helper()
@@ -1957,6 +2266,21 @@ func (mr *MockStoreMockRecorder) GetCustomDomainsCounts(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomDomainsCounts", reflect.TypeOf((*MockStore)(nil).GetCustomDomainsCounts), ctx)
 }
 
+// GetCustomRoleByID mocks base method.
+func (m *MockStore) GetCustomRoleByID(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) (*customroles.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomRoleByID", ctx, lockStrength, accountID, roleID)
+	ret0, _ := ret[0].(*customroles.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomRoleByID indicates an expected call of GetCustomRoleByID.
+func (mr *MockStoreMockRecorder) GetCustomRoleByID(ctx, lockStrength, accountID, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRoleByID", reflect.TypeOf((*MockStore)(nil).GetCustomRoleByID), ctx, lockStrength, accountID, roleID)
+}
+
 // GetDNSRecordByID mocks base method.
 func (m *MockStore) GetDNSRecordByID(ctx context.Context, lockStrength LockingStrength, accountID, zoneID, recordID string) (*records.Record, error) {
 	m.ctrl.T.Helper()
@@ -1987,6 +2311,36 @@ func (mr *MockStoreMockRecorder) GetEmbeddedProxyPeerIDsByCluster(ctx, accountID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmbeddedProxyPeerIDsByCluster", reflect.TypeOf((*MockStore)(nil).GetEmbeddedProxyPeerIDsByCluster), ctx, accountID)
 }
 
+// GetEnabledEventStreamingIntegrations mocks base method.
+func (m *MockStore) GetEnabledEventStreamingIntegrations(ctx context.Context, lockStrength LockingStrength) ([]*eventstreaming.Integration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnabledEventStreamingIntegrations", ctx, lockStrength)
+	ret0, _ := ret[0].([]*eventstreaming.Integration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnabledEventStreamingIntegrations indicates an expected call of GetEnabledEventStreamingIntegrations.
+func (mr *MockStoreMockRecorder) GetEnabledEventStreamingIntegrations(ctx, lockStrength any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnabledEventStreamingIntegrations", reflect.TypeOf((*MockStore)(nil).GetEnabledEventStreamingIntegrations), ctx, lockStrength)
+}
+
+// GetEventStreamingIntegrationByID mocks base method.
+func (m *MockStore) GetEventStreamingIntegrationByID(ctx context.Context, lockStrength LockingStrength, accountID string, integrationID int64) (*eventstreaming.Integration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventStreamingIntegrationByID", ctx, lockStrength, accountID, integrationID)
+	ret0, _ := ret[0].(*eventstreaming.Integration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventStreamingIntegrationByID indicates an expected call of GetEventStreamingIntegrationByID.
+func (mr *MockStoreMockRecorder) GetEventStreamingIntegrationByID(ctx, lockStrength, accountID, integrationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventStreamingIntegrationByID", reflect.TypeOf((*MockStore)(nil).GetEventStreamingIntegrationByID), ctx, lockStrength, accountID, integrationID)
+}
+
 // GetExpiredEphemeralServices mocks base method.
 func (m *MockStore) GetExpiredEphemeralServices(ctx context.Context, ttl time.Duration, limit int) ([]*service.Service, error) {
 	m.ctrl.T.Helper()
@@ -2601,6 +2955,21 @@ func (mr *MockStoreMockRecorder) GetProxyMetrics(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProxyMetrics", reflect.TypeOf((*MockStore)(nil).GetProxyMetrics), ctx)
 }
 
+// GetProxySessionByID mocks base method.
+func (m *MockStore) GetProxySessionByID(ctx context.Context, lockStrength LockingStrength, accountID, serviceID, sessionID string) (*sessions.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProxySessionByID", ctx, lockStrength, accountID, serviceID, sessionID)
+	ret0, _ := ret[0].(*sessions.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProxySessionByID indicates an expected call of GetProxySessionByID.
+func (mr *MockStoreMockRecorder) GetProxySessionByID(ctx, lockStrength, accountID, serviceID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProxySessionByID", reflect.TypeOf((*MockStore)(nil).GetProxySessionByID), ctx, lockStrength, accountID, serviceID, sessionID)
+}
+
 // GetResourceGroups mocks base method.
 func (m *MockStore) GetResourceGroups(ctx context.Context, lockStrength LockingStrength, accountID, resourceID string) ([]*types3.Group, error) {
 	m.ctrl.T.Helper()
@@ -2646,6 +3015,51 @@ func (mr *MockStoreMockRecorder) GetRoutingPeerNetworks(ctx, accountID, peerID a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingPeerNetworks", reflect.TypeOf((*MockStore)(nil).GetRoutingPeerNetworks), ctx, accountID, peerID)
 }
 
+// GetSCIMIntegrationByHashedToken mocks base method.
+func (m *MockStore) GetSCIMIntegrationByHashedToken(ctx context.Context, hashedToken string) (*scim.Integration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMIntegrationByHashedToken", ctx, hashedToken)
+	ret0, _ := ret[0].(*scim.Integration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSCIMIntegrationByHashedToken indicates an expected call of GetSCIMIntegrationByHashedToken.
+func (mr *MockStoreMockRecorder) GetSCIMIntegrationByHashedToken(ctx, hashedToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMIntegrationByHashedToken", reflect.TypeOf((*MockStore)(nil).GetSCIMIntegrationByHashedToken), ctx, hashedToken)
+}
+
+// GetSCIMIntegrationByID mocks base method.
+func (m *MockStore) GetSCIMIntegrationByID(ctx context.Context, lockStrength LockingStrength, accountID string, integrationID int64) (*scim.Integration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMIntegrationByID", ctx, lockStrength, accountID, integrationID)
+	ret0, _ := ret[0].(*scim.Integration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSCIMIntegrationByID indicates an expected call of GetSCIMIntegrationByID.
+func (mr *MockStoreMockRecorder) GetSCIMIntegrationByID(ctx, lockStrength, accountID, integrationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMIntegrationByID", reflect.TypeOf((*MockStore)(nil).GetSCIMIntegrationByID), ctx, lockStrength, accountID, integrationID)
+}
+
+// GetSCIMSyncLogs mocks base method.
+func (m *MockStore) GetSCIMSyncLogs(ctx context.Context, lockStrength LockingStrength, accountID string, integrationID int64, limit int) ([]*scim.SyncLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMSyncLogs", ctx, lockStrength, accountID, integrationID, limit)
+	ret0, _ := ret[0].([]*scim.SyncLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSCIMSyncLogs indicates an expected call of GetSCIMSyncLogs.
+func (mr *MockStoreMockRecorder) GetSCIMSyncLogs(ctx, lockStrength, accountID, integrationID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMSyncLogs", reflect.TypeOf((*MockStore)(nil).GetSCIMSyncLogs), ctx, lockStrength, accountID, integrationID, limit)
+}
+
 // GetServiceByDomain mocks base method.
 func (m *MockStore) GetServiceByDomain(ctx context.Context, arg1 string) (*service.Service, error) {
 	m.ctrl.T.Helper()
@@ -2676,6 +3090,21 @@ func (mr *MockStoreMockRecorder) GetServiceByID(ctx, lockStrength, accountID, se
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceByID", reflect.TypeOf((*MockStore)(nil).GetServiceByID), ctx, lockStrength, accountID, serviceID)
 }
 
+// GetServiceProxySessions mocks base method.
+func (m *MockStore) GetServiceProxySessions(ctx context.Context, lockStrength LockingStrength, accountID, serviceID string, activeAt time.Time) ([]*sessions.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceProxySessions", ctx, lockStrength, accountID, serviceID, activeAt)
+	ret0, _ := ret[0].([]*sessions.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceProxySessions indicates an expected call of GetServiceProxySessions.
+func (mr *MockStoreMockRecorder) GetServiceProxySessions(ctx, lockStrength, accountID, serviceID, activeAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceProxySessions", reflect.TypeOf((*MockStore)(nil).GetServiceProxySessions), ctx, lockStrength, accountID, serviceID, activeAt)
+}
+
 // GetServiceTargetByTargetID mocks base method.
 func (m *MockStore) GetServiceTargetByTargetID(ctx context.Context, lockStrength LockingStrength, accountID, targetID string) (*service.Target, error) {
 	m.ctrl.T.Helper()
@@ -2945,6 +3374,36 @@ func (mr *MockStoreMockRecorder) GetUserPeers(ctx, lockStrength, accountID, user
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPeers", reflect.TypeOf((*MockStore)(nil).GetUserPeers), ctx, lockStrength, accountID, userID)
 }
 
+// GetWorkloadIdentityProvider mocks base method.
+func (m *MockStore) GetWorkloadIdentityProvider(ctx context.Context, lockStrength LockingStrength, providerID string) (*workloadidentity.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkloadIdentityProvider", ctx, lockStrength, providerID)
+	ret0, _ := ret[0].(*workloadidentity.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkloadIdentityProvider indicates an expected call of GetWorkloadIdentityProvider.
+func (mr *MockStoreMockRecorder) GetWorkloadIdentityProvider(ctx, lockStrength, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkloadIdentityProvider", reflect.TypeOf((*MockStore)(nil).GetWorkloadIdentityProvider), ctx, lockStrength, providerID)
+}
+
+// GetWorkloadIdentityProviderByID mocks base method.
+func (m *MockStore) GetWorkloadIdentityProviderByID(ctx context.Context, lockStrength LockingStrength, accountID, providerID string) (*workloadidentity.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkloadIdentityProviderByID", ctx, lockStrength, accountID, providerID)
+	ret0, _ := ret[0].(*workloadidentity.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkloadIdentityProviderByID indicates an expected call of GetWorkloadIdentityProviderByID.
+func (mr *MockStoreMockRecorder) GetWorkloadIdentityProviderByID(ctx, lockStrength, accountID, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkloadIdentityProviderByID", reflect.TypeOf((*MockStore)(nil).GetWorkloadIdentityProviderByID), ctx, lockStrength, accountID, providerID)
+}
+
 // GetZoneByDomain mocks base method.
 func (m *MockStore) GetZoneByDomain(ctx context.Context, accountID, arg2 string) (*zones.Zone, error) {
 	m.ctrl.T.Helper()
@@ -3352,6 +3811,34 @@ func (mr *MockStoreMockRecorder) RevokeProxyAccessToken(ctx, tokenID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeProxyAccessToken", reflect.TypeOf((*MockStore)(nil).RevokeProxyAccessToken), ctx, tokenID)
 }
 
+// RevokeProxySessions mocks base method.
+func (m *MockStore) RevokeProxySessions(ctx context.Context, accountID, serviceID string, sessionIDs []string, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeProxySessions", ctx, accountID, serviceID, sessionIDs, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeProxySessions indicates an expected call of RevokeProxySessions.
+func (mr *MockStoreMockRecorder) RevokeProxySessions(ctx, accountID, serviceID, sessionIDs, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeProxySessions", reflect.TypeOf((*MockStore)(nil).RevokeProxySessions), ctx, accountID, serviceID, sessionIDs, revokedAt)
+}
+
+// SaveAccessRequest mocks base method.
+func (m *MockStore) SaveAccessRequest(ctx context.Context, request *types3.AccessRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAccessRequest", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAccessRequest indicates an expected call of SaveAccessRequest.
+func (mr *MockStoreMockRecorder) SaveAccessRequest(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAccessRequest", reflect.TypeOf((*MockStore)(nil).SaveAccessRequest), ctx, request)
+}
+
 // SaveAccount mocks base method.
 func (m *MockStore) SaveAccount(ctx context.Context, account *types3.Account) error {
 	m.ctrl.T.Helper()
@@ -3785,6 +4272,20 @@ func (mr *MockStoreMockRecorder) UpdateCustomDomain(ctx, accountID, d any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomDomain", reflect.TypeOf((*MockStore)(nil).UpdateCustomDomain), ctx, accountID, d)
 }
 
+// UpdateCustomRole mocks base method.
+func (m *MockStore) UpdateCustomRole(ctx context.Context, role *customroles.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomRole", ctx, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCustomRole indicates an expected call of UpdateCustomRole.
+func (mr *MockStoreMockRecorder) UpdateCustomRole(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRole", reflect.TypeOf((*MockStore)(nil).UpdateCustomRole), ctx, role)
+}
+
 // UpdateDNSRecord mocks base method.
 func (m *MockStore) UpdateDNSRecord(ctx context.Context, record *records.Record) error {
 	m.ctrl.T.Helper()
@@ -3799,6 +4300,34 @@ func (mr *MockStoreMockRecorder) UpdateDNSRecord(ctx, record any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDNSRecord", reflect.TypeOf((*MockStore)(nil).UpdateDNSRecord), ctx, record)
 }
 
+// UpdateEventStreamingDeliveryState mocks base method.
+func (m *MockStore) UpdateEventStreamingDeliveryState(ctx context.Context, integrationID int64, state eventstreaming.DeliveryState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEventStreamingDeliveryState", ctx, integrationID, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEventStreamingDeliveryState indicates an expected call of UpdateEventStreamingDeliveryState.
+func (mr *MockStoreMockRecorder) UpdateEventStreamingDeliveryState(ctx, integrationID, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventStreamingDeliveryState", reflect.TypeOf((*MockStore)(nil).UpdateEventStreamingDeliveryState), ctx, integrationID, state)
+}
+
+// UpdateEventStreamingIntegration mocks base method.
+func (m *MockStore) UpdateEventStreamingIntegration(ctx context.Context, integration *eventstreaming.Integration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEventStreamingIntegration", ctx, integration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEventStreamingIntegration indicates an expected call of UpdateEventStreamingIntegration.
+func (mr *MockStoreMockRecorder) UpdateEventStreamingIntegration(ctx, integration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventStreamingIntegration", reflect.TypeOf((*MockStore)(nil).UpdateEventStreamingIntegration), ctx, integration)
+}
+
 // UpdateGroup mocks base method.
 func (m *MockStore) UpdateGroup(ctx context.Context, group *types3.Group) error {
 	m.ctrl.T.Helper()
@@ -3855,6 +4384,34 @@ func (mr *MockStoreMockRecorder) UpdateProxyHeartbeat(ctx, p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProxyHeartbeat", reflect.TypeOf((*MockStore)(nil).UpdateProxyHeartbeat), ctx, p)
 }
 
+// UpdateSCIMIntegration mocks base method.
+func (m *MockStore) UpdateSCIMIntegration(ctx context.Context, integration *scim.Integration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSCIMIntegration", ctx, integration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSCIMIntegration indicates an expected call of UpdateSCIMIntegration.
+func (mr *MockStoreMockRecorder) UpdateSCIMIntegration(ctx, integration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSCIMIntegration", reflect.TypeOf((*MockStore)(nil).UpdateSCIMIntegration), ctx, integration)
+}
+
+// UpdateSCIMIntegrationLastSynced mocks base method.
+func (m *MockStore) UpdateSCIMIntegrationLastSynced(ctx context.Context, integrationID int64, syncedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSCIMIntegrationLastSynced", ctx, integrationID, syncedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSCIMIntegrationLastSynced indicates an expected call of UpdateSCIMIntegrationLastSynced.
+func (mr *MockStoreMockRecorder) UpdateSCIMIntegrationLastSynced(ctx, integrationID, syncedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSCIMIntegrationLastSynced", reflect.TypeOf((*MockStore)(nil).UpdateSCIMIntegrationLastSynced), ctx, integrationID, syncedAt)
+}
+
 // UpdateService mocks base method.
 func (m *MockStore) UpdateService(ctx context.Context, arg1 *service.Service) error {
 	m.ctrl.T.Helper()
@@ -3869,6 +4426,20 @@ func (mr *MockStoreMockRecorder) UpdateService(ctx, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*MockStore)(nil).UpdateService), ctx, arg1)
 }
 
+// UpdateWorkloadIdentityProvider mocks base method.
+func (m *MockStore) UpdateWorkloadIdentityProvider(ctx context.Context, provider *workloadidentity.Provider) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkloadIdentityProvider", ctx, provider)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkloadIdentityProvider indicates an expected call of UpdateWorkloadIdentityProvider.
+func (mr *MockStoreMockRecorder) UpdateWorkloadIdentityProvider(ctx, provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkloadIdentityProvider", reflect.TypeOf((*MockStore)(nil).UpdateWorkloadIdentityProvider), ctx, provider)
+}
+
 // UpdateZone mocks base method.
 func (m *MockStore) UpdateZone(ctx context.Context, zone *zones.Zone) error {
 	m.ctrl.T.Helper()
@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/netip"
 	"slices"
 	"strconv"
@@ -89,6 +90,8 @@ type Account struct {
 	NetworkRouters   []*routerTypes.NetworkRouter     `gorm:"foreignKey:AccountID;references:id"`
 	NetworkResources []*resourceTypes.NetworkResource `gorm:"foreignKey:AccountID;references:id"`
 	Onboarding       AccountOnboarding                `gorm:"foreignKey:AccountID;references:id;constraint:OnDelete:CASCADE"`
+	// AccessGrants are the approved just-in-time access requests, expired ones are skipped by ApplyAccessGrants
+	AccessGrants []*AccessRequest `gorm:"foreignKey:AccountID;references:id"`
 
 	ReverseProxyFreeDomainNonce string
 
@@ -852,6 +855,11 @@ func (a *Account) Copy() *Account {
 		domains = append(domains, domain.Copy())
 	}
 
+	accessGrants := []*AccessRequest{}
+	for _, grant := range a.AccessGrants {
+		accessGrants = append(accessGrants, grant.Copy())
+	}
+
 	return &Account{
 		Id:                     a.Id,
 		CreatedBy:              a.CreatedBy,
@@ -876,6 +884,7 @@ func (a *Account) Copy() *Account {
 		Services:               services,
 		Onboarding:             a.Onboarding,
 		Domains:                domains,
+		AccessGrants:           accessGrants,
 		PostureValidation:      a.PostureValidation,
 	}
 }
@@ -1668,6 +1677,172 @@ func (a *Account) GetProxyPeers() map[string][]*nbpeer.Peer {
 	return proxyPeers
 }
 
+// ApplyEffectiveAccess brings the account into the state the network map is computed from at the given
+// moment. Access grants go first so a granted policy is still limited by its schedule, and label
+// selectors go last so they resolve against the grant-extended groups and the policies in effect.
+func (a *Account) ApplyEffectiveAccess(now time.Time) {
+	a.ApplyAccessGrants(now)
+	a.ApplyPolicySchedules(now)
+	a.ApplyLabelSelectors()
+}
+
+// ApplyPolicySchedules disables the enabled policies whose schedule doesn't allow them at the given
+// moment, so the network map only contains the policies currently in effect. The policies slice is
+// rebuilt with disabled copies because shallow account copies share it.
+func (a *Account) ApplyPolicySchedules(now time.Time) {
+	var policies []*Policy
+	for i, policy := range a.Policies {
+		if !policy.Enabled || policy.IsActive(now) {
+			continue
+		}
+		if policies == nil {
+			policies = slices.Clone(a.Policies)
+		}
+		inactive := policy.Copy()
+		inactive.Enabled = false
+		policies[i] = inactive
+	}
+	if policies != nil {
+		a.Policies = policies
+	}
+}
+
+// ApplyAccessGrants puts the active just-in-time access grants into effect: the requester's peers
+// join the granted groups and granted policies are enabled. Groups and policies are replaced with
+// copies because shallow account copies share them.
+func (a *Account) ApplyAccessGrants(now time.Time) {
+	var groups map[string]*Group
+	copiedGroups := make(map[string]struct{})
+	var policies []*Policy
+
+	for _, grant := range a.AccessGrants {
+		if !grant.IsActive(now) {
+			continue
+		}
+
+		switch grant.TargetType {
+		case AccessRequestTargetGroup:
+			if _, ok := a.Groups[grant.TargetID]; !ok {
+				continue
+			}
+			userPeers, _ := a.FindUserPeers(grant.UserID)
+			if len(userPeers) == 0 {
+				continue
+			}
+			if groups == nil {
+				groups = maps.Clone(a.Groups)
+			}
+			if _, ok := copiedGroups[grant.TargetID]; !ok {
+				groups[grant.TargetID] = groups[grant.TargetID].Copy()
+				copiedGroups[grant.TargetID] = struct{}{}
+			}
+			for _, peer := range userPeers {
+				groups[grant.TargetID].AddPeer(peer.ID)
+			}
+		case AccessRequestTargetPolicy:
+			i := slices.IndexFunc(a.Policies, func(p *Policy) bool { return p.ID == grant.TargetID })
+			if i < 0 || a.Policies[i].Enabled {
+				continue
+			}
+			if policies == nil {
+				policies = slices.Clone(a.Policies)
+			}
+			enabled := a.Policies[i].Copy()
+			enabled.Enabled = true
+			policies[i] = enabled
+		}
+	}
+
+	if groups != nil {
+		a.Groups = groups
+	}
+	if policies != nil {
+		a.Policies = policies
+	}
+}
+
+// LabelSelectorGroupPrefix prefixes the IDs of the groups ApplyLabelSelectors derives from label selectors
+const LabelSelectorGroupPrefix = "labels:"
+
+// ApplyLabelSelectors resolves the label selectors of policy rules and routes into groups of the peers
+// with matching labels and adds them to the rule sources and destinations and to the route distribution
+// groups, so the network map is computed from groups only. The derived groups are never persisted.
+// Groups, policies and routes are replaced with copies because shallow account copies share them.
+func (a *Account) ApplyLabelSelectors() {
+	var groups map[string]*Group
+	selectorGroup := func(selector map[string]string) string {
+		id := LabelSelectorGroupID(selector)
+		if groups == nil {
+			groups = maps.Clone(a.Groups)
+		}
+		if _, ok := groups[id]; ok {
+			return id
+		}
+		group := &Group{ID: id, AccountID: a.Id, Name: id, Issued: GroupIssuedAPI}
+		for _, peer := range a.Peers {
+			if peer.MatchesLabels(selector) {
+				group.Peers = append(group.Peers, peer.ID)
+			}
+		}
+		slices.Sort(group.Peers)
+		groups[id] = group
+		return id
+	}
+
+	var policies []*Policy
+	for i, policy := range a.Policies {
+		if !policy.HasLabelSelectors() {
+			continue
+		}
+		if policies == nil {
+			policies = slices.Clone(a.Policies)
+		}
+		resolved := policy.Copy()
+		for _, rule := range resolved.Rules {
+			if len(rule.SourceLabels) > 0 {
+				rule.Sources = append(rule.Sources, selectorGroup(rule.SourceLabels))
+			}
+			if len(rule.DestinationLabels) > 0 {
+				rule.Destinations = append(rule.Destinations, selectorGroup(rule.DestinationLabels))
+			}
+		}
+		policies[i] = resolved
+	}
+
+	var routes map[route.ID]*route.Route
+	for id, r := range a.Routes {
+		if len(r.DistributionLabels) == 0 {
+			continue
+		}
+		if routes == nil {
+			routes = maps.Clone(a.Routes)
+		}
+		resolved := r.Copy()
+		resolved.Groups = append(resolved.Groups, selectorGroup(r.DistributionLabels))
+		routes[id] = resolved
+	}
+
+	if groups != nil {
+		a.Groups = groups
+	}
+	if policies != nil {
+		a.Policies = policies
+	}
+	if routes != nil {
+		a.Routes = routes
+	}
+}
+
+// LabelSelectorGroupID returns the ID of the group ApplyLabelSelectors derives from the selector.
+// Selectors with the same key/value pairs share the group.
+func LabelSelectorGroupID(selector map[string]string) string {
+	pairs := make([]string, 0, len(selector))
+	for _, key := range slices.Sorted(maps.Keys(selector)) {
+		pairs = append(pairs, key+"="+selector[key])
+	}
+	return LabelSelectorGroupPrefix + strings.Join(pairs, ",")
+}
+
 func (a *Account) InjectProxyPolicies(ctx context.Context) {
 	if len(a.Services) == 0 {
 		return
@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"net"
 	"net/netip"
+	"time"
 
 	nbroute "github.com/netbirdio/netbird/route"
 	sharedtypes "github.com/netbirdio/netbird/shared/management/types"
@@ -30,6 +31,25 @@ type PolicyTrafficActionType = sharedtypes.PolicyTrafficActionType
 type PolicyRuleProtocolType = sharedtypes.PolicyRuleProtocolType
 type PolicyRuleDirection = sharedtypes.PolicyRuleDirection
 type RulePortRange = sharedtypes.RulePortRange
+type PolicySchedule = sharedtypes.PolicySchedule
+type PolicyScheduleWindow = sharedtypes.PolicyScheduleWindow
+
+type AccessRequest = sharedtypes.AccessRequest
+type AccessRequestTargetType = sharedtypes.AccessRequestTargetType
+type AccessRequestStatus = sharedtypes.AccessRequestStatus
+
+const (
+	AccessRequestTargetGroup  = sharedtypes.AccessRequestTargetGroup
+	AccessRequestTargetPolicy = sharedtypes.AccessRequestTargetPolicy
+
+	AccessRequestStatusPending  = sharedtypes.AccessRequestStatusPending
+	AccessRequestStatusApproved = sharedtypes.AccessRequestStatusApproved
+	AccessRequestStatusDenied   = sharedtypes.AccessRequestStatusDenied
+	AccessRequestStatusRevoked  = sharedtypes.AccessRequestStatusRevoked
+	AccessRequestStatusExpired  = sharedtypes.AccessRequestStatusExpired
+
+	AccessRequestMaxDuration = sharedtypes.AccessRequestMaxDuration
+)
 
 type Resource = sharedtypes.Resource
 type ResourceType = sharedtypes.ResourceType
@@ -66,6 +86,10 @@ const GroupAllName = sharedtypes.GroupAllName
 // resolved to package-local funcs. Plain forwarders (not var aliases) keep
 // the symbol immutable and allow the inliner to flatten the call.
 
+func NextPolicyScheduleTransition(policies []*Policy, now time.Time) (time.Time, bool) {
+	return sharedtypes.NextPolicyScheduleTransition(policies, now)
+}
+
 func PolicyRuleImpliesLegacySSH(rule *PolicyRule) bool {
 	return sharedtypes.PolicyRuleImpliesLegacySSH(rule)
 }
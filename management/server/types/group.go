@@ -33,6 +33,9 @@ type Group struct {
 	// Resources contains a list of resources in that group
 	Resources []Resource `gorm:"serializer:json"`
 
+	// Rules makes the group dynamic: its peers are computed from peer attributes instead of being assigned
+	Rules *GroupRules `gorm:"serializer:json"`
+
 	IntegrationReference integration_reference.IntegrationReference `gorm:"embedded;embeddedPrefix:integration_ref_"`
 }
 
@@ -77,6 +80,7 @@ func (g *Group) Copy() *Group {
 		Peers:                make([]string, len(g.Peers)),
 		GroupPeers:           make([]GroupPeer, len(g.GroupPeers)),
 		Resources:            make([]Resource, len(g.Resources)),
+		Rules:                g.Rules.Copy(),
 		IntegrationReference: g.IntegrationReference,
 	}
 	copy(group.Peers, g.Peers)
@@ -90,6 +94,11 @@ func (g *Group) HasPeers() bool {
 	return len(g.Peers) > 0
 }
 
+// IsDynamic reports whether the group membership is computed from peer attribute rules.
+func (g *Group) IsDynamic() bool {
+	return g.Rules != nil && len(g.Rules.Rules) > 0
+}
+
 // IsGroupAll checks if the group is a default "All" group.
 func (g *Group) IsGroupAll() bool {
 	return g.Name == GroupAllName
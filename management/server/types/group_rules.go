@@ -0,0 +1,331 @@
+package types
+
+import (
+	"fmt"
+	"net/netip"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	goversion "github.com/hashicorp/go-version"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	nbversion "github.com/netbirdio/netbird/version"
+)
+
+// GroupRuleAttribute is the peer attribute a dynamic group rule is evaluated against
+type GroupRuleAttribute string
+
+const (
+	// GroupRuleAttributeOS matches the peer's operating system family (linux, windows, darwin, android, ios)
+	GroupRuleAttributeOS GroupRuleAttribute = "os"
+	// GroupRuleAttributeOSVersion matches the peer's operating system version
+	GroupRuleAttributeOSVersion GroupRuleAttribute = "os_version"
+	// GroupRuleAttributeHostname matches the hostname reported by the peer
+	GroupRuleAttributeHostname GroupRuleAttribute = "hostname"
+	// GroupRuleAttributeNetBirdVersion matches the NetBird client version of the peer
+	GroupRuleAttributeNetBirdVersion GroupRuleAttribute = "netbird_version"
+	// GroupRuleAttributeCountry matches the ISO country code of the peer's connection IP
+	GroupRuleAttributeCountry GroupRuleAttribute = "country"
+	// GroupRuleAttributeConnectionIP matches the public IP the peer connects to management from
+	GroupRuleAttributeConnectionIP GroupRuleAttribute = "connection_ip"
+	// GroupRuleAttributeSetupKey matches the ID of the setup key the peer was registered with
+	GroupRuleAttributeSetupKey GroupRuleAttribute = "setup_key"
+	// GroupRuleAttributeUser matches the ID of the user that registered the peer
+	GroupRuleAttributeUser GroupRuleAttribute = "user"
+)
+
+// GroupRuleOperator defines how a dynamic group rule compares the peer attribute with its values
+type GroupRuleOperator string
+
+const (
+	GroupRuleOperatorEquals     GroupRuleOperator = "equals"
+	GroupRuleOperatorNotEquals  GroupRuleOperator = "not_equals"
+	GroupRuleOperatorGlob       GroupRuleOperator = "glob"
+	GroupRuleOperatorRegex      GroupRuleOperator = "regex"
+	GroupRuleOperatorMinVersion GroupRuleOperator = "min_version"
+	GroupRuleOperatorMaxVersion GroupRuleOperator = "max_version"
+	GroupRuleOperatorInRange    GroupRuleOperator = "in_range"
+	GroupRuleOperatorNotInRange GroupRuleOperator = "not_in_range"
+)
+
+// GroupRulesMatch defines how the results of the rules of a dynamic group are combined
+type GroupRulesMatch string
+
+const (
+	// GroupRulesMatchAll requires every rule to match
+	GroupRulesMatchAll GroupRulesMatch = "all"
+	// GroupRulesMatchAny requires at least one rule to match
+	GroupRulesMatchAny GroupRulesMatch = "any"
+)
+
+var (
+	stringRuleOperators  = []GroupRuleOperator{GroupRuleOperatorEquals, GroupRuleOperatorNotEquals, GroupRuleOperatorGlob, GroupRuleOperatorRegex}
+	versionRuleOperators = append(slices.Clone(stringRuleOperators), GroupRuleOperatorMinVersion, GroupRuleOperatorMaxVersion)
+	rangeRuleOperators   = []GroupRuleOperator{GroupRuleOperatorInRange, GroupRuleOperatorNotInRange}
+
+	groupRuleOperators = map[GroupRuleAttribute][]GroupRuleOperator{
+		GroupRuleAttributeOS:             stringRuleOperators,
+		GroupRuleAttributeOSVersion:      versionRuleOperators,
+		GroupRuleAttributeHostname:       stringRuleOperators,
+		GroupRuleAttributeNetBirdVersion: versionRuleOperators,
+		GroupRuleAttributeCountry:        stringRuleOperators,
+		GroupRuleAttributeConnectionIP:   rangeRuleOperators,
+		GroupRuleAttributeSetupKey:       {GroupRuleOperatorEquals, GroupRuleOperatorNotEquals},
+		GroupRuleAttributeUser:           {GroupRuleOperatorEquals, GroupRuleOperatorNotEquals},
+	}
+)
+
+// GroupRules defines the peer attribute rules that compute the membership of a dynamic group
+type GroupRules struct {
+	// Match defines whether all or any of the rules have to match for a peer to be a member
+	Match GroupRulesMatch
+	// Rules is the list of conditions evaluated against each peer
+	Rules []GroupRule
+}
+
+// GroupRule is a single condition of a dynamic group. It matches when the peer attribute
+// satisfies the operator for any of the values; negated operators match when it satisfies none.
+type GroupRule struct {
+	Attribute GroupRuleAttribute
+	Operator  GroupRuleOperator
+	Values    []string
+}
+
+// Copy returns a deep copy of the rules
+func (r *GroupRules) Copy() *GroupRules {
+	if r == nil {
+		return nil
+	}
+	rules := &GroupRules{
+		Match: r.Match,
+		Rules: make([]GroupRule, len(r.Rules)),
+	}
+	for i, rule := range r.Rules {
+		rules.Rules[i] = GroupRule{
+			Attribute: rule.Attribute,
+			Operator:  rule.Operator,
+			Values:    slices.Clone(rule.Values),
+		}
+	}
+	return rules
+}
+
+// ToAPIResponse converts the rules to their API representation
+func (r *GroupRules) ToAPIResponse() *api.GroupRules {
+	if r == nil {
+		return nil
+	}
+	resp := &api.GroupRules{
+		Match: api.GroupRulesMatch(r.Match),
+		Rules: make([]api.GroupRule, 0, len(r.Rules)),
+	}
+	for _, rule := range r.Rules {
+		resp.Rules = append(resp.Rules, api.GroupRule{
+			Attribute: api.GroupRuleAttribute(rule.Attribute),
+			Operator:  api.GroupRuleOperator(rule.Operator),
+			Values:    slices.Clone(rule.Values),
+		})
+	}
+	return resp
+}
+
+// GroupRulesFromAPIRequest converts API rules, returning nil when the request has none
+func GroupRulesFromAPIRequest(req *api.GroupRules) *GroupRules {
+	if req == nil {
+		return nil
+	}
+	rules := &GroupRules{
+		Match: GroupRulesMatch(req.Match),
+		Rules: make([]GroupRule, 0, len(req.Rules)),
+	}
+	for _, rule := range req.Rules {
+		rules.Rules = append(rules.Rules, GroupRule{
+			Attribute: GroupRuleAttribute(rule.Attribute),
+			Operator:  GroupRuleOperator(rule.Operator),
+			Values:    slices.Clone(rule.Values),
+		})
+	}
+	return rules
+}
+
+// Validate checks that the rules are well-formed
+func (r *GroupRules) Validate() error {
+	if r.Match != GroupRulesMatchAll && r.Match != GroupRulesMatchAny {
+		return fmt.Errorf("invalid rules match %q, expected %q or %q", r.Match, GroupRulesMatchAll, GroupRulesMatchAny)
+	}
+	if len(r.Rules) == 0 {
+		return fmt.Errorf("dynamic group requires at least one rule")
+	}
+	_, err := r.Matcher()
+	return err
+}
+
+// Matcher compiles the rules into a GroupRulesMatcher, so patterns and ranges are parsed once
+// when evaluating many peers.
+func (r *GroupRules) Matcher() (*GroupRulesMatcher, error) {
+	m := &GroupRulesMatcher{
+		matchAll: r.Match != GroupRulesMatchAny,
+		rules:    make([]compiledGroupRule, 0, len(r.Rules)),
+	}
+	for i, rule := range r.Rules {
+		compiled, err := compileGroupRule(rule)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		m.rules = append(m.rules, compiled)
+	}
+	return m, nil
+}
+
+// GroupRulesMatcher evaluates compiled dynamic group rules against peers
+type GroupRulesMatcher struct {
+	matchAll bool
+	rules    []compiledGroupRule
+}
+
+// Matches reports whether the peer is a member of the dynamic group
+func (m *GroupRulesMatcher) Matches(peer *nbpeer.Peer) bool {
+	if len(m.rules) == 0 {
+		return false
+	}
+	for _, rule := range m.rules {
+		matched := rule.matches(peer)
+		if m.matchAll && !matched {
+			return false
+		}
+		if !m.matchAll && matched {
+			return true
+		}
+	}
+	return m.matchAll
+}
+
+// AffectedBy reports whether the metadata change in diff flips the membership verdict for
+// the peer. Like posture.AffectsPosture it replays the rules against the old and the new
+// state, so a change that stays on the same side of every rule is not a membership change.
+func (m *GroupRulesMatcher) AffectedBy(diff *nbpeer.MetaDiff, peer *nbpeer.Peer) bool {
+	if diff == nil || !diff.Updated() {
+		return false
+	}
+	oldPeer := nbpeer.Peer{Meta: diff.OldMeta, Location: diff.OldLocation, UserID: peer.UserID, SetupKeyID: peer.SetupKeyID}
+	newPeer := nbpeer.Peer{Meta: diff.NewMeta, Location: diff.NewLocation, UserID: peer.UserID, SetupKeyID: peer.SetupKeyID}
+	return m.Matches(&oldPeer) != m.Matches(&newPeer)
+}
+
+type compiledGroupRule struct {
+	attribute GroupRuleAttribute
+	operator  GroupRuleOperator
+	values    []string
+	patterns  []*regexp.Regexp
+	prefixes  []netip.Prefix
+}
+
+func compileGroupRule(rule GroupRule) (compiledGroupRule, error) {
+	operators, ok := groupRuleOperators[rule.Attribute]
+	if !ok {
+		return compiledGroupRule{}, fmt.Errorf("unsupported attribute %q", rule.Attribute)
+	}
+	if !slices.Contains(operators, rule.Operator) {
+		return compiledGroupRule{}, fmt.Errorf("operator %q is not supported for attribute %q", rule.Operator, rule.Attribute)
+	}
+	if len(rule.Values) == 0 {
+		return compiledGroupRule{}, fmt.Errorf("attribute %q requires at least one value", rule.Attribute)
+	}
+
+	compiled := compiledGroupRule{attribute: rule.Attribute, operator: rule.Operator, values: rule.Values}
+	for _, value := range rule.Values {
+		switch rule.Operator {
+		case GroupRuleOperatorGlob:
+			if _, err := path.Match(value, ""); err != nil {
+				return compiledGroupRule{}, fmt.Errorf("invalid glob %q: %w", value, err)
+			}
+		case GroupRuleOperatorRegex:
+			pattern, err := regexp.Compile(value)
+			if err != nil {
+				return compiledGroupRule{}, fmt.Errorf("invalid regular expression %q: %w", value, err)
+			}
+			compiled.patterns = append(compiled.patterns, pattern)
+		case GroupRuleOperatorMinVersion, GroupRuleOperatorMaxVersion:
+			if _, err := goversion.NewVersion(value); err != nil {
+				return compiledGroupRule{}, fmt.Errorf("invalid version %q: %w", value, err)
+			}
+		case GroupRuleOperatorInRange, GroupRuleOperatorNotInRange:
+			prefix, err := netip.ParsePrefix(value)
+			if err != nil {
+				return compiledGroupRule{}, fmt.Errorf("invalid network range %q: %w", value, err)
+			}
+			compiled.prefixes = append(compiled.prefixes, prefix.Masked())
+		}
+	}
+	return compiled, nil
+}
+
+func (r compiledGroupRule) matches(peer *nbpeer.Peer) bool {
+	if r.attribute == GroupRuleAttributeConnectionIP {
+		return r.matchesConnectionIP(peer)
+	}
+
+	value := groupRuleAttributeValue(r.attribute, peer)
+	switch r.operator {
+	case GroupRuleOperatorEquals:
+		return slices.ContainsFunc(r.values, func(v string) bool { return strings.EqualFold(v, value) })
+	case GroupRuleOperatorNotEquals:
+		return !slices.ContainsFunc(r.values, func(v string) bool { return strings.EqualFold(v, value) })
+	case GroupRuleOperatorGlob:
+		return slices.ContainsFunc(r.values, func(v string) bool {
+			matched, _ := path.Match(strings.ToLower(v), strings.ToLower(value))
+			return matched
+		})
+	case GroupRuleOperatorRegex:
+		return slices.ContainsFunc(r.patterns, func(p *regexp.Regexp) bool { return p.MatchString(value) })
+	case GroupRuleOperatorMinVersion:
+		return slices.ContainsFunc(r.values, func(v string) bool {
+			meets, err := nbversion.MeetsMinVersion(v, value)
+			return err == nil && meets
+		})
+	case GroupRuleOperatorMaxVersion:
+		return slices.ContainsFunc(r.values, func(v string) bool {
+			meets, err := nbversion.MeetsMinVersion(value, v)
+			return err == nil && meets
+		})
+	default:
+		return false
+	}
+}
+
+func (r compiledGroupRule) matchesConnectionIP(peer *nbpeer.Peer) bool {
+	addr, ok := netip.AddrFromSlice(peer.Location.ConnectionIP)
+	if !ok {
+		return false
+	}
+	addr = addr.Unmap()
+	inRange := slices.ContainsFunc(r.prefixes, func(p netip.Prefix) bool { return p.Contains(addr) })
+	if r.operator == GroupRuleOperatorNotInRange {
+		return !inRange
+	}
+	return inRange
+}
+
+func groupRuleAttributeValue(attribute GroupRuleAttribute, peer *nbpeer.Peer) string {
+	switch attribute {
+	case GroupRuleAttributeOS:
+		return peer.Meta.GoOS
+	case GroupRuleAttributeOSVersion:
+		return peer.Meta.OSVersion
+	case GroupRuleAttributeHostname:
+		return peer.Meta.Hostname
+	case GroupRuleAttributeNetBirdVersion:
+		return peer.Meta.WtVersion
+	case GroupRuleAttributeCountry:
+		return peer.Location.CountryCode
+	case GroupRuleAttributeSetupKey:
+		return peer.SetupKeyID
+	case GroupRuleAttributeUser:
+		return peer.UserID
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestGroupRulesMatcher_Matches(t *testing.T) {
+	peer := &nbpeer.Peer{
+		UserID:     "user-1",
+		SetupKeyID: "key-1",
+		Meta: nbpeer.PeerSystemMeta{
+			Hostname:  "Build-Runner-01",
+			GoOS:      "linux",
+			OSVersion: "22.04",
+			WtVersion: "0.45.1",
+		},
+		Location: nbpeer.Location{
+			ConnectionIP: net.ParseIP("198.51.100.7"),
+			CountryCode:  "DE",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		match   GroupRulesMatch
+		rules   []GroupRule
+		matches bool
+	}{
+		{
+			name:    "os equals is case insensitive",
+			rules:   []GroupRule{{Attribute: GroupRuleAttributeOS, Operator: GroupRuleOperatorEquals, Values: []string{"windows", "Linux"}}},
+			matches: true,
+		},
+		{
+			name:    "os not equals",
+			rules:   []GroupRule{{Attribute: GroupRuleAttributeOS, Operator: GroupRuleOperatorNotEquals, Values: []string{"linux"}}},
+			matches: false,
+		},
+		{
+			name:    "hostname glob",
+			rules:   []GroupRule{{Attribute: GroupRuleAttributeHostname, Operator: GroupRuleOperatorGlob, Values: []string{"build-*"}}},
+			matches: true,
+		},
+		{
+			name:    "hostname regex",
+			rules:   []GroupRule{{Attribute: GroupRuleAttributeHostname, Operator: GroupRuleOperatorRegex, Values: []string{`-\d+$`}}},
+			matches: true,
+		},
+		{
+			name:    "netbird min version",
+			rules:   []GroupRule{{Attribute: GroupRuleAttributeNetBirdVersion, Operator: GroupRuleOperatorMinVersion, Values: []string{"0.46.0"}}},
+			matches: false,
+		},
+		{
+			name:    "os max version",
+			rules:   []GroupRule{{Attribute: GroupRuleAttributeOSVersion, Operator: GroupRuleOperatorMaxVersion, Values: []string{"22.04"}}},
+			matches: true,
+		},
+		{
+			name:    "connection ip in range",
+			rules:   []GroupRule{{Attribute: GroupRuleAttributeConnectionIP, Operator: GroupRuleOperatorInRange, Values: []string{"10.0.0.0/8", "198.51.100.0/24"}}},
+			matches: true,
+		},
+		{
+			name:    "connection ip not in range",
+			rules:   []GroupRule{{Attribute: GroupRuleAttributeConnectionIP, Operator: GroupRuleOperatorNotInRange, Values: []string{"198.51.100.0/24"}}},
+			matches: false,
+		},
+		{
+			name:  "all requires every rule",
+			match: GroupRulesMatchAll,
+			rules: []GroupRule{
+				{Attribute: GroupRuleAttributeCountry, Operator: GroupRuleOperatorEquals, Values: []string{"DE"}},
+				{Attribute: GroupRuleAttributeUser, Operator: GroupRuleOperatorEquals, Values: []string{"user-2"}},
+			},
+			matches: false,
+		},
+		{
+			name:  "any requires one rule",
+			match: GroupRulesMatchAny,
+			rules: []GroupRule{
+				{Attribute: GroupRuleAttributeSetupKey, Operator: GroupRuleOperatorEquals, Values: []string{"key-1"}},
+				{Attribute: GroupRuleAttributeUser, Operator: GroupRuleOperatorEquals, Values: []string{"user-2"}},
+			},
+			matches: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := tt.match
+			if match == "" {
+				match = GroupRulesMatchAll
+			}
+			rules := &GroupRules{Match: match, Rules: tt.rules}
+			require.NoError(t, rules.Validate())
+
+			matcher, err := rules.Matcher()
+			require.NoError(t, err)
+			assert.Equal(t, tt.matches, matcher.Matches(peer))
+		})
+	}
+}
+
+func TestGroupRules_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules *GroupRules
+	}{
+		{name: "invalid match", rules: &GroupRules{Match: "some", Rules: []GroupRule{{Attribute: GroupRuleAttributeOS, Operator: GroupRuleOperatorEquals, Values: []string{"linux"}}}}},
+		{name: "no rules", rules: &GroupRules{Match: GroupRulesMatchAll}},
+		{name: "unknown attribute", rules: &GroupRules{Match: GroupRulesMatchAll, Rules: []GroupRule{{Attribute: "serial", Operator: GroupRuleOperatorEquals, Values: []string{"x"}}}}},
+		{name: "no values", rules: &GroupRules{Match: GroupRulesMatchAll, Rules: []GroupRule{{Attribute: GroupRuleAttributeOS, Operator: GroupRuleOperatorEquals}}}},
+		{name: "invalid glob", rules: &GroupRules{Match: GroupRulesMatchAll, Rules: []GroupRule{{Attribute: GroupRuleAttributeHostname, Operator: GroupRuleOperatorGlob, Values: []string{"build-["}}}}},
+		{name: "invalid version", rules: &GroupRules{Match: GroupRulesMatchAll, Rules: []GroupRule{{Attribute: GroupRuleAttributeNetBirdVersion, Operator: GroupRuleOperatorMinVersion, Values: []string{"latest"}}}}},
+		{name: "invalid range", rules: &GroupRules{Match: GroupRulesMatchAll, Rules: []GroupRule{{Attribute: GroupRuleAttributeConnectionIP, Operator: GroupRuleOperatorInRange, Values: []string{"198.51.100.7"}}}}},
+		{name: "version operator on hostname", rules: &GroupRules{Match: GroupRulesMatchAll, Rules: []GroupRule{{Attribute: GroupRuleAttributeHostname, Operator: GroupRuleOperatorMinVersion, Values: []string{"1.0.0"}}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, tt.rules.Validate())
+		})
+	}
+}
+
+func TestGroupRulesMatcher_AffectedBy(t *testing.T) {
+	rules := &GroupRules{
+		Match: GroupRulesMatchAll,
+		Rules: []GroupRule{{Attribute: GroupRuleAttributeNetBirdVersion, Operator: GroupRuleOperatorMinVersion, Values: []string{"0.40.0"}}},
+	}
+	matcher, err := rules.Matcher()
+	require.NoError(t, err)
+
+	peer := &nbpeer.Peer{}
+	diff := func(oldVersion, newVersion string) *nbpeer.MetaDiff {
+		return &nbpeer.MetaDiff{
+			OldMeta: nbpeer.PeerSystemMeta{WtVersion: oldVersion},
+			NewMeta: nbpeer.PeerSystemMeta{WtVersion: newVersion},
+			Changed: []string{"wt_version"},
+		}
+	}
+
+	assert.True(t, matcher.AffectedBy(diff("0.39.0", "0.40.0"), peer), "upgrade crossing the minimum flips membership")
+	assert.False(t, matcher.AffectedBy(diff("0.41.0", "0.42.0"), peer), "upgrade above the minimum keeps membership")
+	assert.False(t, matcher.AffectedBy(&nbpeer.MetaDiff{}, peer), "no change")
+}
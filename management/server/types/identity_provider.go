@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"net/url"
+	"slices"
 )
 
 // Identity provider validation errors
@@ -15,6 +16,14 @@ var (
 	ErrIdentityProviderIssuerUnreachable = errors.New("identity provider issuer is unreachable")
 	ErrIdentityProviderIssuerMismatch    = errors.New("identity provider issuer does not match the issuer returned by the provider")
 	ErrIdentityProviderClientIDRequired  = errors.New("identity provider client ID is required")
+	ErrIdentityProviderLDAPRequired      = errors.New("ldap identity provider settings are required")
+	ErrIdentityProviderLDAPHostRequired  = errors.New("ldap identity provider host is required")
+	ErrIdentityProviderLDAPUserSearch    = errors.New("ldap identity provider user search requires a base DN and username attributes")
+	ErrIdentityProviderLDAPGroupSearch   = errors.New("ldap identity provider group search requires a base DN, user and group attributes and a name attribute")
+	ErrIdentityProviderSAMLRequired      = errors.New("saml identity provider settings are required")
+	ErrIdentityProviderSAMLSSOURLInvalid = errors.New("saml identity provider SSO URL must be a valid URL")
+	ErrIdentityProviderSAMLCARequired    = errors.New("saml identity provider CA certificate is required unless signature validation is skipped")
+	ErrIdentityProviderSAMLAttributes    = errors.New("saml identity provider username and email attributes are required")
 )
 
 // IdentityProviderType is the type of identity provider
@@ -41,6 +50,10 @@ const (
 	IdentityProviderTypeKeycloak IdentityProviderType = "keycloak"
 	// IdentityProviderTypeADFS is the Microsoft AD FS identity provider
 	IdentityProviderTypeADFS IdentityProviderType = "adfs"
+	// IdentityProviderTypeLDAP is an LDAP or Active Directory server users sign in to with their directory password
+	IdentityProviderTypeLDAP IdentityProviderType = "ldap"
+	// IdentityProviderTypeSAML is a SAML 2.0 identity provider
+	IdentityProviderTypeSAML IdentityProviderType = "saml"
 )
 
 // IdentityProvider represents an identity provider configuration
@@ -59,6 +72,62 @@ type IdentityProvider struct {
 	ClientID string
 	// ClientSecret is the OAuth2 client secret
 	ClientSecret string
+	// LDAP holds the directory settings of ldap identity providers
+	LDAP *IdentityProviderLDAPConfig `gorm:"serializer:json"`
+	// SAML holds the settings of saml identity providers
+	SAML *IdentityProviderSAMLConfig `gorm:"serializer:json"`
+}
+
+// IdentityProviderLDAPConfig holds the settings of an LDAP or Active Directory identity provider
+type IdentityProviderLDAPConfig struct {
+	// Host is the host and optional port of the LDAP server
+	Host               string
+	StartTLS           bool
+	InsecureNoSSL      bool
+	InsecureSkipVerify bool
+	// RootCA is the PEM encoded CA bundle used to verify the server certificate
+	RootCA string
+	// BindDN and BindPassword are the credentials of the service account used to search the directory
+	BindDN       string
+	BindPassword string
+	UserSearch   IdentityProviderLDAPUserSearch
+	// GroupSearch is optional, the groups it finds are issued in the groups claim of the tokens
+	GroupSearch *IdentityProviderLDAPGroupSearch
+}
+
+// IdentityProviderLDAPUserSearch configures how users are looked up when they sign in
+type IdentityProviderLDAPUserSearch struct {
+	BaseDN             string
+	Filter             string
+	UsernameAttributes []string
+	IDAttribute        string
+	EmailAttribute     string
+	NameAttribute      string
+}
+
+// IdentityProviderLDAPGroupSearch configures how the groups of a user are looked up
+type IdentityProviderLDAPGroupSearch struct {
+	BaseDN string
+	Filter string
+	// UserAttribute of the user entry is matched against the GroupAttribute of group entries
+	UserAttribute  string
+	GroupAttribute string
+	NameAttribute  string
+}
+
+// IdentityProviderSAMLConfig holds the settings of a SAML 2.0 identity provider
+type IdentityProviderSAMLConfig struct {
+	SSOURL string
+	// CA is the PEM encoded certificate used to verify the assertion signatures
+	CA                              string
+	InsecureSkipSignatureValidation bool
+	EntityIssuer                    string
+	SSOIssuer                       string
+	UsernameAttribute               string
+	EmailAttribute                  string
+	GroupsAttribute                 string
+	GroupsDelimiter                 string
+	NameIDPolicyFormat              string
 }
 
 // Copy returns a copy of the IdentityProvider
@@ -71,16 +140,48 @@ func (idp *IdentityProvider) Copy() *IdentityProvider {
 		Issuer:       idp.Issuer,
 		ClientID:     idp.ClientID,
 		ClientSecret: idp.ClientSecret,
+		LDAP:         idp.LDAP.Copy(),
+		SAML:         idp.SAML.Copy(),
 	}
 }
 
+// Copy returns a copy of the LDAP settings
+func (c *IdentityProviderLDAPConfig) Copy() *IdentityProviderLDAPConfig {
+	if c == nil {
+		return nil
+	}
+	cp := *c
+	cp.UserSearch.UsernameAttributes = slices.Clone(c.UserSearch.UsernameAttributes)
+	if c.GroupSearch != nil {
+		groupSearch := *c.GroupSearch
+		cp.GroupSearch = &groupSearch
+	}
+	return &cp
+}
+
+// Copy returns a copy of the SAML settings
+func (c *IdentityProviderSAMLConfig) Copy() *IdentityProviderSAMLConfig {
+	if c == nil {
+		return nil
+	}
+	cp := *c
+	return &cp
+}
+
 // EventMeta returns a map of metadata for activity events
 func (idp *IdentityProvider) EventMeta() map[string]any {
-	return map[string]any{
+	meta := map[string]any{
 		"name":   idp.Name,
 		"type":   string(idp.Type),
 		"issuer": idp.Issuer,
 	}
+	if idp.LDAP != nil {
+		meta["host"] = idp.LDAP.Host
+	}
+	if idp.SAML != nil {
+		meta["sso_url"] = idp.SAML.SSOURL
+	}
+	return meta
 }
 
 // Validate validates the identity provider configuration
@@ -94,6 +195,12 @@ func (idp *IdentityProvider) Validate() error {
 	if !idp.Type.IsValid() {
 		return ErrIdentityProviderTypeUnsupported
 	}
+	switch idp.Type {
+	case IdentityProviderTypeLDAP:
+		return idp.LDAP.validate()
+	case IdentityProviderTypeSAML:
+		return idp.SAML.validate()
+	}
 	if !idp.Type.HasBuiltInIssuer() && idp.Issuer == "" {
 		return ErrIdentityProviderIssuerRequired
 	}
@@ -115,7 +222,7 @@ func (t IdentityProviderType) IsValid() bool {
 	case IdentityProviderTypeOIDC, IdentityProviderTypeZitadel, IdentityProviderTypeEntra,
 		IdentityProviderTypeGoogle, IdentityProviderTypeOkta, IdentityProviderTypePocketID,
 		IdentityProviderTypeMicrosoft, IdentityProviderTypeAuthentik, IdentityProviderTypeKeycloak,
-		IdentityProviderTypeADFS:
+		IdentityProviderTypeADFS, IdentityProviderTypeLDAP, IdentityProviderTypeSAML:
 		return true
 	}
 	return false
@@ -125,3 +232,38 @@ func (t IdentityProviderType) IsValid() bool {
 func (t IdentityProviderType) HasBuiltInIssuer() bool {
 	return t == IdentityProviderTypeGoogle || t == IdentityProviderTypeMicrosoft
 }
+
+func (c *IdentityProviderLDAPConfig) validate() error {
+	if c == nil {
+		return ErrIdentityProviderLDAPRequired
+	}
+	if c.Host == "" {
+		return ErrIdentityProviderLDAPHostRequired
+	}
+	if c.UserSearch.BaseDN == "" || len(c.UserSearch.UsernameAttributes) == 0 {
+		return ErrIdentityProviderLDAPUserSearch
+	}
+	if gs := c.GroupSearch; gs != nil {
+		if gs.BaseDN == "" || gs.UserAttribute == "" || gs.GroupAttribute == "" || gs.NameAttribute == "" {
+			return ErrIdentityProviderLDAPGroupSearch
+		}
+	}
+	return nil
+}
+
+func (c *IdentityProviderSAMLConfig) validate() error {
+	if c == nil {
+		return ErrIdentityProviderSAMLRequired
+	}
+	parsedURL, err := url.Parse(c.SSOURL)
+	if err != nil || parsedURL.Scheme == "" || parsedURL.Host == "" {
+		return ErrIdentityProviderSAMLSSOURLInvalid
+	}
+	if c.CA == "" && !c.InsecureSkipSignatureValidation {
+		return ErrIdentityProviderSAMLCARequired
+	}
+	if c.UsernameAttribute == "" || c.EmailAttribute == "" {
+		return ErrIdentityProviderSAMLAttributes
+	}
+	return nil
+}
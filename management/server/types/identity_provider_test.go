@@ -126,6 +126,109 @@ func TestIdentityProvider_Validate(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "valid LDAP provider with group search",
+			idp: &IdentityProvider{
+				Name: "Active Directory",
+				Type: IdentityProviderTypeLDAP,
+				LDAP: &IdentityProviderLDAPConfig{
+					Host:       "ldap.example.com:636",
+					UserSearch: IdentityProviderLDAPUserSearch{BaseDN: "ou=users,dc=example,dc=com", UsernameAttributes: []string{"sAMAccountName"}},
+					GroupSearch: &IdentityProviderLDAPGroupSearch{
+						BaseDN:         "ou=groups,dc=example,dc=com",
+						UserAttribute:  "DN",
+						GroupAttribute: "member",
+						NameAttribute:  "cn",
+					},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "LDAP provider without settings",
+			idp: &IdentityProvider{
+				Name: "Active Directory",
+				Type: IdentityProviderTypeLDAP,
+			},
+			expectedErr: ErrIdentityProviderLDAPRequired,
+		},
+		{
+			name: "LDAP provider without host",
+			idp: &IdentityProvider{
+				Name: "Active Directory",
+				Type: IdentityProviderTypeLDAP,
+				LDAP: &IdentityProviderLDAPConfig{
+					UserSearch: IdentityProviderLDAPUserSearch{BaseDN: "dc=example,dc=com", UsernameAttributes: []string{"uid"}},
+				},
+			},
+			expectedErr: ErrIdentityProviderLDAPHostRequired,
+		},
+		{
+			name: "LDAP provider without username attributes",
+			idp: &IdentityProvider{
+				Name: "Active Directory",
+				Type: IdentityProviderTypeLDAP,
+				LDAP: &IdentityProviderLDAPConfig{
+					Host:       "ldap.example.com:636",
+					UserSearch: IdentityProviderLDAPUserSearch{BaseDN: "dc=example,dc=com"},
+				},
+			},
+			expectedErr: ErrIdentityProviderLDAPUserSearch,
+		},
+		{
+			name: "LDAP provider with incomplete group search",
+			idp: &IdentityProvider{
+				Name: "Active Directory",
+				Type: IdentityProviderTypeLDAP,
+				LDAP: &IdentityProviderLDAPConfig{
+					Host:        "ldap.example.com:636",
+					UserSearch:  IdentityProviderLDAPUserSearch{BaseDN: "dc=example,dc=com", UsernameAttributes: []string{"uid"}},
+					GroupSearch: &IdentityProviderLDAPGroupSearch{BaseDN: "ou=groups,dc=example,dc=com"},
+				},
+			},
+			expectedErr: ErrIdentityProviderLDAPGroupSearch,
+		},
+		{
+			name: "valid SAML provider",
+			idp: &IdentityProvider{
+				Name: "SAML",
+				Type: IdentityProviderTypeSAML,
+				SAML: &IdentityProviderSAMLConfig{
+					SSOURL:            "https://idp.example.com/sso",
+					CA:                "-----BEGIN CERTIFICATE-----",
+					UsernameAttribute: "name",
+					EmailAttribute:    "email",
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "SAML provider with invalid SSO URL",
+			idp: &IdentityProvider{
+				Name: "SAML",
+				Type: IdentityProviderTypeSAML,
+				SAML: &IdentityProviderSAMLConfig{
+					SSOURL:            "idp.example.com/sso",
+					CA:                "-----BEGIN CERTIFICATE-----",
+					UsernameAttribute: "name",
+					EmailAttribute:    "email",
+				},
+			},
+			expectedErr: ErrIdentityProviderSAMLSSOURLInvalid,
+		},
+		{
+			name: "SAML provider without CA",
+			idp: &IdentityProvider{
+				Name: "SAML",
+				Type: IdentityProviderTypeSAML,
+				SAML: &IdentityProviderSAMLConfig{
+					SSOURL:            "https://idp.example.com/sso",
+					UsernameAttribute: "name",
+					EmailAttribute:    "email",
+				},
+			},
+			expectedErr: ErrIdentityProviderSAMLCARequired,
+		},
 	}
 
 	for _, tt := range tests {
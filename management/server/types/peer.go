@@ -37,4 +37,9 @@ type PeerLogin struct {
 
 	// ExtraDNSLabels is a list of extra DNS labels that the peer wants to use
 	ExtraDNSLabels []string
+
+	// WorkloadIdentityProviderID references to a workload identity provider to log in. Can be empty when another auth method is used.
+	WorkloadIdentityProviderID string
+	// WorkloadIdentityToken is the OIDC token of the workload, verified against the workload identity provider
+	WorkloadIdentityToken string
 }
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+// PeerApprovalRuleAttribute is the peer attribute an auto-approval rule is evaluated against
+type PeerApprovalRuleAttribute string
+
+const (
+	// PeerApprovalRuleAttributeSetupKey matches the ID of the setup key the peer was registered with
+	PeerApprovalRuleAttributeSetupKey PeerApprovalRuleAttribute = "setup_key"
+	// PeerApprovalRuleAttributeUser matches the ID of the user that registered the peer
+	PeerApprovalRuleAttributeUser PeerApprovalRuleAttribute = "user"
+	// PeerApprovalRuleAttributeOS matches the peer's operating system family (linux, windows, darwin, android, ios)
+	PeerApprovalRuleAttributeOS PeerApprovalRuleAttribute = "os"
+	// PeerApprovalRuleAttributeSerialNumber matches the system serial number reported by the peer
+	PeerApprovalRuleAttributeSerialNumber PeerApprovalRuleAttribute = "serial_number"
+)
+
+// PeerApprovalRule approves a new peer automatically when its attribute equals one of the values
+type PeerApprovalRule struct {
+	Attribute PeerApprovalRuleAttribute `json:"attribute"`
+	Values    []string                  `json:"values"`
+}
+
+// Validate checks that the rule is well-formed
+func (r PeerApprovalRule) Validate() error {
+	switch r.Attribute {
+	case PeerApprovalRuleAttributeSetupKey, PeerApprovalRuleAttributeUser, PeerApprovalRuleAttributeOS, PeerApprovalRuleAttributeSerialNumber:
+	default:
+		return fmt.Errorf("unsupported attribute %q", r.Attribute)
+	}
+	if len(r.Values) == 0 {
+		return fmt.Errorf("attribute %q requires at least one value", r.Attribute)
+	}
+	if slices.Contains(r.Values, "") {
+		return fmt.Errorf("attribute %q contains an empty value", r.Attribute)
+	}
+	return nil
+}
+
+// Matches reports whether the peer attribute equals one of the rule values.
+// The operating system is compared case-insensitively.
+func (r PeerApprovalRule) Matches(peer *nbpeer.Peer) bool {
+	switch r.Attribute {
+	case PeerApprovalRuleAttributeSetupKey:
+		return peer.SetupKeyID != "" && slices.Contains(r.Values, peer.SetupKeyID)
+	case PeerApprovalRuleAttributeUser:
+		return peer.UserID != "" && slices.Contains(r.Values, peer.UserID)
+	case PeerApprovalRuleAttributeOS:
+		return slices.ContainsFunc(r.Values, func(v string) bool {
+			return strings.EqualFold(v, peer.Meta.GoOS)
+		})
+	case PeerApprovalRuleAttributeSerialNumber:
+		return peer.Meta.SystemSerialNumber != "" && slices.Contains(r.Values, peer.Meta.SystemSerialNumber)
+	default:
+		return false
+	}
+}
+
+func copyPeerApprovalRules(rules []PeerApprovalRule) []PeerApprovalRule {
+	if rules == nil {
+		return nil
+	}
+	c := make([]PeerApprovalRule, 0, len(rules))
+	for _, rule := range rules {
+		c = append(c, PeerApprovalRule{Attribute: rule.Attribute, Values: slices.Clone(rule.Values)})
+	}
+	return c
+}
+
+// ValidatePeerApprovalRules checks every auto-approval rule
+func ValidatePeerApprovalRules(rules []PeerApprovalRule) error {
+	for i, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("peer approval rule %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// PeerApprovalRulesToAPIResponse converts auto-approval rules to their API representation
+func PeerApprovalRulesToAPIResponse(rules []PeerApprovalRule) []api.PeerApprovalRule {
+	resp := make([]api.PeerApprovalRule, 0, len(rules))
+	for _, rule := range rules {
+		resp = append(resp, api.PeerApprovalRule{
+			Attribute: api.PeerApprovalRuleAttribute(rule.Attribute),
+			Values:    slices.Clone(rule.Values),
+		})
+	}
+	return resp
+}
+
+// PeerApprovalRulesFromAPIRequest converts auto-approval rules from their API representation
+func PeerApprovalRulesFromAPIRequest(req *[]api.PeerApprovalRule) []PeerApprovalRule {
+	if req == nil {
+		return nil
+	}
+	rules := make([]PeerApprovalRule, 0, len(*req))
+	for _, rule := range *req {
+		rules = append(rules, PeerApprovalRule{
+			Attribute: PeerApprovalRuleAttribute(rule.Attribute),
+			Values:    slices.Clone(rule.Values),
+		})
+	}
+	return rules
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestPeerApprovalRule_Matches(t *testing.T) {
+	peer := &nbpeer.Peer{
+		SetupKeyID: "key-1",
+		UserID:     "user-1",
+		Meta:       nbpeer.PeerSystemMeta{GoOS: "windows", SystemSerialNumber: "SN-1"},
+	}
+
+	tests := []struct {
+		name    string
+		rule    PeerApprovalRule
+		matches bool
+	}{
+		{name: "setup key", rule: PeerApprovalRule{Attribute: PeerApprovalRuleAttributeSetupKey, Values: []string{"key-0", "key-1"}}, matches: true},
+		{name: "other setup key", rule: PeerApprovalRule{Attribute: PeerApprovalRuleAttributeSetupKey, Values: []string{"key-2"}}, matches: false},
+		{name: "user", rule: PeerApprovalRule{Attribute: PeerApprovalRuleAttributeUser, Values: []string{"user-1"}}, matches: true},
+		{name: "os is case insensitive", rule: PeerApprovalRule{Attribute: PeerApprovalRuleAttributeOS, Values: []string{"Windows"}}, matches: true},
+		{name: "serial number", rule: PeerApprovalRule{Attribute: PeerApprovalRuleAttributeSerialNumber, Values: []string{"SN-1"}}, matches: true},
+		{name: "serial number is case sensitive", rule: PeerApprovalRule{Attribute: PeerApprovalRuleAttributeSerialNumber, Values: []string{"sn-1"}}, matches: false},
+		{name: "unknown attribute", rule: PeerApprovalRule{Attribute: "hostname", Values: []string{"host"}}, matches: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.matches, tt.rule.Matches(peer))
+		})
+	}
+
+	assert.False(t, PeerApprovalRule{Attribute: PeerApprovalRuleAttributeSetupKey, Values: []string{"key-1"}}.Matches(&nbpeer.Peer{}),
+		"peers without the attribute never match")
+}
+
+func TestValidatePeerApprovalRules(t *testing.T) {
+	assert.NoError(t, ValidatePeerApprovalRules(nil))
+	assert.NoError(t, ValidatePeerApprovalRules([]PeerApprovalRule{{Attribute: PeerApprovalRuleAttributeOS, Values: []string{"linux"}}}))
+	assert.Error(t, ValidatePeerApprovalRules([]PeerApprovalRule{{Attribute: "hostname", Values: []string{"a"}}}))
+	assert.Error(t, ValidatePeerApprovalRules([]PeerApprovalRule{{Attribute: PeerApprovalRuleAttributeOS}}))
+	assert.Error(t, ValidatePeerApprovalRules([]PeerApprovalRule{{Attribute: PeerApprovalRuleAttributeOS, Values: []string{""}}}))
+}
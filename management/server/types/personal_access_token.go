@@ -1,10 +1,12 @@
 package types
 
 import (
+	"context"
 	"crypto/sha256"
 	b64 "encoding/base64"
 	"fmt"
 	"hash/crc32"
+	"slices"
 	"time"
 
 	b "github.com/hashicorp/go-secure-stdlib/base62"
@@ -12,6 +14,8 @@ import (
 	"github.com/rs/xid"
 
 	"github.com/netbirdio/netbird/base62"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
 )
 
 const (
@@ -33,7 +37,9 @@ type PersonalAccessToken struct {
 	Name           string
 	HashedToken    string
 	ExpirationDate *time.Time
-	// scope could be added in future
+	// Scopes limit the token to the granted operations on top of the user role. A token without scopes carries
+	// the full role of its user.
+	Scopes    []PATScope `gorm:"serializer:json"`
 	CreatedBy string
 	CreatedAt time.Time
 	LastUsed  *time.Time
@@ -45,6 +51,7 @@ func (t *PersonalAccessToken) Copy() *PersonalAccessToken {
 		Name:           t.Name,
 		HashedToken:    t.HashedToken,
 		ExpirationDate: t.ExpirationDate,
+		Scopes:         copyPATScopes(t.Scopes),
 		CreatedBy:      t.CreatedBy,
 		CreatedAt:      t.CreatedAt,
 		LastUsed:       t.LastUsed,
@@ -67,6 +74,75 @@ func (t *PersonalAccessToken) GetLastUsed() time.Time {
 	return time.Time{}
 }
 
+// ScopeAllows returns true if the token scopes grant the operation on the module. Submodules are covered by a
+// grant on their parent module. A resource-restricted grant only applies to checks of one of its resources.
+func (t *PersonalAccessToken) ScopeAllows(module modules.Module, operation operations.Operation, resourceID string) bool {
+	if len(t.Scopes) == 0 {
+		return true
+	}
+
+	parent, hasParent := module.Parent()
+	for _, scope := range t.Scopes {
+		if scope.Module != module && (!hasParent || scope.Module != parent) {
+			continue
+		}
+		if !slices.Contains(scope.Operations, operation) {
+			continue
+		}
+		if len(scope.ResourceIDs) > 0 && !slices.Contains(scope.ResourceIDs, resourceID) {
+			continue
+		}
+		return true
+	}
+
+	return false
+}
+
+// ScopesCover returns true if the token scopes grant everything the given scopes grant. An unscoped token covers
+// any scopes, while no scoped token covers the unrestricted access of empty scopes.
+func (t *PersonalAccessToken) ScopesCover(scopes []PATScope) bool {
+	if len(t.Scopes) == 0 {
+		return true
+	}
+	if len(scopes) == 0 {
+		return false
+	}
+
+	for _, scope := range scopes {
+		for _, operation := range scope.Operations {
+			if len(scope.ResourceIDs) == 0 && !t.ScopeAllows(scope.Module, operation, "") {
+				return false
+			}
+			for _, resourceID := range scope.ResourceIDs {
+				if !t.ScopeAllows(scope.Module, operation, resourceID) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+type authenticatedPATKey struct{}
+
+// WithAuthenticatedPAT returns a context carrying the token a request was authenticated with, so its scopes are
+// resolved once per request instead of on every permission check.
+func WithAuthenticatedPAT(ctx context.Context, pat *PersonalAccessToken) context.Context {
+	authenticated := pat.Copy()
+	authenticated.HashedToken = ""
+	return context.WithValue(ctx, authenticatedPATKey{}, authenticated)
+}
+
+// AuthenticatedPATFromContext returns the token a request was authenticated with if it is the given one.
+func AuthenticatedPATFromContext(ctx context.Context, patID string) (*PersonalAccessToken, bool) {
+	pat, ok := ctx.Value(authenticatedPATKey{}).(*PersonalAccessToken)
+	if !ok || pat.ID != patID {
+		return nil, false
+	}
+	return pat, true
+}
+
 // PersonalAccessTokenGenerated holds the new PersonalAccessToken and the plain text version of it
 type PersonalAccessTokenGenerated struct {
 	PlainToken string
@@ -75,7 +151,7 @@ type PersonalAccessTokenGenerated struct {
 
 // CreateNewPAT will generate a new PersonalAccessToken that can be assigned to a User.
 // Additionally, it will return the token in plain text once, to give to the user and only save a hashed version
-func CreateNewPAT(name string, expirationInDays int, targetID, createdBy string) (*PersonalAccessTokenGenerated, error) {
+func CreateNewPAT(name string, expirationInDays int, targetID, createdBy string, scopes []PATScope) (*PersonalAccessTokenGenerated, error) {
 	hashedToken, plainToken, err := generateNewToken()
 	if err != nil {
 		return nil, err
@@ -88,6 +164,7 @@ func CreateNewPAT(name string, expirationInDays int, targetID, createdBy string)
 			Name:           name,
 			HashedToken:    hashedToken,
 			ExpirationDate: util.ToPtr(currentTime.AddDate(0, 0, expirationInDays)),
+			Scopes:         copyPATScopes(scopes),
 			CreatedBy:      createdBy,
 			CreatedAt:      currentTime,
 		},
@@ -96,6 +173,66 @@ func CreateNewPAT(name string, expirationInDays int, targetID, createdBy string)
 
 }
 
+// PATScope grants a personal access token a set of operations on a permission module
+type PATScope struct {
+	Module     modules.Module         `json:"module"`
+	Operations []operations.Operation `json:"operations"`
+	// ResourceIDs restricts the grant to the listed resources. Only the modules in PATResourceScopedModules
+	// support resource restrictions.
+	ResourceIDs []string `json:"resource_ids,omitempty"`
+}
+
+// PATResourceScopedModules are the modules whose permission checks carry the ID of the accessed resource
+var PATResourceScopedModules = map[modules.Module]struct{}{
+	modules.Peers:     {},
+	modules.Policies:  {},
+	modules.SetupKeys: {},
+}
+
+var validPATScopeOperations = []operations.Operation{operations.Create, operations.Read, operations.Update, operations.Delete}
+
+// ValidatePATScopes checks that the scopes reference known modules and operations
+func ValidatePATScopes(scopes []PATScope) error {
+	for _, scope := range scopes {
+		if _, ok := modules.All[scope.Module]; !ok {
+			return fmt.Errorf("unknown module %q", scope.Module)
+		}
+		if len(scope.Operations) == 0 {
+			return fmt.Errorf("scope for module %q has no operations", scope.Module)
+		}
+		for _, operation := range scope.Operations {
+			if !slices.Contains(validPATScopeOperations, operation) {
+				return fmt.Errorf("unknown operation %q for module %q", operation, scope.Module)
+			}
+		}
+		if len(scope.ResourceIDs) == 0 {
+			continue
+		}
+		if _, ok := PATResourceScopedModules[scope.Module]; !ok {
+			return fmt.Errorf("module %q does not support resource restrictions", scope.Module)
+		}
+		if slices.Contains(scope.ResourceIDs, "") {
+			return fmt.Errorf("scope for module %q has an empty resource ID", scope.Module)
+		}
+	}
+	return nil
+}
+
+func copyPATScopes(scopes []PATScope) []PATScope {
+	if scopes == nil {
+		return nil
+	}
+	copied := make([]PATScope, len(scopes))
+	for i, scope := range scopes {
+		copied[i] = PATScope{
+			Module:      scope.Module,
+			Operations:  slices.Clone(scope.Operations),
+			ResourceIDs: slices.Clone(scope.ResourceIDs),
+		}
+	}
+	return copied
+}
+
 func generateNewToken() (string, string, error) {
 	secret, err := b.Random(PATSecretLength)
 	if err != nil {
@@ -11,6 +11,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/netbirdio/netbird/base62"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
 )
 
 func TestPAT_GenerateToken_Hashing(t *testing.T) {
@@ -44,3 +46,55 @@ func TestPAT_GenerateToken_Checksum(t *testing.T) {
 	}
 	assert.Equal(t, expectedChecksum, actualChecksum)
 }
+
+func TestPAT_ScopeAllows(t *testing.T) {
+	unscoped := &PersonalAccessToken{}
+	assert.True(t, unscoped.ScopeAllows(modules.Users, operations.Delete, ""), "unscoped tokens carry the full role")
+
+	pat := &PersonalAccessToken{Scopes: []PATScope{
+		{Module: modules.Peers, Operations: []operations.Operation{operations.Read}},
+		{Module: modules.Policies, Operations: []operations.Operation{operations.Update}, ResourceIDs: []string{"policy-1"}},
+		{Module: modules.AgentNetwork, Operations: []operations.Operation{operations.Read}},
+	}}
+
+	assert.True(t, pat.ScopeAllows(modules.Peers, operations.Read, ""))
+	assert.True(t, pat.ScopeAllows(modules.Peers, operations.Read, "peer-1"))
+	assert.False(t, pat.ScopeAllows(modules.Peers, operations.Update, "peer-1"))
+	assert.False(t, pat.ScopeAllows(modules.Groups, operations.Read, ""))
+	assert.True(t, pat.ScopeAllows(modules.Policies, operations.Update, "policy-1"))
+	assert.False(t, pat.ScopeAllows(modules.Policies, operations.Update, "policy-2"))
+	assert.False(t, pat.ScopeAllows(modules.Policies, operations.Update, ""), "resource grants do not cover collection checks")
+	assert.True(t, pat.ScopeAllows(modules.AgentNetworkLogs, operations.Read, ""), "parent grants cover submodules")
+}
+
+func TestPAT_ScopesCover(t *testing.T) {
+	unscoped := &PersonalAccessToken{}
+	assert.True(t, unscoped.ScopesCover(nil), "unscoped tokens cover any scopes")
+
+	pat := &PersonalAccessToken{Scopes: []PATScope{
+		{Module: modules.Peers, Operations: []operations.Operation{operations.Read, operations.Update}},
+		{Module: modules.Policies, Operations: []operations.Operation{operations.Read}, ResourceIDs: []string{"policy-1"}},
+	}}
+
+	assert.False(t, pat.ScopesCover(nil), "scoped tokens don't cover unrestricted access")
+	assert.True(t, pat.ScopesCover([]PATScope{{Module: modules.Peers, Operations: []operations.Operation{operations.Read}}}))
+	assert.True(t, pat.ScopesCover([]PATScope{{Module: modules.Peers, Operations: []operations.Operation{operations.Update}, ResourceIDs: []string{"peer-1"}}}))
+	assert.True(t, pat.ScopesCover([]PATScope{{Module: modules.Policies, Operations: []operations.Operation{operations.Read}, ResourceIDs: []string{"policy-1"}}}))
+	assert.False(t, pat.ScopesCover([]PATScope{{Module: modules.Peers, Operations: []operations.Operation{operations.Delete}}}))
+	assert.False(t, pat.ScopesCover([]PATScope{{Module: modules.Policies, Operations: []operations.Operation{operations.Read}}}), "resource grants don't cover the whole module")
+	assert.False(t, pat.ScopesCover([]PATScope{{Module: modules.Policies, Operations: []operations.Operation{operations.Read}, ResourceIDs: []string{"policy-1", "policy-2"}}}))
+	assert.False(t, pat.ScopesCover([]PATScope{{Module: modules.Users, Operations: []operations.Operation{operations.Read}}}))
+}
+
+func TestValidatePATScopes(t *testing.T) {
+	assert.NoError(t, ValidatePATScopes(nil))
+	assert.NoError(t, ValidatePATScopes([]PATScope{
+		{Module: modules.SetupKeys, Operations: []operations.Operation{operations.Read}, ResourceIDs: []string{"key-1"}},
+	}))
+	assert.ErrorContains(t, ValidatePATScopes([]PATScope{{Module: "billing", Operations: []operations.Operation{operations.Read}}}), "unknown module")
+	assert.ErrorContains(t, ValidatePATScopes([]PATScope{{Module: modules.Peers}}), "no operations")
+	assert.ErrorContains(t, ValidatePATScopes([]PATScope{{Module: modules.Peers, Operations: []operations.Operation{"admin"}}}), "unknown operation")
+	assert.ErrorContains(t, ValidatePATScopes([]PATScope{
+		{Module: modules.Groups, Operations: []operations.Operation{operations.Read}, ResourceIDs: []string{"group-1"}},
+	}), "does not support resource restrictions")
+}
@@ -0,0 +1,564 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"maps"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
+	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/route"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// AccessSimulationDestinationType is the kind of destination an access simulation was evaluated against
+type AccessSimulationDestinationType string
+
+const (
+	AccessSimulationDestinationPeer     AccessSimulationDestinationType = "peer"
+	AccessSimulationDestinationResource AccessSimulationDestinationType = "resource"
+	AccessSimulationDestinationRoute    AccessSimulationDestinationType = "route"
+)
+
+// AccessSimulationRequest describes the connection to evaluate. The source is either a peer or all peers of a
+// user, the destination is either a peer, a network resource or an address that is resolved to one of them or to
+// a network route.
+type AccessSimulationRequest struct {
+	SourcePeerID          string
+	SourceUserID          string
+	DestinationPeerID     string
+	DestinationResourceID string
+	DestinationAddress    netip.Addr
+	// Protocol of the connection, empty matches any protocol
+	Protocol PolicyRuleProtocolType
+	// Port of the connection, 0 matches any port
+	Port uint16
+}
+
+// AccessSimulationRule is a policy rule that applies to the simulated connection
+type AccessSimulationRule struct {
+	PolicyID   string
+	PolicyName string
+	RuleID     string
+	RuleName   string
+	Action     PolicyTrafficActionType
+}
+
+// AccessSimulationResult is the outcome of an access simulation for one source peer
+type AccessSimulationResult struct {
+	SourcePeerID    string
+	DestinationType AccessSimulationDestinationType
+	DestinationID   string
+	Allowed         bool
+	MatchedRules    []AccessSimulationRule
+	FailedChecks    []string
+
+	// blocked is set when the network maps cut the source off the destination regardless of the rules
+	blocked bool
+	// unrestricted is set when the destination accepts traffic without a matching rule
+	unrestricted bool
+	// unexplained is reported as a failed check when the connection is blocked and no other check explains it
+	unexplained string
+}
+
+// block records a failed check that denies the connection regardless of the matched rules
+func (r *AccessSimulationResult) block(check string) {
+	r.FailedChecks = append(r.FailedChecks, check)
+	r.blocked = true
+}
+
+// SimulateAccess evaluates whether the requested connection would be allowed by the network maps generated for the
+// peers involved. The account is expected to have access grants, policy schedules and label selectors applied,
+// validatedPeersMap holds the approved peers.
+func (a *Account) SimulateAccess(ctx context.Context, req *AccessSimulationRequest, validatedPeersMap map[string]struct{}) ([]*AccessSimulationResult, error) {
+	if (req.SourcePeerID == "") == (req.SourceUserID == "") {
+		return nil, status.Errorf(status.InvalidArgument, "either a source peer or a source user is required")
+	}
+
+	var sources []*nbpeer.Peer
+	if req.SourcePeerID != "" {
+		peer := a.GetPeer(req.SourcePeerID)
+		if peer == nil {
+			return nil, status.NewPeerNotFoundError(req.SourcePeerID)
+		}
+		sources = append(sources, peer)
+	} else {
+		if _, err := a.FindUser(req.SourceUserID); err != nil {
+			return nil, err
+		}
+		sources, _ = a.FindUserPeers(req.SourceUserID)
+		slices.SortFunc(sources, func(p1, p2 *nbpeer.Peer) int {
+			return strings.Compare(p1.ID, p2.ID)
+		})
+	}
+
+	evaluate, err := a.accessSimulationEvaluator(req)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation := a.newAccessSimulation(ctx, validatedPeersMap)
+	var withoutPostureChecks *accessSimulation
+
+	results := make([]*AccessSimulationResult, 0, len(sources))
+	for _, source := range sources {
+		result := &AccessSimulationResult{SourcePeerID: source.ID}
+		evaluate(simulation, source, result)
+		result.Allowed = !result.blocked && (result.unrestricted || accessAllowedByRules(result.MatchedRules))
+		if !result.Allowed {
+			if withoutPostureChecks == nil {
+				withoutPostureChecks = simulation.withoutPostureChecks()
+			}
+			simulation.explainDenied(withoutPostureChecks, evaluate, source, result)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// accessAllowedByRules reports whether the matched rules allow the traffic, drop rules take precedence
+func accessAllowedByRules(rules []AccessSimulationRule) bool {
+	allowed := false
+	for _, rule := range rules {
+		if rule.Action == PolicyTrafficActionDrop {
+			return false
+		}
+		if rule.Action == PolicyTrafficActionAccept {
+			allowed = true
+		}
+	}
+	return allowed
+}
+
+// accessEvaluator evaluates the connection of a source peer against the network maps of a simulation
+type accessEvaluator func(*accessSimulation, *nbpeer.Peer, *AccessSimulationResult)
+
+// accessSimulation holds the network maps generated for the peers of a simulated account, so the simulation answers
+// with the peers and firewall rules the peers receive
+type accessSimulation struct {
+	ctx               context.Context
+	account           *Account
+	validatedPeersMap map[string]struct{}
+	resourcePolicies  map[string][]*Policy
+	routers           map[string]map[string]*routerTypes.NetworkRouter
+	rules             map[string]AccessSimulationRule
+	networkMaps       map[string]*NetworkMap
+}
+
+func (a *Account) newAccessSimulation(ctx context.Context, validatedPeersMap map[string]struct{}) *accessSimulation {
+	rules := make(map[string]AccessSimulationRule)
+	for _, policy := range a.Policies {
+		for _, rule := range policy.Rules {
+			rules[rule.ID] = newAccessSimulationRule(policy, rule)
+		}
+	}
+
+	return &accessSimulation{
+		ctx:               ctx,
+		account:           a,
+		validatedPeersMap: validatedPeersMap,
+		resourcePolicies:  a.GetResourcePoliciesMap(),
+		routers:           a.GetResourceRoutersMap(),
+		rules:             rules,
+		networkMaps:       make(map[string]*NetworkMap),
+	}
+}
+
+// networkMap returns the network map generated for the peer
+func (s *accessSimulation) networkMap(peerID string) *NetworkMap {
+	if nm, ok := s.networkMaps[peerID]; ok {
+		return nm
+	}
+	nm := s.account.GetPeerNetworkMapFromComponents(s.ctx, peerID, nbdns.CustomZone{}, nil, s.validatedPeersMap, s.resourcePolicies, s.routers, nil, nil)
+	s.networkMaps[peerID] = nm
+	return nm
+}
+
+// withoutPostureChecks returns a simulation of the account with the policy source posture checks removed
+func (s *accessSimulation) withoutPostureChecks() *accessSimulation {
+	account := *s.account
+	account.Policies = make([]*Policy, 0, len(s.account.Policies))
+	for _, policy := range s.account.Policies {
+		policy = policy.Copy()
+		policy.SourcePostureChecks = nil
+		account.Policies = append(account.Policies, policy)
+	}
+	return account.newAccessSimulation(s.ctx, s.validatedPeersMap)
+}
+
+// matchRule records the rule a generated firewall rule is derived from
+func (s *accessSimulation) matchRule(result *AccessSimulationResult, ruleID string) {
+	rule, ok := s.rules[ruleID]
+	if !ok || slices.ContainsFunc(result.MatchedRules, func(r AccessSimulationRule) bool { return r.RuleID == ruleID }) {
+		return
+	}
+	result.MatchedRules = append(result.MatchedRules, rule)
+}
+
+// matchPolicyRules records the rules of the policy a generated route firewall rule is derived from
+func (s *accessSimulation) matchPolicyRules(result *AccessSimulationResult, policyID string, action string) {
+	for ruleID, rule := range s.rules {
+		if rule.PolicyID == policyID && string(rule.Action) == action {
+			s.matchRule(result, ruleID)
+		}
+	}
+	slices.SortFunc(result.MatchedRules, func(r1, r2 AccessSimulationRule) int {
+		return strings.Compare(r1.RuleID, r2.RuleID)
+	})
+}
+
+// explainDenied reports the failed checks that keep the source off the destination. Rules that only match once the
+// policy posture checks are removed are reported with the posture checks the peers fail.
+func (s *accessSimulation) explainDenied(withoutPostureChecks *accessSimulation, evaluate accessEvaluator, source *nbpeer.Peer, result *AccessSimulationResult) {
+	a := s.account
+	a.simulatePeerState(source, "source", s.validatedPeersMap, result)
+
+	var destination *nbpeer.Peer
+	if result.DestinationType == AccessSimulationDestinationPeer && result.DestinationID != source.ID {
+		destination = a.GetPeer(result.DestinationID)
+		a.simulatePeerState(destination, "destination", s.validatedPeersMap, result)
+	}
+
+	unchecked := &AccessSimulationResult{}
+	evaluate(withoutPostureChecks, source, unchecked)
+	explained := make(map[string]struct{})
+	for _, rule := range unchecked.MatchedRules {
+		if _, ok := explained[rule.PolicyID]; ok || slices.Contains(result.MatchedRules, rule) {
+			continue
+		}
+		explained[rule.PolicyID] = struct{}{}
+
+		policy := a.getPolicy(rule.PolicyID)
+		if policy == nil {
+			continue
+		}
+		result.FailedChecks = append(result.FailedChecks, a.simulationPostureChecks(s.ctx, policy, source)...)
+		if destination != nil && slices.ContainsFunc(policy.Rules, func(r *PolicyRule) bool { return r.ID == rule.RuleID && r.Bidirectional }) {
+			result.FailedChecks = append(result.FailedChecks, a.simulationPostureChecks(s.ctx, policy, destination)...)
+		}
+	}
+
+	if len(result.FailedChecks) == 0 && result.unexplained != "" {
+		result.FailedChecks = append(result.FailedChecks, result.unexplained)
+	}
+}
+
+// getPolicy returns the policy by ID if exists, nil otherwise
+func (a *Account) getPolicy(policyID string) *Policy {
+	for _, policy := range a.Policies {
+		if policy.ID == policyID {
+			return policy
+		}
+	}
+	return nil
+}
+
+// accessSimulationEvaluator resolves the destination of the request and returns the function that evaluates it
+// for a source peer
+func (a *Account) accessSimulationEvaluator(req *AccessSimulationRequest) (accessEvaluator, error) {
+	switch {
+	case req.DestinationPeerID != "":
+		peer := a.GetPeer(req.DestinationPeerID)
+		if peer == nil {
+			return nil, status.NewPeerNotFoundError(req.DestinationPeerID)
+		}
+		return peerAccessEvaluator(req, peer), nil
+	case req.DestinationResourceID != "":
+		resource := a.GetNetworkResource(req.DestinationResourceID)
+		if resource == nil {
+			return nil, status.NewNetworkResourceNotFoundError(req.DestinationResourceID)
+		}
+		return resourceAccessEvaluator(req, resource), nil
+	case req.DestinationAddress.IsValid():
+		address := req.DestinationAddress.Unmap()
+		for _, peer := range a.Peers {
+			if peer.IP == address || (peer.IPv6.IsValid() && peer.IPv6 == address) {
+				return peerAccessEvaluator(req, peer), nil
+			}
+		}
+		if resource := a.findNetworkResourceByAddress(address); resource != nil {
+			return resourceAccessEvaluator(req, resource), nil
+		}
+		return routeAccessEvaluator(req, address), nil
+	default:
+		return nil, status.Errorf(status.InvalidArgument, "a destination peer, network resource or address is required")
+	}
+}
+
+// GetNetworkResource returns a network resource by ID if exists, nil otherwise
+func (a *Account) GetNetworkResource(resourceID string) *resourceTypes.NetworkResource {
+	for _, resource := range a.NetworkResources {
+		if resource.ID == resourceID {
+			return resource
+		}
+	}
+	return nil
+}
+
+// findNetworkResourceByAddress returns the host or subnet resource with the most specific prefix containing the address
+func (a *Account) findNetworkResourceByAddress(address netip.Addr) *resourceTypes.NetworkResource {
+	var found *resourceTypes.NetworkResource
+	for _, resource := range a.NetworkResources {
+		if resource.Type == resourceTypes.Domain || !resource.Prefix.IsValid() || !resource.Prefix.Contains(address) {
+			continue
+		}
+		if found == nil || resource.Prefix.Bits() > found.Prefix.Bits() {
+			found = resource
+		}
+	}
+	return found
+}
+
+// simulatePeerState reports the failed checks that leave the peer out of the network maps
+func (a *Account) simulatePeerState(peer *nbpeer.Peer, role string, validatedPeersMap map[string]struct{}, result *AccessSimulationResult) {
+	if _, ok := validatedPeersMap[peer.ID]; !ok {
+		result.block(fmt.Sprintf("%s peer %s is not approved", role, peer.ID))
+	}
+	if expired, _ := peer.LoginExpired(a.Settings.PeerLoginExpiration); a.Settings.PeerLoginExpirationEnabled && expired {
+		result.block(fmt.Sprintf("%s peer %s login has expired", role, peer.ID))
+	}
+}
+
+// hasAvailableRoutingPeer reports whether any of the routing peers, other than the source, is in the network maps
+func (a *Account) hasAvailableRoutingPeer(peerIDs iter.Seq[string], sourcePeerID string, validatedPeersMap map[string]struct{}) bool {
+	for peerID := range peerIDs {
+		peer := a.GetPeer(peerID)
+		if peer == nil || peer.ID == sourcePeerID {
+			continue
+		}
+		result := &AccessSimulationResult{}
+		a.simulatePeerState(peer, "routing", validatedPeersMap, result)
+		if !result.blocked {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesTraffic reports whether the requested protocol and port are covered by a generated firewall rule
+func (req *AccessSimulationRequest) matchesTraffic(protocol string, port uint16, portRange RulePortRange) bool {
+	ruleProtocol := PolicyRuleProtocolType(protocol)
+	if ruleProtocol == PolicyRuleProtocolNetbirdSSH {
+		ruleProtocol = PolicyRuleProtocolTCP
+	}
+	if req.Protocol != "" && ruleProtocol != PolicyRuleProtocolALL && ruleProtocol != req.Protocol {
+		return false
+	}
+	if req.Port == 0 || (port == 0 && portRange.Start == 0 && portRange.End == 0) {
+		return true
+	}
+	if port != 0 {
+		return port == req.Port
+	}
+	return req.Port >= portRange.Start && req.Port <= portRange.End
+}
+
+// firewallRulePort returns the port of a generated firewall rule, 0 when the rule is not limited to a single port
+func firewallRulePort(rule *FirewallRule) uint16 {
+	port, err := strconv.ParseUint(rule.Port, 10, 16)
+	if err != nil {
+		return 0
+	}
+	return uint16(port)
+}
+
+func newAccessSimulationRule(policy *Policy, rule *PolicyRule) AccessSimulationRule {
+	return AccessSimulationRule{
+		PolicyID:   policy.ID,
+		PolicyName: policy.Name,
+		RuleID:     rule.ID,
+		RuleName:   rule.Name,
+		Action:     rule.Action,
+	}
+}
+
+// simulationPostureChecks returns the policy source posture checks the peer fails, mirroring validatePostureChecksOnPeer
+func (a *Account) simulationPostureChecks(ctx context.Context, policy *Policy, peer *nbpeer.Peer) []string {
+	var failed []string
+	for _, postureChecksID := range policy.SourcePostureChecks {
+		postureChecks := a.GetPostureChecks(postureChecksID)
+		if postureChecks == nil {
+			continue
+		}
+
+		for _, check := range postureChecks.GetChecks() {
+			isValid, err := check.Check(ctx, *peer)
+			if err != nil {
+				log.WithContext(ctx).Debugf("an error occurred check %s: on peer: %s :%s", check.Name(), peer.ID, err.Error())
+			}
+			if !isValid {
+				failed = append(failed, fmt.Sprintf("posture check %q (%s) of policy %q failed on peer %s", postureChecks.Name, check.Name(), policy.Name, peer.ID))
+			}
+		}
+	}
+	return failed
+}
+
+// networkMapHasPeer reports whether the peer is one of the peers the network map connects to
+func networkMapHasPeer(nm *NetworkMap, peerID string) bool {
+	return slices.ContainsFunc(nm.Peers, func(peer *ComponentPeer) bool {
+		return peer.ID == peerID
+	})
+}
+
+// peerHasIP reports whether the address of a generated firewall rule belongs to the peer
+func peerHasIP(peer *nbpeer.Peer, ip string) bool {
+	return ip == peer.IP.String() || (peer.IPv6.IsValid() && ip == peer.IPv6.String())
+}
+
+// sourceRangesHavePeer reports whether the source ranges of a generated route firewall rule cover the peer
+func sourceRangesHavePeer(sourceRanges []string, peer *nbpeer.Peer) bool {
+	for _, sourceRange := range sourceRanges {
+		prefix, err := netip.ParsePrefix(sourceRange)
+		if err != nil {
+			continue
+		}
+		if prefix.Contains(peer.IP) || (peer.IPv6.IsValid() && prefix.Contains(peer.IPv6)) {
+			return true
+		}
+	}
+	return false
+}
+
+// peerAccessEvaluator evaluates peer to peer connections against the inbound firewall rules of the destination
+// network map. Both peers have to be in each other's network map.
+func peerAccessEvaluator(req *AccessSimulationRequest, destination *nbpeer.Peer) accessEvaluator {
+	return func(s *accessSimulation, source *nbpeer.Peer, result *AccessSimulationResult) {
+		result.DestinationType = AccessSimulationDestinationPeer
+		result.DestinationID = destination.ID
+		if source.ID == destination.ID {
+			result.block("source and destination are the same peer")
+			return
+		}
+
+		destinationMap := s.networkMap(destination.ID)
+		for _, rule := range destinationMap.FirewallRules {
+			if rule.Direction != FirewallRuleDirectionIN || !peerHasIP(source, rule.PeerIP) {
+				continue
+			}
+			if req.matchesTraffic(rule.Protocol, firewallRulePort(rule), rule.PortRange) {
+				s.matchRule(result, rule.PolicyID)
+			}
+		}
+
+		if !networkMapHasPeer(s.networkMap(source.ID), destination.ID) || !networkMapHasPeer(destinationMap, source.ID) {
+			result.blocked = true
+			result.unexplained = fmt.Sprintf("peers %s and %s are not in each other's network map", source.ID, destination.ID)
+		}
+	}
+}
+
+// resourceAccessEvaluator evaluates connections to a network resource against the routes of the source network map
+// and the route firewall rules of the routing peers
+func resourceAccessEvaluator(req *AccessSimulationRequest, resource *resourceTypes.NetworkResource) accessEvaluator {
+	return func(s *accessSimulation, source *nbpeer.Peer, result *AccessSimulationResult) {
+		result.DestinationType = AccessSimulationDestinationResource
+		result.DestinationID = resource.ID
+		if !resource.Enabled {
+			result.block(fmt.Sprintf("network resource %s is disabled", resource.ID))
+			return
+		}
+
+		var routes []*route.Route
+		for _, r := range s.networkMap(source.ID).Routes {
+			if string(r.GetResourceID()) == resource.ID {
+				routes = append(routes, r)
+			}
+		}
+		if len(routes) > 0 {
+			s.evaluateRoutes(req, source, routes, result)
+			return
+		}
+
+		if !s.account.hasAvailableRoutingPeer(maps.Keys(s.routers[resource.NetworkID]), source.ID, s.validatedPeersMap) {
+			result.block(fmt.Sprintf("network %s has no available routing peer", resource.NetworkID))
+			return
+		}
+		result.blocked = true
+		result.unexplained = fmt.Sprintf("network resource %s is not routed to peer %s", resource.ID, source.ID)
+	}
+}
+
+// routeAccessEvaluator evaluates connections to an address covered by a route of the source network map. Routes
+// without access control groups allow all traffic of the peers they are distributed to.
+func routeAccessEvaluator(req *AccessSimulationRequest, address netip.Addr) accessEvaluator {
+	return func(s *accessSimulation, source *nbpeer.Peer, result *AccessSimulationResult) {
+		result.DestinationType = AccessSimulationDestinationRoute
+
+		var routes []*route.Route
+		for _, r := range s.networkMap(source.ID).Routes {
+			if r.IsDynamic() || !r.Network.IsValid() || !r.Network.Contains(address) {
+				continue
+			}
+			switch {
+			case len(routes) == 0 || r.Network.Bits() > routes[0].Network.Bits():
+				routes = []*route.Route{r}
+			case r.Network.Bits() == routes[0].Network.Bits():
+				routes = append(routes, r)
+			}
+		}
+		if len(routes) == 0 {
+			result.block(fmt.Sprintf("no peer, network resource or route distributed to peer %s covers %s", source.ID, address))
+			return
+		}
+		result.DestinationID = string(routes[0].GetResourceID())
+
+		s.evaluateRoutes(req, source, routes, result)
+	}
+}
+
+// evaluateRoutes matches the connection against the route firewall rules of the routing peers of the routes
+func (s *accessSimulation) evaluateRoutes(req *AccessSimulationRequest, source *nbpeer.Peer, routes []*route.Route, result *AccessSimulationResult) {
+	sourceMap := s.networkMap(source.ID)
+	reachable := false
+	for _, r := range routes {
+		router := s.account.routingPeer(r)
+		if router == nil || router.ID == source.ID || !networkMapHasPeer(sourceMap, router.ID) {
+			continue
+		}
+		routerMap := s.networkMap(router.ID)
+		if !networkMapHasPeer(routerMap, source.ID) {
+			continue
+		}
+		reachable = true
+
+		for _, rule := range routerMap.RoutesFirewallRules {
+			if rule.RouteID != r.ID || !sourceRangesHavePeer(rule.SourceRanges, source) || !req.matchesTraffic(rule.Protocol, rule.Port, rule.PortRange) {
+				continue
+			}
+			if rule.PolicyID == "" {
+				result.unrestricted = true
+				continue
+			}
+			s.matchPolicyRules(result, rule.PolicyID, rule.Action)
+		}
+	}
+
+	if !reachable {
+		result.blocked = true
+		result.unexplained = fmt.Sprintf("route %s has no routing peer in the network map of peer %s", routes[0].GetResourceID(), source.ID)
+	}
+}
+
+// routingPeer returns the routing peer of a route of a generated network map
+func (a *Account) routingPeer(r *route.Route) *nbpeer.Peer {
+	if r.PeerID != "" {
+		return a.GetPeer(r.PeerID)
+	}
+	for _, peer := range a.Peers {
+		if peer.Key == r.Peer {
+			return peer
+		}
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package types
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
+	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/route"
+)
+
+func simulationTestAccount() *Account {
+	return &Account{
+		Id:      "account",
+		Network: &Network{Identifier: "net", Net: net.IPNet{IP: net.IP{100, 64, 0, 0}, Mask: net.CIDRMask(10, 32)}},
+		Users: map[string]*User{
+			"user": {Id: "user", AccountID: "account"},
+		},
+		Peers: map[string]*nbpeer.Peer{
+			"dev":    {ID: "dev", Key: "dev-key", UserID: "user", IP: netip.MustParseAddr("100.64.0.1"), Meta: nbpeer.PeerSystemMeta{WtVersion: "0.40.0"}},
+			"laptop": {ID: "laptop", Key: "laptop-key", UserID: "user", IP: netip.MustParseAddr("100.64.0.2"), Meta: nbpeer.PeerSystemMeta{WtVersion: "0.20.0"}},
+			"db":     {ID: "db", Key: "db-key", IP: netip.MustParseAddr("100.64.0.3"), Meta: nbpeer.PeerSystemMeta{WtVersion: "0.40.0"}},
+			"router": {ID: "router", Key: "router-key", IP: netip.MustParseAddr("100.64.0.4"), Meta: nbpeer.PeerSystemMeta{WtVersion: "0.40.0"}},
+		},
+		Groups: map[string]*Group{
+			"devs":     {ID: "devs", Peers: []string{"dev", "laptop"}},
+			"dbs":      {ID: "dbs", Peers: []string{"db"}},
+			"routers":  {ID: "routers", Peers: []string{"router"}},
+			"services": {ID: "services", Resources: []Resource{{ID: "api", Type: ResourceTypeHost}}},
+		},
+		PostureChecks: []*posture.Checks{
+			{ID: "version", Name: "Recent client", Checks: posture.ChecksDefinition{NBVersionCheck: &posture.NBVersionCheck{MinVersion: "0.30.0"}}},
+		},
+		Policies: []*Policy{
+			{
+				ID: "postgres", Name: "Postgres", Enabled: true,
+				SourcePostureChecks: []string{"version"},
+				Rules: []*PolicyRule{{
+					ID: "postgres-rule", PolicyID: "postgres", Enabled: true, Action: PolicyTrafficActionAccept,
+					Sources: []string{"devs"}, Destinations: []string{"dbs"},
+					Protocol: PolicyRuleProtocolTCP, Ports: []string{"5432"},
+				}},
+			},
+			{
+				ID: "api", Name: "API", Enabled: true,
+				Rules: []*PolicyRule{{
+					ID: "api-rule", PolicyID: "api", Enabled: true, Action: PolicyTrafficActionAccept,
+					Sources: []string{"devs"}, Destinations: []string{"services"},
+					Protocol: PolicyRuleProtocolTCP, PortRanges: []RulePortRange{{Start: 8000, End: 8100}},
+				}},
+			},
+			{
+				ID: "lan", Name: "LAN", Enabled: true,
+				Rules: []*PolicyRule{{
+					ID: "lan-rule", PolicyID: "lan", Enabled: true, Action: PolicyTrafficActionAccept,
+					Sources: []string{"devs"}, Destinations: []string{"routers"},
+					Protocol: PolicyRuleProtocolICMP,
+				}},
+			},
+		},
+		NetworkResources: []*resourceTypes.NetworkResource{
+			{ID: "api", NetworkID: "net", Type: resourceTypes.Host, Prefix: netip.MustParsePrefix("10.0.0.10/32"), Enabled: true},
+		},
+		NetworkRouters: []*routerTypes.NetworkRouter{
+			{ID: "net-router", NetworkID: "net", PeerGroups: []string{"routers"}, Enabled: true},
+		},
+		Routes: map[route.ID]*route.Route{
+			"lan": {ID: "lan", Network: netip.MustParsePrefix("192.168.0.0/24"), Peer: "router", Groups: []string{"devs"}, Enabled: true},
+		},
+		Settings: &Settings{PeerLoginExpiration: time.Hour},
+	}
+}
+
+func TestAccount_SimulateAccess(t *testing.T) {
+	ctx := context.Background()
+	allValidated := map[string]struct{}{"dev": {}, "laptop": {}, "db": {}, "router": {}}
+
+	tests := []struct {
+		name         string
+		request      AccessSimulationRequest
+		validated    map[string]struct{}
+		allowed      bool
+		destType     AccessSimulationDestinationType
+		matchedRules int
+		failedChecks int
+	}{
+		{
+			name:         "peer port allowed",
+			request:      AccessSimulationRequest{SourcePeerID: "dev", DestinationPeerID: "db", Protocol: PolicyRuleProtocolTCP, Port: 5432},
+			allowed:      true,
+			destType:     AccessSimulationDestinationPeer,
+			matchedRules: 1,
+		},
+		{
+			name:     "peer port not covered",
+			request:  AccessSimulationRequest{SourcePeerID: "dev", DestinationPeerID: "db", Protocol: PolicyRuleProtocolTCP, Port: 22},
+			destType: AccessSimulationDestinationPeer,
+		},
+		{
+			name:     "rule is not bidirectional",
+			request:  AccessSimulationRequest{SourcePeerID: "db", DestinationPeerID: "dev"},
+			destType: AccessSimulationDestinationPeer,
+		},
+		{
+			name:         "posture check fails",
+			request:      AccessSimulationRequest{SourcePeerID: "laptop", DestinationAddress: netip.MustParseAddr("100.64.0.3"), Port: 5432},
+			destType:     AccessSimulationDestinationPeer,
+			failedChecks: 1,
+		},
+		{
+			name:         "destination not approved",
+			request:      AccessSimulationRequest{SourcePeerID: "dev", DestinationPeerID: "db"},
+			validated:    map[string]struct{}{"dev": {}, "laptop": {}, "router": {}},
+			destType:     AccessSimulationDestinationPeer,
+			failedChecks: 1,
+		},
+		{
+			name:         "resource by address",
+			request:      AccessSimulationRequest{SourcePeerID: "laptop", DestinationAddress: netip.MustParseAddr("10.0.0.10"), Protocol: PolicyRuleProtocolTCP, Port: 8080},
+			allowed:      true,
+			destType:     AccessSimulationDestinationResource,
+			matchedRules: 1,
+		},
+		{
+			name:         "resource without routing peer",
+			request:      AccessSimulationRequest{SourcePeerID: "dev", DestinationResourceID: "api"},
+			validated:    map[string]struct{}{"dev": {}, "laptop": {}, "db": {}},
+			destType:     AccessSimulationDestinationResource,
+			failedChecks: 1,
+		},
+		{
+			name:     "route without access control",
+			request:  AccessSimulationRequest{SourcePeerID: "dev", DestinationAddress: netip.MustParseAddr("192.168.0.7")},
+			allowed:  true,
+			destType: AccessSimulationDestinationRoute,
+		},
+		{
+			name:         "address not routed to the source",
+			request:      AccessSimulationRequest{SourcePeerID: "db", DestinationAddress: netip.MustParseAddr("192.168.0.7")},
+			destType:     AccessSimulationDestinationRoute,
+			failedChecks: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validated := tt.validated
+			if validated == nil {
+				validated = allValidated
+			}
+
+			results, err := simulationTestAccount().SimulateAccess(ctx, &tt.request, validated)
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			result := results[0]
+			assert.Equal(t, tt.allowed, result.Allowed)
+			assert.Equal(t, tt.destType, result.DestinationType)
+			assert.Len(t, result.MatchedRules, tt.matchedRules)
+			assert.Len(t, result.FailedChecks, tt.failedChecks, result.FailedChecks)
+		})
+	}
+}
+
+func TestAccount_SimulateAccessForUser(t *testing.T) {
+	account := simulationTestAccount()
+	account.Policies = append(account.Policies, &Policy{
+		ID: "block", Name: "Block laptops", Enabled: true,
+		Rules: []*PolicyRule{{
+			ID: "block-rule", PolicyID: "block", Enabled: true, Action: PolicyTrafficActionDrop,
+			SourceResource: Resource{ID: "dev", Type: ResourceTypePeer}, Destinations: []string{"dbs"},
+			Protocol: PolicyRuleProtocolALL,
+		}},
+	})
+	validated := map[string]struct{}{"dev": {}, "laptop": {}, "db": {}, "router": {}}
+
+	results, err := account.SimulateAccess(context.Background(), &AccessSimulationRequest{SourceUserID: "user", DestinationPeerID: "db", Protocol: PolicyRuleProtocolTCP, Port: 5432}, validated)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "dev", results[0].SourcePeerID)
+	assert.Len(t, results[0].MatchedRules, 2)
+	assert.False(t, results[0].Allowed, "drop rules take precedence")
+	assert.Equal(t, "laptop", results[1].SourcePeerID)
+	assert.False(t, results[1].Allowed)
+
+	_, err = account.SimulateAccess(context.Background(), &AccessSimulationRequest{SourcePeerID: "dev", SourceUserID: "user", DestinationPeerID: "db"}, validated)
+	assert.Error(t, err)
+	_, err = account.SimulateAccess(context.Background(), &AccessSimulationRequest{SourcePeerID: "dev"}, validated)
+	assert.Error(t, err)
+	_, err = account.SimulateAccess(context.Background(), &AccessSimulationRequest{SourcePeerID: "missing", DestinationPeerID: "db"}, validated)
+	assert.Error(t, err)
+}
+
+// TestAccount_SimulateAccessMatchesConnectionResources cross-checks the simulation of every peer pair against the
+// inbound firewall rules GetPeerConnectionResources generates for the destination
+func TestAccount_SimulateAccessMatchesConnectionResources(t *testing.T) {
+	ctx := context.Background()
+	account := simulationTestAccount()
+	validated := map[string]struct{}{"dev": {}, "laptop": {}, "db": {}, "router": {}}
+
+	for _, source := range account.Peers {
+		for _, destination := range account.Peers {
+			if source.ID == destination.ID {
+				continue
+			}
+
+			_, rules, _, _ := account.GetPeerConnectionResources(ctx, destination, validated, nil)
+			expected := slices.ContainsFunc(rules, func(rule *FirewallRule) bool {
+				return rule.Direction == FirewallRuleDirectionIN && rule.PeerIP == source.IP.String() && rule.Action == string(PolicyTrafficActionAccept)
+			})
+
+			results, err := account.SimulateAccess(ctx, &AccessSimulationRequest{SourcePeerID: source.ID, DestinationPeerID: destination.ID}, validated)
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.Equal(t, expected, results[0].Allowed, "%s -> %s", source.ID, destination.ID)
+		}
+	}
+}
@@ -1,9 +1,16 @@
 package types
 
 import (
+	"context"
+	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/route"
 )
 
 func TestPolicyEqual_SameRulesDifferentOrder(t *testing.T) {
@@ -191,3 +198,146 @@ func TestPolicyEqual_FullScenario(t *testing.T) {
 	}
 	assert.True(t, a.Equal(b))
 }
+
+func TestApplyPolicySchedules(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
+	upcoming := &Policy{ID: "upcoming", Enabled: true, Schedule: &PolicySchedule{StartDate: &future}}
+	running := &Policy{ID: "running", Enabled: true, Schedule: &PolicySchedule{StartDate: &past}}
+	unscheduled := &Policy{ID: "unscheduled", Enabled: true}
+	original := []*Policy{upcoming, running, unscheduled}
+
+	account := &Account{Policies: original}
+	account.ApplyPolicySchedules(now)
+
+	assert.False(t, account.Policies[0].Enabled, "policies outside their schedule are disabled")
+	assert.True(t, account.Policies[1].Enabled)
+	assert.Same(t, unscheduled, account.Policies[2])
+	assert.True(t, upcoming.Enabled, "the shared policy is left untouched")
+	assert.Same(t, upcoming, original[0], "the shared slice is left untouched")
+}
+
+func TestApplyAccessGrants(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(time.Hour)
+	expiredAt := now.Add(-time.Minute)
+
+	ops := &Group{ID: "ops", Peers: []string{"peer-a"}}
+	disabled := &Policy{ID: "break-glass", Enabled: false}
+	account := &Account{
+		Peers: map[string]*nbpeer.Peer{
+			"peer-a": {ID: "peer-a", UserID: "other"},
+			"peer-b": {ID: "peer-b", UserID: "requester"},
+			"peer-c": {ID: "peer-c", UserID: "requester"},
+		},
+		Groups:   map[string]*Group{"ops": ops},
+		Policies: []*Policy{disabled},
+		AccessGrants: []*AccessRequest{
+			{UserID: "requester", TargetType: AccessRequestTargetGroup, TargetID: "ops", Status: AccessRequestStatusApproved, ExpiresAt: &expiresAt},
+			{UserID: "requester", TargetType: AccessRequestTargetGroup, TargetID: "missing", Status: AccessRequestStatusApproved, ExpiresAt: &expiresAt},
+			{UserID: "requester", TargetType: AccessRequestTargetPolicy, TargetID: "break-glass", Status: AccessRequestStatusApproved, ExpiresAt: &expiresAt},
+		},
+	}
+	account.ApplyAccessGrants(now)
+
+	assert.ElementsMatch(t, []string{"peer-a", "peer-b", "peer-c"}, account.Groups["ops"].Peers, "the requester's peers join the group")
+	assert.True(t, account.Policies[0].Enabled, "the granted policy is enabled")
+	assert.Equal(t, []string{"peer-a"}, ops.Peers, "the shared group is left untouched")
+	assert.False(t, disabled.Enabled, "the shared policy is left untouched")
+
+	expired := &Account{
+		Peers:    account.Peers,
+		Groups:   map[string]*Group{"ops": ops},
+		Policies: []*Policy{disabled},
+		AccessGrants: []*AccessRequest{
+			{UserID: "requester", TargetType: AccessRequestTargetGroup, TargetID: "ops", Status: AccessRequestStatusApproved, ExpiresAt: &expiredAt},
+			{UserID: "requester", TargetType: AccessRequestTargetPolicy, TargetID: "break-glass", Status: AccessRequestStatusApproved, ExpiresAt: &expiredAt},
+		},
+	}
+	expired.ApplyAccessGrants(now)
+	assert.Same(t, ops, expired.Groups["ops"], "expired grants are ignored")
+	assert.Same(t, disabled, expired.Policies[0])
+}
+
+func TestApplyEffectiveAccess(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(time.Hour)
+	future := now.Add(time.Hour)
+
+	account := &Account{
+		Policies: []*Policy{
+			{ID: "break-glass", Enabled: false},
+			{ID: "upcoming", Enabled: false, Schedule: &PolicySchedule{StartDate: &future}},
+		},
+		AccessGrants: []*AccessRequest{
+			{UserID: "requester", TargetType: AccessRequestTargetPolicy, TargetID: "break-glass", Status: AccessRequestStatusApproved, ExpiresAt: &expiresAt},
+			{UserID: "requester", TargetType: AccessRequestTargetPolicy, TargetID: "upcoming", Status: AccessRequestStatusApproved, ExpiresAt: &expiresAt},
+		},
+	}
+	account.ApplyEffectiveAccess(now)
+
+	assert.True(t, account.Policies[0].Enabled, "the granted policy is enabled")
+	assert.False(t, account.Policies[1].Enabled, "a granted policy is still limited by its schedule")
+}
+
+func TestApplyLabelSelectors(t *testing.T) {
+	rule := &PolicyRule{ID: "rule", Sources: []string{"ops"}, DestinationLabels: map[string]string{"env": "prod"}}
+	labeled := &Policy{ID: "labeled", Enabled: true, Rules: []*PolicyRule{rule}}
+	plain := &Policy{ID: "plain", Enabled: true, Rules: []*PolicyRule{{ID: "plain-rule", Sources: []string{"ops"}, Destinations: []string{"ops"}}}}
+	labeledRoute := &route.Route{ID: "labeled", DistributionLabels: map[string]string{"env": "prod"}}
+	account := &Account{
+		Id: "account",
+		Peers: map[string]*nbpeer.Peer{
+			"peer-a": {ID: "peer-a", Labels: map[string]string{"env": "prod"}},
+			"peer-b": {ID: "peer-b", Meta: nbpeer.PeerSystemMeta{Labels: map[string]string{"env": "prod", "team": "db"}}},
+			"peer-c": {ID: "peer-c", Labels: map[string]string{"env": "dev"}, Meta: nbpeer.PeerSystemMeta{Labels: map[string]string{"env": "prod"}}},
+		},
+		Groups:   map[string]*Group{"ops": {ID: "ops", Peers: []string{"peer-c"}}},
+		Policies: []*Policy{labeled, plain},
+		Routes:   map[route.ID]*route.Route{"labeled": labeledRoute},
+	}
+	account.ApplyLabelSelectors()
+
+	groupID := LabelSelectorGroupID(map[string]string{"env": "prod"})
+	require.Contains(t, account.Groups, groupID)
+	assert.Equal(t, []string{"peer-a"}, account.Groups[groupID].Peers, "client labels are not matched")
+	assert.Equal(t, []string{groupID}, account.Policies[0].Rules[0].Destinations)
+	assert.Equal(t, []string{groupID}, account.Routes["labeled"].Groups)
+	assert.Same(t, plain, account.Policies[1])
+	assert.Empty(t, rule.Destinations, "the shared rule is left untouched")
+	assert.Empty(t, labeledRoute.Groups, "the shared route is left untouched")
+	assert.Equal(t, "labels:env=prod,team=db", LabelSelectorGroupID(map[string]string{"team": "db", "env": "prod"}))
+}
+
+func TestApplyLabelSelectors_ClientLabelsDoNotGrantAccess(t *testing.T) {
+	account := &Account{
+		Id: "account",
+		Peers: map[string]*nbpeer.Peer{
+			"ops":     {ID: "ops", IP: netip.MustParseAddr("100.64.0.1")},
+			"prod":    {ID: "prod", IP: netip.MustParseAddr("100.64.0.2"), Labels: map[string]string{"env": "prod"}},
+			"claimed": {ID: "claimed", IP: netip.MustParseAddr("100.64.0.3"), Meta: nbpeer.PeerSystemMeta{Labels: map[string]string{"env": "prod"}}},
+		},
+		Groups: map[string]*Group{"ops": {ID: "ops", Peers: []string{"ops"}}},
+		Policies: []*Policy{{
+			ID: "prod", Enabled: true,
+			Rules: []*PolicyRule{{
+				ID: "prod-rule", Enabled: true, Action: PolicyTrafficActionAccept, Bidirectional: true,
+				Sources: []string{"ops"}, DestinationLabels: map[string]string{"env": "prod"}, Protocol: PolicyRuleProtocolALL,
+			}},
+		}},
+	}
+	account.ApplyLabelSelectors()
+
+	validated := map[string]struct{}{"ops": {}, "prod": {}, "claimed": {}}
+	peers, _, _, _ := account.GetPeerConnectionResources(context.Background(), account.Peers["ops"], validated, nil)
+	peerIDs := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		peerIDs = append(peerIDs, peer.ID)
+	}
+	assert.Equal(t, []string{"prod"}, peerIDs, "a label reported by the client does not select the peer")
+
+	peers, _, _, _ = account.GetPeerConnectionResources(context.Background(), account.Peers["claimed"], validated, nil)
+	assert.Empty(t, peers)
+}
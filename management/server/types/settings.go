@@ -54,6 +54,10 @@ type Settings struct {
 	// PeerExposeGroups list of peer group IDs allowed to expose services
 	PeerExposeGroups []string `gorm:"serializer:json"`
 
+	// AccessRequestApproverGroups list of user group IDs whose members can approve just-in-time access requests
+	// in addition to administrators
+	AccessRequestApproverGroups []string `gorm:"serializer:json"`
+
 	// Extra is a dictionary of Account settings
 	Extra *ExtraSettings `gorm:"embedded;embeddedPrefix:extra_"`
 
@@ -115,6 +119,7 @@ func (s *Settings) Copy() *Settings {
 		RoutingPeerDNSResolutionEnabled: s.RoutingPeerDNSResolutionEnabled,
 		PeerExposeEnabled:               s.PeerExposeEnabled,
 		PeerExposeGroups:                slices.Clone(s.PeerExposeGroups),
+		AccessRequestApproverGroups:     slices.Clone(s.AccessRequestApproverGroups),
 		LazyConnectionEnabled:           s.LazyConnectionEnabled,
 		DNSDomain:                       s.DNSDomain,
 		NetworkRange:                    s.NetworkRange,
@@ -167,6 +172,8 @@ type ExtraSettings struct {
 	IntegratedValidator string
 	// IntegratedValidatorGroups list of group IDs to be used with integrated approval configurations
 	IntegratedValidatorGroups []string `gorm:"serializer:json"`
+	// PeerApprovalRules approve new peers automatically when any of the rules matches
+	PeerApprovalRules []PeerApprovalRule `gorm:"serializer:json"`
 
 	FlowEnabled              bool     `gorm:"-"`
 	FlowGroups               []string `gorm:"-"`
@@ -182,6 +189,7 @@ func (e *ExtraSettings) Copy() *ExtraSettings {
 		UserApprovalRequired:      e.UserApprovalRequired,
 		IntegratedValidatorGroups: slices.Clone(e.IntegratedValidatorGroups),
 		IntegratedValidator:       e.IntegratedValidator,
+		PeerApprovalRules:         copyPeerApprovalRules(e.PeerApprovalRules),
 		FlowEnabled:               e.FlowEnabled,
 		FlowGroups:                slices.Clone(e.FlowGroups),
 		FlowPacketCounterEnabled:  e.FlowPacketCounterEnabled,
@@ -3,6 +3,7 @@ package types
 import (
 	"crypto/sha256"
 	b64 "encoding/base64"
+	"maps"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -56,6 +57,10 @@ type SetupKey struct {
 	Ephemeral bool
 	// AllowExtraDNSLabels indicates if the key allows extra DNS labels
 	AllowExtraDNSLabels bool
+	// Labels are the key/value labels assigned to a Peer when it uses this key to register
+	Labels map[string]string `gorm:"serializer:json"`
+	// Restrictions limit which machines can enroll with this key
+	Restrictions *SetupKeyRestrictions `gorm:"serializer:json"`
 }
 
 // Copy copies SetupKey to a new object
@@ -82,6 +87,8 @@ func (key *SetupKey) Copy() *SetupKey {
 		UsageLimit:          key.UsageLimit,
 		Ephemeral:           key.Ephemeral,
 		AllowExtraDNSLabels: key.AllowExtraDNSLabels,
+		Labels:              maps.Clone(key.Labels),
+		Restrictions:        key.Restrictions.Copy(),
 	}
 }
 
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+// SetupKeyRestrictions limits which machines can enroll with a setup key. Empty fields don't restrict anything,
+// and nil restrictions allow every machine.
+type SetupKeyRestrictions struct {
+	// AllowedCIDRs are the ranges the login connection IP of the peer has to be in
+	AllowedCIDRs []string `json:"allowed_cidrs,omitempty"`
+	// HostnamePattern is a regular expression the whole hostname of the peer has to match
+	HostnamePattern string `json:"hostname_pattern,omitempty"`
+	// AllowedOS are the operating system families (linux, windows, darwin, freebsd, android, ios, js) the peer has to run
+	AllowedOS []string `json:"allowed_os,omitempty"`
+	// MaxPeersPerHostname is the number of peers with the same hostname that can enroll with the key
+	MaxPeersPerHostname int `json:"max_peers_per_hostname,omitempty"`
+}
+
+// IsEmpty reports whether the restrictions don't restrict anything
+func (r *SetupKeyRestrictions) IsEmpty() bool {
+	return r == nil || len(r.AllowedCIDRs) == 0 && r.HostnamePattern == "" && len(r.AllowedOS) == 0 && r.MaxPeersPerHostname == 0
+}
+
+// Copy returns a deep copy of the restrictions
+func (r *SetupKeyRestrictions) Copy() *SetupKeyRestrictions {
+	if r == nil {
+		return nil
+	}
+	return &SetupKeyRestrictions{
+		AllowedCIDRs:        slices.Clone(r.AllowedCIDRs),
+		HostnamePattern:     r.HostnamePattern,
+		AllowedOS:           slices.Clone(r.AllowedOS),
+		MaxPeersPerHostname: r.MaxPeersPerHostname,
+	}
+}
+
+// Validate checks that the restrictions are well-formed
+func (r *SetupKeyRestrictions) Validate() error {
+	if r == nil {
+		return nil
+	}
+	for _, cidr := range r.AllowedCIDRs {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			return fmt.Errorf("invalid allowed CIDR %q: %w", cidr, err)
+		}
+	}
+	if r.HostnamePattern != "" {
+		if _, err := compileHostnamePattern(r.HostnamePattern); err != nil {
+			return fmt.Errorf("invalid hostname pattern: %w", err)
+		}
+	}
+	for _, os := range r.AllowedOS {
+		if !api.SetupKeyRestrictionsAllowedOs(strings.ToLower(os)).Valid() {
+			return fmt.Errorf("unsupported operating system %q", os)
+		}
+	}
+	if r.MaxPeersPerHostname < 0 {
+		return fmt.Errorf("max peers per hostname can't be negative")
+	}
+	return nil
+}
+
+// Check returns the reason the peer is not allowed to enroll, or nil when it is. The connection IP is taken
+// from the peer location. The hostname limit needs the peers enrolled so far and is checked by CheckHostnameLimit.
+func (r *SetupKeyRestrictions) Check(peer *nbpeer.Peer) error {
+	if r == nil {
+		return nil
+	}
+	if len(r.AllowedCIDRs) > 0 && !r.allowsIP(peer.Location.ConnectionIP) {
+		return fmt.Errorf("connection IP %s is not in the allowed ranges of the setup key", peer.Location.ConnectionIP)
+	}
+	if r.HostnamePattern != "" {
+		pattern, err := compileHostnamePattern(r.HostnamePattern)
+		if err != nil {
+			return fmt.Errorf("invalid hostname pattern: %w", err)
+		}
+		if !pattern.MatchString(peer.Meta.Hostname) {
+			return fmt.Errorf("hostname %q doesn't match the hostname pattern of the setup key", peer.Meta.Hostname)
+		}
+	}
+	if len(r.AllowedOS) > 0 && !slices.ContainsFunc(r.AllowedOS, func(os string) bool {
+		return strings.EqualFold(os, peer.Meta.GoOS)
+	}) {
+		return fmt.Errorf("operating system %q is not allowed by the setup key", peer.Meta.GoOS)
+	}
+	return nil
+}
+
+// CheckHostnameLimit returns the reason a peer with the hostname is not allowed to enroll when the key was
+// already used by the given number of peers with the same hostname.
+func (r *SetupKeyRestrictions) CheckHostnameLimit(hostname string, enrolled int) error {
+	if r != nil && r.MaxPeersPerHostname > 0 && enrolled >= r.MaxPeersPerHostname {
+		return fmt.Errorf("setup key already enrolled %d peers with hostname %q", enrolled, hostname)
+	}
+	return nil
+}
+
+func (r *SetupKeyRestrictions) allowsIP(ip net.IP) bool {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, cidr := range r.AllowedCIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func compileHostnamePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+// SetupKeyRestrictionsToAPIResponse converts setup key restrictions to their API representation
+func SetupKeyRestrictionsToAPIResponse(r *SetupKeyRestrictions) *api.SetupKeyRestrictions {
+	if r.IsEmpty() {
+		return nil
+	}
+	resp := &api.SetupKeyRestrictions{}
+	if len(r.AllowedCIDRs) > 0 {
+		cidrs := slices.Clone(r.AllowedCIDRs)
+		resp.AllowedCidrs = &cidrs
+	}
+	if r.HostnamePattern != "" {
+		resp.HostnamePattern = &r.HostnamePattern
+	}
+	if len(r.AllowedOS) > 0 {
+		allowedOS := make([]api.SetupKeyRestrictionsAllowedOs, 0, len(r.AllowedOS))
+		for _, os := range r.AllowedOS {
+			allowedOS = append(allowedOS, api.SetupKeyRestrictionsAllowedOs(os))
+		}
+		resp.AllowedOs = &allowedOS
+	}
+	if r.MaxPeersPerHostname > 0 {
+		resp.MaxPeersPerHostname = &r.MaxPeersPerHostname
+	}
+	return resp
+}
+
+// SetupKeyRestrictionsFromAPIRequest converts setup key restrictions from their API representation
+func SetupKeyRestrictionsFromAPIRequest(req *api.SetupKeyRestrictions) *SetupKeyRestrictions {
+	if req == nil {
+		return nil
+	}
+	r := &SetupKeyRestrictions{}
+	if req.AllowedCidrs != nil {
+		r.AllowedCIDRs = slices.Clone(*req.AllowedCidrs)
+	}
+	if req.HostnamePattern != nil {
+		r.HostnamePattern = *req.HostnamePattern
+	}
+	if req.AllowedOs != nil {
+		for _, os := range *req.AllowedOs {
+			r.AllowedOS = append(r.AllowedOS, string(os))
+		}
+	}
+	if req.MaxPeersPerHostname != nil {
+		r.MaxPeersPerHostname = *req.MaxPeersPerHostname
+	}
+	return r
+}
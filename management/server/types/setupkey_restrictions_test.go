@@ -0,0 +1,67 @@
+package types
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestSetupKeyRestrictions_Check(t *testing.T) {
+	restrictions := &SetupKeyRestrictions{
+		AllowedCIDRs:    []string{"203.0.113.0/24", "2001:db8::/32"},
+		HostnamePattern: "web-[0-9]+",
+		AllowedOS:       []string{"Linux", "darwin"},
+	}
+	newPeer := func(ip, hostname, goOS string) *nbpeer.Peer {
+		return &nbpeer.Peer{
+			Meta:     nbpeer.PeerSystemMeta{Hostname: hostname, GoOS: goOS},
+			Location: nbpeer.Location{ConnectionIP: net.ParseIP(ip)},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		peer    *nbpeer.Peer
+		allowed bool
+	}{
+		{name: "allowed", peer: newPeer("203.0.113.10", "web-1", "linux"), allowed: true},
+		{name: "ipv6 range", peer: newPeer("2001:db8::1", "web-1", "darwin"), allowed: true},
+		{name: "ipv4 mapped address", peer: newPeer("::ffff:203.0.113.10", "web-1", "linux"), allowed: true},
+		{name: "ip outside the ranges", peer: newPeer("198.51.100.1", "web-1", "linux"), allowed: false},
+		{name: "unknown ip", peer: newPeer("", "web-1", "linux"), allowed: false},
+		{name: "pattern matches the whole hostname", peer: newPeer("203.0.113.10", "web-1.example", "linux"), allowed: false},
+		{name: "os not allowed", peer: newPeer("203.0.113.10", "web-1", "windows"), allowed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := restrictions.Check(tt.peer)
+			if tt.allowed {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+		})
+	}
+
+	var none *SetupKeyRestrictions
+	assert.True(t, none.IsEmpty())
+	assert.NoError(t, none.Check(newPeer("", "", "")))
+	assert.NoError(t, none.CheckHostnameLimit("web-1", 10))
+
+	limited := &SetupKeyRestrictions{MaxPeersPerHostname: 2}
+	assert.NoError(t, limited.CheckHostnameLimit("web-1", 1))
+	assert.Error(t, limited.CheckHostnameLimit("web-1", 2))
+}
+
+func TestSetupKeyRestrictions_Validate(t *testing.T) {
+	assert.NoError(t, (*SetupKeyRestrictions)(nil).Validate())
+	assert.NoError(t, (&SetupKeyRestrictions{AllowedCIDRs: []string{"10.0.0.0/8"}, HostnamePattern: "web-.*", AllowedOS: []string{"Windows"}}).Validate())
+	assert.Error(t, (&SetupKeyRestrictions{AllowedCIDRs: []string{"10.0.0.1"}}).Validate())
+	assert.Error(t, (&SetupKeyRestrictions{HostnamePattern: "web-("}).Validate())
+	assert.Error(t, (&SetupKeyRestrictions{AllowedOS: []string{"plan9"}}).Validate())
+	assert.Error(t, (&SetupKeyRestrictions{MaxPeersPerHostname: -1}).Validate())
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/netbirdio/netbird/management/server/affectedpeers"
 	"github.com/netbirdio/netbird/management/server/idp"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/permissions/modules"
 	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/store"
@@ -303,9 +304,13 @@ func (am *DefaultAccountManager) DeleteUser(ctx context.Context, accountID, init
 		return status.Errorf(status.InvalidArgument, "self deletion is not allowed")
 	}
 
-	initiatorUser, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthNone, initiatorUserID)
-	if err != nil {
-		return err
+	var initiatorUser *types.User
+	if initiatorUserID != activity.SystemInitiator {
+		var err error
+		initiatorUser, err = am.Store.GetUserByUserID(ctx, store.LockingStrengthNone, initiatorUserID)
+		if err != nil {
+			return err
+		}
 	}
 
 	allowed, ctx, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, initiatorUserID, modules.Users, operations.Delete)
@@ -329,8 +334,8 @@ func (am *DefaultAccountManager) DeleteUser(ctx context.Context, accountID, init
 		return status.NewOwnerDeletePermissionError()
 	}
 
-	// disable deleting integration user if the initiator is not admin service user
-	if targetUser.Issued == types.UserIssuedIntegration && !initiatorUser.IsServiceUser {
+	// disable deleting integration user if the initiator is not admin service user or the integration itself
+	if targetUser.Issued == types.UserIssuedIntegration && initiatorUser != nil && !initiatorUser.IsServiceUser {
 		return status.Errorf(status.PermissionDenied, "only integration service user can delete this user")
 	}
 
@@ -397,11 +402,15 @@ func (am *DefaultAccountManager) InviteUser(ctx context.Context, accountID strin
 }
 
 // CreatePAT creates a new PAT for the given user
-func (am *DefaultAccountManager) CreatePAT(ctx context.Context, accountID string, initiatorUserID string, targetUserID string, tokenName string, expiresIn int) (*types.PersonalAccessTokenGenerated, error) {
+func (am *DefaultAccountManager) CreatePAT(ctx context.Context, accountID string, initiatorUserID string, targetUserID string, tokenName string, expiresIn int, scopes []types.PATScope) (*types.PersonalAccessTokenGenerated, error) {
 	if tokenName == "" {
 		return nil, status.Errorf(status.InvalidArgument, "token name can't be empty")
 	}
 
+	if err := types.ValidatePATScopes(scopes); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "invalid token scopes: %v", err)
+	}
+
 	if expiresIn < account.PATMinExpireDays || expiresIn > account.PATMaxExpireDays {
 		return nil, status.Errorf(status.InvalidArgument, "expiration has to be between %d and %d", account.PATMinExpireDays, account.PATMaxExpireDays)
 	}
@@ -414,6 +423,15 @@ func (am *DefaultAccountManager) CreatePAT(ctx context.Context, accountID string
 		return nil, status.NewPermissionDeniedError()
 	}
 
+	// a scoped token must not be able to mint a token with broader access than its own
+	callerPAT, err := permissions.AuthenticatedPAT(ctx, am.Store, initiatorUserID)
+	if err != nil {
+		return nil, err
+	}
+	if callerPAT != nil && !callerPAT.ScopesCover(scopes) {
+		return nil, status.Errorf(status.PermissionDenied, "token scopes must be within the scopes of the token used to create it")
+	}
+
 	initiatorUser, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthNone, initiatorUserID)
 	if err != nil {
 		return nil, err
@@ -433,7 +451,7 @@ func (am *DefaultAccountManager) CreatePAT(ctx context.Context, accountID string
 		return nil, status.NewAdminPermissionError()
 	}
 
-	pat, err := types.CreateNewPAT(tokenName, expiresIn, targetUserID, initiatorUser.Id)
+	pat, err := types.CreateNewPAT(tokenName, expiresIn, targetUserID, initiatorUser.Id, scopes)
 	if err != nil {
 		return nil, status.Errorf(status.Internal, "failed to create PAT: %v", err)
 	}
@@ -442,7 +460,7 @@ func (am *DefaultAccountManager) CreatePAT(ctx context.Context, accountID string
 		return nil, err
 	}
 
-	meta := map[string]any{"name": pat.Name, "is_service_user": targetUser.IsServiceUser, "user_name": targetUser.ServiceUserName}
+	meta := map[string]any{"name": pat.Name, "is_service_user": targetUser.IsServiceUser, "user_name": targetUser.ServiceUserName, "scoped": len(scopes) > 0}
 	am.StoreEvent(ctx, initiatorUserID, targetUserID, accountID, activity.PersonalAccessTokenCreated, meta)
 
 	return pat, nil
@@ -782,6 +800,12 @@ func (am *DefaultAccountManager) processUserUpdate(ctx context.Context, transact
 		return change, nil, nil, nil, err
 	}
 
+	if update.Role != oldUser.Role {
+		if err := am.validateCustomRoleAssignment(ctx, transaction, accountID, initiatorUser, update.Role); err != nil {
+			return change, nil, nil, nil, err
+		}
+	}
+
 	// only auto groups, revoked status, and integration reference can be updated for now
 	updatedUser := oldUser.Copy()
 	updatedUser.Role = update.Role
@@ -986,6 +1010,36 @@ func validateUserUpdate(groupsMap map[string]*types.Group, initiatorUser, oldUse
 	return nil
 }
 
+// validateCustomRoleAssignment checks that a role which isn't built-in refers to an existing custom role of the
+// account and that the initiator holds every permission the custom role grants.
+func (am *DefaultAccountManager) validateCustomRoleAssignment(ctx context.Context, transaction store.Store, accountID string, initiatorUser *types.User, role types.UserRole) error {
+	if types.StrRoleToUserRole(string(role)) != types.UserRoleUnknown {
+		return nil
+	}
+
+	customRole, err := transaction.GetCustomRoleByID(ctx, store.LockingStrengthNone, accountID, string(role))
+	if err != nil {
+		if sErr, ok := status.FromError(err); ok && sErr.Type() == status.NotFound {
+			return status.Errorf(status.InvalidArgument, "invalid user role")
+		}
+		return err
+	}
+
+	if initiatorUser == nil {
+		return nil
+	}
+
+	allowed, err := am.permissionsManager.ValidateRoleGrant(ctx, accountID, initiatorUser.Role, customRole.Permissions)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return status.Errorf(status.PermissionDenied, "custom role %s grants permissions the initiator doesn't hold", customRole.Name)
+	}
+
+	return nil
+}
+
 // GetOrCreateAccountByUser returns an existing account for a given user id or creates a new one if doesn't exist
 func (am *DefaultAccountManager) GetOrCreateAccountByUser(ctx context.Context, userAuth auth.UserAuth) (*types.Account, error) {
 	userID := userAuth.UserId
@@ -1484,7 +1538,7 @@ func (am *DefaultAccountManager) GetCurrentUserInfo(ctx context.Context, userAut
 		Restricted: !userAuth.IsChild && user.IsRestrictable() && settings.RegularUsersViewBlocked,
 	}
 
-	permissions, err := am.permissionsManager.GetPermissionsByRole(ctx, user.Role)
+	permissions, err := am.permissionsManager.GetPermissionsByRole(ctx, accountID, user.Role)
 	if err == nil {
 		userWithPermissions.Permissions = permissions
 	}
@@ -13,9 +13,12 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/netbirdio/netbird/management/internals/controllers/network_map"
+	"github.com/netbirdio/netbird/management/internals/modules/customroles"
 	nbcache "github.com/netbirdio/netbird/management/server/cache"
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
 	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/permissions/roles"
 	"github.com/netbirdio/netbird/management/server/users"
 	"github.com/netbirdio/netbird/management/server/util"
@@ -74,7 +77,7 @@ func TestUser_CreatePAT_ForSameUser(t *testing.T) {
 		permissionsManager: permissionsManager,
 	}
 
-	pat, err := am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenName, mockExpiresIn)
+	pat, err := am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenName, mockExpiresIn, nil)
 	if err != nil {
 		t.Fatalf("Error when adding PAT to user: %s", err)
 	}
@@ -100,6 +103,142 @@ func TestUser_CreatePAT_ForSameUser(t *testing.T) {
 	assert.Equal(t, mockUserID, user.Id)
 }
 
+func TestUser_CreatePAT_Scoped(t *testing.T) {
+	s, cleanup, err := store.NewTestStoreFromSQL(context.Background(), "", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	account := newAccountWithId(context.Background(), mockAccountID, mockUserID, "", "", "", false)
+	require.NoError(t, s.SaveAccount(context.Background(), account))
+
+	eventStore := &activity.InMemoryEventStore{}
+	permissionsManager := permissions.NewManager(s)
+	am := DefaultAccountManager{
+		Store:              s,
+		eventStore:         eventStore,
+		permissionsManager: permissionsManager,
+	}
+	permissionsManager.SetAccountManager(&am)
+
+	_, err = am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenName, mockExpiresIn,
+		[]types.PATScope{{Module: "unknown", Operations: []operations.Operation{operations.Read}}})
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.InvalidArgument, sErr.Type())
+
+	pat, err := am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenName, mockExpiresIn, []types.PATScope{
+		{Module: modules.Peers, Operations: []operations.Operation{operations.Read}},
+		{Module: modules.Policies, Operations: []operations.Operation{operations.Read, operations.Update}, ResourceIDs: []string{"policy-1"}},
+	})
+	require.NoError(t, err)
+
+	stored, err := s.GetPATByID(context.Background(), store.LockingStrengthNone, mockUserID, pat.ID)
+	require.NoError(t, err)
+	assert.Equal(t, pat.Scopes, stored.Scopes)
+
+	ctx := nbcontext.SetUserAuthInContext(context.Background(), auth.UserAuth{
+		UserId:    mockUserID,
+		AccountId: mockAccountID,
+		IsPAT:     true,
+		PATID:     pat.ID,
+	})
+
+	allowed, _, err := permissionsManager.ValidateUserPermissions(ctx, mockAccountID, mockUserID, modules.Peers, operations.Read)
+	require.NoError(t, err)
+	assert.True(t, allowed, "granted module and operation")
+
+	allowed, _, err = permissionsManager.ValidateUserResourcePermissions(ctx, mockAccountID, mockUserID, modules.Policies, operations.Update, "policy-1")
+	require.NoError(t, err)
+	assert.True(t, allowed, "granted resource")
+
+	allowed, _, err = permissionsManager.ValidateUserResourcePermissions(ctx, mockAccountID, mockUserID, modules.Policies, operations.Update, "policy-2")
+	require.NoError(t, err)
+	assert.False(t, allowed, "resource outside of the scope")
+
+	allowed, _, err = permissionsManager.ValidateUserPermissions(ctx, mockAccountID, mockUserID, modules.Peers, operations.Delete)
+	require.NoError(t, err)
+	assert.False(t, allowed, "operation outside of the scope")
+
+	allowed, _, err = permissionsManager.ValidateUserPermissions(context.Background(), mockAccountID, mockUserID, modules.Peers, operations.Delete)
+	require.NoError(t, err)
+	assert.True(t, allowed, "scopes only apply to requests made with the token")
+
+	assert.Eventually(t, func() bool {
+		events, err := eventStore.GetFiltered(context.Background(), mockAccountID, activity.Filter{
+			Activities: []activity.Activity{activity.PersonalAccessTokenScopeDenied},
+		})
+		return err == nil && len(events) == 2
+	}, time.Second, 10*time.Millisecond, "scope denials are recorded")
+}
+
+func TestUser_CreatePAT_FromScopedToken(t *testing.T) {
+	s, cleanup, err := store.NewTestStoreFromSQL(context.Background(), "", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	account := newAccountWithId(context.Background(), mockAccountID, mockUserID, "", "", "", false)
+	require.NoError(t, s.SaveAccount(context.Background(), account))
+
+	permissionsManager := permissions.NewManager(s)
+	am := DefaultAccountManager{
+		Store:              s,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissionsManager,
+	}
+	permissionsManager.SetAccountManager(&am)
+
+	callerScopes := []types.PATScope{
+		{Module: modules.Pats, Operations: []operations.Operation{operations.Create}},
+		{Module: modules.Peers, Operations: []operations.Operation{operations.Read}},
+	}
+	caller, err := am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenName, mockExpiresIn, callerScopes)
+	require.NoError(t, err)
+
+	userAuthCtx := nbcontext.SetUserAuthInContext(context.Background(), auth.UserAuth{
+		UserId:    mockUserID,
+		AccountId: mockAccountID,
+		IsPAT:     true,
+		PATID:     caller.ID,
+	})
+
+	contexts := map[string]context.Context{
+		"token in context": types.WithAuthenticatedPAT(userAuthCtx, &caller.PersonalAccessToken),
+		"token from store": userAuthCtx,
+	}
+	for name, ctx := range contexts {
+		t.Run(name, func(t *testing.T) {
+			_, err := am.CreatePAT(ctx, mockAccountID, mockUserID, mockUserID, "unscoped", mockExpiresIn, nil)
+			sErr, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, status.PermissionDenied, sErr.Type(), "a scoped token can't mint an unscoped token")
+
+			_, err = am.CreatePAT(ctx, mockAccountID, mockUserID, mockUserID, "broader", mockExpiresIn, []types.PATScope{
+				{Module: modules.Peers, Operations: []operations.Operation{operations.Read, operations.Delete}},
+			})
+			sErr, ok = status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, status.PermissionDenied, sErr.Type(), "a scoped token can't mint a broader token")
+
+			_, err = am.CreatePAT(ctx, mockAccountID, mockUserID, mockUserID, "narrower", mockExpiresIn, []types.PATScope{
+				{Module: modules.Peers, Operations: []operations.Operation{operations.Read}},
+			})
+			assert.NoError(t, err)
+		})
+	}
+
+	// scopes carried in the context are used as is, without looking the token up in the store
+	detached := &types.PersonalAccessToken{ID: "detached", Scopes: []types.PATScope{{Module: modules.Peers, Operations: []operations.Operation{operations.Read}}}}
+	ctx := types.WithAuthenticatedPAT(nbcontext.SetUserAuthInContext(context.Background(), auth.UserAuth{
+		UserId:    mockUserID,
+		AccountId: mockAccountID,
+		IsPAT:     true,
+		PATID:     detached.ID,
+	}), detached)
+	allowed, _, err := permissionsManager.ValidateUserPermissions(ctx, mockAccountID, mockUserID, modules.Peers, operations.Read)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+}
+
 func TestUser_CreatePAT_ForDifferentUser(t *testing.T) {
 	store, cleanup, err := store.NewTestStoreFromSQL(context.Background(), "", t.TempDir())
 	if err != nil {
@@ -124,7 +263,7 @@ func TestUser_CreatePAT_ForDifferentUser(t *testing.T) {
 		permissionsManager: permissionsManager,
 	}
 
-	_, err = am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockTargetUserId, mockTokenName, mockExpiresIn)
+	_, err = am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockTargetUserId, mockTokenName, mockExpiresIn, nil)
 	assert.Errorf(t, err, "Creating PAT for different user should thorw error")
 }
 
@@ -152,7 +291,7 @@ func TestUser_CreatePAT_ForServiceUser(t *testing.T) {
 		permissionsManager: permissionsManager,
 	}
 
-	pat, err := am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockTargetUserId, mockTokenName, mockExpiresIn)
+	pat, err := am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockTargetUserId, mockTokenName, mockExpiresIn, nil)
 	if err != nil {
 		t.Fatalf("Error when adding PAT to user: %s", err)
 	}
@@ -181,7 +320,7 @@ func TestUser_CreatePAT_WithWrongExpiration(t *testing.T) {
 		permissionsManager: permissionsManager,
 	}
 
-	_, err = am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenName, mockWrongExpiresIn)
+	_, err = am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenName, mockWrongExpiresIn, nil)
 	assert.Errorf(t, err, "Wrong expiration should thorw error")
 }
 
@@ -206,7 +345,7 @@ func TestUser_CreatePAT_WithEmptyName(t *testing.T) {
 		permissionsManager: permissionsManager,
 	}
 
-	_, err = am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockEmptyTokenName, mockExpiresIn)
+	_, err = am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockEmptyTokenName, mockExpiresIn, nil)
 	assert.Errorf(t, err, "Wrong expiration should thorw error")
 }
 
@@ -391,13 +530,13 @@ func TestUser_PAT_CrossAccountProtection(t *testing.T) {
 		am, cleanup := setupStore(t)
 		t.Cleanup(cleanup)
 
-		_, err := am.CreatePAT(context.Background(), accountAID, userAID, serviceUserBID, "xss-token", 7)
+		_, err := am.CreatePAT(context.Background(), accountAID, userAID, serviceUserBID, "xss-token", 7, nil)
 		require.Error(t, err, "cross-account CreatePAT must fail")
 
-		_, err = am.CreatePAT(context.Background(), accountAID, userAID, regularUserBID, "xss-token", 7)
+		_, err = am.CreatePAT(context.Background(), accountAID, userAID, regularUserBID, "xss-token", 7, nil)
 		require.Error(t, err, "cross-account CreatePAT for regular user must fail")
 
-		_, err = am.CreatePAT(context.Background(), accountBID, adminBID, serviceUserBID, "legit-token", 7)
+		_, err = am.CreatePAT(context.Background(), accountBID, adminBID, serviceUserBID, "legit-token", 7, nil)
 		require.NoError(t, err, "same-account CreatePAT should succeed")
 	})
 
@@ -429,7 +568,7 @@ func TestUser_PAT_CrossAccountProtection(t *testing.T) {
 		am, cleanup := setupStore(t)
 		t.Cleanup(cleanup)
 
-		_, err := am.CreatePAT(context.Background(), accountAID, userAID, adminBID, "forged", 7)
+		_, err := am.CreatePAT(context.Background(), accountAID, userAID, adminBID, "forged", 7, nil)
 		require.Error(t, err, "forged accountID CreatePAT must fail")
 	})
 }
@@ -1500,6 +1639,48 @@ func TestDefaultAccountManager_SaveUser(t *testing.T) {
 	}
 }
 
+func TestUser_SaveUserCustomRole(t *testing.T) {
+	manager, _, err := createManager(t)
+	require.NoError(t, err)
+
+	ownerUserID := "ownerUserID"
+	adminUserID := "adminUserID"
+	regularUserID := "regularUserID"
+
+	account, err := manager.GetOrCreateAccountByUser(context.Background(), auth.UserAuth{UserId: ownerUserID, Domain: "netbird.io"})
+	require.NoError(t, err)
+	account.Users[regularUserID] = types.NewRegularUser(regularUserID, "", "")
+	account.Users[adminUserID] = types.NewAdminUser(adminUserID)
+	require.NoError(t, manager.Store.SaveAccount(context.Background(), account))
+
+	routesRole := customroles.NewRole(account.Id, "Routes", "", roles.Permissions{modules.Routes: {operations.Update: true}}, nil)
+	require.NoError(t, manager.Store.CreateCustomRole(context.Background(), routesRole))
+	accountsRole := customroles.NewRole(account.Id, "Accounts", "", roles.Permissions{modules.Accounts: {operations.Delete: true}}, nil)
+	require.NoError(t, manager.Store.CreateCustomRole(context.Background(), accountsRole))
+
+	_, err = manager.SaveUser(context.Background(), account.Id, adminUserID, &types.User{Id: regularUserID, Role: "missing-role"})
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.InvalidArgument, sErr.Type(), "unknown custom roles are rejected")
+
+	_, err = manager.SaveUser(context.Background(), account.Id, adminUserID, &types.User{Id: regularUserID, Role: types.UserRole(accountsRole.ID)})
+	sErr, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.PermissionDenied, sErr.Type(), "admins can't assign roles granting more than they hold")
+
+	updated, err := manager.SaveUser(context.Background(), account.Id, adminUserID, &types.User{Id: regularUserID, Role: types.UserRole(routesRole.ID)})
+	require.NoError(t, err)
+	assert.Equal(t, routesRole.ID, updated.Role)
+
+	allowed, _, err := manager.permissionsManager.ValidateUserPermissions(context.Background(), account.Id, regularUserID, modules.Routes, operations.Update)
+	require.NoError(t, err)
+	assert.True(t, allowed, "custom role grants route updates")
+
+	allowed, _, err = manager.permissionsManager.ValidateUserPermissions(context.Background(), account.Id, regularUserID, modules.Peers, operations.Read)
+	require.NoError(t, err)
+	assert.False(t, allowed, "custom role grants nothing beyond its permissions")
+}
+
 func TestUserAccountPeersUpdate(t *testing.T) {
 	// account groups propagation is enabled
 	manager, updateManager, account, peer1, peer2, peer3 := setupNetworkMapTest(t)
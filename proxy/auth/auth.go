@@ -18,6 +18,8 @@ const (
 	MethodPIN      Method = "pin"
 	MethodOIDC     Method = "oidc"
 	MethodHeader   Method = "header"
+	MethodTOTP     Method = "totp"
+	MethodWebAuthn Method = "webauthn"
 )
 
 func (m Method) String() string {
@@ -83,6 +85,18 @@ func ValidateSessionJWT(tokenString, domain string, publicKey ed25519.PublicKey)
 	return sub, emailClaim, methodClaim, groups, groupNames, nil
 }
 
+// SessionJWTID returns the "jti" claim of a session token without verifying
+// it. Callers must validate the token with ValidateSessionJWT first. Tokens
+// minted without an ID yield an empty string.
+func SessionJWTID(tokenString string) string {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(tokenString, claims); err != nil {
+		return ""
+	}
+	jti, _ := claims["jti"].(string)
+	return jti
+}
+
 // extractGroupsClaim decodes the "groups" claim into a string slice. The JWT
 // library decodes JSON arrays as []interface{}, so we coerce element-wise
 // and skip non-string entries silently.
@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -17,6 +18,8 @@ import (
 
 	"github.com/netbirdio/netbird/client/embed"
 	"github.com/netbirdio/netbird/proxy"
+	"github.com/netbirdio/netbird/proxy/internal/accesslog"
+	"github.com/netbirdio/netbird/proxy/internal/accesslog/sink"
 	nbacme "github.com/netbirdio/netbird/proxy/internal/acme"
 	"github.com/netbirdio/netbird/trustedproxy"
 	"github.com/netbirdio/netbird/util"
@@ -72,6 +75,7 @@ var (
 	wildcardCertDir       string
 	wgPort                uint16
 	proxyProtocol         bool
+	http3Enabled          bool
 	preSharedKey          string
 	supportsCustomPorts   bool
 	requireSubdomain      bool
@@ -79,6 +83,8 @@ var (
 	geoDataDir            string
 	crowdsecAPIURL        string
 	crowdsecAPIKey        string
+	accessLogSinks        string
+	accessLogManagement   string
 )
 
 var rootCmd = &cobra.Command{
@@ -115,6 +121,7 @@ func init() {
 	rootCmd.Flags().StringVar(&wildcardCertDir, "wildcard-cert-dir", envStringOrDefault("NB_PROXY_WILDCARD_CERT_DIR", ""), "Directory containing wildcard certificate pairs (<name>.crt/<name>.key). Wildcard patterns are extracted from SANs automatically")
 	rootCmd.Flags().Uint16Var(&wgPort, "wg-port", envUint16OrDefault("NB_PROXY_WG_PORT", 0), "WireGuard listen port (0 = random). Fixed port only works with single-account deployments")
 	rootCmd.Flags().BoolVar(&proxyProtocol, "proxy-protocol", envBoolOrDefault("NB_PROXY_PROXY_PROTOCOL", false), "Enable PROXY protocol on TCP listeners to preserve client IPs behind L4 proxies")
+	rootCmd.Flags().BoolVar(&http3Enabled, "http3", envBoolOrDefault("NB_PROXY_HTTP3", false), "Serve HTTP/3 (QUIC) on the UDP port matching the listen address and advertise it via Alt-Svc")
 	rootCmd.Flags().StringVar(&preSharedKey, "preshared-key", envStringOrDefault("NB_PROXY_PRESHARED_KEY", ""), "Define a pre-shared key for the tunnel between proxy and peers")
 	rootCmd.Flags().BoolVar(&supportsCustomPorts, "supports-custom-ports", envBoolOrDefault("NB_PROXY_SUPPORTS_CUSTOM_PORTS", true), "Whether the proxy can bind arbitrary ports for UDP/TCP passthrough")
 	rootCmd.Flags().BoolVar(&requireSubdomain, "require-subdomain", envBoolOrDefault("NB_PROXY_REQUIRE_SUBDOMAIN", false), "Require a subdomain label in front of the cluster domain")
@@ -125,6 +132,8 @@ func init() {
 	rootCmd.Flags().StringVar(&geoDataDir, "geo-data-dir", envStringOrDefault("NB_PROXY_GEO_DATA_DIR", "/var/lib/netbird/geolocation"), "Directory for the GeoLite2 MMDB file (auto-downloaded if missing)")
 	rootCmd.Flags().StringVar(&crowdsecAPIURL, "crowdsec-api-url", envStringOrDefault("NB_PROXY_CROWDSEC_API_URL", ""), "CrowdSec LAPI URL for IP reputation checks")
 	rootCmd.Flags().StringVar(&crowdsecAPIKey, "crowdsec-api-key", envStringOrDefault("NB_PROXY_CROWDSEC_API_KEY", ""), "CrowdSec bouncer API key")
+	rootCmd.Flags().StringVar(&accessLogSinks, "access-log-sinks", envStringOrDefault("NB_PROXY_ACCESS_LOG_SINKS", ""), "Comma-separated access log sink URIs (e.g. 'file:///var/log/netbird/access.log,syslog+tls://siem:6514,otlp+https://collector:4318,loki+http://loki:3100')")
+	rootCmd.Flags().StringVar(&accessLogManagement, "access-log-management", envStringOrDefault("NB_PROXY_ACCESS_LOG_MANAGEMENT", "full"), "Access log entries sent to management: full, or usage to keep full entries on the sinks only")
 }
 
 // Execute runs the root command.
@@ -215,6 +224,14 @@ func runServer(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid --trusted-proxies: %w", err)
 	}
 
+	sinkURIs := strings.Split(accessLogSinks, ",")
+	if _, err := sink.ParseConfigs(sinkURIs); err != nil {
+		return fmt.Errorf("invalid --access-log-sinks: %w", err)
+	}
+	if _, err := accesslog.ParseManagementMode(accessLogManagement); err != nil {
+		return fmt.Errorf("invalid --access-log-management: %w", err)
+	}
+
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
 	defer stop()
 
@@ -244,6 +261,7 @@ func runServer(cmd *cobra.Command, args []string) error {
 		WireguardPort:            wgPort,
 		Performance:              perf,
 		ProxyProtocol:            proxyProtocol,
+		HTTP3:                    http3Enabled,
 		PreSharedKey:             preSharedKey,
 		SupportsCustomPorts:      supportsCustomPorts,
 		RequireSubdomain:         requireSubdomain,
@@ -254,6 +272,8 @@ func runServer(cmd *cobra.Command, args []string) error {
 		GeoDataDir:               geoDataDir,
 		CrowdSecAPIURL:           crowdsecAPIURL,
 		CrowdSecAPIKey:           crowdsecAPIKey,
+		AccessLogSinks:           sinkURIs,
+		AccessLogManagementMode:  accessLogManagement,
 	})
 
 	return srv.ListenAndServe(ctx, addr)
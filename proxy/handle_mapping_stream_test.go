@@ -3,14 +3,20 @@ package proxy
 import (
 	"context"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/netbirdio/netbird/proxy/internal/accesslog"
 	"github.com/netbirdio/netbird/proxy/internal/health"
 	"github.com/netbirdio/netbird/shared/management/proto"
 )
@@ -102,3 +108,42 @@ func TestHandleMappingStream_NilHealthChecker(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, syncDone, "sync done flag should be set even without health checker")
 }
+
+func TestHandleMappingStream_AppliesClusterConfig(t *testing.T) {
+	s := &Server{
+		Logger:      log.StandardLogger(),
+		routerReady: closedChan(),
+		accessLog:   accesslog.NewLogger(nil, log.StandardLogger(), nil),
+	}
+
+	path := filepath.Join(t.TempDir(), "access.log")
+	stream := &mockMappingStream{
+		messages: []*proto.GetMappingUpdateResponse{
+			{
+				InitialSyncComplete: true,
+				ClusterConfig: &proto.ProxyClusterConfig{
+					AccessLogSinks:      []string{"gopher://invalid", "file://" + path},
+					AccessLogManagement: "usage",
+				},
+			},
+		},
+	}
+
+	syncDone := false
+	require.NoError(t, s.handleMappingStream(context.Background(), stream, &syncDone, time.Time{}))
+
+	// Usage mode keeps the entry off the nil management client, the valid
+	// sink receives it and the invalid one is skipped.
+	srv := httptest.NewServer(s.accessLog.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+	resp, err := http.Get(srv.URL + "/app")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	srv.Close()
+	s.accessLog.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"/app"`)
+}
@@ -0,0 +1,92 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/quic-go/quic-go/http3"
+)
+
+// startHTTP3 binds a UDP socket on the main listener's host and port and
+// serves HTTP/3 on it with the same handler chain and certificates as the
+// TCP path. PROXY protocol does not apply to QUIC, so client addresses are
+// always taken from the UDP source.
+func (s *Server) startHTTP3(ctx context.Context, handler http.Handler, tlsConfig *tls.Config, tcpAddr net.Addr) error {
+	host, _, err := net.SplitHostPort(s.ListenAddr)
+	if err != nil {
+		return fmt.Errorf("parse listen address %s: %w", s.ListenAddr, err)
+	}
+	port := tcpPort(tcpAddr)
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+
+	lc := net.ListenConfig{}
+	conn, err := lc.ListenPacket(ctx, "udp", addr)
+	if err != nil {
+		return fmt.Errorf("listen on udp %s: %w", addr, err)
+	}
+
+	s.http3Conn = conn
+	s.http3 = &http3.Server{
+		Addr:        addr,
+		Port:        port,
+		Handler:     handler,
+		TLSConfig:   tlsConfig,
+		IdleTimeout: httpIdleTimeout,
+	}
+
+	go func() {
+		s.Logger.Debugf("starting HTTP/3 server on udp %s", conn.LocalAddr())
+		if serveErr := s.http3.Serve(conn); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+			s.recordRunErr(fmt.Errorf("http3 server: %w", serveErr))
+		}
+	}()
+	return nil
+}
+
+// advertiseHTTP3 adds an Alt-Svc header announcing the HTTP/3 listener to
+// responses served over TCP. It is a no-op when HTTP/3 is disabled.
+func (s *Server) advertiseHTTP3(next http.Handler) http.Handler {
+	if !s.HTTP3 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor < 3 && s.http3 != nil {
+			if err := s.http3.SetQUICHeaders(w.Header()); err != nil {
+				s.Logger.Debugf("set Alt-Svc header: %v", err)
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// shutdownHTTP3 sends GOAWAY to HTTP/3 clients, waits for in-flight
+// requests until ctx is done and closes the UDP socket.
+func (s *Server) shutdownHTTP3(ctx context.Context) {
+	if s.http3 == nil {
+		return
+	}
+	if err := s.http3.Shutdown(ctx); err != nil {
+		s.Logger.Warnf("http3 server drain: %v", err)
+	}
+	if err := s.http3Conn.Close(); err != nil {
+		s.Logger.Debugf("close http3 socket: %v", err)
+	}
+}
+
+// tcpPort returns the port of a bound TCP address.
+func tcpPort(addr net.Addr) int {
+	if tcpAddr, ok := addr.(*net.TCPAddr); ok {
+		return tcpAddr.Port
+	}
+	_, portStr, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return 0
+	}
+	port, _ := strconv.Atoi(portStr)
+	return port
+}
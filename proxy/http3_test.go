@@ -0,0 +1,74 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go/http3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_HTTP3(t *testing.T) {
+	certPEM, keyPEM := generateCertWithSANs(t, []string{"svc.example.com"})
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+
+	// The UDP socket follows the port of the main TCP listener.
+	tcpLn, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = tcpLn.Close() }()
+
+	s := &Server{
+		Logger:     quietLifecycleLogger(),
+		ListenAddr: "127.0.0.1:0",
+		HTTP3:      true,
+		runErrCh:   make(chan struct{}),
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, r.Proto)
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, s.startHTTP3(ctx, handler, &tls.Config{Certificates: []tls.Certificate{cert}}, tcpLn.Addr()))
+	defer s.shutdownHTTP3(ctx)
+
+	port := tcpLn.Addr().(*net.TCPAddr).Port
+	assert.Equal(t, port, s.http3Conn.LocalAddr().(*net.UDPAddr).Port)
+
+	t.Run("serves requests over QUIC", func(t *testing.T) {
+		roots := x509.NewCertPool()
+		require.True(t, roots.AppendCertsFromPEM(certPEM))
+		tr := &http3.Transport{TLSClientConfig: &tls.Config{RootCAs: roots, ServerName: "svc.example.com"}}
+		defer func() { _ = tr.Close() }()
+
+		client := &http.Client{Transport: tr, Timeout: 5 * time.Second}
+		resp, err := client.Get("https://127.0.0.1:" + strconv.Itoa(port) + "/")
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "HTTP/3.0", string(body))
+	})
+
+	t.Run("advertises Alt-Svc on TCP responses", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		s.advertiseHTTP3(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, `h3=":`+strconv.Itoa(port)+`"; ma=2592000`, rec.Header().Get("Alt-Svc"))
+	})
+
+	t.Run("does not advertise when disabled", func(t *testing.T) {
+		disabled := &Server{Logger: quietLifecycleLogger()}
+		rec := httptest.NewRecorder()
+		disabled.advertiseHTTP3(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Empty(t, rec.Header().Get("Alt-Svc"))
+	})
+}
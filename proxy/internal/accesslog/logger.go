@@ -2,6 +2,7 @@ package accesslog
 
 import (
 	"context"
+	"fmt"
 	"maps"
 	"net/netip"
 	"sync"
@@ -14,6 +15,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/netbirdio/netbird/proxy/auth"
+	"github.com/netbirdio/netbird/proxy/internal/accesslog/sink"
 	"github.com/netbirdio/netbird/proxy/internal/types"
 	"github.com/netbirdio/netbird/shared/management/proto"
 	"github.com/netbirdio/netbird/trustedproxy"
@@ -63,7 +65,33 @@ type denyBucket struct {
 	suppressed int64
 }
 
-// Logger sends access log entries to the management server via gRPC.
+// ManagementMode controls which access log entries are sent to management.
+type ManagementMode string
+
+const (
+	// ManagementModeFull sends every entry to management.
+	ManagementModeFull ManagementMode = "full"
+	// ManagementModeUsage sends only the usage-only form of agent-network
+	// entries, which management needs for usage and cost accounting. Full
+	// entries are delivered to the configured sinks only.
+	ManagementModeUsage ManagementMode = "usage"
+)
+
+// ParseManagementMode validates a management mode name. Empty selects
+// ManagementModeFull.
+func ParseManagementMode(mode string) (ManagementMode, error) {
+	switch ManagementMode(mode) {
+	case "", ManagementModeFull:
+		return ManagementModeFull, nil
+	case ManagementModeUsage:
+		return ManagementModeUsage, nil
+	default:
+		return "", fmt.Errorf("invalid access log management mode %q: must be full or usage", mode)
+	}
+}
+
+// Logger sends access log entries to the management server via gRPC and
+// to any configured sinks.
 type Logger struct {
 	client         gRPCClient
 	logger         *log.Logger
@@ -78,6 +106,15 @@ type Logger struct {
 	logSem        chan struct{}
 	cleanupCancel context.CancelFunc
 	dropped       atomic.Int64
+
+	sinks          []*sink.Sink
+	managementMode ManagementMode
+
+	// clusterMu guards the sinks and management mode management selected
+	// for the proxy's cluster. They are replaced on every mapping snapshot.
+	clusterMu    sync.RWMutex
+	clusterSinks []*sink.Sink
+	clusterMode  ManagementMode
 }
 
 // NewLogger creates a new access log Logger. The trustedProxies parameter
@@ -97,6 +134,7 @@ func NewLogger(client gRPCClient, logger *log.Logger, trustedProxies *trustedpro
 		denyBuckets:    make(map[denyBucketKey]*denyBucket),
 		logSem:         make(chan struct{}, maxLogWorkers),
 		cleanupCancel:  cancel,
+		managementMode: ManagementModeFull,
 	}
 
 	// Start background cleanup routine
@@ -105,11 +143,48 @@ func NewLogger(client gRPCClient, logger *log.Logger, trustedProxies *trustedpro
 	return l
 }
 
-// Close stops the cleanup routine. Should be called during graceful shutdown.
+// SetSinks configures the sinks every access log entry is delivered to in
+// addition to management. It must be called before the logger is used.
+func (l *Logger) SetSinks(sinks []*sink.Sink) {
+	l.sinks = sinks
+}
+
+// SetManagementMode selects which entries are sent to management. It must
+// be called before the logger is used.
+func (l *Logger) SetManagementMode(mode ManagementMode) {
+	l.managementMode = mode
+}
+
+// SetClusterSinks replaces the sinks and management mode selected for the
+// proxy's cluster by management. The cluster sinks are used in addition to
+// the sinks set with SetSinks; an empty mode keeps the local mode. The
+// previous cluster sinks are drained and closed. Safe for concurrent use.
+func (l *Logger) SetClusterSinks(sinks []*sink.Sink, mode ManagementMode) {
+	l.clusterMu.Lock()
+	previous := l.clusterSinks
+	l.clusterSinks = sinks
+	l.clusterMode = mode
+	l.clusterMu.Unlock()
+
+	l.closeSinks(previous)
+}
+
+// Close stops the cleanup routine and drains the sinks. Should be called
+// during graceful shutdown.
 func (l *Logger) Close() {
 	if l.cleanupCancel != nil {
 		l.cleanupCancel()
 	}
+	l.closeSinks(l.sinks)
+	l.SetClusterSinks(nil, "")
+}
+
+func (l *Logger) closeSinks(sinks []*sink.Sink) {
+	for _, s := range sinks {
+		if err := s.Close(); err != nil {
+			l.logger.Debugf("close access log sink %s: %v", s.Name(), err)
+		}
+	}
 }
 
 type logEntry struct {
@@ -271,7 +346,68 @@ func stripAgentNetworkEntryForUsage(entry logEntry) logEntry {
 	return entry
 }
 
+// log delivers entry to the sinks and, depending on the management mode,
+// to management.
 func (l *Logger) log(entry logEntry) {
+	l.clusterMu.RLock()
+	clusterSinks, mode := l.clusterSinks, l.managementMode
+	if l.clusterMode != "" {
+		mode = l.clusterMode
+	}
+	l.clusterMu.RUnlock()
+
+	now := time.Now()
+	if len(l.sinks) > 0 || len(clusterSinks) > 0 {
+		rec := entry.toRecord(now)
+		for _, s := range l.sinks {
+			s.Enqueue(rec)
+		}
+		for _, s := range clusterSinks {
+			s.Enqueue(rec)
+		}
+	}
+
+	if mode == ManagementModeUsage {
+		if !entry.AgentNetwork {
+			return
+		}
+		entry = stripAgentNetworkEntryForUsage(entry)
+	}
+	l.send(entry, now)
+}
+
+// toRecord converts the entry into its sink representation.
+func (e logEntry) toRecord(ts time.Time) sink.Record {
+	rec := sink.Record{
+		Timestamp:     ts,
+		ID:            e.ID,
+		AccountID:     string(e.AccountID),
+		ServiceID:     string(e.ServiceID),
+		Protocol:      string(e.Protocol),
+		Host:          e.Host,
+		Path:          e.Path,
+		Method:        e.Method,
+		ResponseCode:  e.ResponseCode,
+		DurationMs:    e.DurationMs,
+		AuthMechanism: e.AuthMechanism,
+		AuthSuccess:   e.AuthSuccess,
+		BytesUpload:   e.BytesUpload,
+		BytesDownload: e.BytesDownload,
+		AgentNetwork:  e.AgentNetwork,
+		Metadata:      e.Metadata,
+	}
+	// Only OIDC sessions have a meaningful user identity.
+	if e.AuthMechanism == auth.MethodOIDC.String() {
+		rec.UserID = e.UserID
+	}
+	if e.SourceIP.IsValid() {
+		rec.SourceIP = e.SourceIP.String()
+	}
+	return rec
+}
+
+// send ships entry to management.
+func (l *Logger) send(entry logEntry, ts time.Time) {
 	// Fire off the log request in a separate routine.
 	// This increases the possibility of losing a log message
 	// (although it should still get logged in the event of an error),
@@ -280,7 +416,7 @@ func (l *Logger) log(entry logEntry) {
 	// There is also a chance that log messages will arrive at
 	// the server out of order; however, the timestamp should
 	// allow for resolving that on the server.
-	now := timestamppb.Now()
+	now := timestamppb.New(ts)
 	select {
 	case l.logSem <- struct{}{}:
 	default:
@@ -91,14 +91,15 @@ func (l *Logger) Middleware(next http.Handler) http.Handler {
 		// Emit the access log unless the matched target opted out
 		// (agent-network synth targets do this when the account's
 		// EnableLogCollection toggle is off). For agent-network entries we
-		// still ship a stripped, usage-only record even when suppressed, so
-		// usage/cost is collected regardless of the log-collection toggle;
-		// request detail and prompt capture are dropped before sending.
+		// still ship a stripped, usage-only record to management even when
+		// suppressed, so usage/cost is collected regardless of the
+		// log-collection toggle; request detail and prompt capture are
+		// dropped before sending, and sinks never see the entry.
 		switch {
 		case !capturedData.GetSuppressAccessLog():
 			l.log(entry)
 		case entry.AgentNetwork:
-			l.log(stripAgentNetworkEntryForUsage(entry))
+			l.send(stripAgentNetworkEntryForUsage(entry), time.Now())
 		}
 
 		// Track usage for cost monitoring (upload + download) by domain
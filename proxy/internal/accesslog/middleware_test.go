@@ -2,8 +2,11 @@ package accesslog
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -13,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 
+	"github.com/netbirdio/netbird/proxy/internal/accesslog/sink"
 	"github.com/netbirdio/netbird/proxy/internal/proxy"
 	"github.com/netbirdio/netbird/shared/management/proto"
 )
@@ -183,3 +187,110 @@ func hostNoPort(url string) string {
 	}
 	return host
 }
+
+// TestMiddleware_UsageManagementMode_SinksOnly asserts that in usage mode a
+// regular request reaches the configured sinks but is not sent to management.
+func TestMiddleware_UsageManagementMode_SinksOnly(t *testing.T) {
+	client := newRecorderClient()
+	l := NewLogger(client, nil, nil)
+
+	path := filepath.Join(t.TempDir(), "access.log")
+	cfg, err := sink.ParseConfig("file://" + path)
+	require.NoError(t, err)
+	s, err := sink.New(cfg, nil, nil)
+	require.NoError(t, err)
+	l.SetSinks([]*sink.Sink{s})
+	l.SetManagementMode(ManagementModeUsage)
+
+	inner := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	srv := httptest.NewServer(l.Middleware(inner))
+	t.Cleanup(srv.Close)
+
+	resp, err := http.Get(srv.URL + "/service/healthz")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	// Close drains the sinks, so the file is complete afterwards.
+	l.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var rec sink.Record
+	require.NoError(t, json.Unmarshal(data, &rec))
+	assert.Equal(t, "/service/healthz", rec.Path)
+	assert.Equal(t, int32(http.StatusTeapot), rec.ResponseCode)
+	assert.Equal(t, int64(1), s.Stats().Written)
+
+	select {
+	case <-client.called:
+		t.Fatal("usage mode must not send regular entries to management")
+	case <-time.After(150 * time.Millisecond):
+	}
+}
+
+// TestMiddleware_ClusterSinks asserts that the sinks and mode selected for
+// the cluster apply until they are replaced, and that replacing them drains
+// the previous sinks.
+func TestMiddleware_ClusterSinks(t *testing.T) {
+	client := newRecorderClient()
+	l := NewLogger(client, nil, nil)
+	t.Cleanup(l.Close)
+
+	path := filepath.Join(t.TempDir(), "access.log")
+	cfg, err := sink.ParseConfig("file://" + path)
+	require.NoError(t, err)
+	s, err := sink.New(cfg, nil, nil)
+	require.NoError(t, err)
+	l.SetClusterSinks([]*sink.Sink{s}, ManagementModeUsage)
+
+	inner := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	srv := httptest.NewServer(l.Middleware(inner))
+	t.Cleanup(srv.Close)
+
+	resp, err := http.Get(srv.URL + "/cluster")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	select {
+	case <-client.called:
+		t.Fatal("the cluster usage mode must not send regular entries to management")
+	case <-time.After(150 * time.Millisecond):
+	}
+
+	// Clearing the cluster sinks drains them and restores the local mode.
+	l.SetClusterSinks(nil, "")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var rec sink.Record
+	require.NoError(t, json.Unmarshal(data, &rec))
+	assert.Equal(t, "/cluster", rec.Path)
+
+	resp, err = http.Get(srv.URL + "/local")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	select {
+	case <-client.called:
+	case <-time.After(2 * time.Second):
+		t.Fatal("the local full mode must send entries to management again")
+	}
+	assert.Equal(t, int64(1), s.Stats().Written)
+}
+
+func TestParseManagementMode(t *testing.T) {
+	mode, err := ParseManagementMode("")
+	require.NoError(t, err)
+	assert.Equal(t, ManagementModeFull, mode)
+
+	mode, err = ParseManagementMode("usage")
+	require.NoError(t, err)
+	assert.Equal(t, ManagementModeUsage, mode)
+
+	_, err = ParseManagementMode("none")
+	assert.Error(t, err)
+}
@@ -0,0 +1,112 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// fileWriter appends records as JSON lines and rotates the file once it
+// would grow past maxSize, keeping up to maxBackups rotated files named
+// <path>.1 (newest) to <path>.<maxBackups> (oldest).
+type fileWriter struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	f    *os.File
+	size int64
+	buf  bytes.Buffer
+}
+
+func newFileWriter(path string, maxSize int64, maxBackups int) (*fileWriter, error) {
+	w := &fileWriter{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("create log directory: %w", err)
+	}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *fileWriter) open() error {
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return fmt.Errorf("open log file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("stat log file: %w", err)
+	}
+	w.f = f
+	w.size = info.Size()
+	return nil
+}
+
+func (w *fileWriter) Write(_ context.Context, records []Record) error {
+	if w.f == nil {
+		if err := w.open(); err != nil {
+			return err
+		}
+	}
+
+	w.buf.Reset()
+	enc := json.NewEncoder(&w.buf)
+	for i := range records {
+		if err := enc.Encode(&records[i]); err != nil {
+			return fmt.Errorf("encode record: %w", err)
+		}
+	}
+
+	if w.size > 0 && w.size+int64(w.buf.Len()) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := w.f.Write(w.buf.Bytes())
+	w.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("write log file: %w", err)
+	}
+	return nil
+}
+
+func (w *fileWriter) rotate() error {
+	if err := w.f.Close(); err != nil {
+		return fmt.Errorf("close log file: %w", err)
+	}
+	w.f = nil
+
+	if w.maxBackups == 0 {
+		if err := os.Remove(w.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove log file: %w", err)
+		}
+		return w.open()
+	}
+
+	for i := w.maxBackups - 1; i >= 1; i-- {
+		src := fmt.Sprintf("%s.%d", w.path, i)
+		if err := os.Rename(src, fmt.Sprintf("%s.%d", w.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("rotate %s: %w", src, err)
+		}
+	}
+	if err := os.Rename(w.path, w.path+".1"); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("rotate log file: %w", err)
+	}
+	return w.open()
+}
+
+func (w *fileWriter) Close() error {
+	if w.f == nil {
+		return nil
+	}
+	err := w.f.Close()
+	w.f = nil
+	return err
+}
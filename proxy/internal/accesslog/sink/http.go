@@ -0,0 +1,57 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxErrorBodyBytes bounds how much of a rejected push response is read
+// into the returned error.
+const maxErrorBodyBytes = 512
+
+// httpPusher POSTs JSON payloads to a collector endpoint.
+type httpPusher struct {
+	endpoint string
+	headers  http.Header
+	client   *http.Client
+}
+
+func newHTTPPusher(endpoint string, headers http.Header) httpPusher {
+	return httpPusher{
+		endpoint: endpoint,
+		headers:  headers,
+		client:   &http.Client{Timeout: writeTimeout},
+	}
+}
+
+func (p httpPusher) push(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	for name, values := range p.headers {
+		req.Header[name] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("push to %s: %w", req.URL.Host, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return fmt.Errorf("push to %s: unexpected status %d: %s", req.URL.Host, resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+func (p httpPusher) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
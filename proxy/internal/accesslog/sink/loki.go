@@ -0,0 +1,78 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const lokiJobLabel = "netbird-proxy"
+
+// lokiWriter pushes records to the Loki push API. Records are grouped into
+// one stream per account, service and protocol; the JSON record is the
+// log line.
+type lokiWriter struct {
+	httpPusher
+}
+
+func newLokiWriter(endpoint string, headers http.Header) *lokiWriter {
+	return &lokiWriter{httpPusher: newHTTPPusher(endpoint, headers)}
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+type lokiPushRequest struct {
+	Streams []*lokiStream `json:"streams"`
+}
+
+type lokiStreamKey struct {
+	accountID string
+	serviceID string
+	protocol  string
+}
+
+func (w *lokiWriter) Write(ctx context.Context, records []Record) error {
+	var req lokiPushRequest
+	streams := make(map[lokiStreamKey]*lokiStream)
+	for i := range records {
+		rec := &records[i]
+		line, err := json.Marshal(rec)
+		if err != nil {
+			return fmt.Errorf("encode record: %w", err)
+		}
+
+		key := lokiStreamKey{accountID: rec.AccountID, serviceID: rec.ServiceID, protocol: rec.Protocol}
+		stream, ok := streams[key]
+		if !ok {
+			stream = &lokiStream{Stream: lokiLabels(key)}
+			streams[key] = stream
+			req.Streams = append(req.Streams, stream)
+		}
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(rec.Timestamp.UnixNano(), 10), string(line)})
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("encode Loki push: %w", err)
+	}
+	return w.push(ctx, body)
+}
+
+func lokiLabels(key lokiStreamKey) map[string]string {
+	labels := map[string]string{"job": lokiJobLabel}
+	if key.accountID != "" {
+		labels["account_id"] = key.accountID
+	}
+	if key.serviceID != "" {
+		labels["service_id"] = key.serviceID
+	}
+	if key.protocol != "" {
+		labels["protocol"] = key.protocol
+	}
+	return labels
+}
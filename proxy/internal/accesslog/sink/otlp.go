@@ -0,0 +1,164 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+const (
+	otlpScopeName   = "netbird.proxy.accesslog"
+	otlpServiceName = "netbird-proxy"
+	// otlpSeverityInfo is SEVERITY_NUMBER_INFO from the OTLP logs data model.
+	otlpSeverityInfo = 9
+)
+
+// otlpWriter exports records as OTLP logs over HTTP using the JSON
+// encoding of ExportLogsServiceRequest.
+type otlpWriter struct {
+	httpPusher
+}
+
+func newOTLPWriter(endpoint string, headers http.Header) *otlpWriter {
+	return &otlpWriter{httpPusher: newHTTPPusher(endpoint, headers)}
+}
+
+type otlpAnyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano         string         `json:"timeUnixNano"`
+	ObservedTimeUnixNano string         `json:"observedTimeUnixNano"`
+	SeverityNumber       int            `json:"severityNumber"`
+	SeverityText         string         `json:"severityText"`
+	Body                 otlpAnyValue   `json:"body"`
+	Attributes           []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeLogs struct {
+	Scope      map[string]string `json:"scope"`
+	LogRecords []otlpLogRecord   `json:"logRecords"`
+}
+
+type otlpResourceLogs struct {
+	Resource  map[string][]otlpKeyValue `json:"resource"`
+	ScopeLogs []otlpScopeLogs           `json:"scopeLogs"`
+}
+
+type otlpExportRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+func otlpString(key, v string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: &v}}
+}
+
+func otlpInt(key string, v int64) otlpKeyValue {
+	s := strconv.FormatInt(v, 10)
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{IntValue: &s}}
+}
+
+func otlpBool(key string, v bool) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{BoolValue: &v}}
+}
+
+func (w *otlpWriter) Write(ctx context.Context, records []Record) error {
+	logRecords := make([]otlpLogRecord, 0, len(records))
+	for i := range records {
+		logRecords = append(logRecords, toOTLPLogRecord(&records[i]))
+	}
+	body, err := json.Marshal(otlpExportRequest{
+		ResourceLogs: []otlpResourceLogs{{
+			Resource: map[string][]otlpKeyValue{
+				"attributes": {otlpString("service.name", otlpServiceName)},
+			},
+			ScopeLogs: []otlpScopeLogs{{
+				Scope:      map[string]string{"name": otlpScopeName},
+				LogRecords: logRecords,
+			}},
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("encode OTLP logs: %w", err)
+	}
+	return w.push(ctx, body)
+}
+
+// toOTLPLogRecord maps a record onto OpenTelemetry semantic convention
+// attribute names where one exists and netbird.* names otherwise.
+func toOTLPLogRecord(rec *Record) otlpLogRecord {
+	ts := strconv.FormatInt(rec.Timestamp.UnixNano(), 10)
+	body := summary(rec)
+
+	attrs := []otlpKeyValue{
+		otlpString("netbird.request_id", rec.ID),
+		otlpString("netbird.account_id", rec.AccountID),
+		otlpString("netbird.service_id", rec.ServiceID),
+		otlpString("network.protocol.name", rec.Protocol),
+		otlpInt("netbird.duration_ms", rec.DurationMs),
+		otlpBool("netbird.auth.success", rec.AuthSuccess),
+		otlpInt("netbird.bytes_upload", rec.BytesUpload),
+		otlpInt("netbird.bytes_download", rec.BytesDownload),
+	}
+	if rec.Host != "" {
+		attrs = append(attrs, otlpString("server.address", rec.Host))
+	}
+	if rec.Path != "" {
+		attrs = append(attrs, otlpString("url.path", rec.Path))
+	}
+	if rec.Method != "" {
+		attrs = append(attrs, otlpString("http.request.method", rec.Method))
+	}
+	if rec.ResponseCode != 0 {
+		attrs = append(attrs, otlpInt("http.response.status_code", int64(rec.ResponseCode)))
+	}
+	if rec.SourceIP != "" {
+		attrs = append(attrs, otlpString("client.address", rec.SourceIP))
+	}
+	if rec.AuthMechanism != "" {
+		attrs = append(attrs, otlpString("netbird.auth.mechanism", rec.AuthMechanism))
+	}
+	if rec.UserID != "" {
+		attrs = append(attrs, otlpString("enduser.id", rec.UserID))
+	}
+	if rec.AgentNetwork {
+		attrs = append(attrs, otlpBool("netbird.agent_network", true))
+	}
+
+	keys := make([]string, 0, len(rec.Metadata))
+	for k := range rec.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		attrs = append(attrs, otlpString("netbird.metadata."+k, rec.Metadata[k]))
+	}
+
+	return otlpLogRecord{
+		TimeUnixNano:         ts,
+		ObservedTimeUnixNano: ts,
+		SeverityNumber:       otlpSeverityInfo,
+		SeverityText:         "INFO",
+		Body:                 otlpAnyValue{StringValue: &body},
+		Attributes:           attrs,
+	}
+}
+
+// summary is the human-readable one-line form of a record.
+func summary(rec *Record) string {
+	if rec.Method != "" {
+		return fmt.Sprintf("%s %s%s %d", rec.Method, rec.Host, rec.Path, rec.ResponseCode)
+	}
+	return fmt.Sprintf("%s %s %s", rec.Protocol, rec.Host, rec.SourceIP)
+}
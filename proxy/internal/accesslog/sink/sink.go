@@ -0,0 +1,221 @@
+// Package sink delivers proxy access log records to destinations other than
+// management: rotating local files, syslog, OTLP log collectors and Loki.
+// Every sink owns a bounded buffer drained by a single goroutine, so a slow
+// or unreachable destination drops records instead of stalling requests.
+package sink
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultBufferSize is the number of records a sink buffers before
+	// new records are dropped.
+	DefaultBufferSize = 10000
+	// maxBatchSize caps how many records are handed to a Writer at once.
+	maxBatchSize = 500
+	// flushInterval bounds how long a partial batch waits for more records.
+	flushInterval = time.Second
+	// writeTimeout bounds a single batch delivery.
+	writeTimeout = 10 * time.Second
+	// closeTimeout bounds how long Close waits for buffered records to drain.
+	closeTimeout = 5 * time.Second
+	// errorLogInterval rate-limits delivery error logs per sink.
+	errorLogInterval = 30 * time.Second
+)
+
+// Record is the sink representation of an access log entry.
+type Record struct {
+	Timestamp     time.Time         `json:"timestamp"`
+	ID            string            `json:"id"`
+	AccountID     string            `json:"account_id,omitempty"`
+	ServiceID     string            `json:"service_id,omitempty"`
+	Protocol      string            `json:"protocol,omitempty"`
+	Host          string            `json:"host,omitempty"`
+	Path          string            `json:"path,omitempty"`
+	Method        string            `json:"method,omitempty"`
+	ResponseCode  int32             `json:"response_code,omitempty"`
+	DurationMs    int64             `json:"duration_ms"`
+	SourceIP      string            `json:"source_ip,omitempty"`
+	AuthMechanism string            `json:"auth_mechanism,omitempty"`
+	UserID        string            `json:"user_id,omitempty"`
+	AuthSuccess   bool              `json:"auth_success"`
+	BytesUpload   int64             `json:"bytes_upload"`
+	BytesDownload int64             `json:"bytes_download"`
+	AgentNetwork  bool              `json:"agent_network,omitempty"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
+}
+
+// Writer delivers batches of records to one destination. Write and Close
+// are only called from the owning sink's goroutine.
+type Writer interface {
+	Write(ctx context.Context, records []Record) error
+	Close() error
+}
+
+// Observer receives per-sink delivery counters. All methods must be safe
+// for concurrent use.
+type Observer interface {
+	AccessLogSinkWritten(sink string, n int)
+	AccessLogSinkDropped(sink string, n int)
+	AccessLogSinkFailed(sink string, n int)
+}
+
+// Stats are the cumulative delivery counters of a sink.
+type Stats struct {
+	Written int64
+	Dropped int64
+	Failed  int64
+}
+
+// Sink buffers records and delivers them in batches to a Writer.
+type Sink struct {
+	name     string
+	writer   Writer
+	observer Observer
+	logger   *log.Logger
+
+	mu     sync.RWMutex
+	closed bool
+	queue  chan Record
+	done   chan struct{}
+
+	written atomic.Int64
+	dropped atomic.Int64
+	failed  atomic.Int64
+
+	lastErrorLog time.Time
+}
+
+func newSink(name string, writer Writer, bufferSize int, observer Observer, logger *log.Logger) *Sink {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	if logger == nil {
+		logger = log.StandardLogger()
+	}
+	s := &Sink{
+		name:     name,
+		writer:   writer,
+		observer: observer,
+		logger:   logger,
+		queue:    make(chan Record, bufferSize),
+		done:     make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Name identifies the sink in logs and metrics. It never contains
+// credentials.
+func (s *Sink) Name() string {
+	return s.name
+}
+
+// Stats returns the sink's delivery counters.
+func (s *Sink) Stats() Stats {
+	return Stats{
+		Written: s.written.Load(),
+		Dropped: s.dropped.Load(),
+		Failed:  s.failed.Load(),
+	}
+}
+
+// Enqueue hands rec to the sink without blocking. It reports false and
+// counts the record as dropped when the buffer is full or the sink is
+// closed.
+func (s *Sink) Enqueue(rec Record) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.closed {
+		select {
+		case s.queue <- rec:
+			return true
+		default:
+		}
+	}
+	s.dropped.Add(1)
+	if s.observer != nil {
+		s.observer.AccessLogSinkDropped(s.name, 1)
+	}
+	return false
+}
+
+// Close stops accepting records, waits a bounded time for buffered
+// records to be delivered and closes the writer.
+func (s *Sink) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.queue)
+	s.mu.Unlock()
+
+	select {
+	case <-s.done:
+		return s.writer.Close()
+	case <-time.After(closeTimeout):
+		s.logger.Warnf("access log sink %s: timed out draining buffered records", s.name)
+		return nil
+	}
+}
+
+func (s *Sink) run() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Record, 0, maxBatchSize)
+	for {
+		select {
+		case rec, ok := <-s.queue:
+			if !ok {
+				s.flush(batch)
+				return
+			}
+			batch = append(batch, rec)
+			if len(batch) >= maxBatchSize {
+				batch = s.flush(batch)
+			}
+		case <-ticker.C:
+			batch = s.flush(batch)
+		}
+	}
+}
+
+// flush delivers batch and returns it emptied for reuse.
+func (s *Sink) flush(batch []Record) []Record {
+	if len(batch) == 0 {
+		return batch
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	err := s.writer.Write(ctx, batch)
+	cancel()
+
+	n := len(batch)
+	if err != nil {
+		s.failed.Add(int64(n))
+		if s.observer != nil {
+			s.observer.AccessLogSinkFailed(s.name, n)
+		}
+		if now := time.Now(); now.Sub(s.lastErrorLog) >= errorLogInterval {
+			s.lastErrorLog = now
+			s.logger.Warnf("access log sink %s: failed to deliver %d records (total failed: %d): %v", s.name, n, s.failed.Load(), err)
+		}
+	} else {
+		s.written.Add(int64(n))
+		if s.observer != nil {
+			s.observer.AccessLogSinkWritten(s.name, n)
+		}
+	}
+	clear(batch)
+	return batch[:0]
+}
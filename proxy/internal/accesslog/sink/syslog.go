@@ -0,0 +1,135 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	syslogAppName = "netbird-proxy"
+	syslogMsgID   = "access"
+	// syslogPriority is facility local0 (16) with severity informational (6).
+	syslogPriority    = 16*8 + 6
+	syslogDialTimeout = 5 * time.Second
+)
+
+// syslogWriter sends RFC 5424 messages with the JSON record as MSG. UDP
+// sends one datagram per record; TCP and TLS use octet-counting framing
+// (RFC 6587 / RFC 5425).
+type syslogWriter struct {
+	network   string
+	address   string
+	tlsConfig *tls.Config
+	hostname  string
+	procID    string
+
+	conn net.Conn
+	buf  bytes.Buffer
+}
+
+func newSyslogWriter(network, address string, tlsConfig *tls.Config) *syslogWriter {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &syslogWriter{
+		network:   network,
+		address:   address,
+		tlsConfig: tlsConfig,
+		hostname:  hostname,
+		procID:    strconv.Itoa(os.Getpid()),
+	}
+}
+
+func (w *syslogWriter) dial(ctx context.Context) error {
+	dialer := &net.Dialer{Timeout: syslogDialTimeout}
+	var (
+		conn net.Conn
+		err  error
+	)
+	switch w.network {
+	case "tls":
+		td := &tls.Dialer{NetDialer: dialer, Config: w.tlsConfig}
+		conn, err = td.DialContext(ctx, "tcp", w.address)
+	default:
+		conn, err = dialer.DialContext(ctx, w.network, w.address)
+	}
+	if err != nil {
+		return fmt.Errorf("dial syslog %s: %w", w.address, err)
+	}
+	w.conn = conn
+	return nil
+}
+
+func (w *syslogWriter) Write(ctx context.Context, records []Record) error {
+	for i := range records {
+		msg, err := w.format(&records[i])
+		if err != nil {
+			return err
+		}
+		if err := w.send(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// send writes one message, redialling once when the connection was lost.
+func (w *syslogWriter) send(ctx context.Context, msg []byte) error {
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if w.conn == nil {
+			if err = w.dial(ctx); err != nil {
+				return err
+			}
+		}
+		if deadline, ok := ctx.Deadline(); ok {
+			_ = w.conn.SetWriteDeadline(deadline)
+		}
+		if _, err = w.conn.Write(msg); err == nil {
+			return nil
+		}
+		_ = w.conn.Close()
+		w.conn = nil
+	}
+	return fmt.Errorf("write syslog %s: %w", w.address, err)
+}
+
+// format renders rec as
+// <PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID - JSON
+// prefixed with its length for stream transports.
+func (w *syslogWriter) format(rec *Record) ([]byte, error) {
+	body, err := json.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("encode record: %w", err)
+	}
+
+	w.buf.Reset()
+	fmt.Fprintf(&w.buf, "<%d>1 %s %s %s %s %s - ",
+		syslogPriority, rec.Timestamp.UTC().Format(time.RFC3339Nano), w.hostname, syslogAppName, w.procID, syslogMsgID)
+	w.buf.Write(body)
+
+	if w.network == "udp" {
+		return bytes.Clone(w.buf.Bytes()), nil
+	}
+	framed := make([]byte, 0, w.buf.Len()+8)
+	framed = strconv.AppendInt(framed, int64(w.buf.Len()), 10)
+	framed = append(framed, ' ')
+	return append(framed, w.buf.Bytes()...), nil
+}
+
+func (w *syslogWriter) Close() error {
+	if w.conn == nil {
+		return nil
+	}
+	err := w.conn.Close()
+	w.conn = nil
+	return err
+}
@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/proxy/internal/proxy"
+	"github.com/netbirdio/netbird/proxy/web"
+)
+
+// AuthorizationRule restricts requests under PathPrefix, optionally only
+// for the listed methods, to the listed users and members of the listed
+// groups. Rules are evaluated after authentication in order and the first
+// match decides; requests matching no rule are allowed. PathPrefix matches
+// whole path segments, so "/admin" covers "/admin" and "/admin/users" but
+// not "/administrator".
+type AuthorizationRule struct {
+	PathPrefix string
+	// Methods are upper-case HTTP methods; empty matches every method.
+	Methods []string
+	Groups  []string
+	Users   []string
+}
+
+// matches reports whether the rule applies to a request with the given
+// clean path.
+func (r *AuthorizationRule) matches(method, reqPath string) bool {
+	prefix := strings.TrimSuffix(r.PathPrefix, "/")
+	if prefix != "" && reqPath != prefix && !strings.HasPrefix(reqPath, prefix+"/") {
+		return false
+	}
+	return len(r.Methods) == 0 || slices.Contains(r.Methods, method)
+}
+
+// cleanRequestPath returns the clean form of p, keeping a trailing slash,
+// and whether p already was in that form. Paths such as "//admin" or
+// "/x/../admin" are not clean and could otherwise slip past a rule prefix.
+func cleanRequestPath(p string) (string, bool) {
+	if p == "" {
+		return "/", true
+	}
+	cleaned := path.Clean(p)
+	if cleaned != "/" && strings.HasSuffix(p, "/") {
+		cleaned += "/"
+	}
+	return cleaned, cleaned == p
+}
+
+func (r *AuthorizationRule) allows(userID string, groups []string) bool {
+	if userID != "" && slices.Contains(r.Users, userID) {
+		return true
+	}
+	for _, g := range groups {
+		if slices.Contains(r.Groups, g) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetAuthorizationRules replaces the authorization rules for a domain
+// registered with AddDomain. Unknown domains are ignored.
+func (mw *Middleware) SetAuthorizationRules(domain string, rules []AuthorizationRule) {
+	mw.domainsMux.Lock()
+	defer mw.domainsMux.Unlock()
+	config, ok := mw.domains[domain]
+	if !ok {
+		return
+	}
+	config.AuthorizationRules = rules
+	mw.domains[domain] = config
+}
+
+// authorize wraps next with the domain's authorization rules. It reads the
+// identity the authentication paths stored in CapturedData, so it must only
+// be reached after authentication succeeded. Without captured data no
+// identity is known and a matching rule denies the request. Requests whose
+// path is not in clean form are rejected, as the backend may resolve them to
+// a path a rule would have matched.
+func (mw *Middleware) authorize(next http.Handler, rules []AuthorizationRule) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqPath, clean := cleanRequestPath(r.URL.Path)
+		if !clean {
+			var requestID string
+			if cd := proxy.CapturedDataFromContext(r.Context()); cd != nil {
+				cd.SetOrigin(proxy.OriginAuth)
+				requestID = cd.GetRequestID()
+			}
+			mw.logger.WithFields(log.Fields{
+				"host": r.Host,
+				"path": r.URL.Path,
+			}).Debug("request with non-canonical path rejected by authorization rules")
+			web.ServeAccessDeniedPage(w, r, http.StatusBadRequest, "Bad Request",
+				"The request path is not in canonical form.", requestID)
+			return
+		}
+
+		idx := slices.IndexFunc(rules, func(rule AuthorizationRule) bool { return rule.matches(r.Method, reqPath) })
+		if idx < 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rule := &rules[idx]
+		cd := proxy.CapturedDataFromContext(r.Context())
+		if cd != nil && rule.allows(cd.GetUserID(), cd.GetUserGroups()) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var requestID string
+		if cd != nil {
+			cd.SetOrigin(proxy.OriginAuth)
+			cd.SetMetadata("authz.denied_path", rule.PathPrefix)
+			requestID = cd.GetRequestID()
+			mw.logger.WithFields(log.Fields{
+				"host":    r.Host,
+				"path":    r.URL.Path,
+				"method":  r.Method,
+				"user_id": cd.GetUserID(),
+				"rule":    rule.PathPrefix,
+			}).Debug("request denied by authorization rule")
+		}
+		web.ServeAccessDeniedPage(w, r, http.StatusForbidden, "Access Denied",
+			"You are not authorized to access this page.", requestID)
+	})
+}
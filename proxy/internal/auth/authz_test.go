@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/internals/modules/reverseproxy/sessionkey"
+	"github.com/netbirdio/netbird/proxy/auth"
+	"github.com/netbirdio/netbird/proxy/internal/proxy"
+)
+
+func TestProtect_AuthorizationRules(t *testing.T) {
+	mw := NewMiddleware(log.StandardLogger(), nil, nil)
+	kp := generateTestKeyPair(t)
+
+	scheme := &stubScheme{method: auth.MethodPIN, promptID: "pin"}
+	require.NoError(t, mw.AddDomain("example.com", []Scheme{scheme}, kp.PublicKey, time.Hour, "", "", nil, false))
+	mw.SetAuthorizationRules("example.com", []AuthorizationRule{
+		{PathPrefix: "/admin", Groups: []string{"admins"}},
+		{PathPrefix: "/api", Methods: []string{http.MethodDelete}, Users: []string{"owner"}},
+	})
+
+	tests := []struct {
+		name       string
+		userID     string
+		groups     []string
+		method     string
+		path       string
+		wantAllow  bool
+		wantStatus int
+	}{
+		{name: "unmatched path is allowed", userID: "dev", groups: []string{"engineering"}, method: http.MethodGet, path: "/public", wantAllow: true},
+		{name: "group member is allowed", userID: "dev", groups: []string{"admins"}, method: http.MethodGet, path: "/admin/users", wantAllow: true},
+		{name: "non-member is denied", userID: "dev", groups: []string{"engineering"}, method: http.MethodGet, path: "/admin/users"},
+		{name: "unscoped method is allowed", userID: "dev", method: http.MethodGet, path: "/api/items", wantAllow: true},
+		{name: "scoped method is denied", userID: "dev", method: http.MethodDelete, path: "/api/items"},
+		{name: "listed user is allowed", userID: "owner", method: http.MethodDelete, path: "/api/items", wantAllow: true},
+		{name: "exact prefix is matched", userID: "dev", method: http.MethodGet, path: "/admin"},
+		{name: "prefix matches whole segments only", userID: "dev", method: http.MethodGet, path: "/administrator", wantAllow: true},
+		{name: "double slash is rejected", userID: "dev", method: http.MethodGet, path: "//admin", wantStatus: http.StatusBadRequest},
+		{name: "dot segment is rejected", userID: "dev", method: http.MethodGet, path: "/./admin", wantStatus: http.StatusBadRequest},
+		{name: "dot-dot segment is rejected", userID: "dev", method: http.MethodGet, path: "/x/../admin", wantStatus: http.StatusBadRequest},
+		{name: "trailing slash is matched", userID: "dev", method: http.MethodGet, path: "/admin/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := sessionkey.SignToken(kp.PrivateKey, tt.userID, "", "example.com", auth.MethodPIN, tt.groups, nil, time.Hour)
+			require.NoError(t, err)
+
+			var backendCalled bool
+			handler := mw.Protect(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				backendCalled = true
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			capturedData := proxy.NewCapturedData("")
+			req := httptest.NewRequest(tt.method, "http://example.com"+tt.path, nil)
+			req = req.WithContext(proxy.WithCapturedData(req.Context(), capturedData))
+			req.AddCookie(&http.Cookie{Name: auth.SessionCookieName, Value: token})
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantAllow, backendCalled)
+			if tt.wantAllow {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				return
+			}
+			wantStatus := tt.wantStatus
+			if wantStatus == 0 {
+				wantStatus = http.StatusForbidden
+			}
+			assert.Equal(t, wantStatus, rec.Code)
+			assert.Equal(t, proxy.OriginAuth, capturedData.GetOrigin())
+		})
+	}
+}
+
+func TestProtect_AuthorizationRulesSharedHandler(t *testing.T) {
+	mw := NewMiddleware(log.StandardLogger(), nil, nil)
+	kp := generateTestKeyPair(t)
+
+	scheme := &stubScheme{method: auth.MethodPIN, promptID: "pin"}
+	require.NoError(t, mw.AddDomain("restricted.example.com", []Scheme{scheme}, kp.PublicKey, time.Hour, "", "", nil, false))
+	require.NoError(t, mw.AddDomain("open.example.com", []Scheme{scheme}, kp.PublicKey, time.Hour, "", "", nil, false))
+	mw.SetAuthorizationRules("restricted.example.com", []AuthorizationRule{{PathPrefix: "/", Users: []string{"owner"}}})
+
+	// One handler serves every domain, as it does in the proxy.
+	handler := mw.Protect(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(domain string) int {
+		token, err := sessionkey.SignToken(kp.PrivateKey, "dev", "", domain, auth.MethodPIN, nil, nil, time.Hour)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "http://"+domain+"/", nil)
+		req = req.WithContext(proxy.WithCapturedData(req.Context(), proxy.NewCapturedData("")))
+		req.AddCookie(&http.Cookie{Name: auth.SessionCookieName, Value: token})
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, http.StatusForbidden, serve("restricted.example.com"))
+		}()
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, http.StatusOK, serve("open.example.com"), "rules of another domain must not apply")
+		}()
+	}
+	wg.Wait()
+
+	mw.SetAuthorizationRules("restricted.example.com", nil)
+	assert.Equal(t, http.StatusOK, serve("restricted.example.com"), "removed rules must no longer apply")
+}
+
+func TestAuthorize_DeniesWithoutCapturedData(t *testing.T) {
+	mw := NewMiddleware(log.StandardLogger(), nil, nil)
+
+	var backendCalled bool
+	handler := mw.authorize(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		backendCalled = true
+	}), []AuthorizationRule{{PathPrefix: "/", Users: []string{"owner"}}})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "http://example.com/", nil))
+
+	assert.False(t, backendCalled, "matching rule without a known identity must fail closed")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
@@ -50,6 +50,8 @@ func (h Header) Authenticate(r *http.Request) (string, string, error) {
 	res, err := h.client.Authenticate(r.Context(), &proto.AuthenticateRequest{
 		Id:        string(h.id),
 		AccountId: string(h.accountId),
+		ClientIp:  requestClientIP(r),
+		UserAgent: r.UserAgent(),
 		Request: &proto.AuthenticateRequest_HeaderAuth{
 			HeaderAuth: &proto.HeaderAuthRequest{
 				HeaderValue: value,
@@ -59,6 +59,12 @@ type DomainConfig struct {
 	IPRestrictions    *restrict.Filter
 	// Private routes the domain through ValidateTunnelPeer; failure → 403.
 	Private bool
+	// RevokedSessions holds session JWT IDs revoked by management; cookies
+	// carrying one of them are no longer accepted.
+	RevokedSessions map[string]struct{}
+	// AuthorizationRules gate paths by user and group once the request is
+	// authenticated.
+	AuthorizationRules []AuthorizationRule
 }
 
 type validationResult struct {
@@ -119,13 +125,19 @@ func (mw *Middleware) Protect(next http.Handler) http.Handler {
 		// Set account and service IDs in captured data for access logging.
 		setCapturedIDs(r, config)
 
+		// The rules wrap a request-local handler, next is shared by every domain.
+		h := next
+		if len(config.AuthorizationRules) > 0 {
+			h = mw.authorize(next, config.AuthorizationRules)
+		}
+
 		if !mw.checkIPRestrictions(w, r, config) {
 			return
 		}
 
 		// Private services bypass operator schemes and gate on tunnel peer.
 		if config.Private {
-			if mw.forwardWithTunnelPeer(w, r, host, config, next) {
+			if mw.forwardWithTunnelPeer(w, r, host, config, h) {
 				return
 			}
 			http.Error(w, "Forbidden", http.StatusForbidden)
@@ -134,7 +146,7 @@ func (mw *Middleware) Protect(next http.Handler) http.Handler {
 
 		// Domains with no authentication schemes pass through after IP checks.
 		if len(config.Schemes) == 0 {
-			next.ServeHTTP(w, r)
+			h.ServeHTTP(w, r)
 			return
 		}
 
@@ -142,15 +154,15 @@ func (mw *Middleware) Protect(next http.Handler) http.Handler {
 			return
 		}
 
-		if mw.forwardWithSessionCookie(w, r, host, config, next) {
+		if mw.forwardWithSessionCookie(w, r, host, config, h) {
 			return
 		}
 
-		if mw.forwardWithHeaderAuth(w, r, host, config, next) {
+		if mw.forwardWithHeaderAuth(w, r, host, config, h) {
 			return
 		}
 
-		if mw.forwardWithTunnelPeer(w, r, host, config, next) {
+		if mw.forwardWithTunnelPeer(w, r, host, config, h) {
 			return
 		}
 
@@ -220,7 +232,7 @@ func (mw *Middleware) checkIPRestrictions(w http.ResponseWriter, r *http.Request
 		return true
 	}
 
-	clientIP := mw.resolveClientIP(r)
+	clientIP := resolveClientIP(r)
 	if !clientIP.IsValid() {
 		mw.logger.Debugf("IP restriction: cannot resolve client address for %q, denying", r.RemoteAddr)
 		http.Error(w, "Forbidden", http.StatusForbidden)
@@ -263,7 +275,7 @@ func (mw *Middleware) checkIPRestrictions(w http.ResponseWriter, r *http.Request
 }
 
 // resolveClientIP extracts the real client IP from CapturedData, falling back to r.RemoteAddr.
-func (mw *Middleware) resolveClientIP(r *http.Request) netip.Addr {
+func resolveClientIP(r *http.Request) netip.Addr {
 	if cd := proxy.CapturedDataFromContext(r.Context()); cd != nil {
 		if ip := cd.GetClientIP(); ip.IsValid() {
 			return ip
@@ -281,6 +293,16 @@ func (mw *Middleware) resolveClientIP(r *http.Request) netip.Addr {
 	return addr.Unmap()
 }
 
+// requestClientIP returns the resolved client IP as reported to management
+// when a session is issued, or an empty string when it cannot be determined.
+func requestClientIP(r *http.Request) string {
+	ip := resolveClientIP(r)
+	if !ip.IsValid() {
+		return ""
+	}
+	return ip.String()
+}
+
 // blockIPRestriction sets captured data fields for an IP-restriction block event.
 func (mw *Middleware) blockIPRestriction(r *http.Request, reason string) {
 	if cd := proxy.CapturedDataFromContext(r.Context()); cd != nil {
@@ -325,6 +347,13 @@ func (mw *Middleware) forwardWithSessionCookie(w http.ResponseWriter, r *http.Re
 	if err != nil {
 		return false
 	}
+	if _, revoked := config.RevokedSessions[auth.SessionJWTID(cookie.Value)]; revoked {
+		mw.logger.WithFields(log.Fields{
+			"domain":  host,
+			"user_id": userID,
+		}).Debug("Session cookie revoked")
+		return false
+	}
 	if cd := proxy.CapturedDataFromContext(r.Context()); cd != nil {
 		cd.SetUserID(userID)
 		cd.SetUserEmail(email)
@@ -359,7 +388,7 @@ func (mw *Middleware) forwardWithTunnelPeer(w http.ResponseWriter, r *http.Reque
 	if mw.sessionValidator == nil {
 		return false
 	}
-	clientIP := mw.resolveClientIP(r)
+	clientIP := resolveClientIP(r)
 	if !clientIP.IsValid() {
 		return false
 	}
@@ -636,6 +665,10 @@ func wasCredentialSubmitted(r *http.Request, method auth.Method) bool {
 		return r.FormValue("pin") != ""
 	case auth.MethodPassword:
 		return r.FormValue("password") != ""
+	case auth.MethodTOTP:
+		return r.FormValue(totpFormId) != ""
+	case auth.MethodWebAuthn:
+		return r.FormValue(webAuthnCredentialFormId) != ""
 	case auth.MethodOIDC:
 		return r.URL.Query().Get("session_token") != ""
 	}
@@ -679,6 +712,25 @@ func (mw *Middleware) AddDomain(domain string, schemes []Scheme, publicKeyB64 st
 	return nil
 }
 
+// SetRevokedSessions replaces the set of revoked session IDs for a domain
+// registered with AddDomain. Unknown domains are ignored.
+func (mw *Middleware) SetRevokedSessions(domain string, sessionIDs []string) {
+	mw.domainsMux.Lock()
+	defer mw.domainsMux.Unlock()
+	config, ok := mw.domains[domain]
+	if !ok {
+		return
+	}
+	config.RevokedSessions = nil
+	if len(sessionIDs) > 0 {
+		config.RevokedSessions = make(map[string]struct{}, len(sessionIDs))
+		for _, id := range sessionIDs {
+			config.RevokedSessions[id] = struct{}{}
+		}
+	}
+	mw.domains[domain] = config
+}
+
 // RemoveDomain unregisters authentication for the given domain.
 func (mw *Middleware) RemoveDomain(domain string) {
 	mw.domainsMux.Lock()
@@ -264,6 +264,39 @@ func TestProtect_ValidSessionCookiePassesThrough(t *testing.T) {
 	assert.Equal(t, "authenticated", rec.Body.String())
 }
 
+func TestProtect_RevokedSessionCookieIsRejected(t *testing.T) {
+	mw := NewMiddleware(log.StandardLogger(), nil, nil)
+	kp := generateTestKeyPair(t)
+
+	scheme := &stubScheme{method: auth.MethodPIN, promptID: "pin"}
+	require.NoError(t, mw.AddDomain("example.com", []Scheme{scheme}, kp.PublicKey, time.Hour, "", "", nil, false))
+
+	token, sessionID, err := sessionkey.SignTokenWithID(kp.PrivateKey, "test-user", "", "example.com", auth.MethodPIN, nil, nil, time.Hour)
+	require.NoError(t, err)
+	require.NotEmpty(t, sessionID)
+	assert.Equal(t, sessionID, auth.SessionJWTID(token))
+
+	mw.SetRevokedSessions("example.com", []string{sessionID})
+
+	var backendCalled bool
+	handler := mw.Protect(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		backendCalled = true
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+	req.AddCookie(&http.Cookie{Name: auth.SessionCookieName, Value: token})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.False(t, backendCalled, "revoked session cookie must not reach the backend")
+
+	mw.SetRevokedSessions("example.com", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.True(t, backendCalled, "cookie should be accepted once the revocation is lifted")
+}
+
 // TestProtect_SessionCookieGroupsPropagate verifies the cookie path lifts the
 // JWT's groups claim into CapturedData so policy-aware middlewares can
 // authorise without an extra management round-trip.
@@ -1404,3 +1437,90 @@ func TestProtect_TunnelPeerFastPath_TakesPathWithInboundMarker(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rec.Code,
 		"a successful tunnel-peer validation must forward to the next handler")
 }
+
+func TestProtect_TOTPAuthRedirectsWithCookie(t *testing.T) {
+	mw := NewMiddleware(log.StandardLogger(), nil, nil)
+	kp := generateTestKeyPair(t)
+
+	token, err := sessionkey.SignToken(kp.PrivateKey, "alice", "", "example.com", auth.MethodTOTP, nil, nil, time.Hour)
+	require.NoError(t, err)
+
+	mock := &mockAuthenticator{fn: func(_ context.Context, req *proto.AuthenticateRequest) (*proto.AuthenticateResponse, error) {
+		if req.GetTotp().GetUser() == "alice" && req.GetTotp().GetCode() == "123456" {
+			return &proto.AuthenticateResponse{Success: true, SessionToken: token}, nil
+		}
+		return &proto.AuthenticateResponse{Success: false}, nil
+	}}
+	require.NoError(t, mw.AddDomain("example.com", []Scheme{NewTOTP(mock, "svc1", "acc1")}, kp.PublicKey, time.Hour, "acc1", "svc1", nil, false))
+
+	handler := mw.Protect(newPassthroughHandler())
+
+	form := url.Values{"totp_user": {"alice"}, "totp": {"123456"}}
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/somepath", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusSeeOther, rec.Code)
+	assert.Equal(t, "/somepath", rec.Header().Get("Location"))
+}
+
+func TestProtect_FailedTOTPAuthCapturesAuthMethod(t *testing.T) {
+	mw := NewMiddleware(log.StandardLogger(), nil, nil)
+	kp := generateTestKeyPair(t)
+
+	mock := &mockAuthenticator{fn: func(_ context.Context, _ *proto.AuthenticateRequest) (*proto.AuthenticateResponse, error) {
+		return &proto.AuthenticateResponse{Success: false}, nil
+	}}
+	require.NoError(t, mw.AddDomain("example.com", []Scheme{NewTOTP(mock, "svc1", "acc1")}, kp.PublicKey, time.Hour, "acc1", "svc1", nil, false))
+
+	handler := mw.Protect(newPassthroughHandler())
+
+	capturedData := proxy.NewCapturedData("")
+	form := url.Values{"totp_user": {"alice"}, "totp": {"000000"}}
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req = req.WithContext(proxy.WithCapturedData(req.Context(), capturedData))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, "totp", capturedData.GetAuthMethod())
+}
+
+func TestWebAuthn_BeginReturnsChallenge(t *testing.T) {
+	mock := &mockAuthenticator{fn: func(_ context.Context, req *proto.AuthenticateRequest) (*proto.AuthenticateResponse, error) {
+		wa := req.GetWebauthn()
+		require.NotNil(t, wa)
+		assert.Equal(t, proto.WebAuthnStage_WEBAUTHN_STAGE_BEGIN_LOGIN, wa.GetStage())
+		assert.Equal(t, "alice", wa.GetUser())
+		return &proto.AuthenticateResponse{
+			WebauthnOptions:   []byte(`{"publicKey":{}}`),
+			WebauthnSessionId: "session-1",
+		}, nil
+	}}
+	scheme := NewWebAuthn(mock, "svc1", "acc1")
+
+	form := url.Values{"webauthn_user": {"alice"}, "webauthn_stage": {"begin_login"}}
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	token, prompt, err := scheme.Authenticate(req)
+	require.NoError(t, err)
+	assert.Empty(t, token)
+	assert.JSONEq(t, `{"stage":"begin_login","user":"alice","session_id":"session-1","options":{"publicKey":{}}}`, prompt)
+}
+
+func TestWebAuthn_NoStagePrompts(t *testing.T) {
+	mock := &mockAuthenticator{fn: func(_ context.Context, _ *proto.AuthenticateRequest) (*proto.AuthenticateResponse, error) {
+		t.Fatal("management must not be called without a stage")
+		return nil, nil
+	}}
+	scheme := NewWebAuthn(mock, "svc1", "acc1")
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+	token, prompt, err := scheme.Authenticate(req)
+	require.NoError(t, err)
+	assert.Empty(t, token)
+	assert.Equal(t, "webauthn", prompt)
+}
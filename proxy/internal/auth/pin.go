@@ -45,6 +45,8 @@ func (p Pin) Authenticate(r *http.Request) (string, string, error) {
 	res, err := p.client.Authenticate(r.Context(), &proto.AuthenticateRequest{
 		Id:        string(p.id),
 		AccountId: string(p.accountId),
+		ClientIp:  requestClientIP(r),
+		UserAgent: r.UserAgent(),
 		Request: &proto.AuthenticateRequest_Pin{
 			Pin: &proto.PinRequest{
 				Pin: pin,
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/netbirdio/netbird/proxy/auth"
+	"github.com/netbirdio/netbird/proxy/internal/types"
+	"github.com/netbirdio/netbird/shared/management/proto"
+)
+
+const (
+	totpFormId     = "totp"
+	totpUserFormId = "totp_user"
+)
+
+type TOTP struct {
+	id        types.ServiceID
+	accountId types.AccountID
+	client    authenticator
+}
+
+func NewTOTP(client authenticator, id types.ServiceID, accountId types.AccountID) TOTP {
+	return TOTP{
+		id:        id,
+		accountId: accountId,
+		client:    client,
+	}
+}
+
+func (TOTP) Type() auth.Method {
+	return auth.MethodTOTP
+}
+
+// Authenticate attempts to authenticate the request using a user name
+// and a time-based one-time code passed as form values.
+// If authentication fails, the required HTTP form ID is returned
+// so that it can be injected into a request from the UI so that
+// authentication may be successful.
+func (t TOTP) Authenticate(r *http.Request) (string, string, error) {
+	user := r.FormValue(totpUserFormId)
+	code := r.FormValue(totpFormId)
+
+	if user == "" || code == "" {
+		// No code submitted; return the form ID so the UI can prompt the user.
+		return "", totpFormId, nil
+	}
+
+	res, err := t.client.Authenticate(r.Context(), &proto.AuthenticateRequest{
+		Id:        string(t.id),
+		AccountId: string(t.accountId),
+		ClientIp:  requestClientIP(r),
+		UserAgent: r.UserAgent(),
+		Request: &proto.AuthenticateRequest_Totp{
+			Totp: &proto.TOTPRequest{
+				User: user,
+				Code: code,
+			},
+		},
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("authenticate totp: %w", err)
+	}
+
+	if res.GetSuccess() {
+		return res.GetSessionToken(), "", nil
+	}
+
+	return "", totpFormId, nil
+}
@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/netbirdio/netbird/proxy/auth"
+	"github.com/netbirdio/netbird/proxy/internal/types"
+	"github.com/netbirdio/netbird/shared/management/proto"
+)
+
+const (
+	webAuthnFormId           = "webauthn"
+	webAuthnUserFormId       = "webauthn_user"
+	webAuthnStageFormId      = "webauthn_stage"
+	webAuthnEnrollmentFormId = "webauthn_enrollment_code"
+	webAuthnSessionFormId    = "webauthn_session"
+	webAuthnCredentialFormId = "webauthn_credential"
+)
+
+// webAuthnStages maps the stage form values submitted by the login page to
+// the ceremony stage sent to management.
+var webAuthnStages = map[string]proto.WebAuthnStage{
+	"begin_login":         proto.WebAuthnStage_WEBAUTHN_STAGE_BEGIN_LOGIN,
+	"finish_login":        proto.WebAuthnStage_WEBAUTHN_STAGE_FINISH_LOGIN,
+	"begin_registration":  proto.WebAuthnStage_WEBAUTHN_STAGE_BEGIN_REGISTRATION,
+	"finish_registration": proto.WebAuthnStage_WEBAUTHN_STAGE_FINISH_REGISTRATION,
+}
+
+// webAuthnChallenge is returned as prompt data after a begin stage so the
+// login page can run the browser ceremony and submit the finish stage.
+type webAuthnChallenge struct {
+	Stage     string          `json:"stage"`
+	User      string          `json:"user"`
+	SessionID string          `json:"session_id"`
+	Options   json.RawMessage `json:"options"`
+}
+
+type WebAuthn struct {
+	id        types.ServiceID
+	accountId types.AccountID
+	client    authenticator
+}
+
+func NewWebAuthn(client authenticator, id types.ServiceID, accountId types.AccountID) WebAuthn {
+	return WebAuthn{
+		id:        id,
+		accountId: accountId,
+		client:    client,
+	}
+}
+
+func (WebAuthn) Type() auth.Method {
+	return auth.MethodWebAuthn
+}
+
+// Authenticate drives a two-step passkey ceremony through form submissions.
+// A begin stage returns the challenge as prompt data for the login page; a
+// finish stage submits the signed credential and yields a session token on
+// success. Requests without a stage return the form ID so the UI can offer
+// passkey sign-in.
+func (a WebAuthn) Authenticate(r *http.Request) (string, string, error) {
+	stageValue := r.FormValue(webAuthnStageFormId)
+	user := r.FormValue(webAuthnUserFormId)
+	stage, ok := webAuthnStages[stageValue]
+	if !ok || user == "" {
+		return "", webAuthnFormId, nil
+	}
+
+	res, err := a.client.Authenticate(r.Context(), &proto.AuthenticateRequest{
+		Id:        string(a.id),
+		AccountId: string(a.accountId),
+		ClientIp:  requestClientIP(r),
+		UserAgent: r.UserAgent(),
+		Request: &proto.AuthenticateRequest_Webauthn{
+			Webauthn: &proto.WebAuthnRequest{
+				Stage:          stage,
+				User:           user,
+				EnrollmentCode: r.FormValue(webAuthnEnrollmentFormId),
+				SessionId:      r.FormValue(webAuthnSessionFormId),
+				Credential:     []byte(r.FormValue(webAuthnCredentialFormId)),
+			},
+		},
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("authenticate webauthn: %w", err)
+	}
+
+	if res.GetSuccess() {
+		return res.GetSessionToken(), "", nil
+	}
+
+	if len(res.GetWebauthnOptions()) == 0 {
+		return "", webAuthnFormId, nil
+	}
+
+	challenge, err := json.Marshal(webAuthnChallenge{
+		Stage:     stageValue,
+		User:      user,
+		SessionID: res.GetWebauthnSessionId(),
+		Options:   res.GetWebauthnOptions(),
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("encode webauthn challenge: %w", err)
+	}
+	return "", string(challenge), nil
+}
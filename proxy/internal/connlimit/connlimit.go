@@ -0,0 +1,343 @@
+// Package connlimit enforces per-service connection caps and bandwidth
+// shaping for L4 (tcp, tls, udp) relays.
+package connlimit
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// minBurst is the smallest token bucket size. It must be at least the
+// largest single read or UDP datagram so WaitN and AllowN never fail on
+// an oversized request.
+const minBurst = 64 << 10
+
+var (
+	// ErrMaxConnections is returned when the service has reached its
+	// concurrent connection cap.
+	ErrMaxConnections = errors.New("service connection limit reached")
+	// ErrMaxConnectionsPerSource is returned when the client address has
+	// reached its concurrent connection cap.
+	ErrMaxConnectionsPerSource = errors.New("per-source connection limit reached")
+)
+
+// Limits configures a service's connection caps and bandwidth. Zero
+// fields are unlimited. Bandwidths are in bytes per second and apply to
+// each direction independently.
+type Limits struct {
+	// MaxConnections caps concurrent TCP connections or UDP sessions.
+	MaxConnections int
+	// MaxConnectionsPerSource caps concurrent connections or sessions from
+	// a single client IP.
+	MaxConnectionsPerSource int
+	// ConnectionBandwidth caps each connection or session.
+	ConnectionBandwidth int64
+	// AggregateBandwidth caps all connections of the service together.
+	AggregateBandwidth int64
+}
+
+// IsZero reports whether no limit is configured.
+func (l Limits) IsZero() bool {
+	return l == Limits{}
+}
+
+// Limiter tracks the connections of one service. A nil *Limiter imposes
+// no limits, so callers need not special-case unlimited services.
+type Limiter struct {
+	mu        sync.Mutex
+	limits    Limits
+	active    int
+	perSource map[netip.Addr]int
+
+	// upload and download are the aggregate buckets; nil when unlimited.
+	upload   *rate.Limiter
+	download *rate.Limiter
+}
+
+// New returns a limiter for limits, or nil when limits is zero.
+func New(limits Limits) *Limiter {
+	if limits.IsZero() {
+		return nil
+	}
+	return NewTracking(limits)
+}
+
+// NewTracking returns a limiter for limits that counts connections even
+// when limits is zero, so caps set later through SetLimits also cover
+// connections that were opened while the service was unlimited.
+func NewTracking(limits Limits) *Limiter {
+	return &Limiter{
+		limits:    limits,
+		perSource: make(map[netip.Addr]int),
+		upload:    newBucket(limits.AggregateBandwidth),
+		download:  newBucket(limits.AggregateBandwidth),
+	}
+}
+
+// SetLimits replaces the limits in place. Open connections keep their
+// leases and count against the new caps, so a lowered cap only rejects
+// new connections. Per-connection bandwidth applies to new connections.
+func (l *Limiter) SetLimits(limits Limits) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limits == limits {
+		return
+	}
+	l.limits = limits
+	l.upload = updateBucket(l.upload, limits.AggregateBandwidth)
+	l.download = updateBucket(l.download, limits.AggregateBandwidth)
+}
+
+func newBucket(bytesPerSec int64) *rate.Limiter {
+	if bytesPerSec <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(bytesPerSec), int(max(bytesPerSec, minBurst)))
+}
+
+// updateBucket adjusts bucket to bytesPerSec in place, so connections
+// already holding it follow the change, or replaces it when the bandwidth
+// becomes limited or unlimited.
+func updateBucket(bucket *rate.Limiter, bytesPerSec int64) *rate.Limiter {
+	if bucket == nil || bytesPerSec <= 0 {
+		return newBucket(bytesPerSec)
+	}
+	bucket.SetLimit(rate.Limit(bytesPerSec))
+	bucket.SetBurst(int(max(bytesPerSec, minBurst)))
+	return bucket
+}
+
+// Limits returns the configured limits.
+func (l *Limiter) Limits() Limits {
+	if l == nil {
+		return Limits{}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limits
+}
+
+// Active returns the number of connections currently holding a lease.
+func (l *Limiter) Active() int {
+	if l == nil {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.active
+}
+
+// Acquire reserves a connection slot for src. It returns ErrMaxConnections
+// or ErrMaxConnectionsPerSource when a cap is reached. The returned lease
+// must be released when the connection ends. On a nil limiter it returns
+// a nil lease, which is valid and unlimited.
+func (l *Limiter) Acquire(src netip.Addr) (*Lease, error) {
+	if l == nil {
+		return nil, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limits.MaxConnections > 0 && l.active >= l.limits.MaxConnections {
+		return nil, ErrMaxConnections
+	}
+	if l.limits.MaxConnectionsPerSource > 0 && l.perSource[src] >= l.limits.MaxConnectionsPerSource {
+		return nil, ErrMaxConnectionsPerSource
+	}
+	l.active++
+	l.perSource[src]++
+
+	return &Lease{
+		limiter:           l,
+		src:               src,
+		upload:            newBucket(l.limits.ConnectionBandwidth),
+		download:          newBucket(l.limits.ConnectionBandwidth),
+		aggregateUpload:   l.upload,
+		aggregateDownload: l.download,
+	}, nil
+}
+
+func (l *Limiter) release(src netip.Addr) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.active--
+	if l.perSource[src] <= 1 {
+		delete(l.perSource, src)
+		return
+	}
+	l.perSource[src]--
+}
+
+// Lease is one connection's slot and bandwidth allowance. All methods are
+// safe on a nil lease and then impose no limits.
+type Lease struct {
+	limiter  *Limiter
+	src      netip.Addr
+	released atomic.Bool
+
+	// upload and download are the per-connection buckets; nil when
+	// unlimited.
+	upload   *rate.Limiter
+	download *rate.Limiter
+	// aggregateUpload and aggregateDownload are the limiter's aggregate
+	// buckets at acquire time, so the lease never reads them unlocked.
+	aggregateUpload   *rate.Limiter
+	aggregateDownload *rate.Limiter
+}
+
+// Release frees the connection slot. It is idempotent.
+func (le *Lease) Release() {
+	if le == nil || !le.released.CompareAndSwap(false, true) {
+		return
+	}
+	le.limiter.release(le.src)
+}
+
+func (le *Lease) uploadBuckets() []*rate.Limiter {
+	return buckets(le.upload, le.aggregateUpload)
+}
+
+func (le *Lease) downloadBuckets() []*rate.Limiter {
+	return buckets(le.download, le.aggregateDownload)
+}
+
+func buckets(conn, aggregate *rate.Limiter) []*rate.Limiter {
+	var out []*rate.Limiter
+	if conn != nil {
+		out = append(out, conn)
+	}
+	if aggregate != nil {
+		out = append(out, aggregate)
+	}
+	return out
+}
+
+// AllowUpload reports whether a client-to-backend datagram of n bytes
+// fits the bandwidth allowance. Datagrams that do not fit are meant to be
+// dropped, which is how UDP relays police rather than queue traffic.
+func (le *Lease) AllowUpload(n int) bool {
+	if le == nil {
+		return true
+	}
+	return allowAll(le.uploadBuckets(), n)
+}
+
+// AllowDownload is AllowUpload for backend-to-client datagrams.
+func (le *Lease) AllowDownload(n int) bool {
+	if le == nil {
+		return true
+	}
+	return allowAll(le.downloadBuckets(), n)
+}
+
+// allowAll takes n tokens from every bucket or from none. Reservations
+// already made are cancelled when a later bucket refuses.
+func allowAll(limiters []*rate.Limiter, n int) bool {
+	now := time.Now()
+	reservations := make([]*rate.Reservation, 0, len(limiters))
+	for _, lim := range limiters {
+		r := lim.ReserveN(now, n)
+		if !r.OK() || r.DelayFrom(now) > 0 {
+			r.CancelAt(now)
+			for _, prev := range reservations {
+				prev.CancelAt(now)
+			}
+			return false
+		}
+		reservations = append(reservations, r)
+	}
+	return true
+}
+
+// WrapConn shapes a client connection: reads (client to backend) are
+// throttled by the upload buckets and writes (backend to client) by the
+// download buckets. Waiting is aborted when ctx is done. Without
+// bandwidth limits conn is returned unchanged.
+func (le *Lease) WrapConn(ctx context.Context, conn net.Conn) net.Conn {
+	if le == nil {
+		return conn
+	}
+	up, down := le.uploadBuckets(), le.downloadBuckets()
+	if len(up) == 0 && len(down) == 0 {
+		return conn
+	}
+	return &shapedConn{Conn: conn, ctx: ctx, upload: up, download: down}
+}
+
+// halfCloser matches connections that support shutting down the write
+// side while keeping the read side open (e.g. *net.TCPConn).
+type halfCloser interface {
+	CloseWrite() error
+}
+
+// shapedConn throttles a net.Conn with token buckets.
+type shapedConn struct {
+	net.Conn
+	ctx      context.Context
+	upload   []*rate.Limiter
+	download []*rate.Limiter
+}
+
+// Read reads at most minBurst bytes and then waits until the upload
+// buckets have room for what was read.
+func (c *shapedConn) Read(b []byte) (int, error) {
+	if len(c.upload) > 0 && len(b) > minBurst {
+		b = b[:minBurst]
+	}
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		if waitErr := waitAll(c.ctx, c.upload, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
+
+// Write waits for the download buckets before writing each chunk.
+func (c *shapedConn) Write(b []byte) (int, error) {
+	if len(c.download) == 0 {
+		return c.Conn.Write(b)
+	}
+	var written int
+	for len(b) > 0 {
+		chunk := b[:min(len(b), minBurst)]
+		if err := waitAll(c.ctx, c.download, len(chunk)); err != nil {
+			return written, err
+		}
+		n, err := c.Conn.Write(chunk)
+		written += n
+		if err != nil {
+			return written, err
+		}
+		b = b[n:]
+	}
+	return written, nil
+}
+
+// CloseWrite delegates to the underlying connection if it supports
+// half-close, so wrapping does not hide it from the relay.
+func (c *shapedConn) CloseWrite() error {
+	if hc, ok := c.Conn.(halfCloser); ok {
+		return hc.CloseWrite()
+	}
+	return nil
+}
+
+func waitAll(ctx context.Context, limiters []*rate.Limiter, n int) error {
+	for _, lim := range limiters {
+		if err := lim.WaitN(ctx, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package connlimit
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+var (
+	srcA = netip.MustParseAddr("203.0.113.1")
+	srcB = netip.MustParseAddr("203.0.113.2")
+)
+
+func TestNew_ZeroLimitsIsNil(t *testing.T) {
+	var l *Limiter = New(Limits{})
+	assert.Nil(t, l)
+
+	lease, err := l.Acquire(srcA)
+	require.NoError(t, err)
+	assert.True(t, lease.AllowUpload(1<<20))
+	assert.True(t, lease.AllowDownload(1<<20))
+	lease.Release()
+	assert.Zero(t, l.Active())
+}
+
+func TestLimiter_MaxConnections(t *testing.T) {
+	l := New(Limits{MaxConnections: 2})
+
+	first, err := l.Acquire(srcA)
+	require.NoError(t, err)
+	_, err = l.Acquire(srcB)
+	require.NoError(t, err)
+
+	_, err = l.Acquire(srcA)
+	assert.ErrorIs(t, err, ErrMaxConnections)
+
+	first.Release()
+	first.Release() // idempotent
+	assert.Equal(t, 1, l.Active())
+
+	_, err = l.Acquire(srcA)
+	assert.NoError(t, err)
+}
+
+func TestLimiter_MaxConnectionsPerSource(t *testing.T) {
+	l := New(Limits{MaxConnectionsPerSource: 1})
+
+	lease, err := l.Acquire(srcA)
+	require.NoError(t, err)
+
+	_, err = l.Acquire(srcA)
+	assert.ErrorIs(t, err, ErrMaxConnectionsPerSource)
+	_, err = l.Acquire(srcB)
+	assert.NoError(t, err, "other sources are unaffected")
+
+	lease.Release()
+	_, err = l.Acquire(srcA)
+	assert.NoError(t, err)
+}
+
+func TestLease_AllowPolicesDatagrams(t *testing.T) {
+	l := New(Limits{ConnectionBandwidth: 1024})
+	lease, err := l.Acquire(srcA)
+	require.NoError(t, err)
+
+	// The bucket starts full at minBurst; once drained, datagrams drop.
+	assert.True(t, lease.AllowUpload(minBurst))
+	assert.False(t, lease.AllowUpload(512))
+	assert.True(t, lease.AllowDownload(512), "directions are shaped independently")
+}
+
+func TestLease_AggregateSharedAcrossConnections(t *testing.T) {
+	l := New(Limits{AggregateBandwidth: 1024})
+	a, err := l.Acquire(srcA)
+	require.NoError(t, err)
+	b, err := l.Acquire(srcB)
+	require.NoError(t, err)
+
+	assert.True(t, a.AllowUpload(minBurst))
+	assert.False(t, b.AllowUpload(512), "aggregate bucket is shared")
+}
+
+func TestLease_WrapConnThrottles(t *testing.T) {
+	const bandwidth = minBurst
+	l := New(Limits{ConnectionBandwidth: bandwidth})
+	lease, err := l.Acquire(srcA)
+	require.NoError(t, err)
+
+	client, server := net.Pipe()
+	defer func() { _ = client.Close() }()
+	shaped := lease.WrapConn(context.Background(), server)
+	defer func() { _ = shaped.Close() }()
+
+	go func() { _, _ = io.Copy(io.Discard, client) }()
+
+	// The first minBurst bytes are covered by the initial burst; the next
+	// half burst has to wait for roughly half a second of refill.
+	start := time.Now()
+	_, err = shaped.Write(make([]byte, minBurst+minBurst/2))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
+
+func TestLease_WrapConnAbortsOnCancel(t *testing.T) {
+	l := New(Limits{ConnectionBandwidth: 1024})
+	lease, err := l.Acquire(srcA)
+	require.NoError(t, err)
+
+	client, server := net.Pipe()
+	defer func() { _ = client.Close() }()
+	ctx, cancel := context.WithCancel(context.Background())
+	shaped := lease.WrapConn(ctx, server)
+	defer func() { _ = shaped.Close() }()
+	go func() { _, _ = io.Copy(io.Discard, client) }()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := shaped.Write(make([]byte, 2*minBurst))
+		done <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(2 * time.Second):
+		t.Fatal("shaped write did not abort on cancel")
+	}
+}
+
+func TestLease_WrapConnUnlimitedReturnsConn(t *testing.T) {
+	l := New(Limits{MaxConnections: 1})
+	lease, err := l.Acquire(srcA)
+	require.NoError(t, err)
+
+	client, server := net.Pipe()
+	defer func() { _ = client.Close() }()
+	defer func() { _ = server.Close() }()
+	assert.Same(t, server, lease.WrapConn(context.Background(), server))
+}
+
+func TestLimiter_SetLimitsKeepsOpenConnections(t *testing.T) {
+	l := NewTracking(Limits{})
+
+	lease, err := l.Acquire(srcA)
+	require.NoError(t, err, "a tracking limiter without limits admits connections")
+	_, err = l.Acquire(srcA)
+	require.NoError(t, err)
+
+	l.SetLimits(Limits{MaxConnections: 2, MaxConnectionsPerSource: 1})
+	assert.Equal(t, 2, l.Active(), "open connections count against the new caps")
+	_, err = l.Acquire(srcB)
+	assert.ErrorIs(t, err, ErrMaxConnections)
+
+	lease.Release()
+	_, err = l.Acquire(srcA)
+	assert.ErrorIs(t, err, ErrMaxConnectionsPerSource)
+	_, err = l.Acquire(srcB)
+	assert.NoError(t, err)
+}
+
+func TestLimiter_SetLimitsAdjustsAggregateBandwidth(t *testing.T) {
+	l := New(Limits{AggregateBandwidth: minBurst})
+	lease, err := l.Acquire(srcA)
+	require.NoError(t, err)
+
+	l.SetLimits(Limits{AggregateBandwidth: 2 * minBurst})
+	require.Len(t, lease.uploadBuckets(), 1)
+	assert.Equal(t, rate.Limit(2*minBurst), lease.uploadBuckets()[0].Limit(), "open connections share the adjusted bucket")
+}
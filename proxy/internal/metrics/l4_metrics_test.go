@@ -51,6 +51,7 @@ func TestTCPRelayMetrics(t *testing.T) {
 	m.TCPRelayEnded(acct, 10*time.Second, 1000, 500)
 	m.TCPRelayDialError(acct)
 	m.TCPRelayRejected(acct)
+	m.TCPRelayLimited(acct)
 }
 
 func TestUDPSessionMetrics(t *testing.T) {
@@ -63,6 +64,7 @@ func TestUDPSessionMetrics(t *testing.T) {
 	m.UDPSessionEnded(acct)
 	m.UDPSessionDialError(acct)
 	m.UDPSessionRejected(acct)
+	m.UDPSessionLimited(acct)
 	m.UDPPacketRelayed(types.RelayDirectionClientToBackend, 100)
 	m.UDPPacketRelayed(types.RelayDirectionClientToBackend, 200)
 	m.UDPPacketRelayed(types.RelayDirectionBackendToClient, 150)
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
@@ -46,6 +47,13 @@ type Metrics struct {
 	udpPacketsTotal  metric.Int64Counter
 	udpBytesTotal    metric.Int64Counter
 
+	// HTTP request mirroring metrics.
+	mirrorRequestsTotal metric.Int64Counter
+	mirrorDuration      metric.Int64Histogram
+
+	// Access log sink delivery metrics.
+	accessLogSinkRecords metric.Int64Counter
+
 	mappingsMux  sync.Mutex
 	mappingPaths map[string]int
 }
@@ -74,6 +82,12 @@ func New(ctx context.Context, meter metric.Meter) (*Metrics, error) {
 	if err := m.initL4Metrics(meter); err != nil {
 		return nil, err
 	}
+	if err := m.initMirrorMetrics(meter); err != nil {
+		return nil, err
+	}
+	if err := m.initAccessLogSinkMetrics(meter); err != nil {
+		return nil, err
+	}
 
 	return m, nil
 }
@@ -279,6 +293,37 @@ func (m *Metrics) initL4Metrics(meter metric.Meter) error {
 	return err
 }
 
+func (m *Metrics) initMirrorMetrics(meter metric.Meter) error {
+	var err error
+
+	m.mirrorRequestsTotal, err = meter.Int64Counter(
+		"proxy.mirror.requests.total",
+		metric.WithUnit("1"),
+		metric.WithDescription("Total mirrored HTTP requests by result and service"),
+	)
+	if err != nil {
+		return err
+	}
+
+	m.mirrorDuration, err = meter.Int64Histogram(
+		"proxy.mirror.duration.ms",
+		metric.WithUnit("milliseconds"),
+		metric.WithDescription("Duration of mirrored HTTP requests to the mirror target"),
+	)
+	return err
+}
+
+func (m *Metrics) initAccessLogSinkMetrics(meter metric.Meter) error {
+	var err error
+
+	m.accessLogSinkRecords, err = meter.Int64Counter(
+		"proxy.accesslog.sink.records.total",
+		metric.WithUnit("1"),
+		metric.WithDescription("Access log records handled by each sink by result (written, dropped, failed)"),
+	)
+	return err
+}
+
 type responseInterceptor struct {
 	*responsewriter.PassthroughWriter
 	status int
@@ -305,22 +350,36 @@ func (w *responseInterceptor) Unwrap() http.ResponseWriter {
 // Middleware wraps an HTTP handler with request metrics.
 func (m *Metrics) Middleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		m.requestsTotal.Add(m.ctx, 1)
-		m.activeRequests.Add(m.ctx, 1)
+		proto := metric.WithAttributes(attribute.String("protocol", requestProtocol(r)))
+		m.requestsTotal.Add(m.ctx, 1, proto)
+		m.activeRequests.Add(m.ctx, 1, proto)
 
 		interceptor := &responseInterceptor{PassthroughWriter: responsewriter.New(w)}
 
 		start := time.Now()
 		defer func() {
 			duration := time.Since(start)
-			m.activeRequests.Add(m.ctx, -1)
-			m.requestDuration.Record(m.ctx, duration.Milliseconds())
+			m.activeRequests.Add(m.ctx, -1, proto)
+			m.requestDuration.Record(m.ctx, duration.Milliseconds(), proto)
 		}()
 
 		next.ServeHTTP(interceptor, r)
 	})
 }
 
+// requestProtocol names the HTTP version a request arrived over, e.g.
+// "http/1.1", "h2" or "h3".
+func requestProtocol(r *http.Request) string {
+	switch r.ProtoMajor {
+	case 3:
+		return "h3"
+	case 2:
+		return "h2"
+	default:
+		return fmt.Sprintf("http/%d.%d", r.ProtoMajor, r.ProtoMinor)
+	}
+}
+
 type roundTripperFunc func(*http.Request) (*http.Response, error)
 
 func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
@@ -423,6 +482,15 @@ func (m *Metrics) TCPRelayRejected(accountID types.AccountID) {
 	))
 }
 
+// TCPRelayLimited records a TCP relay refused by the service's
+// connection caps.
+func (m *Metrics) TCPRelayLimited(accountID types.AccountID) {
+	m.tcpConnsTotal.Add(m.ctx, 1, metric.WithAttributes(
+		attribute.String("account_id", string(accountID)),
+		attribute.String("result", "limited"),
+	))
+}
+
 // UDPSessionStarted records a new UDP session starting.
 func (m *Metrics) UDPSessionStarted(accountID types.AccountID) {
 	acct := attribute.String("account_id", string(accountID))
@@ -451,9 +519,71 @@ func (m *Metrics) UDPSessionRejected(accountID types.AccountID) {
 	))
 }
 
+// UDPSessionLimited records a UDP session refused by the service's
+// session caps.
+func (m *Metrics) UDPSessionLimited(accountID types.AccountID) {
+	m.udpSessionsTotal.Add(m.ctx, 1, metric.WithAttributes(
+		attribute.String("account_id", string(accountID)),
+		attribute.String("result", "limited"),
+	))
+}
+
 // UDPPacketRelayed records a packet relayed in the given direction with its size in bytes.
 func (m *Metrics) UDPPacketRelayed(direction types.RelayDirection, bytes int) {
 	dir := attribute.String("direction", string(direction))
 	m.udpPacketsTotal.Add(m.ctx, 1, metric.WithAttributes(dir))
 	m.udpBytesTotal.Add(m.ctx, int64(bytes), metric.WithAttributes(dir))
 }
+
+// MirrorCompleted records a mirrored request that received a response.
+func (m *Metrics) MirrorCompleted(serviceID types.ServiceID, duration time.Duration, statusCode int) {
+	svc := attribute.String("service_id", string(serviceID))
+	m.mirrorDuration.Record(m.ctx, duration.Milliseconds(), metric.WithAttributes(svc))
+	m.mirrorRequestsTotal.Add(m.ctx, 1, metric.WithAttributes(
+		svc,
+		attribute.String("result", "success"),
+		attribute.Int("status_code", statusCode),
+	))
+}
+
+// MirrorFailed records a mirrored request that failed before a response.
+func (m *Metrics) MirrorFailed(serviceID types.ServiceID, duration time.Duration) {
+	svc := attribute.String("service_id", string(serviceID))
+	m.mirrorDuration.Record(m.ctx, duration.Milliseconds(), metric.WithAttributes(svc))
+	m.mirrorRequestsTotal.Add(m.ctx, 1, metric.WithAttributes(
+		svc,
+		attribute.String("result", "error"),
+	))
+}
+
+// MirrorSkipped records a sampled request that was not mirrored.
+func (m *Metrics) MirrorSkipped(serviceID types.ServiceID, reason string) {
+	m.mirrorRequestsTotal.Add(m.ctx, 1, metric.WithAttributes(
+		attribute.String("service_id", string(serviceID)),
+		attribute.String("result", "skipped"),
+		attribute.String("reason", reason),
+	))
+}
+
+// AccessLogSinkWritten records access log records delivered by a sink.
+func (m *Metrics) AccessLogSinkWritten(sink string, n int) {
+	m.addAccessLogSinkRecords(sink, "written", n)
+}
+
+// AccessLogSinkDropped records access log records dropped because a sink's
+// buffer was full.
+func (m *Metrics) AccessLogSinkDropped(sink string, n int) {
+	m.addAccessLogSinkRecords(sink, "dropped", n)
+}
+
+// AccessLogSinkFailed records access log records a sink failed to deliver.
+func (m *Metrics) AccessLogSinkFailed(sink string, n int) {
+	m.addAccessLogSinkRecords(sink, "failed", n)
+}
+
+func (m *Metrics) addAccessLogSinkRecords(sink, result string, n int) {
+	m.accessLogSinkRecords.Add(m.ctx, int64(n), metric.WithAttributes(
+		attribute.String("sink", sink),
+		attribute.String("result", result),
+	))
+}
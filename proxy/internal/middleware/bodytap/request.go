@@ -180,6 +180,58 @@ func CaptureRequest(r *http.Request, cfg *Config, b Budget) (body []byte, trunca
 	return buf, false, originalSize, "", release, nil
 }
 
+// BufferRequest reads the whole request body into memory when it fits
+// within maxBytes, replacing r.Body with a replay reader so the upstream
+// still sees the original bytes. Unlike CaptureRequest it applies no
+// content-type filter and never surfaces a partial body: a body larger
+// than maxBytes is reported with BypassContentLengthCap and keeps
+// streaming to the upstream untouched. The returned release function
+// returns the acquired budget tokens; it is always non-nil and must be
+// called once the buffered body is no longer referenced.
+func BufferRequest(r *http.Request, maxBytes int64, b Budget) (body []byte, bypass string, release func(), err error) {
+	release = func() {}
+	if r == nil {
+		return nil, BypassNoConfig, release, nil
+	}
+	if maxBytes <= 0 {
+		return nil, BypassCapZero, release, nil
+	}
+	if r.Body == nil || r.Body == http.NoBody || r.ContentLength == 0 {
+		return nil, "", release, nil
+	}
+	if r.ContentLength > maxBytes {
+		return nil, BypassContentLengthCap, release, nil
+	}
+
+	limit := maxBytes
+	if r.ContentLength > 0 {
+		limit = r.ContentLength
+	}
+	if b != nil && !b.Acquire(limit) {
+		return nil, BypassBudget, release, nil
+	}
+	if b != nil {
+		var released sync.Once
+		release = func() {
+			released.Do(func() { b.Release(limit) })
+		}
+	}
+
+	buf, readErr := io.ReadAll(io.LimitReader(r.Body, limit+1))
+	if readErr != nil && !errors.Is(readErr, io.EOF) {
+		release()
+		return nil, "", func() {}, readErr
+	}
+	if int64(len(buf)) > limit {
+		r.Body = &replayReadCloser{replay: bytes.NewReader(buf), tail: r.Body}
+		release()
+		return nil, BypassContentLengthCap, func() {}, nil
+	}
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(buf))
+	return buf, "", release, nil
+}
+
 // replayReadCloser replays the captured prefix and then forwards the
 // remaining bytes from the original body so the upstream sees the
 // full request stream even when capture truncates.
@@ -0,0 +1,78 @@
+package bodytap
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferRequest_FitsWithinCap(t *testing.T) {
+	r := httptest.NewRequest("POST", "http://example.com/", strings.NewReader("payload"))
+	b := NewBudget(1024)
+
+	body, bypass, release, err := BufferRequest(r, 64, b)
+	require.NoError(t, err)
+	assert.Empty(t, bypass)
+	assert.Equal(t, "payload", string(body))
+
+	replayed, err := io.ReadAll(r.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "payload", string(replayed), "upstream must still see the original body")
+
+	assert.False(t, b.Acquire(1024), "budget must be held until release")
+	release()
+	assert.True(t, b.Acquire(1024), "release must return the budget")
+}
+
+func TestBufferRequest_ContentLengthOverCap(t *testing.T) {
+	r := httptest.NewRequest("POST", "http://example.com/", strings.NewReader(strings.Repeat("x", 100)))
+
+	body, bypass, release, err := BufferRequest(r, 10, nil)
+	defer release()
+	require.NoError(t, err)
+	assert.Nil(t, body)
+	assert.Equal(t, BypassContentLengthCap, bypass)
+}
+
+func TestBufferRequest_UnknownLengthOverCapReplays(t *testing.T) {
+	payload := strings.Repeat("x", 100)
+	r := httptest.NewRequest("POST", "http://example.com/", strings.NewReader(payload))
+	r.ContentLength = -1
+	b := NewBudget(1024)
+
+	body, bypass, release, err := BufferRequest(r, 10, b)
+	defer release()
+	require.NoError(t, err)
+	assert.Nil(t, body)
+	assert.Equal(t, BypassContentLengthCap, bypass)
+	assert.True(t, b.Acquire(1024), "budget must be released for an oversized body")
+
+	replayed, err := io.ReadAll(r.Body)
+	require.NoError(t, err)
+	assert.Equal(t, payload, string(replayed), "upstream must receive the full oversized body")
+}
+
+func TestBufferRequest_BudgetExhausted(t *testing.T) {
+	r := httptest.NewRequest("POST", "http://example.com/", strings.NewReader("payload"))
+	b := NewBudget(4)
+
+	body, bypass, release, err := BufferRequest(r, 64, b)
+	defer release()
+	require.NoError(t, err)
+	assert.Nil(t, body)
+	assert.Equal(t, BypassBudget, bypass)
+}
+
+func TestBufferRequest_NoBody(t *testing.T) {
+	r := httptest.NewRequest("GET", "http://example.com/", nil)
+
+	body, bypass, release, err := BufferRequest(r, 64, nil)
+	defer release()
+	require.NoError(t, err)
+	assert.Nil(t, body)
+	assert.Empty(t, bypass)
+}
@@ -27,6 +27,8 @@ const (
 	OriginProxyError
 	// OriginAuth means the proxy intercepted the request for authentication.
 	OriginAuth
+	// OriginMaintenance means the service is in maintenance mode.
+	OriginMaintenance
 )
 
 func (o ResponseOrigin) String() string {
@@ -37,6 +39,8 @@ func (o ResponseOrigin) String() string {
 		return "proxy_error"
 	case OriginAuth:
 		return "auth"
+	case OriginMaintenance:
+		return "maintenance"
 	default:
 		return "backend"
 	}
@@ -0,0 +1,173 @@
+package proxy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/netbirdio/netbird/proxy/web"
+	"github.com/netbirdio/netbird/trustedproxy"
+)
+
+// maxRenderedErrorPageBytes bounds the output of a custom error page
+// template so a runaway template cannot exhaust memory.
+const maxRenderedErrorPageBytes = 256 << 10
+
+const defaultMaintenanceMessage = "This service is undergoing maintenance. Please try again later."
+
+var errErrorPageTooLarge = errors.New("rendered error page exceeds size limit")
+
+// ErrorPages holds a service's custom error page templates. A nil template
+// keeps the built-in page for that status class.
+type ErrorPages struct {
+	// ClientError renders 4xx responses generated by the proxy.
+	ClientError *template.Template
+	// ServerError renders 5xx responses generated by the proxy.
+	ServerError *template.Template
+}
+
+// ParseErrorPages parses the 4xx and 5xx page templates. Empty sources keep
+// the built-in page; nil is returned when neither is set.
+func ParseErrorPages(clientError, serverError string) (*ErrorPages, error) {
+	if clientError == "" && serverError == "" {
+		return nil, nil
+	}
+	var pages ErrorPages
+	var err error
+	if clientError != "" {
+		if pages.ClientError, err = template.New("client_error").Parse(clientError); err != nil {
+			return nil, fmt.Errorf("parse client error page: %w", err)
+		}
+	}
+	if serverError != "" {
+		if pages.ServerError, err = template.New("server_error").Parse(serverError); err != nil {
+			return nil, fmt.Errorf("parse server error page: %w", err)
+		}
+	}
+	return &pages, nil
+}
+
+func (e *ErrorPages) templateFor(code int) *template.Template {
+	if e == nil {
+		return nil
+	}
+	switch {
+	case code >= 400 && code < 500:
+		return e.ClientError
+	case code >= 500 && code < 600:
+		return e.ServerError
+	default:
+		return nil
+	}
+}
+
+// errorPageData is the data custom error page templates are executed with.
+type errorPageData struct {
+	Code      int
+	Title     string
+	Message   string
+	RequestID string
+}
+
+// limitedBuffer fails writes once the buffered output would exceed limit.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.limit {
+		return 0, errErrorPageTooLarge
+	}
+	return b.Buffer.Write(p)
+}
+
+// serveErrorPage renders the service's custom page for the status class of
+// code and falls back to the built-in page when none is configured or the
+// template fails to execute.
+func (p *ReverseProxy) serveErrorPage(w http.ResponseWriter, r *http.Request, pages *ErrorPages, code int, title, message, requestID string, status web.ErrorStatus) {
+	if tmpl := pages.templateFor(code); tmpl != nil {
+		buf := &limitedBuffer{limit: maxRenderedErrorPageBytes}
+		err := tmpl.Execute(buf, errorPageData{Code: code, Title: title, Message: message, RequestID: requestID})
+		if err == nil {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.WriteHeader(code)
+			_, _ = w.Write(buf.Bytes())
+			return
+		}
+		p.logger.Debugf("custom error page for host %s failed, using built-in page: %v", r.Host, err)
+	}
+	web.ServeErrorPage(w, r, code, title, message, requestID, status)
+}
+
+// Maintenance puts a service into maintenance mode. Requests are answered
+// with 503 unless the client belongs to one of AllowedGroups or connects
+// from one of AllowedPrefixes.
+type Maintenance struct {
+	Message string
+	// RetryAfter is sent as the Retry-After header; zero omits it.
+	RetryAfter      time.Duration
+	AllowedGroups   []string
+	AllowedPrefixes []netip.Prefix
+}
+
+// bypasses reports whether the client may reach the service despite
+// maintenance mode.
+func (m *Maintenance) bypasses(clientIP netip.Addr, groups []string) bool {
+	if clientIP.IsValid() {
+		clientIP = clientIP.Unmap()
+		for _, prefix := range m.AllowedPrefixes {
+			if prefix.Contains(clientIP) {
+				return true
+			}
+		}
+	}
+	for _, g := range groups {
+		if slices.Contains(m.AllowedGroups, g) {
+			return true
+		}
+	}
+	return false
+}
+
+// serveMaintenance answers requests for a service in maintenance mode
+// and reports whether it did. Allowlisted clients are let through.
+func (p *ReverseProxy) serveMaintenance(w http.ResponseWriter, r *http.Request, result targetResult) bool {
+	m := result.maintenance
+	if m == nil {
+		return false
+	}
+
+	var groups []string
+	clientIP := trustedproxy.ExtractHostIP(r.RemoteAddr)
+	cd := CapturedDataFromContext(r.Context())
+	if cd != nil {
+		groups = cd.GetUserGroups()
+		if ip := cd.GetClientIP(); ip.IsValid() {
+			clientIP = ip
+		}
+	}
+	if m.bypasses(clientIP, groups) {
+		return false
+	}
+
+	if cd != nil {
+		cd.SetOrigin(OriginMaintenance)
+	}
+	if m.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(m.RetryAfter.Seconds())))
+	}
+	message := m.Message
+	if message == "" {
+		message = defaultMaintenanceMessage
+	}
+	p.serveErrorPage(w, r, result.errorPages, http.StatusServiceUnavailable, "Under Maintenance", message,
+		getRequestID(r), web.ErrorStatus{Proxy: true, Destination: true})
+	return true
+}
@@ -0,0 +1,128 @@
+package proxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/proxy/web"
+)
+
+func newErrorPagesTestProxy(t *testing.T, backendURL string, pages *ErrorPages, maintenance *Maintenance) *ReverseProxy {
+	t.Helper()
+	target, err := url.Parse(backendURL)
+	require.NoError(t, err)
+
+	rp := NewReverseProxy(http.DefaultTransport, "auto", nil, nil)
+	rp.AddMapping(Mapping{
+		ID:          "svc-1",
+		AccountID:   "acct-1",
+		Host:        "app.example.com",
+		Paths:       map[string]*PathTarget{"/": {URL: target}},
+		ErrorPages:  pages,
+		Maintenance: maintenance,
+	})
+	return rp
+}
+
+func TestParseErrorPages(t *testing.T) {
+	pages, err := ParseErrorPages("", "")
+	require.NoError(t, err)
+	assert.Nil(t, pages, "no templates keeps the built-in pages")
+
+	pages, err = ParseErrorPages("", "<p>{{.Code}}</p>")
+	require.NoError(t, err)
+	require.NotNil(t, pages)
+	assert.Nil(t, pages.templateFor(http.StatusNotFound))
+	assert.NotNil(t, pages.templateFor(http.StatusBadGateway))
+
+	_, err = ParseErrorPages("{{.Code", "")
+	assert.Error(t, err)
+}
+
+func TestServeHTTP_CustomServerErrorPage(t *testing.T) {
+	backend := httptest.NewServer(http.NotFoundHandler())
+	backendURL := backend.URL
+	backend.Close()
+
+	pages, err := ParseErrorPages("", `<h1>{{.Code}} {{.Title}}</h1><p>{{.RequestID}}</p>`)
+	require.NoError(t, err)
+	rp := newErrorPagesTestProxy(t, backendURL, pages, nil)
+
+	cd := NewCapturedData("req-1")
+	req := httptest.NewRequest(http.MethodGet, "http://app.example.com/", nil)
+	req = req.WithContext(WithCapturedData(req.Context(), cd))
+	rec := httptest.NewRecorder()
+	rp.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+	assert.Contains(t, rec.Body.String(), "<h1>502 ")
+	assert.Contains(t, rec.Body.String(), "<p>req-1</p>")
+	assert.Equal(t, OriginProxyError, cd.GetOrigin())
+}
+
+func TestServeErrorPage_FallsBackWhenTemplateFails(t *testing.T) {
+	pages, err := ParseErrorPages("", `{{.Missing.Field}}`)
+	require.NoError(t, err)
+	rp := NewReverseProxy(http.DefaultTransport, "auto", nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "http://app.example.com/", nil)
+	rec := httptest.NewRecorder()
+	rp.serveErrorPage(rec, req, pages, http.StatusBadGateway, "Bad Gateway", "upstream down", "", web.ErrorStatus{Proxy: true})
+
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+	assert.Equal(t, "text/html", rec.Header().Get("Content-Type"), "built-in page must be served")
+}
+
+func TestServeHTTP_Maintenance(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("backend"))
+	}))
+	defer backend.Close()
+
+	maintenance := &Maintenance{
+		Message:         "Back soon",
+		RetryAfter:      30 * time.Minute,
+		AllowedGroups:   []string{"admins"},
+		AllowedPrefixes: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+	}
+	rp := newErrorPagesTestProxy(t, backend.URL, nil, maintenance)
+
+	tests := []struct {
+		name       string
+		clientIP   string
+		groups     []string
+		wantStatus int
+	}{
+		{name: "blocked", clientIP: "203.0.113.5", groups: []string{"engineering"}, wantStatus: http.StatusServiceUnavailable},
+		{name: "allowed cidr", clientIP: "10.1.2.3", wantStatus: http.StatusOK},
+		{name: "allowed group", clientIP: "203.0.113.5", groups: []string{"admins"}, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cd := NewCapturedData("")
+			cd.SetClientIP(netip.MustParseAddr(tt.clientIP))
+			cd.SetUserGroups(tt.groups)
+
+			req := httptest.NewRequest(http.MethodGet, "http://app.example.com/", nil)
+			req = req.WithContext(WithCapturedData(req.Context(), cd))
+			rec := httptest.NewRecorder()
+			rp.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, "backend", rec.Body.String())
+				return
+			}
+			assert.Equal(t, "1800", rec.Header().Get("Retry-After"))
+			assert.Contains(t, rec.Body.String(), "Back soon")
+			assert.Equal(t, OriginMaintenance, cd.GetOrigin())
+		})
+	}
+}
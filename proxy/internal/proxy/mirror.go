@@ -0,0 +1,191 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/netbirdio/netbird/proxy/internal/middleware/bodytap"
+	"github.com/netbirdio/netbird/proxy/internal/types"
+)
+
+const (
+	// MaxMirrorBodyBytes bounds how much of a request body is buffered to
+	// be replayed to a mirror target. Requests with larger bodies are
+	// forwarded to the primary target only.
+	MaxMirrorBodyBytes int64 = 1 << 20
+	// maxConcurrentMirrors caps in-flight mirror requests so a slow mirror
+	// target cannot pile up goroutines and buffered bodies.
+	maxConcurrentMirrors = 256
+	// defaultMirrorTimeout bounds a mirror round trip when the target has
+	// no request timeout of its own.
+	defaultMirrorTimeout = 30 * time.Second
+	// maxMirrorDrainBytes bounds how much of a mirror response is read
+	// before the connection is closed.
+	maxMirrorDrainBytes int64 = 1 << 20
+)
+
+// Mirror skip reasons reported to the MirrorObserver in addition to the
+// bodytap bypass reasons.
+const (
+	MirrorSkipSaturated = "saturated"
+	MirrorSkipBodyError = "body_read_error"
+)
+
+// MirrorTarget describes where a sample of a target's traffic is copied.
+type MirrorTarget struct {
+	URL *url.URL
+	// Percent is the share of requests (1-100) sent to URL.
+	Percent int
+}
+
+// MirrorObserver receives the outcome of mirrored requests.
+// All methods must be safe for concurrent use.
+type MirrorObserver interface {
+	MirrorCompleted(serviceID types.ServiceID, duration time.Duration, statusCode int)
+	MirrorFailed(serviceID types.ServiceID, duration time.Duration)
+	MirrorSkipped(serviceID types.ServiceID, reason string)
+}
+
+// WithMirroring configures how mirrored requests are sent. The transport
+// is used instead of the primary one so mirror round trips stay out of
+// the backend metrics; a nil transport keeps the primary transport. The
+// observer, when non-nil, receives the outcome of every mirror attempt.
+func WithMirroring(transport http.RoundTripper, observer MirrorObserver) Option {
+	return func(p *ReverseProxy) {
+		p.mirrorTransport = transport
+		p.mirrorObserver = observer
+	}
+}
+
+// mirrorRequest sends a copy of r to the target's mirror when the request
+// is sampled. The body is buffered up to MaxMirrorBodyBytes against the
+// shared capture budget and replayed to the primary target unchanged.
+// The mirror round trip runs in the background; its response is drained
+// and discarded and never affects the client response.
+func (p *ReverseProxy) mirrorRequest(r *http.Request, result targetResult, rewriteMatchedPath string) {
+	mirror := result.target.Mirror
+	if mirror == nil || mirror.URL == nil {
+		return
+	}
+	if mirror.Percent < 100 && rand.IntN(100) >= mirror.Percent { //nolint:gosec
+		return
+	}
+	if r.Header.Get("Upgrade") != "" {
+		p.mirrorSkipped(result.serviceID, bodytap.BypassUpgradeHeader)
+		return
+	}
+
+	select {
+	case p.mirrorSem <- struct{}{}:
+	default:
+		p.mirrorSkipped(result.serviceID, MirrorSkipSaturated)
+		return
+	}
+
+	body, bypass, release, err := bodytap.BufferRequest(r, MaxMirrorBodyBytes, p.mirrorBudget)
+	if err != nil || bypass != "" {
+		<-p.mirrorSem
+		if err != nil {
+			p.logger.Debugf("mirror body read for service %s: %v", result.serviceID, err)
+			bypass = MirrorSkipBodyError
+		}
+		p.mirrorSkipped(result.serviceID, bypass)
+		return
+	}
+
+	out, cancel := p.newMirrorRequest(r, result, rewriteMatchedPath, body)
+	go func() {
+		defer func() {
+			cancel()
+			release()
+			<-p.mirrorSem
+		}()
+		p.sendMirror(out, result.serviceID)
+	}()
+}
+
+// newMirrorRequest clones the inbound request and rewrites it for the
+// mirror target with the same path, header and forwarding rules as the
+// primary target. The request context is detached from the client so the
+// mirror is not cancelled when the primary response completes.
+func (p *ReverseProxy) newMirrorRequest(r *http.Request, result targetResult, rewriteMatchedPath string, body []byte) (*http.Request, context.CancelFunc) {
+	pt := result.target
+	timeout := defaultMirrorTimeout
+	if pt.RequestTimeout > 0 {
+		timeout = pt.RequestTimeout
+	}
+	ctx, cancel := context.WithTimeout(p.buildTargetContext(context.Background(), result), timeout)
+
+	out := r.Clone(ctx)
+	out.RequestURI = ""
+	out.Close = false
+	out.Body = nil
+	out.GetBody = nil
+	out.ContentLength = 0
+	if len(body) > 0 {
+		out.Body = io.NopCloser(bytes.NewReader(body))
+		out.ContentLength = int64(len(body))
+	}
+	removeHopByHopHeaders(out.Header)
+
+	rewrite := p.rewriteFunc(pt.Mirror.URL, rewriteMatchedPath, result.passHostHeader, pt.PathRewrite, pt.CustomHeaders, result.stripAuthHeaders)
+	rewrite(&httputil.ProxyRequest{In: r, Out: out})
+	return out, cancel
+}
+
+func (p *ReverseProxy) sendMirror(out *http.Request, serviceID types.ServiceID) {
+	start := time.Now()
+	resp, err := p.mirrorTransport.RoundTrip(out)
+	if err != nil {
+		p.logger.Debugf("mirror request for service %s to %s failed: %v", serviceID, out.URL.Host, err)
+		if p.mirrorObserver != nil {
+			p.mirrorObserver.MirrorFailed(serviceID, time.Since(start))
+		}
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxMirrorDrainBytes))
+	_ = resp.Body.Close()
+	if p.mirrorObserver != nil {
+		p.mirrorObserver.MirrorCompleted(serviceID, time.Since(start), resp.StatusCode)
+	}
+}
+
+func (p *ReverseProxy) mirrorSkipped(serviceID types.ServiceID, reason string) {
+	if p.mirrorObserver != nil {
+		p.mirrorObserver.MirrorSkipped(serviceID, reason)
+	}
+}
+
+// hopByHopHeaders are connection-scoped headers that must not be copied
+// onto the mirror request (RFC 9110 §7.6.1).
+var hopByHopHeaders = []string{
+	"Connection",
+	"Proxy-Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+func removeHopByHopHeaders(h http.Header) {
+	for _, f := range h.Values("Connection") {
+		for _, name := range strings.Split(f, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				h.Del(name)
+			}
+		}
+	}
+	for _, name := range hopByHopHeaders {
+		h.Del(name)
+	}
+}
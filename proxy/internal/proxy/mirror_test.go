@@ -0,0 +1,181 @@
+package proxy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/proxy/internal/middleware/bodytap"
+	"github.com/netbirdio/netbird/proxy/internal/types"
+)
+
+type mirroredRequest struct {
+	method string
+	path   string
+	body   string
+}
+
+type recordingMirrorObserver struct {
+	mu        sync.Mutex
+	completed []int
+	failed    int
+	skipped   []string
+	done      chan struct{}
+}
+
+func newRecordingMirrorObserver() *recordingMirrorObserver {
+	return &recordingMirrorObserver{done: make(chan struct{}, 16)}
+}
+
+func (o *recordingMirrorObserver) MirrorCompleted(_ types.ServiceID, _ time.Duration, statusCode int) {
+	o.mu.Lock()
+	o.completed = append(o.completed, statusCode)
+	o.mu.Unlock()
+	o.done <- struct{}{}
+}
+
+func (o *recordingMirrorObserver) MirrorFailed(types.ServiceID, time.Duration) {
+	o.mu.Lock()
+	o.failed++
+	o.mu.Unlock()
+	o.done <- struct{}{}
+}
+
+func (o *recordingMirrorObserver) MirrorSkipped(_ types.ServiceID, reason string) {
+	o.mu.Lock()
+	o.skipped = append(o.skipped, reason)
+	o.mu.Unlock()
+	o.done <- struct{}{}
+}
+
+func (o *recordingMirrorObserver) wait(t *testing.T) {
+	t.Helper()
+	select {
+	case <-o.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("mirror observer was not notified")
+	}
+}
+
+func newMirrorTestProxy(t *testing.T, observer MirrorObserver, mirrorURL string) *ReverseProxy {
+	t.Helper()
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write([]byte("primary:" + r.URL.Path + ":" + string(body)))
+	}))
+	t.Cleanup(primary.Close)
+
+	primaryURL, err := url.Parse(primary.URL)
+	require.NoError(t, err)
+	mirror, err := url.Parse(mirrorURL)
+	require.NoError(t, err)
+
+	rp := NewReverseProxy(http.DefaultTransport, "auto", nil, nil, WithMirroring(nil, observer))
+	rp.AddMapping(Mapping{
+		ID:        "svc-1",
+		AccountID: "acct-1",
+		Host:      "app.example.com",
+		Paths: map[string]*PathTarget{
+			"/api": {
+				URL:    primaryURL,
+				Mirror: &MirrorTarget{URL: mirror, Percent: 100},
+			},
+		},
+	})
+	return rp
+}
+
+func TestServeHTTP_MirrorReceivesCopy(t *testing.T) {
+	mirrored := make(chan mirroredRequest, 1)
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mirrored <- mirroredRequest{method: r.Method, path: r.URL.Path, body: string(body)}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer mirror.Close()
+
+	observer := newRecordingMirrorObserver()
+	rp := newMirrorTestProxy(t, observer, mirror.URL)
+
+	req := httptest.NewRequest(http.MethodPost, "http://app.example.com/api/items", strings.NewReader("payload"))
+	rec := httptest.NewRecorder()
+	rp.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code, "mirror failures must not affect the client response")
+	assert.Equal(t, "primary:/items:payload", rec.Body.String())
+
+	select {
+	case got := <-mirrored:
+		assert.Equal(t, mirroredRequest{method: http.MethodPost, path: "/items", body: "payload"}, got)
+	case <-time.After(5 * time.Second):
+		t.Fatal("mirror target did not receive the request")
+	}
+
+	observer.wait(t)
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+	assert.Equal(t, []int{http.StatusInternalServerError}, observer.completed)
+}
+
+func TestServeHTTP_MirrorSkipsOversizedBody(t *testing.T) {
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("oversized request must not be mirrored")
+	}))
+	defer mirror.Close()
+
+	observer := newRecordingMirrorObserver()
+	rp := newMirrorTestProxy(t, observer, mirror.URL)
+
+	payload := strings.Repeat("x", int(MaxMirrorBodyBytes)+1)
+	req := httptest.NewRequest(http.MethodPost, "http://app.example.com/api/upload", strings.NewReader(payload))
+	rec := httptest.NewRecorder()
+	rp.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "primary:/upload:"+payload, rec.Body.String(), "primary must receive the full body")
+
+	observer.wait(t)
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+	assert.Equal(t, []string{bodytap.BypassContentLengthCap}, observer.skipped)
+}
+
+func TestServeHTTP_MirrorUnreachableReportsFailure(t *testing.T) {
+	mirror := httptest.NewServer(http.NotFoundHandler())
+	mirrorURL := mirror.URL
+	mirror.Close()
+
+	observer := newRecordingMirrorObserver()
+	rp := newMirrorTestProxy(t, observer, mirrorURL)
+
+	req := httptest.NewRequest(http.MethodGet, "http://app.example.com/api/", nil)
+	rec := httptest.NewRecorder()
+	rp.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	observer.wait(t)
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+	assert.Equal(t, 1, observer.failed)
+}
+
+func TestRemoveHopByHopHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("Connection", "X-Private, keep-alive")
+	h.Set("X-Private", "secret")
+	h.Set("Upgrade", "websocket")
+	h.Set("X-Keep", "value")
+
+	removeHopByHopHeaders(h)
+
+	assert.Equal(t, http.Header{"X-Keep": []string{"value"}}, h)
+}
@@ -42,6 +42,13 @@ type ReverseProxy struct {
 	// target) keeps the reverse-proxy hot path on the no-capture fast
 	// path with no middleware overhead.
 	middlewareManager *middleware.Manager
+	// mirrorTransport sends mirrored requests; defaults to transport.
+	mirrorTransport http.RoundTripper
+	mirrorObserver  MirrorObserver
+	// mirrorBudget bounds the memory held by buffered mirror bodies. It
+	// is the middleware capture budget when a manager is attached.
+	mirrorBudget bodytap.Budget
+	mirrorSem    chan struct{}
 }
 
 // Option configures optional ReverseProxy behavior. Options exist so the core
@@ -78,13 +85,22 @@ func NewReverseProxy(transport http.RoundTripper, forwardedProto string, trusted
 	for _, opt := range opts {
 		opt(p)
 	}
+	if p.mirrorTransport == nil {
+		p.mirrorTransport = transport
+	}
+	if p.middlewareManager != nil {
+		p.mirrorBudget = p.middlewareManager.Budget()
+	} else {
+		p.mirrorBudget = bodytap.NewBudget(bodytap.DefaultCaptureBudgetBytes)
+	}
+	p.mirrorSem = make(chan struct{}, maxConcurrentMirrors)
 	return p
 }
 
 func (p *ReverseProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	result, exists := p.findTargetForRequest(r)
 	if !exists {
-		p.serveRouteError(w, r, http.StatusNotFound, "Service Not Found",
+		p.serveRouteError(w, r, nil, http.StatusNotFound, "Service Not Found",
 			"The requested service could not be found. Please check the URL, try refreshing, or check if the peer is running. If that doesn't work, see our documentation for help.")
 		return
 	}
@@ -95,11 +111,15 @@ func (p *ReverseProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// with 421 (Misdirected Request) so the caller sees an explicit
 	// error instead of silently doubling tunnel traffic.
 	if p.isSelfTargetLoop(r, result.target.URL) {
-		p.serveRouteError(w, r, http.StatusMisdirectedRequest, "Loop Detected",
+		p.serveRouteError(w, r, result.errorPages, http.StatusMisdirectedRequest, "Loop Detected",
 			"This peer is the target of the requested service. Reach the backend directly instead of dialing the public service URL from the same machine.")
 		return
 	}
 
+	if p.serveMaintenance(w, r, result) {
+		return
+	}
+
 	pt := result.target
 	ctx := p.buildTargetContext(r.Context(), result)
 
@@ -128,12 +148,13 @@ func (p *ReverseProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 // serveRouteError marks the request as un-routed on any captured-data
-// context and renders the proxy error page.
-func (p *ReverseProxy) serveRouteError(w http.ResponseWriter, r *http.Request, status int, title, message string) {
+// context and renders the proxy error page, using the service's custom
+// page when the service is known.
+func (p *ReverseProxy) serveRouteError(w http.ResponseWriter, r *http.Request, pages *ErrorPages, status int, title, message string) {
 	if cd := CapturedDataFromContext(r.Context()); cd != nil {
 		cd.SetOrigin(OriginNoRoute)
 	}
-	web.ServeErrorPage(w, r, status, title, message, getRequestID(r),
+	p.serveErrorPage(w, r, pages, status, title, message, getRequestID(r),
 		web.ErrorStatus{Proxy: true, Destination: false})
 }
 
@@ -158,11 +179,12 @@ func (p *ReverseProxy) buildTargetContext(ctx context.Context, result targetResu
 // path for plain reverse-proxy targets.
 func (p *ReverseProxy) serveDirect(w http.ResponseWriter, r *http.Request, ctx context.Context, result targetResult, rewriteMatchedPath string) {
 	pt := result.target
+	p.mirrorRequest(r, result, rewriteMatchedPath)
 	rp := &httputil.ReverseProxy{
 		Rewrite:       p.rewriteFunc(pt.URL, rewriteMatchedPath, result.passHostHeader, pt.PathRewrite, pt.CustomHeaders, result.stripAuthHeaders),
 		Transport:     p.transport,
 		FlushInterval: -1,
-		ErrorHandler:  p.proxyErrorHandler,
+		ErrorHandler:  p.proxyErrorHandler(result.errorPages),
 	}
 	if result.rewriteRedirects {
 		rp.ModifyResponse = p.rewriteLocationFunc(pt.URL, rewriteMatchedPath, r) //nolint:bodyclose
@@ -201,6 +223,7 @@ func (p *ReverseProxy) serveWithChain(w http.ResponseWriter, r *http.Request, ct
 		defer p.observeResponse(ctx, chain, acc, reqInput, requestMeta, capturingWriter, w, capturedData, result, middlewareIDs)
 	}
 
+	p.mirrorRequest(r, result, rewriteMatchedPath)
 	p.forwardUpstream(respWriter, r, ctx, result, rewriteMatchedPath, upstreamRewrite)
 }
 
@@ -358,7 +381,7 @@ func (p *ReverseProxy) forwardUpstream(respWriter http.ResponseWriter, r *http.R
 		Rewrite:       p.rewriteFunc(effectiveURL, rewriteMatchedPath, result.passHostHeader, pt.PathRewrite, pt.CustomHeaders, result.stripAuthHeaders),
 		Transport:     p.transport,
 		FlushInterval: -1,
-		ErrorHandler:  p.proxyErrorHandler,
+		ErrorHandler:  p.proxyErrorHandler(result.errorPages),
 	}
 	if result.rewriteRedirects {
 		rp.ModifyResponse = p.rewriteLocationFunc(effectiveURL, rewriteMatchedPath, r) //nolint:bodyclose
@@ -741,20 +764,23 @@ func extractForwardedPort(host, resolvedProto string) string {
 	return "80"
 }
 
-// proxyErrorHandler handles errors from the reverse proxy and serves
-// user-friendly error pages instead of raw error responses.
-func (p *ReverseProxy) proxyErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
-	if cd := CapturedDataFromContext(r.Context()); cd != nil {
-		cd.SetOrigin(OriginProxyError)
-	}
-	requestID := getRequestID(r)
-	clientIP := getClientIP(r)
-	title, message, code, status := classifyProxyError(err)
+// proxyErrorHandler returns the reverse proxy error handler that serves
+// user-friendly error pages, or the service's custom pages, instead of
+// raw error responses.
+func (p *ReverseProxy) proxyErrorHandler(pages *ErrorPages) func(http.ResponseWriter, *http.Request, error) {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		if cd := CapturedDataFromContext(r.Context()); cd != nil {
+			cd.SetOrigin(OriginProxyError)
+		}
+		requestID := getRequestID(r)
+		clientIP := getClientIP(r)
+		title, message, code, status := classifyProxyError(err)
 
-	p.logger.Warnf("proxy error: request_id=%s client_ip=%s method=%s host=%s path=%s status=%d title=%q err=%v",
-		requestID, clientIP, r.Method, r.Host, r.URL.Path, code, title, err)
+		p.logger.Warnf("proxy error: request_id=%s client_ip=%s method=%s host=%s path=%s status=%d title=%q err=%v",
+			requestID, clientIP, r.Method, r.Host, r.URL.Path, code, title, err)
 
-	web.ServeErrorPage(w, r, code, title, message, requestID, status)
+		p.serveErrorPage(w, r, pages, code, title, message, requestID, status)
+	}
 }
 
 // getClientIP retrieves the resolved client IP string from context.
@@ -48,6 +48,9 @@ type PathTarget struct {
 	// unchanged. The agent-network synthesizer sets this true only when the
 	// account's EnableLogCollection toggle is off.
 	DisableAccessLog bool
+	// Mirror, when non-nil, receives an asynchronous copy of a sample of
+	// the requests routed to this target.
+	Mirror *MirrorTarget
 }
 
 // Mapping describes how a domain is routed by the HTTP reverse proxy.
@@ -61,6 +64,10 @@ type Mapping struct {
 	// StripAuthHeaders are header names used for header-based auth.
 	// These headers are stripped from requests before forwarding.
 	StripAuthHeaders []string
+	// ErrorPages, when non-nil, replaces the built-in error pages.
+	ErrorPages *ErrorPages
+	// Maintenance, when non-nil, puts the service into maintenance mode.
+	Maintenance *Maintenance
 	// sortedPaths caches the paths sorted by length (longest first).
 	sortedPaths []string
 }
@@ -73,6 +80,8 @@ type targetResult struct {
 	passHostHeader   bool
 	rewriteRedirects bool
 	stripAuthHeaders []string
+	errorPages       *ErrorPages
+	maintenance      *Maintenance
 }
 
 func (p *ReverseProxy) findTargetForRequest(req *http.Request) (targetResult, bool) {
@@ -107,6 +116,8 @@ func (p *ReverseProxy) findTargetForRequest(req *http.Request) (targetResult, bo
 				passHostHeader:   m.PassHostHeader,
 				rewriteRedirects: m.RewriteRedirects,
 				stripAuthHeaders: m.StripAuthHeaders,
+				errorPages:       m.ErrorPages,
+				maintenance:      m.Maintenance,
 			}, true
 		}
 	}
@@ -14,6 +14,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/proxy/internal/accesslog"
+	"github.com/netbirdio/netbird/proxy/internal/connlimit"
 	"github.com/netbirdio/netbird/proxy/internal/restrict"
 	"github.com/netbirdio/netbird/proxy/internal/types"
 	"github.com/netbirdio/netbird/util/netrelay"
@@ -76,6 +77,9 @@ type Route struct {
 	SessionIdleTimeout time.Duration
 	// Filter holds connection-level IP/geo restrictions. Nil means no restrictions.
 	Filter *restrict.Filter
+	// Limiter enforces the service's connection caps and bandwidth. Nil
+	// means unlimited.
+	Limiter *connlimit.Limiter
 }
 
 // l4Logger sends layer-4 access log entries to the management server.
@@ -90,6 +94,7 @@ type RelayObserver interface {
 	TCPRelayEnded(accountID types.AccountID, duration time.Duration, srcToDst, dstToSrc int64)
 	TCPRelayDialError(accountID types.AccountID)
 	TCPRelayRejected(accountID types.AccountID)
+	TCPRelayLimited(accountID types.AccountID)
 }
 
 // Router accepts raw TCP connections on a shared listener, peeks at
@@ -573,6 +578,12 @@ func (r *Router) relayTCP(ctx context.Context, conn net.Conn, sni SNIHost, route
 		}
 	}
 
+	lease, err := r.acquireLease(conn, route)
+	if err != nil {
+		return err
+	}
+	defer lease.Release()
+
 	svcCtx, err := r.acquireRelay(ctx, route)
 	if err != nil {
 		return err
@@ -616,7 +627,7 @@ func (r *Router) relayTCP(ctx context.Context, conn net.Conn, sni SNIHost, route
 	}
 
 	start := time.Now()
-	s2d, d2s := netrelay.Relay(svcCtx, conn, backend, netrelay.Options{
+	s2d, d2s := netrelay.Relay(svcCtx, lease.WrapConn(svcCtx, conn), backend, netrelay.Options{
 		IdleTimeout: idleTimeout,
 		Logger:      entry,
 	})
@@ -631,6 +642,24 @@ func (r *Router) relayTCP(ctx context.Context, conn net.Conn, sni SNIHost, route
 	return nil
 }
 
+// acquireLease reserves a slot in the route's connection limiter for the
+// client address.
+func (r *Router) acquireLease(conn net.Conn, route Route) (*connlimit.Lease, error) {
+	if route.Limiter == nil {
+		return nil, nil
+	}
+	addr, _ := addrFromConn(conn)
+	lease, err := route.Limiter.Acquire(addr)
+	if err != nil {
+		r.logger.Debugf("connection from %s for service %s limited: %v", conn.RemoteAddr(), route.ServiceID, err)
+		if obs := r.getObserver(); obs != nil {
+			obs.TCPRelayLimited(route.AccountID)
+		}
+		return nil, err
+	}
+	return lease, nil
+}
+
 // acquireRelay checks draining state, increments activeRelays, and acquires
 // a semaphore slot. Returns the per-service context on success.
 // The caller must release the semaphore and call activeRelays.Done() when done.
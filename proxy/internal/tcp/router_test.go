@@ -9,6 +9,7 @@ import (
 	"crypto/x509"
 	"math/big"
 	"net"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/netbirdio/netbird/proxy/internal/connlimit"
 	"github.com/netbirdio/netbird/proxy/internal/restrict"
 	"github.com/netbirdio/netbird/proxy/internal/types"
 )
@@ -529,6 +531,93 @@ func TestPortRouter_FallbackRelaysData(t *testing.T) {
 	assert.Equal(t, testData, buf[:n], "should receive echoed data through fallback relay")
 }
 
+type limitObserver struct {
+	limited atomic.Int32
+}
+
+func (o *limitObserver) TCPRelayStarted(types.AccountID)                            {}
+func (o *limitObserver) TCPRelayEnded(types.AccountID, time.Duration, int64, int64) {}
+func (o *limitObserver) TCPRelayDialError(types.AccountID)                          {}
+func (o *limitObserver) TCPRelayRejected(types.AccountID)                           {}
+func (o *limitObserver) TCPRelayLimited(types.AccountID)                            { o.limited.Add(1) }
+
+func TestPortRouter_ConnectionLimit(t *testing.T) {
+	backendLn, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer backendLn.Close()
+
+	go func() {
+		for {
+			conn, err := backendLn.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				buf := make([]byte, 1024)
+				for {
+					n, err := conn.Read(buf)
+					if err != nil {
+						return
+					}
+					_, _ = conn.Write(buf[:n])
+				}
+			}()
+		}
+	}()
+
+	dialResolve := func(_ types.AccountID) (types.DialContextFunc, error) {
+		return func(_ context.Context, network, address string) (net.Conn, error) {
+			return net.Dial(network, address)
+		}, nil
+	}
+
+	limiter := connlimit.New(connlimit.Limits{MaxConnections: 1})
+	obs := &limitObserver{}
+	router := NewPortRouter(log.StandardLogger(), dialResolve)
+	router.SetObserver(obs)
+	router.SetFallback(Route{
+		Type:      RouteTCP,
+		AccountID: "test-account",
+		ServiceID: "test-service",
+		Target:    backendLn.Addr().String(),
+		Limiter:   limiter,
+	})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = router.Serve(ctx, ln) }()
+
+	echo := func(conn net.Conn) error {
+		if _, err := conn.Write([]byte("hello")); err != nil {
+			return err
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		buf := make([]byte, 5)
+		_, err := conn.Read(buf)
+		return err
+	}
+
+	first, err := net.DialTimeout("tcp", ln.Addr().String(), 2*time.Second)
+	require.NoError(t, err)
+	defer first.Close()
+	require.NoError(t, echo(first), "first connection should be relayed")
+
+	second, err := net.DialTimeout("tcp", ln.Addr().String(), 2*time.Second)
+	require.NoError(t, err)
+	defer second.Close()
+	assert.Error(t, echo(second), "second connection should be closed by the limit")
+	assert.Equal(t, int32(1), obs.limited.Load())
+
+	require.NoError(t, first.Close())
+	require.Eventually(t, func() bool { return limiter.Active() == 0 }, 2*time.Second, 10*time.Millisecond,
+		"closing the relay must release its lease")
+}
+
 func TestPortRouter_FallbackOnUnknownSNI(t *testing.T) {
 	// Backend TLS echo server.
 	backendCert := generateSelfSignedCert(t)
@@ -1964,4 +2053,3 @@ func TestRouter_Serve_BacksOffOnTransientError(t *testing.T) {
 type errSentinel string
 
 func (e errSentinel) Error() string { return string(e) }
-
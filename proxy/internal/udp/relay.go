@@ -14,6 +14,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/netbirdio/netbird/proxy/internal/accesslog"
+	"github.com/netbirdio/netbird/proxy/internal/connlimit"
 	"github.com/netbirdio/netbird/proxy/internal/netutil"
 	"github.com/netbirdio/netbird/proxy/internal/restrict"
 	"github.com/netbirdio/netbird/proxy/internal/types"
@@ -48,6 +49,7 @@ type SessionObserver interface {
 	UDPSessionEnded(accountID types.AccountID)
 	UDPSessionDialError(accountID types.AccountID)
 	UDPSessionRejected(accountID types.AccountID)
+	UDPSessionLimited(accountID types.AccountID)
 	UDPPacketRelayed(direction types.RelayDirection, bytes int)
 }
 
@@ -70,6 +72,7 @@ type Relay struct {
 	maxSessions int
 	filter      *restrict.Filter
 	geo         restrict.GeoResolver
+	limiter     *connlimit.Limiter
 
 	mu       sync.RWMutex
 	sessions map[clientAddr]*session
@@ -90,6 +93,8 @@ type session struct {
 	// lastSeen stores the last activity timestamp as unix nanoseconds.
 	lastSeen atomic.Int64
 	cancel   context.CancelFunc
+	// lease holds the session's slot in the service's connection limiter.
+	lease *connlimit.Lease
 	// bytesIn tracks total bytes received from the client.
 	bytesIn atomic.Int64
 	// bytesOut tracks total bytes sent back to the client.
@@ -121,6 +126,9 @@ type RelayConfig struct {
 	Filter *restrict.Filter
 	// Geo is the geolocation lookup used for country-based restrictions.
 	Geo restrict.GeoResolver
+	// Limiter enforces the service's session caps and bandwidth. Nil means
+	// unlimited.
+	Limiter *connlimit.Limiter
 }
 
 // New creates a UDP relay for the given listener and backend target.
@@ -155,6 +163,7 @@ func New(parentCtx context.Context, cfg RelayConfig) *Relay {
 		maxSessions: maxSessions,
 		filter:      cfg.Filter,
 		geo:         cfg.Geo,
+		limiter:     cfg.Limiter,
 		sessions:    make(map[clientAddr]*session),
 		bufPool: sync.Pool{
 			New: func() any {
@@ -216,6 +225,12 @@ func (r *Relay) Serve() {
 
 		sess.updateLastSeen()
 
+		if !sess.lease.AllowUpload(n) {
+			// Over the bandwidth allowance: police by dropping.
+			r.bufPool.Put(bufp)
+			continue
+		}
+
 		nw, err := sess.backend.Write(data)
 		if err != nil {
 			r.bufPool.Put(bufp)
@@ -284,6 +299,16 @@ func (r *Relay) getOrCreateSession(addr net.Addr) (*session, error) {
 		return nil, fmt.Errorf("session creation rate limited")
 	}
 
+	clientIP, _ := addrFromUDPAddr(addr)
+	lease, err := r.limiter.Acquire(clientIP)
+	if err != nil {
+		r.mu.Unlock()
+		if obs := r.getObserver(); obs != nil {
+			obs.UDPSessionLimited(r.accountID)
+		}
+		return nil, err
+	}
+
 	// Reserve the slot with a nil session so concurrent callers for the same
 	// key see it exists and wait. Release the lock before dialing.
 	r.sessions[key] = nil
@@ -296,6 +321,7 @@ func (r *Relay) getOrCreateSession(addr net.Addr) (*session, error) {
 		r.mu.Lock()
 		delete(r.sessions, key)
 		r.mu.Unlock()
+		lease.Release()
 		if obs := r.getObserver(); obs != nil {
 			obs.UDPSessionDialError(r.accountID)
 		}
@@ -308,6 +334,7 @@ func (r *Relay) getOrCreateSession(addr net.Addr) (*session, error) {
 		addr:      addr,
 		createdAt: time.Now(),
 		cancel:    sessCancel,
+		lease:     lease,
 	}
 	sess.updateLastSeen()
 
@@ -365,6 +392,10 @@ func (r *Relay) relayBackendToClient(ctx context.Context, sess *session) {
 
 		sess.updateLastSeen()
 
+		if !sess.lease.AllowDownload(len(data)) {
+			continue
+		}
+
 		nw, err := r.listener.WriteTo(data, sess.addr)
 		if err != nil {
 			if !netutil.IsExpectedError(err) {
@@ -436,6 +467,7 @@ func (r *Relay) cleanupIdleSessions() {
 				sess.addr, idle, sess.bytesIn.Load(), sess.bytesOut.Load())
 			delete(r.sessions, key)
 			sess.cancel()
+			sess.lease.Release()
 			if err := sess.backend.Close(); err != nil {
 				r.logger.Debugf("close idle session %s backend: %v", sess.addr, err)
 			}
@@ -463,6 +495,7 @@ func (r *Relay) removeSession(sess *session) {
 	if removed {
 		delete(r.sessions, key)
 		sess.cancel()
+		sess.lease.Release()
 		if err := sess.backend.Close(); err != nil {
 			r.logger.Debugf("close session %s backend: %v", sess.addr, err)
 		}
@@ -544,6 +577,7 @@ func (r *Relay) Close() {
 		r.logger.Debugf("UDP session %s closed (client→backend: %d bytes, backend→client: %d bytes)",
 			sess.addr, sess.bytesIn.Load(), sess.bytesOut.Load())
 		sess.cancel()
+		sess.lease.Release()
 		if err := sess.backend.Close(); err != nil {
 			r.logger.Debugf("close session %s backend: %v", sess.addr, err)
 		}
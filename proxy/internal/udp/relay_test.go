@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/netbirdio/netbird/proxy/internal/connlimit"
 	"github.com/netbirdio/netbird/proxy/internal/types"
 )
 
@@ -365,6 +366,7 @@ type testObserver struct {
 	started  int
 	ended    int
 	rejected int
+	limited  int
 	dialErr  int
 	packets  int
 	bytes    int
@@ -374,6 +376,7 @@ func (o *testObserver) UDPSessionStarted(types.AccountID)   { o.mu.Lock(); o.sta
 func (o *testObserver) UDPSessionEnded(types.AccountID)     { o.mu.Lock(); o.ended++; o.mu.Unlock() }
 func (o *testObserver) UDPSessionDialError(types.AccountID) { o.mu.Lock(); o.dialErr++; o.mu.Unlock() }
 func (o *testObserver) UDPSessionRejected(types.AccountID)  { o.mu.Lock(); o.rejected++; o.mu.Unlock() }
+func (o *testObserver) UDPSessionLimited(types.AccountID)   { o.mu.Lock(); o.limited++; o.mu.Unlock() }
 func (o *testObserver) UDPPacketRelayed(_ types.RelayDirection, b int) {
 	o.mu.Lock()
 	o.packets++
@@ -381,6 +384,70 @@ func (o *testObserver) UDPPacketRelayed(_ types.RelayDirection, b int) {
 	o.mu.Unlock()
 }
 
+func TestRelay_ConnectionLimitPerSource(t *testing.T) {
+	backend, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer backend.Close()
+
+	go func() {
+		buf := make([]byte, 65535)
+		for {
+			n, addr, err := backend.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			_, _ = backend.WriteTo(buf[:n], addr)
+		}
+	}()
+
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := log.NewEntry(log.StandardLogger())
+	dialFunc := func(ctx context.Context, network, address string) (net.Conn, error) {
+		return net.Dial(network, address)
+	}
+
+	limiter := connlimit.New(connlimit.Limits{MaxConnectionsPerSource: 1})
+	relay := New(ctx, RelayConfig{Logger: logger, Listener: listener, Target: backend.LocalAddr().String(), DialFunc: dialFunc, Limiter: limiter})
+	obs := &testObserver{}
+	relay.SetObserver(obs)
+	go relay.Serve()
+	defer relay.Close()
+
+	// Both clients share 127.0.0.1, so only the first gets a session.
+	client1, err := net.Dial("udp", listener.LocalAddr().String())
+	require.NoError(t, err)
+	defer client1.Close()
+	_, err = client1.Write([]byte("hello"))
+	require.NoError(t, err)
+	require.NoError(t, client1.SetReadDeadline(time.Now().Add(2*time.Second)))
+	buf := make([]byte, 1024)
+	_, err = client1.Read(buf)
+	require.NoError(t, err, "first client should get a response")
+
+	client2, err := net.Dial("udp", listener.LocalAddr().String())
+	require.NoError(t, err)
+	defer client2.Close()
+	_, err = client2.Write([]byte("should be dropped"))
+	require.NoError(t, err)
+	require.NoError(t, client2.SetReadDeadline(time.Now().Add(500*time.Millisecond)))
+	_, err = client2.Read(buf)
+	assert.Error(t, err, "second client from the same source should be limited")
+
+	obs.mu.Lock()
+	assert.Equal(t, 1, obs.limited)
+	obs.mu.Unlock()
+	assert.Equal(t, 1, limiter.Active())
+
+	relay.Close()
+	assert.Equal(t, 0, limiter.Active(), "closing the relay must release every lease")
+}
+
 func TestRelay_CloseFiresObserverEnded(t *testing.T) {
 	backend, err := net.ListenPacket("udp", "127.0.0.1:0")
 	require.NoError(t, err)
@@ -91,6 +91,9 @@ type Config struct {
 	WireguardPort uint16
 	// ProxyProtocol enables PROXY protocol (v1/v2) on TCP listeners.
 	ProxyProtocol bool
+	// HTTP3 enables an HTTP/3 (QUIC) listener on the UDP port matching
+	// the main TCP listener.
+	HTTP3 bool
 	// PreSharedKey is the WireGuard pre-shared key used between the
 	// proxy's embedded clients and peers.
 	PreSharedKey string
@@ -126,6 +129,13 @@ type Config struct {
 	// CrowdSecAPIKey is the CrowdSec bouncer API key. Empty disables
 	// CrowdSec.
 	CrowdSecAPIKey string
+
+	// AccessLogSinks are sink URIs access log entries are delivered to in
+	// addition to management. See sink.Config for the supported schemes.
+	AccessLogSinks []string
+	// AccessLogManagementMode selects which access log entries are sent
+	// to management: "full" (default) or "usage".
+	AccessLogManagementMode string
 }
 
 // New builds a Server from cfg without performing any I/O. No goroutines
@@ -161,6 +171,7 @@ func New(ctx context.Context, cfg Config) *Server {
 		TrustedProxies:           cfg.TrustedProxies,
 		WireguardPort:            cfg.WireguardPort,
 		ProxyProtocol:            cfg.ProxyProtocol,
+		HTTP3:                    cfg.HTTP3,
 		PreSharedKey:             cfg.PreSharedKey,
 		Performance:              cfg.Performance,
 		SupportsCustomPorts:      cfg.SupportsCustomPorts,
@@ -172,5 +183,7 @@ func New(ctx context.Context, cfg Config) *Server {
 		GeoDataDir:               cfg.GeoDataDir,
 		CrowdSecAPIURL:           cfg.CrowdSecAPIURL,
 		CrowdSecAPIKey:           cfg.CrowdSecAPIKey,
+		AccessLogSinks:           cfg.AccessLogSinks,
+		AccessLogManagementMode:  cfg.AccessLogManagementMode,
 	}
 }
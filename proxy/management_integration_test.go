@@ -329,6 +329,18 @@ func (m *storeBackedServiceManager) SetStatus(ctx context.Context, accountID, se
 	return nil
 }
 
+func (m *storeBackedServiceManager) RegisterWebAuthnCredential(_ context.Context, _, _, _ string, _ *service.WebAuthnCredential) error {
+	return nil
+}
+
+func (m *storeBackedServiceManager) UpdateWebAuthnSignCount(_ context.Context, _, _, _ string, _ []byte, _ uint32) error {
+	return nil
+}
+
+func (m *storeBackedServiceManager) RevokeSessions(_ context.Context, _, _ string, _ []*service.RevokedSession) error {
+	return nil
+}
+
 func (m *storeBackedServiceManager) ReloadAllServicesForAccount(ctx context.Context, accountID string) error {
 	return nil
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/pires/go-proxyproto"
 	prometheus2 "github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/quic-go/quic-go/http3"
 	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/sdk/metric"
@@ -44,9 +45,11 @@ import (
 
 	"github.com/netbirdio/netbird/client/embed"
 	"github.com/netbirdio/netbird/proxy/internal/accesslog"
+	"github.com/netbirdio/netbird/proxy/internal/accesslog/sink"
 	"github.com/netbirdio/netbird/proxy/internal/acme"
 	"github.com/netbirdio/netbird/proxy/internal/auth"
 	"github.com/netbirdio/netbird/proxy/internal/certwatch"
+	"github.com/netbirdio/netbird/proxy/internal/connlimit"
 	"github.com/netbirdio/netbird/proxy/internal/conntrack"
 	"github.com/netbirdio/netbird/proxy/internal/crowdsec"
 	"github.com/netbirdio/netbird/proxy/internal/debug"
@@ -88,11 +91,16 @@ type Server struct {
 	auth              *auth.Middleware
 	http              *http.Server
 	https             *http.Server
+	http3             *http3.Server
+	http3Conn         net.PacketConn
 	debug             *http.Server
 	healthServer      *health.Server
 	healthChecker     *health.Checker
 	meter             *proxymetrics.Metrics
 	accessLog         *accesslog.Logger
+	// clusterConfig is the cluster config last applied from a mapping
+	// snapshot. Only the mapping stream goroutine accesses it.
+	clusterConfig *proto.ProxyClusterConfig
 	// middlewareManager drives per-target middleware dispatch. Always
 	// constructed during boot; an empty registry produces empty chains and
 	// the reverse-proxy stays on the no-capture fast path.
@@ -108,6 +116,8 @@ type Server struct {
 	portMu             sync.RWMutex
 	portRouters        map[uint16]*portRouter
 	svcPorts           map[types.ServiceID][]uint16
+	limiterMu          sync.Mutex
+	l4Limiters         map[types.ServiceID]*connlimit.Limiter
 	lastMappings       map[types.ServiceID]*proto.ProxyMapping
 	portRouterWg       sync.WaitGroup
 
@@ -250,6 +260,95 @@ type Server struct {
 	// budget passed to middleware.NewManager. Zero or negative values fall
 	// back to defaultMiddlewareCaptureBudgetBytes (256 MiB).
 	MiddlewareCaptureBudgetBytes int64
+	// AccessLogSinks are sink URIs access log entries are delivered to in
+	// addition to management, e.g. a rotating file or a SIEM collector.
+	// Management can select further sinks for the proxy's cluster, which
+	// are used in addition to these.
+	AccessLogSinks []string
+	// AccessLogManagementMode selects which access log entries are sent
+	// to management: "full" (default) or "usage", which keeps full
+	// entries on the sinks only.
+	AccessLogManagementMode string
+	// HTTP3 enables an HTTP/3 (QUIC) listener on the UDP port matching
+	// the main TCP listener and advertises it via Alt-Svc on TCP responses.
+	HTTP3 bool
+}
+
+// initAccessLogSinks creates the configured access log sinks and applies
+// the management mode to the access logger.
+func (s *Server) initAccessLogSinks() error {
+	mode, err := accesslog.ParseManagementMode(s.AccessLogManagementMode)
+	if err != nil {
+		return err
+	}
+	configs, err := sink.ParseConfigs(s.AccessLogSinks)
+	if err != nil {
+		return err
+	}
+	if mode == accesslog.ManagementModeUsage && len(configs) == 0 {
+		s.Logger.Warn("access log management mode is usage but no sinks are configured: HTTP and L4 access logs are discarded")
+	}
+
+	sinks := make([]*sink.Sink, 0, len(configs))
+	for _, cfg := range configs {
+		sk, err := sink.New(cfg, s.accessLogSinkObserver(), s.Logger)
+		if err != nil {
+			for _, created := range sinks {
+				_ = created.Close()
+			}
+			return err
+		}
+		s.Logger.Infof("access log sink enabled: %s", sk.Name())
+		sinks = append(sinks, sk)
+	}
+	s.accessLog.SetSinks(sinks)
+	s.accessLog.SetManagementMode(mode)
+	return nil
+}
+
+func (s *Server) accessLogSinkObserver() sink.Observer {
+	if s.meter == nil {
+		return nil
+	}
+	return s.meter
+}
+
+// applyClusterConfig applies the settings management selected for the
+// proxy's cluster with a mapping snapshot. A nil config clears them, leaving
+// the locally configured access log sinks and mode. Invalid entries are
+// skipped, so a bad cluster setting never stops the mapping stream.
+func (s *Server) applyClusterConfig(cfg *proto.ProxyClusterConfig) {
+	if s.accessLog == nil || goproto.Equal(s.clusterConfig, cfg) {
+		return
+	}
+	s.clusterConfig = cfg
+
+	var mode accesslog.ManagementMode
+	if raw := cfg.GetAccessLogManagement(); raw != "" {
+		parsed, err := accesslog.ParseManagementMode(raw)
+		if err != nil {
+			s.Logger.Warnf("ignoring cluster access log management mode: %v", err)
+		} else {
+			mode = parsed
+		}
+	}
+
+	var sinks []*sink.Sink
+	for _, raw := range cfg.GetAccessLogSinks() {
+		sinkCfg, err := sink.ParseConfig(raw)
+		if err != nil {
+			s.Logger.Warnf("ignoring cluster access log sink: %v", err)
+			continue
+		}
+		sk, err := sink.New(sinkCfg, s.accessLogSinkObserver(), s.Logger)
+		if err != nil {
+			s.Logger.Warnf("ignoring cluster access log sink: %v", err)
+			continue
+		}
+		s.Logger.Infof("cluster access log sink enabled: %s", sk.Name())
+		sinks = append(sinks, sk)
+	}
+	s.accessLog.SetClusterSinks(sinks, mode)
 }
 
 // defaultMiddlewareCaptureBudgetBytes is the proxy-wide in-flight capture cap
@@ -408,6 +507,9 @@ func (s *Server) Start(ctx context.Context) error {
 
 	s.auth = auth.NewMiddleware(s.Logger, s.mgmtClient, s.geo)
 	s.accessLog = accesslog.NewLogger(s.mgmtClient, s.Logger, s.TrustedProxies)
+	if err := s.initAccessLogSinks(); err != nil {
+		return err
+	}
 
 	s.startDebugEndpoint()
 
@@ -423,6 +525,15 @@ func (s *Server) Start(ctx context.Context) error {
 		return err
 	}
 
+	if s.HTTP3 {
+		if err := s.startHTTP3(ctx, handler, tlsConfig, ln.Addr()); err != nil {
+			if closeErr := ln.Close(); closeErr != nil {
+				s.Logger.Debugf("close main listener on startup failure: %v", closeErr)
+			}
+			return err
+		}
+	}
+
 	s.mainRouter = nbtcp.NewRouter(s.Logger, s.resolveDialFunc, ln.Addr())
 	s.mainRouter.SetObserver(s.meter)
 	s.mainRouter.SetAccessLogger(s.accessLog)
@@ -430,7 +541,7 @@ func (s *Server) Start(ctx context.Context) error {
 
 	s.https = &http.Server{
 		Addr:              s.ListenAddr,
-		Handler:           handler,
+		Handler:           s.advertiseHTTP3(handler),
 		TLSConfig:         tlsConfig,
 		ReadHeaderTimeout: httpReadHeaderTimeout,
 		IdleTimeout:       httpIdleTimeout,
@@ -593,6 +704,7 @@ func (s *Server) initReverseProxy() {
 	if s.middlewareManager != nil {
 		rpOpts = append(rpOpts, proxy.WithMiddlewareManager(s.middlewareManager))
 	}
+	rpOpts = append(rpOpts, proxy.WithMirroring(upstreamRT, s.meter))
 	s.proxy = proxy.NewReverseProxy(s.meter.RoundTripper(upstreamRT), s.ForwardedProto, s.TrustedProxies, s.Logger, rpOpts...)
 }
 
@@ -907,6 +1019,7 @@ func (s *Server) gracefulShutdown() {
 			s.Logger.Warnf("https server drain: %v", err)
 		}
 	}
+	s.shutdownHTTP3(drainCtx)
 
 	// Step 4: Close hijacked connections (WebSocket) that Shutdown does not handle.
 	if n := s.hijackTracker.CloseAll(); n > 0 {
@@ -1375,6 +1488,9 @@ func (s *Server) handleSyncMappingsStream(ctx context.Context, stream proto.Prox
 			}
 			s.Logger.Debug("Processing mapping update completed")
 			tracker.recordBatch(ctx, s, msg.GetMapping(), msg.GetInitialSyncComplete(), batchStart)
+			if msg.GetInitialSyncComplete() {
+				s.applyClusterConfig(msg.GetClusterConfig())
+			}
 
 			if err := stream.Send(&proto.SyncMappingsRequest{
 				Msg: &proto.SyncMappingsRequest_Ack{Ack: &proto.SyncMappingsAck{}},
@@ -1461,6 +1577,9 @@ func (s *Server) handleMappingStream(ctx context.Context, mappingClient proto.Pr
 			}
 			s.Logger.Debug("Processing mapping update completed")
 			tracker.recordBatch(ctx, s, msg.GetMapping(), msg.GetInitialSyncComplete(), batchStart)
+			if msg.GetInitialSyncComplete() {
+				s.applyClusterConfig(msg.GetClusterConfig())
+			}
 		}
 	}
 }
@@ -1755,6 +1874,7 @@ func (s *Server) setupTCPMapping(ctx context.Context, mapping *proto.ProxyMappin
 		DialTimeout:        s.l4DialTimeout(mapping),
 		SessionIdleTimeout: s.clampIdleTimeout(l4SessionIdleTimeout(mapping)),
 		Filter:             s.parseRestrictions(mapping),
+		Limiter:            s.l4Limiter(mapping),
 	})
 
 	s.portMu.Lock()
@@ -1837,6 +1957,7 @@ func (s *Server) setupTLSMapping(ctx context.Context, mapping *proto.ProxyMappin
 		DialTimeout:        s.l4DialTimeout(mapping),
 		SessionIdleTimeout: s.clampIdleTimeout(l4SessionIdleTimeout(mapping)),
 		Filter:             s.parseRestrictions(mapping),
+		Limiter:            s.l4Limiter(mapping),
 	})
 
 	if tlsPort != s.mainPort {
@@ -1985,6 +2106,41 @@ func l4SessionIdleTimeout(mapping *proto.ProxyMapping) time.Duration {
 	return 0
 }
 
+// l4Limiter returns the service's connection limiter with the mapping's
+// limits applied. The limiter is kept across mapping updates, so
+// connections that predate an update keep counting against the caps.
+func (s *Server) l4Limiter(mapping *proto.ProxyMapping) *connlimit.Limiter {
+	l := mapping.GetConnectionLimits()
+	limits := connlimit.Limits{
+		MaxConnections:          int(l.GetMaxConnections()),
+		MaxConnectionsPerSource: int(l.GetMaxConnectionsPerSource()),
+		ConnectionBandwidth:     l.GetConnectionBandwidth(),
+		AggregateBandwidth:      l.GetAggregateBandwidth(),
+	}
+	svcID := types.ServiceID(mapping.GetId())
+
+	s.limiterMu.Lock()
+	defer s.limiterMu.Unlock()
+	if limiter, ok := s.l4Limiters[svcID]; ok {
+		limiter.SetLimits(limits)
+		return limiter
+	}
+	if s.l4Limiters == nil {
+		s.l4Limiters = make(map[types.ServiceID]*connlimit.Limiter)
+	}
+	limiter := connlimit.NewTracking(limits)
+	s.l4Limiters[svcID] = limiter
+	return limiter
+}
+
+// removeL4Limiter drops the service's connection limiter once the service
+// is removed.
+func (s *Server) removeL4Limiter(svcID types.ServiceID) {
+	s.limiterMu.Lock()
+	delete(s.l4Limiters, svcID)
+	s.limiterMu.Unlock()
+}
+
 // addUDPRelay starts a UDP relay on the specified listen port.
 func (s *Server) addUDPRelay(ctx context.Context, mapping *proto.ProxyMapping, targetAddress string, listenPort uint16) error {
 	svcID := types.ServiceID(mapping.GetId())
@@ -2031,6 +2187,7 @@ func (s *Server) addUDPRelay(ctx context.Context, mapping *proto.ProxyMapping, t
 		AccessLog:   s.accessLog,
 		Filter:      s.parseRestrictions(mapping),
 		Geo:         s.geo,
+		Limiter:     s.l4Limiter(mapping),
 	})
 	relay.SetObserver(s.meter)
 
@@ -2059,6 +2216,12 @@ func (s *Server) updateMapping(ctx context.Context, mapping *proto.ProxyMapping)
 	if mapping.GetAuth().GetPin() {
 		schemes = append(schemes, auth.NewPin(s.mgmtClient, svcID, accountID))
 	}
+	if mapping.GetAuth().GetTotp() {
+		schemes = append(schemes, auth.NewTOTP(s.mgmtClient, svcID, accountID))
+	}
+	if mapping.GetAuth().GetWebauthn() {
+		schemes = append(schemes, auth.NewWebAuthn(s.mgmtClient, svcID, accountID))
+	}
 	if mapping.GetAuth().GetOidc() {
 		schemes = append(schemes, auth.NewOIDC(s.mgmtClient, svcID, accountID, s.ForwardedProto))
 	}
@@ -2073,6 +2236,8 @@ func (s *Server) updateMapping(ctx context.Context, mapping *proto.ProxyMapping)
 	if err := s.auth.AddDomain(mapping.GetDomain(), schemes, mapping.GetAuth().GetSessionKey(), maxSessionAge, accountID, svcID, ipRestrictions, mapping.GetPrivate()); err != nil {
 		return fmt.Errorf("auth setup for domain %s: %w", mapping.GetDomain(), err)
 	}
+	s.auth.SetRevokedSessions(mapping.GetDomain(), mapping.GetAuth().GetRevokedSessions())
+	s.auth.SetAuthorizationRules(mapping.GetDomain(), protoToAuthorizationRules(mapping.GetAuthorizationRules()))
 	m := s.protoToMapping(ctx, mapping)
 	s.proxy.AddMapping(m)
 	s.meter.AddMapping(m)
@@ -2190,6 +2355,7 @@ func (s *Server) removeMapping(ctx context.Context, mapping *proto.ProxyMapping)
 	} else {
 		s.cleanupMappingRoutes(mapping)
 	}
+	s.removeL4Limiter(types.ServiceID(mapping.GetId()))
 }
 
 // cleanupMappingRoutes removes HTTP/TLS/L4 routes and custom port state for a
@@ -2314,6 +2480,7 @@ func (s *Server) protoToMapping(ctx context.Context, mapping *proto.ProxyMapping
 			pt.Middlewares = translateMiddlewareConfigs(ctx, mapping.GetId(), opts.GetMiddlewares(), s.middlewareRegistry)
 			pt.AgentNetwork = opts.GetAgentNetwork()
 			pt.DisableAccessLog = opts.GetDisableAccessLog()
+			pt.Mirror = s.protoToMirror(mapping, pathMapping.GetPath(), opts)
 		}
 		pt.RequestTimeout = s.clampDialTimeout(pt.RequestTimeout)
 		paths[pathMapping.GetPath()] = pt
@@ -2329,9 +2496,86 @@ func (s *Server) protoToMapping(ctx context.Context, mapping *proto.ProxyMapping
 	for _, ha := range mapping.GetAuth().GetHeaderAuths() {
 		m.StripAuthHeaders = append(m.StripAuthHeaders, ha.GetHeader())
 	}
+	if ep := mapping.GetErrorPages(); ep != nil {
+		pages, err := proxy.ParseErrorPages(ep.GetClientError(), ep.GetServerError())
+		if err != nil {
+			s.Logger.WithFields(log.Fields{
+				"service_id": mapping.GetId(),
+				"domain":     mapping.GetDomain(),
+			}).WithError(err).Warn("invalid custom error pages, using built-in pages")
+		}
+		m.ErrorPages = pages
+	}
+	m.Maintenance = s.protoToMaintenance(mapping)
 	return m
 }
 
+// protoToMaintenance returns the maintenance configuration for a service,
+// or nil when the service is not in maintenance mode. Unparseable CIDRs
+// are skipped so a bad entry never lifts maintenance for everyone.
+func (s *Server) protoToMaintenance(mapping *proto.ProxyMapping) *proxy.Maintenance {
+	mm := mapping.GetMaintenance()
+	if mm == nil {
+		return nil
+	}
+	m := &proxy.Maintenance{
+		Message:       mm.GetMessage(),
+		RetryAfter:    time.Duration(mm.GetRetryAfterSeconds()) * time.Second,
+		AllowedGroups: mm.GetAllowedGroups(),
+	}
+	for _, raw := range mm.GetAllowedCidrs() {
+		prefix, err := netip.ParsePrefix(raw)
+		if err != nil {
+			s.Logger.WithFields(log.Fields{
+				"service_id": mapping.GetId(),
+				"cidr":       raw,
+			}).Warn("invalid maintenance allowlist CIDR, skipping")
+			continue
+		}
+		m.AllowedPrefixes = append(m.AllowedPrefixes, prefix.Masked())
+	}
+	return m
+}
+
+// protoToMirror returns the mirror configuration for a path, or nil when
+// mirroring is not configured or the mirror URL is unusable. A bad mirror
+// URL only disables mirroring; the primary target keeps serving.
+func (s *Server) protoToMirror(mapping *proto.ProxyMapping, path string, opts *proto.PathTargetOptions) *proxy.MirrorTarget {
+	if opts.GetMirrorTarget() == "" {
+		return nil
+	}
+	mirrorURL, err := url.Parse(opts.GetMirrorTarget())
+	if err != nil || (mirrorURL.Scheme != "http" && mirrorURL.Scheme != "https") || mirrorURL.Host == "" {
+		s.Logger.WithFields(log.Fields{
+			"service_id": mapping.GetId(),
+			"path":       path,
+			"mirror":     opts.GetMirrorTarget(),
+		}).Warn("invalid mirror target, mirroring disabled for path")
+		return nil
+	}
+	percent := int(opts.GetMirrorPercent())
+	if percent <= 0 || percent > 100 {
+		percent = 100
+	}
+	return &proxy.MirrorTarget{URL: mirrorURL, Percent: percent}
+}
+
+func protoToAuthorizationRules(rules []*proto.AuthorizationRule) []auth.AuthorizationRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	out := make([]auth.AuthorizationRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, auth.AuthorizationRule{
+			PathPrefix: r.GetPath(),
+			Methods:    r.GetMethods(),
+			Groups:     r.GetGroups(),
+			Users:      r.GetUsers(),
+		})
+	}
+	return out
+}
+
 func protoToPathRewrite(mode proto.PathRewriteMode) proxy.PathRewriteMode {
 	switch mode {
 	case proto.PathRewriteMode_PATH_REWRITE_PRESERVE:
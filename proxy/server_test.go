@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/netip"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"go.opentelemetry.io/otel/metric/noop"
 	"google.golang.org/grpc"
 
+	"github.com/netbirdio/netbird/proxy/internal/connlimit"
 	proxymetrics "github.com/netbirdio/netbird/proxy/internal/metrics"
 	"github.com/netbirdio/netbird/proxy/internal/types"
 	"github.com/netbirdio/netbird/shared/management/proto"
@@ -322,3 +324,35 @@ func TestCustomTCPPortRouterOutlivesMappingBatchContext(t *testing.T) {
 		return false
 	}, 200*time.Millisecond, 10*time.Millisecond, "custom TCP listener must outlive mapping-batch context cancellation")
 }
+
+func TestL4LimiterSurvivesMappingUpdates(t *testing.T) {
+	srv := &Server{}
+	src := netip.MustParseAddr("203.0.113.1")
+
+	mapping := &proto.ProxyMapping{
+		Id:               "svc-tcp",
+		Mode:             "tcp",
+		ConnectionLimits: &proto.ConnectionLimits{MaxConnections: 2},
+		Path:             []*proto.PathMapping{{Target: "10.0.0.5:22"}},
+	}
+	limiter := srv.l4Limiter(mapping)
+	for range 2 {
+		_, err := limiter.Acquire(src)
+		require.NoError(t, err)
+	}
+
+	// An unrelated change must not reset the connections opened before it.
+	mapping.Path = []*proto.PathMapping{{Target: "10.0.0.6:22"}}
+	updated := srv.l4Limiter(mapping)
+	assert.Same(t, limiter, updated, "the limiter must be kept across updates")
+	_, err := updated.Acquire(src)
+	assert.ErrorIs(t, err, connlimit.ErrMaxConnections)
+
+	mapping.ConnectionLimits = &proto.ConnectionLimits{MaxConnections: 3}
+	_, err = srv.l4Limiter(mapping).Acquire(src)
+	assert.NoError(t, err, "a raised cap applies on top of the open connections")
+	assert.Equal(t, 3, limiter.Active())
+
+	srv.removeL4Limiter("svc-tcp")
+	assert.NotSame(t, limiter, srv.l4Limiter(mapping), "a removed service starts with a fresh limiter")
+}
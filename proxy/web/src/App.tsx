@@ -1,5 +1,5 @@
 import { useState, useRef, useEffect } from "react";
-import {Loader2, Lock, Binary, LogIn} from "lucide-react";
+import {Loader2, Lock, Binary, LogIn, KeyRound, Smartphone} from "lucide-react";
 import { getData, type Data } from "@/data";
 import Button from "@/components/Button";
 import { Input } from "@/components/Input";
@@ -12,6 +12,7 @@ import { Description } from "@/components/Description";
 import { Separator } from "@/components/Separator";
 import { ErrorMessage } from "@/components/ErrorMessage";
 import { Label } from "@/components/Label";
+import { runPasskeyCeremony } from "@/utils/webauthn";
 
 const data = getData();
 
@@ -30,6 +31,11 @@ function App() {
   const [submitting, setSubmitting] = useState<string | null>(null);
   const [pin, setPin] = useState("");
   const [password, setPassword] = useState("");
+  const [totpUser, setTotpUser] = useState("");
+  const [totpCode, setTotpCode] = useState("");
+  const [passkeyUser, setPasskeyUser] = useState("");
+  const [enrollmentCode, setEnrollmentCode] = useState("");
+  const [enrolling, setEnrolling] = useState(false);
   const passwordRef = useRef<HTMLInputElement>(null);
   const pinRef = useRef<PinCodeInputRef>(null);
   const [activeTab, setActiveTab] = useState<"password" | "pin">(
@@ -77,6 +83,55 @@ function App() {
       });
   };
 
+  const submitTOTP = () => {
+    setError(null);
+    setSubmitting("totp");
+
+    const formData = new FormData();
+    formData.append("totp_user", totpUser);
+    formData.append(methods.totp!, totpCode);
+
+    fetch(globalThis.location.href, {
+      method: "POST",
+      body: formData,
+      redirect: "manual",
+    })
+      .then((res) => {
+        if (res.type === "opaqueredirect" || res.status === 0) {
+          setSubmitting("redirect");
+          globalThis.location.reload();
+        } else {
+          setError("Authentication failed. Please try again.");
+          setSubmitting(null);
+          setTotpCode("");
+        }
+      })
+      .catch(() => {
+        setError("An error occurred. Please try again.");
+        setSubmitting(null);
+      });
+  };
+
+  const submitPasskey = () => {
+    setError(null);
+    setSubmitting("webauthn");
+
+    runPasskeyCeremony(enrolling ? "registration" : "login", passkeyUser, enrolling ? enrollmentCode : "")
+      .then((ok) => {
+        if (ok) {
+          setSubmitting("redirect");
+          globalThis.location.reload();
+        } else {
+          setError(enrolling ? "Passkey registration failed. Check the enrollment code." : "Passkey sign-in failed. Please try again.");
+          setSubmitting(null);
+        }
+      })
+      .catch(() => {
+        setError("Passkey ceremony was cancelled or is not supported by this browser.");
+        setSubmitting(null);
+      });
+  };
+
   const handlePinChange = (value: string) => {
     setPin(value);
     if (value.length === 6) {
@@ -216,6 +271,97 @@ function App() {
                 </Button>
               </form>
             )}
+
+            {/* Authenticator app */}
+            {methods.totp && (
+              <>
+                {(methods.oidc || hasCredentialAuth) && <Separator />}
+                <form onSubmit={(e) => {
+                  e.preventDefault();
+                  submitTOTP();
+                }}>
+                  <div className="flex flex-col gap-2 mb-4">
+                    <Label htmlFor="totp-user">Authenticator app</Label>
+                    <Input
+                      id="totp-user"
+                      placeholder="Username"
+                      autoComplete="username"
+                      disabled={submitting !== null}
+                      value={totpUser}
+                      onChange={(e) => setTotpUser(e.target.value)}
+                    />
+                    <Input
+                      id="totp-code"
+                      placeholder="6-digit code"
+                      inputMode="numeric"
+                      autoComplete="one-time-code"
+                      maxLength={6}
+                      disabled={submitting !== null}
+                      value={totpCode}
+                      onChange={(e) => setTotpCode(e.target.value.replace(/\D/g, ""))}
+                    />
+                  </div>
+                  <Button
+                    type="submit"
+                    disabled={submitting !== null || totpUser === "" || totpCode.length !== 6}
+                    variant="secondary"
+                    className="w-full"
+                  >
+                    {submitting === "totp" ? <Loader2 className="animate-spin" size={16} /> : <Smartphone size={16} />}
+                    Verify code
+                  </Button>
+                </form>
+              </>
+            )}
+
+            {/* Passkey */}
+            {methods.webauthn && (
+              <>
+                {(methods.oidc || hasCredentialAuth || methods.totp) && <Separator />}
+                <form onSubmit={(e) => {
+                  e.preventDefault();
+                  submitPasskey();
+                }}>
+                  <div className="flex flex-col gap-2 mb-4">
+                    <Label htmlFor="passkey-user">Passkey</Label>
+                    <Input
+                      id="passkey-user"
+                      placeholder="Username"
+                      autoComplete="username webauthn"
+                      disabled={submitting !== null}
+                      value={passkeyUser}
+                      onChange={(e) => setPasskeyUser(e.target.value)}
+                    />
+                    {enrolling && (
+                      <Input
+                        id="passkey-enrollment"
+                        type="password"
+                        placeholder="Enrollment code"
+                        disabled={submitting !== null}
+                        value={enrollmentCode}
+                        onChange={(e) => setEnrollmentCode(e.target.value)}
+                      />
+                    )}
+                  </div>
+                  <Button
+                    type="submit"
+                    disabled={submitting !== null || passkeyUser === "" || (enrolling && enrollmentCode === "")}
+                    variant="secondary"
+                    className="w-full"
+                  >
+                    {submitting === "webauthn" ? <Loader2 className="animate-spin" size={16} /> : <KeyRound size={16} />}
+                    {enrolling ? "Register passkey" : "Sign in with passkey"}
+                  </Button>
+                  <button
+                    type="button"
+                    className="mt-3 w-full text-sm text-nb-gray-400 hover:text-nb-gray-200"
+                    onClick={() => setEnrolling(!enrolling)}
+                  >
+                    {enrolling ? "I already have a passkey" : "Register a new passkey"}
+                  </button>
+                </form>
+              </>
+            )}
           </div>
         </Card>
 
@@ -1,5 +1,5 @@
 // Auth method types matching Go
-export type AuthMethod = 'pin' | 'password' | 'oidc' | "link"
+export type AuthMethod = 'pin' | 'password' | 'oidc' | 'totp' | 'webauthn' | "link"
 
 // Page types
 export type PageType = 'auth' | 'error'
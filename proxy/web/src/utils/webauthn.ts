@@ -0,0 +1,102 @@
+// Passkey ceremony helpers. The proxy relays each stage to management as a
+// form POST; begin stages answer with the login page whose injected data
+// carries the challenge under methods.webauthn.
+
+type Stage = "login" | "registration";
+
+interface Challenge {
+  stage: string;
+  user: string;
+  session_id: string;
+  // eslint-disable-next-line @typescript-eslint/no-explicit-any
+  options: { publicKey: any };
+}
+
+function fromBase64Url(value: string): ArrayBuffer {
+  const base64 = value.replace(/-/g, "+").replace(/_/g, "/");
+  const padded = base64 + "=".repeat((4 - (base64.length % 4)) % 4);
+  const bytes = Uint8Array.from(atob(padded), (c) => c.charCodeAt(0));
+  return bytes.buffer;
+}
+
+function toBase64Url(buffer: ArrayBuffer): string {
+  const bytes = new Uint8Array(buffer);
+  let binary = "";
+  bytes.forEach((b) => { binary += String.fromCharCode(b); });
+  return btoa(binary).replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "");
+}
+
+function post(form: FormData) {
+  return fetch(globalThis.location.href, { method: "POST", body: form, redirect: "manual" });
+}
+
+async function begin(stage: Stage, user: string, enrollmentCode: string): Promise<Challenge | null> {
+  const form = new FormData();
+  form.append("webauthn_stage", `begin_${stage}`);
+  form.append("webauthn_user", user);
+  if (enrollmentCode) form.append("webauthn_enrollment_code", enrollmentCode);
+
+  const html = await (await post(form)).text();
+  const match = /window\.__DATA__ = (.*);/.exec(html);
+  if (!match) return null;
+  const prompt = JSON.parse(match[1])?.methods?.webauthn;
+  if (!prompt || prompt === "webauthn") return null;
+  return JSON.parse(prompt) as Challenge;
+}
+
+function encodeCredential(credential: PublicKeyCredential): string {
+  // eslint-disable-next-line @typescript-eslint/no-explicit-any
+  const response = credential.response as any;
+  const encoded: Record<string, string> = {
+    clientDataJSON: toBase64Url(response.clientDataJSON),
+  };
+  if (response.attestationObject) {
+    encoded.attestationObject = toBase64Url(response.attestationObject);
+  } else {
+    encoded.authenticatorData = toBase64Url(response.authenticatorData);
+    encoded.signature = toBase64Url(response.signature);
+    if (response.userHandle) encoded.userHandle = toBase64Url(response.userHandle);
+  }
+  return JSON.stringify({
+    id: credential.id,
+    rawId: toBase64Url(credential.rawId),
+    type: credential.type,
+    response: encoded,
+  });
+}
+
+/**
+ * Runs a passkey sign-in or registration. Resolves true once the proxy has
+ * issued a session (answered with a redirect).
+ */
+export async function runPasskeyCeremony(stage: Stage, user: string, enrollmentCode = ""): Promise<boolean> {
+  const challenge = await begin(stage, user, enrollmentCode);
+  if (!challenge) return false;
+
+  const publicKey = challenge.options.publicKey;
+  publicKey.challenge = fromBase64Url(publicKey.challenge);
+  let credential: Credential | null;
+  if (stage === "registration") {
+    publicKey.user.id = fromBase64Url(publicKey.user.id);
+    publicKey.excludeCredentials = (publicKey.excludeCredentials ?? []).map(
+      (c: { id: string }) => ({ ...c, id: fromBase64Url(c.id) }),
+    );
+    credential = await navigator.credentials.create({ publicKey });
+  } else {
+    publicKey.allowCredentials = (publicKey.allowCredentials ?? []).map(
+      (c: { id: string }) => ({ ...c, id: fromBase64Url(c.id) }),
+    );
+    credential = await navigator.credentials.get({ publicKey });
+  }
+  if (!credential) return false;
+
+  const form = new FormData();
+  form.append("webauthn_stage", `finish_${stage}`);
+  form.append("webauthn_user", user);
+  form.append("webauthn_session", challenge.session_id);
+  if (enrollmentCode) form.append("webauthn_enrollment_code", enrollmentCode);
+  form.append("webauthn_credential", encodeCredential(credential as PublicKeyCredential));
+
+  const res = await post(form);
+  return res.type === "opaqueredirect" || res.status === 0;
+}
@@ -2,6 +2,7 @@ package route
 
 import (
 	"fmt"
+	"maps"
 	"net/netip"
 	"slices"
 	"strings"
@@ -113,6 +114,8 @@ type Route struct {
 	AccessControlGroups []string `gorm:"serializer:json"`
 	// SkipAutoApply indicates if this exit node route (0.0.0.0/0) should skip auto-application for client routing
 	SkipAutoApply bool
+	// DistributionLabels distributes the route to the peers with these labels in addition to the Groups
+	DistributionLabels map[string]string `gorm:"serializer:json"`
 }
 
 // EventMeta returns activity event meta related to the route
@@ -145,6 +148,7 @@ func (r *Route) Copy() *Route {
 		Groups:              slices.Clone(r.Groups),
 		AccessControlGroups: slices.Clone(r.AccessControlGroups),
 		SkipAutoApply:       r.SkipAutoApply,
+		DistributionLabels:  maps.Clone(r.DistributionLabels),
 	}
 	return route
 }
@@ -172,7 +176,8 @@ func (r *Route) Equal(other *Route) bool {
 		slices.Equal(r.Groups, other.Groups) &&
 		slices.Equal(r.PeerGroups, other.PeerGroups) &&
 		slices.Equal(r.AccessControlGroups, other.AccessControlGroups) &&
-		other.SkipAutoApply == r.SkipAutoApply
+		other.SkipAutoApply == r.SkipAutoApply &&
+		maps.Equal(r.DistributionLabels, other.DistributionLabels)
 }
 
 // IsDynamic returns if the route is dynamic, i.e. has domains
@@ -34,4 +34,6 @@ type UserAuth struct {
 
 	// Indicates whether this user has authenticated with a Personal Access Token
 	IsPAT bool
+	// The ID of the Personal Access Token the user has authenticated with
+	PATID string
 }
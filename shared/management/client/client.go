@@ -15,6 +15,9 @@ type Client interface {
 	Sync(ctx context.Context, sysInfo *system.Info, msgHandler func(msg *proto.SyncResponse) error) error
 	Job(ctx context.Context, msgHandler func(msg *proto.JobRequest) *proto.JobResponse) error
 	Register(setupKey string, jwtToken string, sysInfo *system.Info, sshKey []byte, dnsLabels domain.List) (*proto.LoginResponse, error)
+	// RegisterWithWorkloadIdentity registers the peer with an OIDC token of a CI system or a Kubernetes service
+	// account, verified by the workload identity provider of the account.
+	RegisterWithWorkloadIdentity(providerID string, token string, sysInfo *system.Info, sshKey []byte, dnsLabels domain.List) (*proto.LoginResponse, error)
 	Login(sysInfo *system.Info, sshKey []byte, dnsLabels domain.List) (*proto.LoginResponse, error)
 	// ExtendAuthSession refreshes the peer's SSO session deadline using a fresh JWT.
 	// Returns the new absolute deadline; zero time when the server reports the peer
@@ -653,6 +653,17 @@ func (c *GrpcClient) Register(setupKey string, jwtToken string, sysInfo *system.
 	return c.login(&proto.LoginRequest{SetupKey: setupKey, Meta: infoToMetaData(sysInfo), JwtToken: jwtToken, PeerKeys: keys, DnsLabels: dnsLabels.ToPunycodeList()})
 }
 
+// RegisterWithWorkloadIdentity registers the peer with a workload identity token instead of a setup key.
+// Takes care of encrypting and decrypting messages.
+func (c *GrpcClient) RegisterWithWorkloadIdentity(providerID string, token string, sysInfo *system.Info, pubSSHKey []byte, dnsLabels domain.List) (*proto.LoginResponse, error) {
+	keys := &proto.PeerKeys{
+		SshPubKey: pubSSHKey,
+		WgPubKey:  []byte(c.key.PublicKey().String()),
+	}
+	workloadIdentity := &proto.WorkloadIdentity{ProviderId: providerID, Token: token}
+	return c.login(&proto.LoginRequest{Meta: infoToMetaData(sysInfo), PeerKeys: keys, DnsLabels: dnsLabels.ToPunycodeList(), WorkloadIdentity: workloadIdentity})
+}
+
 // Login attempts login to Management Server. Takes care of encrypting and decrypting messages.
 func (c *GrpcClient) Login(sysInfo *system.Info, pubSSHKey []byte, dnsLabels domain.List) (*proto.LoginResponse, error) {
 	keys := &proto.PeerKeys{
@@ -1059,6 +1070,8 @@ func infoToMetaData(info *system.Info) *proto.PeerSystemMeta {
 		Capabilities: peerCapabilities(*info),
 
 		SyncMessageVersion: syncMessageVersion(*info),
+
+		Labels: info.Labels,
 	}
 }
 
@@ -10,21 +10,22 @@ import (
 
 // MockClient is a mock implementation of the Client interface for testing.
 type MockClient struct {
-	CloseFunc                      func() error
-	SyncFunc                       func(ctx context.Context, sysInfo *system.Info, msgHandler func(msg *proto.SyncResponse) error) error
-	RegisterFunc                   func(setupKey string, jwtToken string, info *system.Info, sshKey []byte, dnsLabels domain.List) (*proto.LoginResponse, error)
-	LoginFunc                      func(info *system.Info, sshKey []byte, dnsLabels domain.List) (*proto.LoginResponse, error)
-	ExtendAuthSessionFunc          func(info *system.Info, jwtToken string) (*proto.ExtendAuthSessionResponse, error)
-	GetDeviceAuthorizationFlowFunc func() (*proto.DeviceAuthorizationFlow, error)
-	GetPKCEAuthorizationFlowFunc   func() (*proto.PKCEAuthorizationFlow, error)
-	GetServerURLFunc               func() string
-	HealthCheckFunc                func() error
-	SyncMetaFunc                   func(sysInfo *system.Info) error
-	LogoutFunc                     func() error
-	JobFunc                        func(ctx context.Context, msgHandler func(msg *proto.JobRequest) *proto.JobResponse) error
-	CreateExposeFunc               func(ctx context.Context, req ExposeRequest) (*ExposeResponse, error)
-	RenewExposeFunc                func(ctx context.Context, domain string) error
-	StopExposeFunc                 func(ctx context.Context, domain string) error
+	CloseFunc                        func() error
+	SyncFunc                         func(ctx context.Context, sysInfo *system.Info, msgHandler func(msg *proto.SyncResponse) error) error
+	RegisterFunc                     func(setupKey string, jwtToken string, info *system.Info, sshKey []byte, dnsLabels domain.List) (*proto.LoginResponse, error)
+	RegisterWithWorkloadIdentityFunc func(providerID string, token string, info *system.Info, sshKey []byte, dnsLabels domain.List) (*proto.LoginResponse, error)
+	LoginFunc                        func(info *system.Info, sshKey []byte, dnsLabels domain.List) (*proto.LoginResponse, error)
+	ExtendAuthSessionFunc            func(info *system.Info, jwtToken string) (*proto.ExtendAuthSessionResponse, error)
+	GetDeviceAuthorizationFlowFunc   func() (*proto.DeviceAuthorizationFlow, error)
+	GetPKCEAuthorizationFlowFunc     func() (*proto.PKCEAuthorizationFlow, error)
+	GetServerURLFunc                 func() string
+	HealthCheckFunc                  func() error
+	SyncMetaFunc                     func(sysInfo *system.Info) error
+	LogoutFunc                       func() error
+	JobFunc                          func(ctx context.Context, msgHandler func(msg *proto.JobRequest) *proto.JobResponse) error
+	CreateExposeFunc                 func(ctx context.Context, req ExposeRequest) (*ExposeResponse, error)
+	RenewExposeFunc                  func(ctx context.Context, domain string) error
+	StopExposeFunc                   func(ctx context.Context, domain string) error
 }
 
 func (m *MockClient) IsHealthy() bool {
@@ -59,6 +60,13 @@ func (m *MockClient) Register(setupKey string, jwtToken string, info *system.Inf
 	return m.RegisterFunc(setupKey, jwtToken, info, sshKey, dnsLabels)
 }
 
+func (m *MockClient) RegisterWithWorkloadIdentity(providerID string, token string, info *system.Info, sshKey []byte, dnsLabels domain.List) (*proto.LoginResponse, error) {
+	if m.RegisterWithWorkloadIdentityFunc == nil {
+		return nil, nil
+	}
+	return m.RegisterWithWorkloadIdentityFunc(providerID, token, info, sshKey, dnsLabels)
+}
+
 func (m *MockClient) Login(info *system.Info, sshKey []byte, dnsLabels domain.List) (*proto.LoginResponse, error) {
 	if m.LoginFunc == nil {
 		return nil, nil
@@ -3,6 +3,7 @@ package rest
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/netbirdio/netbird/shared/management/http/api"
@@ -82,10 +83,61 @@ func NetworkTrafficEndDate(t time.Time) NetworkTrafficOption {
 	}
 }
 
-// ListAuditEvents list all audit events
+// AuditEventOption options for ListAuditEvents API
+type AuditEventOption func(query map[string]string)
+
+// AuditEventActivityCodes filters audit events by any of the given activity codes
+func AuditEventActivityCodes(codes ...string) AuditEventOption {
+	return func(query map[string]string) {
+		query["activity_code"] = strings.Join(codes, ",")
+	}
+}
+
+func AuditEventInitiatorID(initiatorID string) AuditEventOption {
+	return func(query map[string]string) {
+		query["initiator_id"] = initiatorID
+	}
+}
+
+func AuditEventTargetID(targetID string) AuditEventOption {
+	return func(query map[string]string) {
+		query["target_id"] = targetID
+	}
+}
+
+func AuditEventStartDate(t time.Time) AuditEventOption {
+	return func(query map[string]string) {
+		query["start_date"] = t.Format(time.RFC3339)
+	}
+}
+
+func AuditEventEndDate(t time.Time) AuditEventOption {
+	return func(query map[string]string) {
+		query["end_date"] = t.Format(time.RFC3339)
+	}
+}
+
+// AuditEventCursor returns only events older than the event with the given ID
+func AuditEventCursor(eventID string) AuditEventOption {
+	return func(query map[string]string) {
+		query["cursor"] = eventID
+	}
+}
+
+func AuditEventLimit(limit int) AuditEventOption {
+	return func(query map[string]string) {
+		query["limit"] = fmt.Sprintf("%d", limit)
+	}
+}
+
+// ListAuditEvents list audit events, newest first
 // See more: https://docs.netbird.io/api/resources/events#list-all-audit-events
-func (a *EventsAPI) ListAuditEvents(ctx context.Context) ([]api.Event, error) {
-	resp, err := a.c.NewRequest(ctx, "GET", "/api/events/audit", nil, nil)
+func (a *EventsAPI) ListAuditEvents(ctx context.Context, opts ...AuditEventOption) ([]api.Event, error) {
+	query := make(map[string]string)
+	for _, o := range opts {
+		o(query)
+	}
+	resp, err := a.c.NewRequest(ctx, "GET", "/api/events/audit", nil, query)
 	if err != nil {
 		return nil, err
 	}
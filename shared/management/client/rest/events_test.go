@@ -43,6 +43,28 @@ func TestEvents_ListAuditEvents_200(t *testing.T) {
 	})
 }
 
+func TestEvents_ListAuditEvents_Filtered(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/events/audit", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "policy.add,policy.update", r.URL.Query().Get("activity_code"))
+			assert.Equal(t, "user-1", r.URL.Query().Get("initiator_id"))
+			assert.Equal(t, "42", r.URL.Query().Get("cursor"))
+			assert.Equal(t, "20", r.URL.Query().Get("limit"))
+			retBytes, _ := json.Marshal([]api.Event{testEvent})
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Events.ListAuditEvents(context.Background(),
+			rest.AuditEventActivityCodes("policy.add", "policy.update"),
+			rest.AuditEventInitiatorID("user-1"),
+			rest.AuditEventCursor("42"),
+			rest.AuditEventLimit(20),
+		)
+		require.NoError(t, err)
+		assert.Len(t, ret, 1)
+	})
+}
+
 func TestEvents_ListAuditEvents_Err(t *testing.T) {
 	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
 		mux.HandleFunc("/api/events/audit", func(w http.ResponseWriter, r *http.Request) {
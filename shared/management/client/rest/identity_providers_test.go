@@ -88,13 +88,13 @@ func TestIdentityProviders_Create_200(t *testing.T) {
 			var req api.PostApiIdentityProvidersJSONRequestBody
 			err = json.Unmarshal(reqBytes, &req)
 			require.NoError(t, err)
-			assert.Equal(t, "new-client-id", req.ClientId)
+			assert.Equal(t, "new-client-id", *req.ClientId)
 			retBytes, _ := json.Marshal(testIdentityProvider)
 			_, err = w.Write(retBytes)
 			require.NoError(t, err)
 		})
 		ret, err := c.IdentityProviders.Create(context.Background(), api.PostApiIdentityProvidersJSONRequestBody{
-			ClientId: "new-client-id",
+			ClientId: ptr("new-client-id"),
 		})
 		require.NoError(t, err)
 		assert.Equal(t, testIdentityProvider, *ret)
@@ -110,7 +110,7 @@ func TestIdentityProviders_Create_Err(t *testing.T) {
 			require.NoError(t, err)
 		})
 		ret, err := c.IdentityProviders.Create(context.Background(), api.PostApiIdentityProvidersJSONRequestBody{
-			ClientId: "new-client-id",
+			ClientId: ptr("new-client-id"),
 		})
 		assert.Error(t, err)
 		assert.Equal(t, "No", err.Error())
@@ -127,13 +127,13 @@ func TestIdentityProviders_Update_200(t *testing.T) {
 			var req api.PutApiIdentityProvidersIdpIdJSONRequestBody
 			err = json.Unmarshal(reqBytes, &req)
 			require.NoError(t, err)
-			assert.Equal(t, "updated-client-id", req.ClientId)
+			assert.Equal(t, "updated-client-id", *req.ClientId)
 			retBytes, _ := json.Marshal(testIdentityProvider)
 			_, err = w.Write(retBytes)
 			require.NoError(t, err)
 		})
 		ret, err := c.IdentityProviders.Update(context.Background(), "Test", api.PutApiIdentityProvidersIdpIdJSONRequestBody{
-			ClientId: "updated-client-id",
+			ClientId: ptr("updated-client-id"),
 		})
 		require.NoError(t, err)
 		assert.Equal(t, testIdentityProvider, *ret)
@@ -149,7 +149,7 @@ func TestIdentityProviders_Update_Err(t *testing.T) {
 			require.NoError(t, err)
 		})
 		ret, err := c.IdentityProviders.Update(context.Background(), "Test", api.PutApiIdentityProvidersIdpIdJSONRequestBody{
-			ClientId: "updated-client-id",
+			ClientId: ptr("updated-client-id"),
 		})
 		assert.Error(t, err)
 		assert.Equal(t, "No", err.Error())
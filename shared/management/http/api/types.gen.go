@@ -17,6 +17,51 @@ const (
 	TokenAuthScopes  tokenAuthContextKey  = "TokenAuth.Scopes"
 )
 
+// Defines values for AccessRequestStatus.
+const (
+	AccessRequestStatusApproved AccessRequestStatus = "approved"
+	AccessRequestStatusDenied   AccessRequestStatus = "denied"
+	AccessRequestStatusExpired  AccessRequestStatus = "expired"
+	AccessRequestStatusPending  AccessRequestStatus = "pending"
+	AccessRequestStatusRevoked  AccessRequestStatus = "revoked"
+)
+
+// Valid indicates whether the value is a known member of the AccessRequestStatus enum.
+func (e AccessRequestStatus) Valid() bool {
+	switch e {
+	case AccessRequestStatusApproved:
+		return true
+	case AccessRequestStatusDenied:
+		return true
+	case AccessRequestStatusExpired:
+		return true
+	case AccessRequestStatusPending:
+		return true
+	case AccessRequestStatusRevoked:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AccessRequestTargetType.
+const (
+	AccessRequestTargetTypeGroup  AccessRequestTargetType = "group"
+	AccessRequestTargetTypePolicy AccessRequestTargetType = "policy"
+)
+
+// Valid indicates whether the value is a known member of the AccessRequestTargetType enum.
+func (e AccessRequestTargetType) Valid() bool {
+	switch e {
+	case AccessRequestTargetTypeGroup:
+		return true
+	case AccessRequestTargetTypePolicy:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for AccessRestrictionsCrowdsecMode.
 const (
 	AccessRestrictionsCrowdsecModeEnforce AccessRestrictionsCrowdsecMode = "enforce"
@@ -101,6 +146,7 @@ const (
 	CreateIntegrationRequestPlatformFirehose    CreateIntegrationRequestPlatform = "firehose"
 	CreateIntegrationRequestPlatformGenericHttp CreateIntegrationRequestPlatform = "generic_http"
 	CreateIntegrationRequestPlatformS3          CreateIntegrationRequestPlatform = "s3"
+	CreateIntegrationRequestPlatformSyslog      CreateIntegrationRequestPlatform = "syslog"
 )
 
 // Valid indicates whether the value is a known member of the CreateIntegrationRequestPlatform enum.
@@ -114,6 +160,8 @@ func (e CreateIntegrationRequestPlatform) Valid() bool {
 		return true
 	case CreateIntegrationRequestPlatformS3:
 		return true
+	case CreateIntegrationRequestPlatformSyslog:
+		return true
 	default:
 		return false
 	}
@@ -548,15 +596,107 @@ func (e GroupMinimumIssued) Valid() bool {
 	}
 }
 
+// Defines values for GroupRuleAttribute.
+const (
+	GroupRuleAttributeConnectionIp   GroupRuleAttribute = "connection_ip"
+	GroupRuleAttributeCountry        GroupRuleAttribute = "country"
+	GroupRuleAttributeHostname       GroupRuleAttribute = "hostname"
+	GroupRuleAttributeNetbirdVersion GroupRuleAttribute = "netbird_version"
+	GroupRuleAttributeOs             GroupRuleAttribute = "os"
+	GroupRuleAttributeOsVersion      GroupRuleAttribute = "os_version"
+	GroupRuleAttributeSetupKey       GroupRuleAttribute = "setup_key"
+	GroupRuleAttributeUser           GroupRuleAttribute = "user"
+)
+
+// Valid indicates whether the value is a known member of the GroupRuleAttribute enum.
+func (e GroupRuleAttribute) Valid() bool {
+	switch e {
+	case GroupRuleAttributeConnectionIp:
+		return true
+	case GroupRuleAttributeCountry:
+		return true
+	case GroupRuleAttributeHostname:
+		return true
+	case GroupRuleAttributeNetbirdVersion:
+		return true
+	case GroupRuleAttributeOs:
+		return true
+	case GroupRuleAttributeOsVersion:
+		return true
+	case GroupRuleAttributeSetupKey:
+		return true
+	case GroupRuleAttributeUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GroupRuleOperator.
+const (
+	GroupRuleOperatorEquals     GroupRuleOperator = "equals"
+	GroupRuleOperatorGlob       GroupRuleOperator = "glob"
+	GroupRuleOperatorInRange    GroupRuleOperator = "in_range"
+	GroupRuleOperatorMaxVersion GroupRuleOperator = "max_version"
+	GroupRuleOperatorMinVersion GroupRuleOperator = "min_version"
+	GroupRuleOperatorNotEquals  GroupRuleOperator = "not_equals"
+	GroupRuleOperatorNotInRange GroupRuleOperator = "not_in_range"
+	GroupRuleOperatorRegex      GroupRuleOperator = "regex"
+)
+
+// Valid indicates whether the value is a known member of the GroupRuleOperator enum.
+func (e GroupRuleOperator) Valid() bool {
+	switch e {
+	case GroupRuleOperatorEquals:
+		return true
+	case GroupRuleOperatorGlob:
+		return true
+	case GroupRuleOperatorInRange:
+		return true
+	case GroupRuleOperatorMaxVersion:
+		return true
+	case GroupRuleOperatorMinVersion:
+		return true
+	case GroupRuleOperatorNotEquals:
+		return true
+	case GroupRuleOperatorNotInRange:
+		return true
+	case GroupRuleOperatorRegex:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GroupRulesMatch.
+const (
+	GroupRulesMatchAll GroupRulesMatch = "all"
+	GroupRulesMatchAny GroupRulesMatch = "any"
+)
+
+// Valid indicates whether the value is a known member of the GroupRulesMatch enum.
+func (e GroupRulesMatch) Valid() bool {
+	switch e {
+	case GroupRulesMatchAll:
+		return true
+	case GroupRulesMatchAny:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for IdentityProviderType.
 const (
 	IdentityProviderTypeAdfs      IdentityProviderType = "adfs"
 	IdentityProviderTypeEntra     IdentityProviderType = "entra"
 	IdentityProviderTypeGoogle    IdentityProviderType = "google"
+	IdentityProviderTypeLdap      IdentityProviderType = "ldap"
 	IdentityProviderTypeMicrosoft IdentityProviderType = "microsoft"
 	IdentityProviderTypeOidc      IdentityProviderType = "oidc"
 	IdentityProviderTypeOkta      IdentityProviderType = "okta"
 	IdentityProviderTypePocketid  IdentityProviderType = "pocketid"
+	IdentityProviderTypeSaml      IdentityProviderType = "saml"
 	IdentityProviderTypeZitadel   IdentityProviderType = "zitadel"
 )
 
@@ -569,6 +709,8 @@ func (e IdentityProviderType) Valid() bool {
 		return true
 	case IdentityProviderTypeGoogle:
 		return true
+	case IdentityProviderTypeLdap:
+		return true
 	case IdentityProviderTypeMicrosoft:
 		return true
 	case IdentityProviderTypeOidc:
@@ -577,6 +719,8 @@ func (e IdentityProviderType) Valid() bool {
 		return true
 	case IdentityProviderTypePocketid:
 		return true
+	case IdentityProviderTypeSaml:
+		return true
 	case IdentityProviderTypeZitadel:
 		return true
 	default:
@@ -653,6 +797,7 @@ const (
 	IntegrationResponsePlatformFirehose    IntegrationResponsePlatform = "firehose"
 	IntegrationResponsePlatformGenericHttp IntegrationResponsePlatform = "generic_http"
 	IntegrationResponsePlatformS3          IntegrationResponsePlatform = "s3"
+	IntegrationResponsePlatformSyslog      IntegrationResponsePlatform = "syslog"
 )
 
 // Valid indicates whether the value is a known member of the IntegrationResponsePlatform enum.
@@ -666,6 +811,8 @@ func (e IntegrationResponsePlatform) Valid() bool {
 		return true
 	case IntegrationResponsePlatformS3:
 		return true
+	case IntegrationResponsePlatformSyslog:
+		return true
 	default:
 		return false
 	}
@@ -764,6 +911,30 @@ func (e NotificationChannelType) Valid() bool {
 	}
 }
 
+// Defines values for PeerApprovalRuleAttribute.
+const (
+	PeerApprovalRuleAttributeOs           PeerApprovalRuleAttribute = "os"
+	PeerApprovalRuleAttributeSerialNumber PeerApprovalRuleAttribute = "serial_number"
+	PeerApprovalRuleAttributeSetupKey     PeerApprovalRuleAttribute = "setup_key"
+	PeerApprovalRuleAttributeUser         PeerApprovalRuleAttribute = "user"
+)
+
+// Valid indicates whether the value is a known member of the PeerApprovalRuleAttribute enum.
+func (e PeerApprovalRuleAttribute) Valid() bool {
+	switch e {
+	case PeerApprovalRuleAttributeOs:
+		return true
+	case PeerApprovalRuleAttributeSerialNumber:
+		return true
+	case PeerApprovalRuleAttributeSetupKey:
+		return true
+	case PeerApprovalRuleAttributeUser:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for PeerNetworkRangeCheckAction.
 const (
 	PeerNetworkRangeCheckActionAllow PeerNetworkRangeCheckAction = "allow"
@@ -782,6 +953,30 @@ func (e PeerNetworkRangeCheckAction) Valid() bool {
 	}
 }
 
+// Defines values for PersonalAccessTokenScopeOperations.
+const (
+	PersonalAccessTokenScopeOperationsCreate PersonalAccessTokenScopeOperations = "create"
+	PersonalAccessTokenScopeOperationsDelete PersonalAccessTokenScopeOperations = "delete"
+	PersonalAccessTokenScopeOperationsRead   PersonalAccessTokenScopeOperations = "read"
+	PersonalAccessTokenScopeOperationsUpdate PersonalAccessTokenScopeOperations = "update"
+)
+
+// Valid indicates whether the value is a known member of the PersonalAccessTokenScopeOperations enum.
+func (e PersonalAccessTokenScopeOperations) Valid() bool {
+	switch e {
+	case PersonalAccessTokenScopeOperationsCreate:
+		return true
+	case PersonalAccessTokenScopeOperationsDelete:
+		return true
+	case PersonalAccessTokenScopeOperationsRead:
+		return true
+	case PersonalAccessTokenScopeOperationsUpdate:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for PolicyRuleAction.
 const (
 	PolicyRuleActionAccept PolicyRuleAction = "accept"
@@ -917,6 +1112,102 @@ func (e PolicyRuleUpdateProtocol) Valid() bool {
 	}
 }
 
+// Defines values for PolicyScheduleWindowDays.
+const (
+	PolicyScheduleWindowDaysFri PolicyScheduleWindowDays = "fri"
+	PolicyScheduleWindowDaysMon PolicyScheduleWindowDays = "mon"
+	PolicyScheduleWindowDaysSat PolicyScheduleWindowDays = "sat"
+	PolicyScheduleWindowDaysSun PolicyScheduleWindowDays = "sun"
+	PolicyScheduleWindowDaysThu PolicyScheduleWindowDays = "thu"
+	PolicyScheduleWindowDaysTue PolicyScheduleWindowDays = "tue"
+	PolicyScheduleWindowDaysWed PolicyScheduleWindowDays = "wed"
+)
+
+// Valid indicates whether the value is a known member of the PolicyScheduleWindowDays enum.
+func (e PolicyScheduleWindowDays) Valid() bool {
+	switch e {
+	case PolicyScheduleWindowDaysFri:
+		return true
+	case PolicyScheduleWindowDaysMon:
+		return true
+	case PolicyScheduleWindowDaysSat:
+		return true
+	case PolicyScheduleWindowDaysSun:
+		return true
+	case PolicyScheduleWindowDaysThu:
+		return true
+	case PolicyScheduleWindowDaysTue:
+		return true
+	case PolicyScheduleWindowDaysWed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PolicySimulationPeerResultDestinationType.
+const (
+	PolicySimulationPeerResultDestinationTypePeer     PolicySimulationPeerResultDestinationType = "peer"
+	PolicySimulationPeerResultDestinationTypeResource PolicySimulationPeerResultDestinationType = "resource"
+	PolicySimulationPeerResultDestinationTypeRoute    PolicySimulationPeerResultDestinationType = "route"
+)
+
+// Valid indicates whether the value is a known member of the PolicySimulationPeerResultDestinationType enum.
+func (e PolicySimulationPeerResultDestinationType) Valid() bool {
+	switch e {
+	case PolicySimulationPeerResultDestinationTypePeer:
+		return true
+	case PolicySimulationPeerResultDestinationTypeResource:
+		return true
+	case PolicySimulationPeerResultDestinationTypeRoute:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PolicySimulationRequestProtocol.
+const (
+	PolicySimulationRequestProtocolAll  PolicySimulationRequestProtocol = "all"
+	PolicySimulationRequestProtocolIcmp PolicySimulationRequestProtocol = "icmp"
+	PolicySimulationRequestProtocolTcp  PolicySimulationRequestProtocol = "tcp"
+	PolicySimulationRequestProtocolUdp  PolicySimulationRequestProtocol = "udp"
+)
+
+// Valid indicates whether the value is a known member of the PolicySimulationRequestProtocol enum.
+func (e PolicySimulationRequestProtocol) Valid() bool {
+	switch e {
+	case PolicySimulationRequestProtocolAll:
+		return true
+	case PolicySimulationRequestProtocolIcmp:
+		return true
+	case PolicySimulationRequestProtocolTcp:
+		return true
+	case PolicySimulationRequestProtocolUdp:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PolicySimulationRuleAction.
+const (
+	PolicySimulationRuleActionAccept PolicySimulationRuleAction = "accept"
+	PolicySimulationRuleActionDrop   PolicySimulationRuleAction = "drop"
+)
+
+// Valid indicates whether the value is a known member of the PolicySimulationRuleAction enum.
+func (e PolicySimulationRuleAction) Valid() bool {
+	switch e {
+	case PolicySimulationRuleActionAccept:
+		return true
+	case PolicySimulationRuleActionDrop:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ProxyClusterType.
 const (
 	ProxyClusterTypeAccount ProxyClusterType = "account"
@@ -1142,6 +1433,39 @@ func (e ServiceTargetOptionsPathRewrite) Valid() bool {
 	}
 }
 
+// Defines values for SetupKeyRestrictionsAllowedOs.
+const (
+	SetupKeyRestrictionsAllowedOsAndroid SetupKeyRestrictionsAllowedOs = "android"
+	SetupKeyRestrictionsAllowedOsDarwin  SetupKeyRestrictionsAllowedOs = "darwin"
+	SetupKeyRestrictionsAllowedOsFreebsd SetupKeyRestrictionsAllowedOs = "freebsd"
+	SetupKeyRestrictionsAllowedOsIos     SetupKeyRestrictionsAllowedOs = "ios"
+	SetupKeyRestrictionsAllowedOsJs      SetupKeyRestrictionsAllowedOs = "js"
+	SetupKeyRestrictionsAllowedOsLinux   SetupKeyRestrictionsAllowedOs = "linux"
+	SetupKeyRestrictionsAllowedOsWindows SetupKeyRestrictionsAllowedOs = "windows"
+)
+
+// Valid indicates whether the value is a known member of the SetupKeyRestrictionsAllowedOs enum.
+func (e SetupKeyRestrictionsAllowedOs) Valid() bool {
+	switch e {
+	case SetupKeyRestrictionsAllowedOsAndroid:
+		return true
+	case SetupKeyRestrictionsAllowedOsDarwin:
+		return true
+	case SetupKeyRestrictionsAllowedOsFreebsd:
+		return true
+	case SetupKeyRestrictionsAllowedOsIos:
+		return true
+	case SetupKeyRestrictionsAllowedOsJs:
+		return true
+	case SetupKeyRestrictionsAllowedOsLinux:
+		return true
+	case SetupKeyRestrictionsAllowedOsWindows:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for TenantResponseStatus.
 const (
 	TenantResponseStatusActive   TenantResponseStatus = "active"
@@ -1532,6 +1856,78 @@ func (e PutApiIntegrationsMspTenantsIdInviteJSONBodyValue) Valid() bool {
 	}
 }
 
+// AccessRequest A user's request for temporary access to a group or a policy. Once approved it is a grant that is in effect until it expires.
+type AccessRequest struct {
+	// CreatedAt Time the request was made
+	CreatedAt time.Time `json:"created_at"`
+
+	// Duration Number of seconds the access is granted for once approved
+	Duration int `json:"duration"`
+
+	// ExpiresAt Time the grant ends
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Id Access request ID
+	Id string `json:"id"`
+
+	// Justification Why the access is needed
+	Justification string `json:"justification"`
+
+	// ReviewComment Approver's note on the decision
+	ReviewComment *string `json:"review_comment,omitempty"`
+
+	// ReviewedAt Time the request was approved or denied
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+
+	// ReviewedBy ID of the user that approved or denied the request
+	ReviewedBy *string `json:"reviewed_by,omitempty"`
+
+	// RevokedAt Time the request was withdrawn or the grant was ended early
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+
+	// RevokedBy ID of the user that withdrew the request or ended the grant early
+	RevokedBy *string `json:"revoked_by,omitempty"`
+
+	// Status State of the request
+	Status AccessRequestStatus `json:"status"`
+
+	// TargetId ID of the requested group or policy
+	TargetId string `json:"target_id"`
+
+	// TargetType Kind of the requested resource. A group grant adds the requester's peers to the group, a policy grant enables a disabled policy.
+	TargetType AccessRequestTargetType `json:"target_type"`
+
+	// UserId ID of the user that requested access
+	UserId string `json:"user_id"`
+}
+
+// AccessRequestStatus State of the request
+type AccessRequestStatus string
+
+// AccessRequestCreate defines model for AccessRequestCreate.
+type AccessRequestCreate struct {
+	// Duration Number of seconds the access is requested for, at most 30 days
+	Duration int `json:"duration"`
+
+	// Justification Why the access is needed
+	Justification string `json:"justification"`
+
+	// TargetId ID of the requested group or policy
+	TargetId string `json:"target_id"`
+
+	// TargetType Kind of the requested resource. A group grant adds the requester's peers to the group, a policy grant enables a disabled policy.
+	TargetType AccessRequestTargetType `json:"target_type"`
+}
+
+// AccessRequestReview defines model for AccessRequestReview.
+type AccessRequestReview struct {
+	// Comment Note on the decision
+	Comment *string `json:"comment,omitempty"`
+}
+
+// AccessRequestTargetType Kind of the requested resource. A group grant adds the requester's peers to the group, a policy grant enables a disabled policy.
+type AccessRequestTargetType string
+
 // AccessRestrictions Connection-level access restrictions based on IP address or geography. Applies to both HTTP and L4 services.
 type AccessRestrictions struct {
 	// AllowedCidrs CIDR allowlist. If non-empty, only IPs matching these CIDRs are allowed.
@@ -1629,9 +2025,12 @@ type AccountExtraSettings struct {
 	// NetworkTrafficPacketCounterEnabled Enables or disables network traffic packet counter. If enabled, network packets and their size will be counted and reported. (This can have an slight impact on performance)
 	NetworkTrafficPacketCounterEnabled bool `json:"network_traffic_packet_counter_enabled"`
 
-	// PeerApprovalEnabled (Cloud only) Enables or disables peer approval globally. If enabled, all peers added will be in pending state until approved by an admin.
+	// PeerApprovalEnabled Enables or disables peer approval globally. If enabled, all peers added will be in pending state until approved by an admin.
 	PeerApprovalEnabled bool `json:"peer_approval_enabled"`
 
+	// PeerApprovalRules Auto-approval rules for new peers. A peer matching any rule skips the pending state. If unset the existing rules are kept.
+	PeerApprovalRules *[]PeerApprovalRule `json:"peer_approval_rules,omitempty"`
+
 	// UserApprovalRequired Enables manual approval for new users joining via domain matching. When enabled, users are blocked with pending approval status until explicitly approved by an admin.
 	UserApprovalRequired bool `json:"user_approval_required"`
 }
@@ -1653,6 +2052,9 @@ type AccountRequest struct {
 
 // AccountSettings defines model for AccountSettings.
 type AccountSettings struct {
+	// AccessRequestApproverGroups User groups whose members can approve just-in-time access requests in addition to administrators. If unset the existing groups are kept.
+	AccessRequestApproverGroups *[]string `json:"access_request_approver_groups,omitempty"`
+
 	// AgentNetworkOnly Limits the dashboard to the Agent Network surface for this account. Set for accounts created via netbird.ai signups and can be disabled later. Enabling this requires dashboard_features.agent_network to be true in the same request.
 	AgentNetworkOnly *bool `json:"agent_network_only,omitempty"`
 
@@ -2735,9 +3137,15 @@ type CreateSetupKeyRequest struct {
 	// ExpiresIn Expiration time in seconds
 	ExpiresIn int `json:"expires_in"`
 
+	// Labels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	Labels *Labels `json:"labels,omitempty"`
+
 	// Name Setup Key name
 	Name string `json:"name"`
 
+	// Restrictions Restrictions a peer has to satisfy to enroll with the setup key. Empty fields don't restrict anything.
+	Restrictions *SetupKeyRestrictions `json:"restrictions,omitempty"`
+
 	// Type Setup key type, one-off for single time usage and reusable
 	Type string `json:"type"`
 
@@ -2757,6 +3165,39 @@ type CreateTenantRequest struct {
 	Name string `json:"name"`
 }
 
+// CustomRole defines model for CustomRole.
+type CustomRole struct {
+	// Description Custom role description
+	Description *string `json:"description,omitempty"`
+
+	// Id Custom role ID, used as the role of the users it is assigned to
+	Id string `json:"id"`
+
+	// JwtGroups JWT groups whose members are assigned this role when JWT group sync is enabled
+	JwtGroups *[]string `json:"jwt_groups,omitempty"`
+
+	// Name Custom role name, unique within the account
+	Name string `json:"name"`
+
+	// Permissions Operations granted per module. Granting a parent module such as agent_network also grants its submodules.
+	Permissions map[string]map[string]bool `json:"permissions"`
+}
+
+// CustomRoleRequest defines model for CustomRoleRequest.
+type CustomRoleRequest struct {
+	// Description Custom role description
+	Description *string `json:"description,omitempty"`
+
+	// JwtGroups JWT groups whose members are assigned this role when JWT group sync is enabled
+	JwtGroups *[]string `json:"jwt_groups,omitempty"`
+
+	// Name Custom role name, unique within the account
+	Name string `json:"name"`
+
+	// Permissions Operations granted per module. Granting a parent module such as agent_network also grants its submodules.
+	Permissions map[string]map[string]bool `json:"permissions"`
+}
+
 // DNSChallengeResponse defines model for DNSChallengeResponse.
 type DNSChallengeResponse struct {
 	// DnsChallenge The DNS challenge to set in a TXT record
@@ -3209,6 +3650,9 @@ type Group struct {
 
 	// ResourcesCount Count of resources associated to the group
 	ResourcesCount int `json:"resources_count"`
+
+	// Rules Peer attribute rules that make the group dynamic. The peers of a dynamic group are computed from the rules and re-evaluated when peer metadata changes, so peers can't be assigned to it directly.
+	Rules *GroupRules `json:"rules,omitempty"`
 }
 
 // GroupIssued How the group was issued (api, integration, jwt)
@@ -3243,8 +3687,39 @@ type GroupRequest struct {
 	// Peers List of peers ids
 	Peers     *[]string   `json:"peers,omitempty"`
 	Resources *[]Resource `json:"resources,omitempty"`
+
+	// Rules Peer attribute rules that make the group dynamic. The peers of a dynamic group are computed from the rules and re-evaluated when peer metadata changes, so peers can't be assigned to it directly.
+	Rules *GroupRules `json:"rules,omitempty"`
+}
+
+// GroupRule defines model for GroupRule.
+type GroupRule struct {
+	// Attribute Peer attribute the rule is evaluated against. os is the operating system family, setup_key the ID of the setup key used to register the peer and user the ID of the user that registered it.
+	Attribute GroupRuleAttribute `json:"attribute"`
+
+	// Operator Comparison applied to the attribute. The rule matches when the attribute matches any of the values, negated operators when it matches none. min_version and max_version apply to os_version and netbird_version, in_range and not_in_range to connection_ip.
+	Operator GroupRuleOperator `json:"operator"`
+
+	// Values Values compared with the attribute
+	Values []string `json:"values"`
 }
 
+// GroupRuleAttribute Peer attribute the rule is evaluated against. os is the operating system family, setup_key the ID of the setup key used to register the peer and user the ID of the user that registered it.
+type GroupRuleAttribute string
+
+// GroupRuleOperator Comparison applied to the attribute. The rule matches when the attribute matches any of the values, negated operators when it matches none. min_version and max_version apply to os_version and netbird_version, in_range and not_in_range to connection_ip.
+type GroupRuleOperator string
+
+// GroupRules Peer attribute rules that make the group dynamic. The peers of a dynamic group are computed from the rules and re-evaluated when peer metadata changes, so peers can't be assigned to it directly.
+type GroupRules struct {
+	// Match Whether all or any of the rules have to match for a peer to be a member
+	Match GroupRulesMatch `json:"match"`
+	Rules []GroupRule     `json:"rules"`
+}
+
+// GroupRulesMatch Whether all or any of the rules have to match for a peer to be a member
+type GroupRulesMatch string
+
 // HeaderAuthConfig Static header-value authentication. The proxy checks that the named header matches the configured value.
 type HeaderAuthConfig struct {
 	// Enabled Whether header auth is enabled
@@ -3283,31 +3758,145 @@ type IdentityProvider struct {
 	// Issuer OIDC issuer URL
 	Issuer string `json:"issuer"`
 
+	// Ldap Settings of an LDAP or Active Directory identity provider
+	Ldap *IdentityProviderLDAPConfig `json:"ldap,omitempty"`
+
 	// Name Human-readable name for the identity provider
 	Name string `json:"name"`
 
+	// Saml Settings of a SAML 2.0 identity provider
+	Saml *IdentityProviderSAMLConfig `json:"saml,omitempty"`
+
 	// Type Type of identity provider
 	Type IdentityProviderType `json:"type"`
 }
 
+// IdentityProviderLDAPConfig Settings of an LDAP or Active Directory identity provider
+type IdentityProviderLDAPConfig struct {
+	// BindDn DN of the service account used to search the directory, anonymous bind when empty
+	BindDn *string `json:"bind_dn,omitempty"`
+
+	// BindPassword Password of the service account. Never returned, the stored password is kept when empty on update.
+	BindPassword *string `json:"bind_password,omitempty"`
+
+	// GroupSearch How the groups of a user are looked up. The group names are issued in the groups claim of the tokens and synced to NetBird groups when JWT group sync is enabled.
+	GroupSearch *IdentityProviderLDAPGroupSearch `json:"group_search,omitempty"`
+
+	// Host Host and optional port of the LDAP server
+	Host string `json:"host"`
+
+	// InsecureNoSsl Connect without TLS
+	InsecureNoSsl *bool `json:"insecure_no_ssl,omitempty"`
+
+	// InsecureSkipVerify Skip the verification of the server certificate
+	InsecureSkipVerify *bool `json:"insecure_skip_verify,omitempty"`
+
+	// RootCa PEM encoded CA bundle used to verify the server certificate
+	RootCa *string `json:"root_ca,omitempty"`
+
+	// StartTls Connect without TLS and upgrade the connection with StartTLS
+	StartTls *bool `json:"start_tls,omitempty"`
+
+	// UserSearch How users are looked up in the directory when they sign in
+	UserSearch IdentityProviderLDAPUserSearch `json:"user_search"`
+}
+
+// IdentityProviderLDAPGroupSearch How the groups of a user are looked up. The group names are issued in the groups claim of the tokens and synced to NetBird groups when JWT group sync is enabled.
+type IdentityProviderLDAPGroupSearch struct {
+	// BaseDn Base DN to search groups under
+	BaseDn string `json:"base_dn"`
+
+	// Filter Optional LDAP filter applied to the group search
+	Filter *string `json:"filter,omitempty"`
+
+	// GroupAttribute Attribute of the group entry holding its members
+	GroupAttribute string `json:"group_attribute"`
+
+	// NameAttribute Attribute holding the group name
+	NameAttribute string `json:"name_attribute"`
+
+	// UserAttribute Attribute of the user entry matched against the group attribute
+	UserAttribute string `json:"user_attribute"`
+}
+
+// IdentityProviderLDAPUserSearch How users are looked up in the directory when they sign in
+type IdentityProviderLDAPUserSearch struct {
+	// BaseDn Base DN to search users under
+	BaseDn string `json:"base_dn"`
+
+	// EmailAttribute Attribute holding the user email, defaults to mail
+	EmailAttribute *string `json:"email_attribute,omitempty"`
+
+	// Filter Optional LDAP filter applied to the user search
+	Filter *string `json:"filter,omitempty"`
+
+	// IdAttribute Attribute used as the user ID, defaults to uid
+	IdAttribute *string `json:"id_attribute,omitempty"`
+
+	// NameAttribute Attribute holding the user display name
+	NameAttribute *string `json:"name_attribute,omitempty"`
+
+	// UsernameAttributes Attributes matched against the username entered on the login page
+	UsernameAttributes []string `json:"username_attributes"`
+}
+
 // IdentityProviderRequest defines model for IdentityProviderRequest.
 type IdentityProviderRequest struct {
-	// ClientId OAuth2 client ID
-	ClientId string `json:"client_id"`
+	// ClientId OAuth2 client ID, required for OAuth2 based identity providers
+	ClientId *string `json:"client_id,omitempty"`
 
 	// ClientSecret OAuth2 client secret
-	ClientSecret string `json:"client_secret"`
+	ClientSecret *string `json:"client_secret,omitempty"`
 
-	// Issuer OIDC issuer URL
-	Issuer string `json:"issuer"`
+	// Issuer OIDC issuer URL, required for OAuth2 based identity providers except google and microsoft
+	Issuer *string `json:"issuer,omitempty"`
+
+	// Ldap Settings of an LDAP or Active Directory identity provider
+	Ldap *IdentityProviderLDAPConfig `json:"ldap,omitempty"`
 
 	// Name Human-readable name for the identity provider
 	Name string `json:"name"`
 
+	// Saml Settings of a SAML 2.0 identity provider
+	Saml *IdentityProviderSAMLConfig `json:"saml,omitempty"`
+
 	// Type Type of identity provider
 	Type IdentityProviderType `json:"type"`
 }
 
+// IdentityProviderSAMLConfig Settings of a SAML 2.0 identity provider
+type IdentityProviderSAMLConfig struct {
+	// Ca PEM encoded certificate used to verify the assertion signatures
+	Ca *string `json:"ca,omitempty"`
+
+	// EmailAttribute Assertion attribute holding the user email
+	EmailAttribute string `json:"email_attribute"`
+
+	// EntityIssuer Issuer of the authentication requests sent to the identity provider
+	EntityIssuer *string `json:"entity_issuer,omitempty"`
+
+	// GroupsAttribute Assertion attribute holding the user groups
+	GroupsAttribute *string `json:"groups_attribute,omitempty"`
+
+	// GroupsDelimiter Delimiter splitting the groups attribute when it holds a single value
+	GroupsDelimiter *string `json:"groups_delimiter,omitempty"`
+
+	// InsecureSkipSignatureValidation Skip the validation of the assertion signatures
+	InsecureSkipSignatureValidation *bool `json:"insecure_skip_signature_validation,omitempty"`
+
+	// NameIdPolicyFormat Requested format of the NameID
+	NameIdPolicyFormat *string `json:"name_id_policy_format,omitempty"`
+
+	// SsoIssuer Issuer expected in the assertions of the identity provider
+	SsoIssuer *string `json:"sso_issuer,omitempty"`
+
+	// SsoUrl URL of the identity provider the authentication requests are sent to
+	SsoUrl string `json:"sso_url"`
+
+	// UsernameAttribute Assertion attribute holding the username
+	UsernameAttribute string `json:"username_attribute"`
+}
+
 // IdentityProviderType Type of identity provider
 type IdentityProviderType string
 
@@ -3480,6 +4069,27 @@ type InstanceVersionInfo struct {
 	ManagementUpdateAvailable bool `json:"management_update_available"`
 }
 
+// IntegrationDeliveryStatus Delivery progress of an event streaming integration.
+type IntegrationDeliveryStatus struct {
+	// ConsecutiveFailures Number of delivery attempts that failed in a row.
+	ConsecutiveFailures int `json:"consecutive_failures"`
+
+	// LastAttemptAt Timestamp of the last delivery attempt.
+	LastAttemptAt *time.Time `json:"last_attempt_at,omitempty"`
+
+	// LastDeliveryAt Timestamp of the last successful delivery.
+	LastDeliveryAt *time.Time `json:"last_delivery_at,omitempty"`
+
+	// LastError Error of the last failed delivery attempt. Empty after a successful delivery.
+	LastError *string `json:"last_error,omitempty"`
+
+	// LastEventId ID of the last activity event that was delivered. Events after it are delivered next.
+	LastEventId int64 `json:"last_event_id"`
+
+	// NextRetryAt Earliest time of the next delivery attempt while backing off after failures.
+	NextRetryAt *time.Time `json:"next_retry_at,omitempty"`
+}
+
 // IntegrationEnabled defines model for IntegrationEnabled.
 type IntegrationEnabled struct {
 	// Enabled Whether the integration is enabled
@@ -3497,6 +4107,9 @@ type IntegrationResponse struct {
 	// CreatedAt Timestamp of when the integration was created.
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 
+	// DeliveryStatus Delivery progress of an event streaming integration.
+	DeliveryStatus *IntegrationDeliveryStatus `json:"delivery_status,omitempty"`
+
 	// Enabled Whether the integration is currently active.
 	Enabled *bool `json:"enabled,omitempty"`
 
@@ -3568,6 +4181,9 @@ type JobResponse struct {
 // JobResponseStatus defines model for JobResponse.Status.
 type JobResponseStatus string
 
+// Labels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+type Labels map[string]string
+
 // LinkAuthConfig defines model for LinkAuthConfig.
 type LinkAuthConfig struct {
 	// Enabled Whether link auth is enabled
@@ -4079,6 +4695,9 @@ type Peer struct {
 	// CityName Commonly used English name of the city
 	CityName CityName `json:"city_name"`
 
+	// ClientLabels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	ClientLabels *Labels `json:"client_labels,omitempty"`
+
 	// Connected Peer to Management connection status
 	Connected bool `json:"connected"`
 
@@ -4127,6 +4746,9 @@ type Peer struct {
 	// KernelVersion Peer's operating system kernel version
 	KernelVersion string `json:"kernel_version"`
 
+	// Labels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	Labels *Labels `json:"labels,omitempty"`
+
 	// LastLogin Last time this peer performed log in (authentication). E.g., user authenticated.
 	LastLogin time.Time `json:"last_login"`
 
@@ -4162,6 +4784,18 @@ type Peer struct {
 	Version string `json:"version"`
 }
 
+// PeerApprovalRule defines model for PeerApprovalRule.
+type PeerApprovalRule struct {
+	// Attribute Peer attribute the rule is evaluated against
+	Attribute PeerApprovalRuleAttribute `json:"attribute"`
+
+	// Values Values the attribute is compared with. The rule matches when the attribute equals any of them.
+	Values []string `json:"values"`
+}
+
+// PeerApprovalRuleAttribute Peer attribute the rule is evaluated against
+type PeerApprovalRuleAttribute string
+
 // PeerBatch defines model for PeerBatch.
 type PeerBatch struct {
 	// AccessiblePeersCount Number of accessible peers
@@ -4173,6 +4807,9 @@ type PeerBatch struct {
 	// CityName Commonly used English name of the city
 	CityName CityName `json:"city_name"`
 
+	// ClientLabels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	ClientLabels *Labels `json:"client_labels,omitempty"`
+
 	// Connected Peer to Management connection status
 	Connected bool `json:"connected"`
 
@@ -4221,6 +4858,9 @@ type PeerBatch struct {
 	// KernelVersion Peer's operating system kernel version
 	KernelVersion string `json:"kernel_version"`
 
+	// Labels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	Labels *Labels `json:"labels,omitempty"`
+
 	// LastLogin Last time this peer performed log in (authentication). E.g., user authenticated.
 	LastLogin time.Time `json:"last_login"`
 
@@ -4322,7 +4962,10 @@ type PeerRequest struct {
 	Ip *string `json:"ip,omitempty"`
 
 	// Ipv6 Peer's IPv6 overlay address. Omitted if IPv6 is not enabled for the account.
-	Ipv6                   *string `json:"ipv6,omitempty"`
+	Ipv6 *string `json:"ipv6,omitempty"`
+
+	// Labels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	Labels                 *Labels `json:"labels,omitempty"`
 	LoginExpirationEnabled bool    `json:"login_expiration_enabled"`
 	Name                   string  `json:"name"`
 	SshEnabled             bool    `json:"ssh_enabled"`
@@ -4371,6 +5014,9 @@ type PersonalAccessToken struct {
 
 	// Name Name of the token
 	Name string `json:"name"`
+
+	// Scopes Grants that limit the token on top of its user's role. A token without scopes carries the full role of its user.
+	Scopes *[]PersonalAccessTokenScope `json:"scopes,omitempty"`
 }
 
 // PersonalAccessTokenGenerated defines model for PersonalAccessTokenGenerated.
@@ -4388,8 +5034,26 @@ type PersonalAccessTokenRequest struct {
 
 	// Name Name of the token
 	Name string `json:"name"`
+
+	// Scopes Optional grants that limit the token on top of the user's role. Omit to give the token the full role of the user.
+	Scopes *[]PersonalAccessTokenScope `json:"scopes,omitempty"`
+}
+
+// PersonalAccessTokenScope defines model for PersonalAccessTokenScope.
+type PersonalAccessTokenScope struct {
+	// Module Permission module the scope grants access to, e.g. peers or policies
+	Module string `json:"module"`
+
+	// Operations Operations granted on the module
+	Operations []PersonalAccessTokenScopeOperations `json:"operations"`
+
+	// ResourceIds Restricts the grant to the listed resources. Supported by the peers, policies and setup_keys modules.
+	ResourceIds *[]string `json:"resource_ids,omitempty"`
 }
 
+// PersonalAccessTokenScopeOperations defines model for PersonalAccessTokenScope.Operations.
+type PersonalAccessTokenScopeOperations string
+
 // Policy defines model for Policy.
 type Policy struct {
 	// Description Policy friendly description
@@ -4407,6 +5071,9 @@ type Policy struct {
 	// Rules Policy rule object for policy UI editor
 	Rules []PolicyRule `json:"rules"`
 
+	// Schedule Limits when an enabled policy is in effect. The policy is only active between the start and end dates and, when windows are set, inside one of the windows.
+	Schedule *PolicySchedule `json:"schedule,omitempty"`
+
 	// SourcePostureChecks Posture checks ID's applied to policy source groups
 	SourcePostureChecks []string `json:"source_posture_checks"`
 }
@@ -4425,6 +5092,9 @@ type PolicyCreate struct {
 	// Rules Policy rule object for policy UI editor
 	Rules []PolicyRuleUpdate `json:"rules"`
 
+	// Schedule Limits when an enabled policy is in effect. The policy is only active between the start and end dates and, when windows are set, inside one of the windows.
+	Schedule *PolicySchedule `json:"schedule,omitempty"`
+
 	// SourcePostureChecks Posture checks ID's applied to policy source groups
 	SourcePostureChecks *[]string `json:"source_posture_checks,omitempty"`
 }
@@ -4439,6 +5109,9 @@ type PolicyMinimum struct {
 
 	// Name Policy name identifier
 	Name string `json:"name"`
+
+	// Schedule Limits when an enabled policy is in effect. The policy is only active between the start and end dates and, when windows are set, inside one of the windows.
+	Schedule *PolicySchedule `json:"schedule,omitempty"`
 }
 
 // PolicyRule defines model for PolicyRule.
@@ -4456,6 +5129,9 @@ type PolicyRule struct {
 	Description         *string   `json:"description,omitempty"`
 	DestinationResource *Resource `json:"destinationResource,omitempty"`
 
+	// DestinationLabels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	DestinationLabels *Labels `json:"destination_labels,omitempty"`
+
 	// Destinations Policy rule destination group IDs
 	Destinations *[]GroupMinimum `json:"destinations,omitempty"`
 
@@ -4478,6 +5154,9 @@ type PolicyRule struct {
 	Protocol       PolicyRuleProtocol `json:"protocol"`
 	SourceResource *Resource          `json:"sourceResource,omitempty"`
 
+	// SourceLabels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	SourceLabels *Labels `json:"source_labels,omitempty"`
+
 	// Sources Policy rule source group IDs
 	Sources *[]GroupMinimum `json:"sources,omitempty"`
 }
@@ -4539,6 +5218,9 @@ type PolicyRuleUpdate struct {
 	Description         *string   `json:"description,omitempty"`
 	DestinationResource *Resource `json:"destinationResource,omitempty"`
 
+	// DestinationLabels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	DestinationLabels *Labels `json:"destination_labels,omitempty"`
+
 	// Destinations Policy rule destination group IDs
 	Destinations *[]string `json:"destinations,omitempty"`
 
@@ -4561,6 +5243,9 @@ type PolicyRuleUpdate struct {
 	Protocol       PolicyRuleUpdateProtocol `json:"protocol"`
 	SourceResource *Resource                `json:"sourceResource,omitempty"`
 
+	// SourceLabels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	SourceLabels *Labels `json:"source_labels,omitempty"`
+
 	// Sources Policy rule source group IDs
 	Sources *[]string `json:"sources,omitempty"`
 }
@@ -4571,6 +5256,117 @@ type PolicyRuleUpdateAction string
 // PolicyRuleUpdateProtocol Policy rule type of the traffic
 type PolicyRuleUpdateProtocol string
 
+// PolicySchedule Limits when an enabled policy is in effect. The policy is only active between the start and end dates and, when windows are set, inside one of the windows.
+type PolicySchedule struct {
+	// EndDate Moment the policy stops being active
+	EndDate *time.Time `json:"end_date,omitempty"`
+
+	// StartDate Moment the policy becomes active
+	StartDate *time.Time `json:"start_date,omitempty"`
+
+	// Timezone IANA timezone the windows are evaluated in, UTC when empty
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Windows Recurring weekly time windows the policy is active in
+	Windows *[]PolicyScheduleWindow `json:"windows,omitempty"`
+}
+
+// PolicyScheduleWindow defines model for PolicyScheduleWindow.
+type PolicyScheduleWindow struct {
+	// Days Weekdays the window starts on, every day when empty
+	Days *[]PolicyScheduleWindowDays `json:"days,omitempty"`
+
+	// End Time of day in HH:MM the window closes at. A window ending before it starts spans midnight.
+	End string `json:"end"`
+
+	// Start Time of day in HH:MM the window opens at
+	Start string `json:"start"`
+}
+
+// PolicyScheduleWindowDays defines model for PolicyScheduleWindow.Days.
+type PolicyScheduleWindowDays string
+
+// PolicySimulationPeerResult defines model for PolicySimulationPeerResult.
+type PolicySimulationPeerResult struct {
+	// Allowed Whether the connection is allowed
+	Allowed bool `json:"allowed"`
+
+	// DestinationId ID of the destination peer, network resource or route, empty when no route covers the address
+	DestinationId string `json:"destination_id"`
+
+	// DestinationType Kind of destination the connection was evaluated against
+	DestinationType PolicySimulationPeerResultDestinationType `json:"destination_type"`
+
+	// FailedChecks Peer state, routing and posture checks that failed for the connection
+	FailedChecks []string `json:"failed_checks"`
+
+	// MatchedRules Policy rules that apply to the connection
+	MatchedRules []PolicySimulationRule `json:"matched_rules"`
+
+	// SourcePeerId ID of the source peer
+	SourcePeerId string `json:"source_peer_id"`
+}
+
+// PolicySimulationPeerResultDestinationType Kind of destination the connection was evaluated against
+type PolicySimulationPeerResultDestinationType string
+
+// PolicySimulationRequest defines model for PolicySimulationRequest.
+type PolicySimulationRequest struct {
+	// DestinationAddress Destination IP address, resolved to a peer, a network resource or a network route
+	DestinationAddress *string `json:"destination_address,omitempty"`
+
+	// DestinationPeerId ID of the destination peer
+	DestinationPeerId *string `json:"destination_peer_id,omitempty"`
+
+	// DestinationResourceId ID of the destination network resource
+	DestinationResourceId *string `json:"destination_resource_id,omitempty"`
+
+	// Port Destination port of a tcp or udp connection, any port when omitted
+	Port *int `json:"port,omitempty"`
+
+	// Protocol Protocol of the connection, any protocol when omitted
+	Protocol *PolicySimulationRequestProtocol `json:"protocol,omitempty"`
+
+	// SourcePeerId ID of the peer initiating the connection, mutually exclusive with source_user_id
+	SourcePeerId *string `json:"source_peer_id,omitempty"`
+
+	// SourceUserId ID of the user whose peers initiate the connection, mutually exclusive with source_peer_id
+	SourceUserId *string `json:"source_user_id,omitempty"`
+}
+
+// PolicySimulationRequestProtocol Protocol of the connection, any protocol when omitted
+type PolicySimulationRequestProtocol string
+
+// PolicySimulationResult defines model for PolicySimulationResult.
+type PolicySimulationResult struct {
+	// Allowed Whether the connection is allowed from every source peer
+	Allowed bool `json:"allowed"`
+
+	// Results Simulation results for each source peer
+	Results []PolicySimulationPeerResult `json:"results"`
+}
+
+// PolicySimulationRule defines model for PolicySimulationRule.
+type PolicySimulationRule struct {
+	// Action Policy rule accept or drops packets
+	Action PolicySimulationRuleAction `json:"action"`
+
+	// PolicyId ID of the policy
+	PolicyId string `json:"policy_id"`
+
+	// PolicyName Name of the policy
+	PolicyName string `json:"policy_name"`
+
+	// RuleId ID of the policy rule
+	RuleId string `json:"rule_id"`
+
+	// RuleName Name of the policy rule
+	RuleName string `json:"rule_name"`
+}
+
+// PolicySimulationRuleAction Policy rule accept or drops packets
+type PolicySimulationRuleAction string
+
 // PolicyUpdate defines model for PolicyUpdate.
 type PolicyUpdate struct {
 	// Description Policy friendly description
@@ -4585,6 +5381,9 @@ type PolicyUpdate struct {
 	// Rules Policy rule object for policy UI editor
 	Rules []PolicyRuleUpdate `json:"rules"`
 
+	// Schedule Limits when an enabled policy is in effect. The policy is only active between the start and end dates and, when windows are set, inside one of the windows.
+	Schedule *PolicySchedule `json:"schedule,omitempty"`
+
 	// SourcePostureChecks Posture checks ID's applied to policy source groups
 	SourcePostureChecks *[]string `json:"source_posture_checks,omitempty"`
 }
@@ -4788,6 +5587,39 @@ type ProxyCluster struct {
 // `shared` clusters are operated by NetBird and shared across accounts.
 type ProxyClusterType string
 
+// ProxySession An authenticated session issued by the reverse proxy for a service
+type ProxySession struct {
+	// AuthMethod Authentication method the session was issued for (e.g. password, pin, oidc, totp, webauthn)
+	AuthMethod string `json:"auth_method"`
+
+	// ExpiresAt Time the session expires
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Id Unique identifier of the session (the session token ID)
+	Id string `json:"id"`
+
+	// IssuedAt Time the session was issued
+	IssuedAt time.Time `json:"issued_at"`
+
+	// RevokedAt Time the session was revoked, if it was
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+
+	// ServiceId ID of the service the session grants access to
+	ServiceId string `json:"service_id"`
+
+	// SourceIp IP address of the client the session was issued to
+	SourceIp *string `json:"source_ip,omitempty"`
+
+	// UserAgent User agent of the client the session was issued to
+	UserAgent *string `json:"user_agent,omitempty"`
+
+	// UserEmail Email of the authenticated user, if known
+	UserEmail *string `json:"user_email,omitempty"`
+
+	// UserId ID or name of the authenticated user, or the auth method for shared secrets (e.g. pin-user)
+	UserId string `json:"user_id"`
+}
+
 // ProxyToken defines model for ProxyToken.
 type ProxyToken struct {
 	CreatedAt time.Time  `json:"created_at"`
@@ -4880,13 +5712,16 @@ type Route struct {
 	// Description Route description
 	Description string `json:"description"`
 
+	// DistributionLabels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	DistributionLabels *Labels `json:"distribution_labels,omitempty"`
+
 	// Domains Domain list to be dynamically resolved. Max of 32 domains can be added per route configuration. Conflicts with network
 	Domains *[]string `json:"domains,omitempty"`
 
 	// Enabled Route status
 	Enabled bool `json:"enabled"`
 
-	// Groups Group IDs containing routing peers
+	// Groups Group IDs containing routing peers. May be empty when `distribution_labels` is set.
 	Groups []string `json:"groups"`
 
 	// Id Route Id
@@ -4928,13 +5763,16 @@ type RouteRequest struct {
 	// Description Route description
 	Description string `json:"description"`
 
+	// DistributionLabels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	DistributionLabels *Labels `json:"distribution_labels,omitempty"`
+
 	// Domains Domain list to be dynamically resolved. Max of 32 domains can be added per route configuration. Conflicts with network
 	Domains *[]string `json:"domains,omitempty"`
 
 	// Enabled Route status
 	Enabled bool `json:"enabled"`
 
-	// Groups Group IDs containing routing peers
+	// Groups Group IDs containing routing peers. May be empty when `distribution_labels` is set.
 	Groups []string `json:"groups"`
 
 	// KeepRoute Indicate if the route should be kept after a domain doesn't resolve that IP anymore
@@ -5046,18 +5884,38 @@ type Service struct {
 	AccessRestrictions *AccessRestrictions `json:"access_restrictions,omitempty"`
 	Auth               ServiceAuthConfig   `json:"auth"`
 
+	// AuthorizationRules Per-path authorization evaluated after authentication. The first rule whose
+	// path prefix and method match the request decides; requests matching no rule
+	// are allowed. Requires bearer auth (SSO) or a private service so the proxy
+	// knows the user and their groups.
+	AuthorizationRules *[]ServiceAuthorizationRule `json:"authorization_rules,omitempty"`
+
+	// ConnectionLimits Connection caps and bandwidth shaping for L4 (tcp, tls, udp) services. Zero or
+	// omitted fields are unlimited. Bandwidths are bytes per second and apply to each
+	// direction independently; UDP traffic over the allowance is dropped.
+	ConnectionLimits *ServiceConnectionLimits `json:"connection_limits,omitempty"`
+
 	// Domain Domain for the service
 	Domain string `json:"domain"`
 
 	// Enabled Whether the service is enabled
 	Enabled bool `json:"enabled"`
 
+	// ErrorPages Custom html/template sources replacing the proxy's built-in error pages. Templates
+	// receive .Code, .Title, .Message and .RequestID. HTTP services only.
+	ErrorPages *ServiceErrorPages `json:"error_pages,omitempty"`
+
 	// Id Service ID
 	Id string `json:"id"`
 
 	// ListenPort Port the proxy listens on (L4/TLS only)
-	ListenPort *int        `json:"listen_port,omitempty"`
-	Meta       ServiceMeta `json:"meta"`
+	ListenPort *int `json:"listen_port,omitempty"`
+
+	// Maintenance Maintenance mode. While enabled the proxy answers 503 with a Retry-After header
+	// instead of forwarding, except for members of the allowed groups and clients
+	// connecting from the allowed CIDRs. HTTP services only.
+	Maintenance *ServiceMaintenance `json:"maintenance,omitempty"`
+	Meta        ServiceMeta         `json:"meta"`
 
 	// Mode Service mode. "http" for L7 reverse proxy, "tcp"/"udp"/"tls" for L4 passthrough.
 	Mode *ServiceMode `json:"mode,omitempty"`
@@ -5097,6 +5955,74 @@ type ServiceAuthConfig struct {
 	LinkAuth     *LinkAuthConfig     `json:"link_auth,omitempty"`
 	PasswordAuth *PasswordAuthConfig `json:"password_auth,omitempty"`
 	PinAuth      *PINAuthConfig      `json:"pin_auth,omitempty"`
+
+	// TotpAuth Time-based one-time password (RFC 6238) authentication. Each user signs in with their name and a code from an authenticator app.
+	TotpAuth *TOTPAuthConfig `json:"totp_auth,omitempty"`
+
+	// WebauthnAuth WebAuthn (passkey) authentication. Users register a passkey on the service's login page using a one-time enrollment code.
+	WebauthnAuth *WebAuthnAuthConfig `json:"webauthn_auth,omitempty"`
+}
+
+// ServiceAuthorizationRule defines model for ServiceAuthorizationRule.
+type ServiceAuthorizationRule struct {
+	// Groups NetBird group IDs allowed to access the path
+	Groups *[]string `json:"groups,omitempty"`
+
+	// Methods HTTP methods the rule applies to. Empty matches every method.
+	Methods *[]string `json:"methods,omitempty"`
+
+	// Path Request path prefix the rule applies to
+	Path string `json:"path"`
+
+	// Users NetBird user IDs allowed to access the path
+	Users *[]string `json:"users,omitempty"`
+}
+
+// ServiceConnectionLimits Connection caps and bandwidth shaping for L4 (tcp, tls, udp) services. Zero or
+// omitted fields are unlimited. Bandwidths are bytes per second and apply to each
+// direction independently; UDP traffic over the allowance is dropped.
+type ServiceConnectionLimits struct {
+	// AggregateBandwidth Bandwidth cap across all connections of the service in bytes per second
+	AggregateBandwidth *int64 `json:"aggregate_bandwidth,omitempty"`
+
+	// ConnectionBandwidth Bandwidth cap per connection or session in bytes per second
+	ConnectionBandwidth *int64 `json:"connection_bandwidth,omitempty"`
+
+	// MaxConnections Maximum concurrent TCP connections or UDP sessions for the service
+	MaxConnections *int `json:"max_connections,omitempty"`
+
+	// MaxConnectionsPerSource Maximum concurrent TCP connections or UDP sessions from a single client IP
+	MaxConnectionsPerSource *int `json:"max_connections_per_source,omitempty"`
+}
+
+// ServiceErrorPages Custom html/template sources replacing the proxy's built-in error pages. Templates
+// receive .Code, .Title, .Message and .RequestID. HTTP services only.
+type ServiceErrorPages struct {
+	// ClientError Template rendered for 4xx responses generated by the proxy
+	ClientError *string `json:"client_error,omitempty"`
+
+	// ServerError Template rendered for 5xx responses generated by the proxy, including the maintenance page
+	ServerError *string `json:"server_error,omitempty"`
+}
+
+// ServiceMaintenance Maintenance mode. While enabled the proxy answers 503 with a Retry-After header
+// instead of forwarding, except for members of the allowed groups and clients
+// connecting from the allowed CIDRs. HTTP services only.
+type ServiceMaintenance struct {
+	// AllowedCidrs Client CIDRs that bypass maintenance mode
+	AllowedCidrs *[]string `json:"allowed_cidrs,omitempty"`
+
+	// AllowedGroups NetBird group IDs whose authenticated users bypass maintenance mode
+	AllowedGroups *[]string `json:"allowed_groups,omitempty"`
+
+	// Enabled Whether the service is in maintenance mode
+	Enabled bool `json:"enabled"`
+
+	// Message Message shown on the maintenance page
+	Message *string `json:"message,omitempty"`
+
+	// RetryAfterSeconds Value of the Retry-After header in seconds. Omitted when zero.
+	RetryAfterSeconds *int `json:"retry_after_seconds,omitempty"`
 }
 
 // ServiceMeta defines model for ServiceMeta.
@@ -5123,15 +6049,35 @@ type ServiceRequest struct {
 	AccessRestrictions *AccessRestrictions `json:"access_restrictions,omitempty"`
 	Auth               *ServiceAuthConfig  `json:"auth,omitempty"`
 
+	// AuthorizationRules Per-path authorization evaluated after authentication. The first rule whose
+	// path prefix and method match the request decides; requests matching no rule
+	// are allowed. Requires bearer auth (SSO) or a private service so the proxy
+	// knows the user and their groups.
+	AuthorizationRules *[]ServiceAuthorizationRule `json:"authorization_rules,omitempty"`
+
+	// ConnectionLimits Connection caps and bandwidth shaping for L4 (tcp, tls, udp) services. Zero or
+	// omitted fields are unlimited. Bandwidths are bytes per second and apply to each
+	// direction independently; UDP traffic over the allowance is dropped.
+	ConnectionLimits *ServiceConnectionLimits `json:"connection_limits,omitempty"`
+
 	// Domain Domain for the service
 	Domain string `json:"domain"`
 
 	// Enabled Whether the service is enabled
 	Enabled bool `json:"enabled"`
 
+	// ErrorPages Custom html/template sources replacing the proxy's built-in error pages. Templates
+	// receive .Code, .Title, .Message and .RequestID. HTTP services only.
+	ErrorPages *ServiceErrorPages `json:"error_pages,omitempty"`
+
 	// ListenPort Port the proxy listens on (L4/TLS only). Set to 0 for auto-assignment.
 	ListenPort *int `json:"listen_port,omitempty"`
 
+	// Maintenance Maintenance mode. While enabled the proxy answers 503 with a Retry-After header
+	// instead of forwarding, except for members of the allowed groups and clients
+	// connecting from the allowed CIDRs. HTTP services only.
+	Maintenance *ServiceMaintenance `json:"maintenance,omitempty"`
+
 	// Mode Service mode. "http" for L7 reverse proxy, "tcp"/"udp"/"tls" for L4 passthrough.
 	Mode *ServiceRequestMode `json:"mode,omitempty"`
 
@@ -5196,6 +6142,15 @@ type ServiceTargetOptions struct {
 	// sidecars).
 	DirectUpstream *bool `json:"direct_upstream,omitempty"`
 
+	// MirrorPercent Percentage of requests copied to mirror_target. Defaults to 100 when mirror_target is set.
+	MirrorPercent *int `json:"mirror_percent,omitempty"`
+
+	// MirrorTarget HTTP(S) URL that receives an asynchronous copy of the requests sent to
+	// this target, e.g. a new backend being migrated to. Mirror responses are
+	// discarded and never affect the client response. Only supported for HTTP
+	// services; request bodies larger than 1 MiB are not mirrored.
+	MirrorTarget *string `json:"mirror_target,omitempty"`
+
 	// PathRewrite Controls how the request path is rewritten before forwarding to the backend. Default strips the matched prefix. "preserve" keeps the full original request path.
 	PathRewrite *ServiceTargetOptionsPathRewrite `json:"path_rewrite,omitempty"`
 
@@ -5235,12 +6190,18 @@ type SetupKey struct {
 	// Key Setup Key as secret
 	Key string `json:"key"`
 
+	// Labels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	Labels *Labels `json:"labels,omitempty"`
+
 	// LastUsed Setup key last usage date
 	LastUsed time.Time `json:"last_used"`
 
 	// Name Setup key name identifier
 	Name string `json:"name"`
 
+	// Restrictions Restrictions a peer has to satisfy to enroll with the setup key. Empty fields don't restrict anything.
+	Restrictions *SetupKeyRestrictions `json:"restrictions,omitempty"`
+
 	// Revoked Setup key revocation status
 	Revoked bool `json:"revoked"`
 
@@ -5280,12 +6241,18 @@ type SetupKeyBase struct {
 	// Id Setup Key ID
 	Id string `json:"id"`
 
+	// Labels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	Labels *Labels `json:"labels,omitempty"`
+
 	// LastUsed Setup key last usage date
 	LastUsed time.Time `json:"last_used"`
 
 	// Name Setup key name identifier
 	Name string `json:"name"`
 
+	// Restrictions Restrictions a peer has to satisfy to enroll with the setup key. Empty fields don't restrict anything.
+	Restrictions *SetupKeyRestrictions `json:"restrictions,omitempty"`
+
 	// Revoked Setup key revocation status
 	Revoked bool `json:"revoked"`
 
@@ -5328,12 +6295,18 @@ type SetupKeyClear struct {
 	// Key Setup Key as plain text
 	Key string `json:"key"`
 
+	// Labels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	Labels *Labels `json:"labels,omitempty"`
+
 	// LastUsed Setup key last usage date
 	LastUsed time.Time `json:"last_used"`
 
 	// Name Setup key name identifier
 	Name string `json:"name"`
 
+	// Restrictions Restrictions a peer has to satisfy to enroll with the setup key. Empty fields don't restrict anything.
+	Restrictions *SetupKeyRestrictions `json:"restrictions,omitempty"`
+
 	// Revoked Setup key revocation status
 	Revoked bool `json:"revoked"`
 
@@ -5361,10 +6334,34 @@ type SetupKeyRequest struct {
 	// AutoGroups List of group IDs to auto-assign to peers registered with this key
 	AutoGroups []string `json:"auto_groups"`
 
+	// Labels Key/value labels. Keys are made of alphanumerics, '.', '_', '-' and '/', values of alphanumerics, '.', '_' and '-'. Both are at most 63 characters long.
+	Labels *Labels `json:"labels,omitempty"`
+
+	// Restrictions Restrictions a peer has to satisfy to enroll with the setup key. Empty fields don't restrict anything.
+	Restrictions *SetupKeyRestrictions `json:"restrictions,omitempty"`
+
 	// Revoked Setup key revocation status
 	Revoked bool `json:"revoked"`
 }
 
+// SetupKeyRestrictions Restrictions a peer has to satisfy to enroll with the setup key. Empty fields don't restrict anything.
+type SetupKeyRestrictions struct {
+	// AllowedCidrs IP ranges the peer has to connect from
+	AllowedCidrs *[]string `json:"allowed_cidrs,omitempty"`
+
+	// AllowedOs Operating system families the peer has to run
+	AllowedOs *[]SetupKeyRestrictionsAllowedOs `json:"allowed_os,omitempty"`
+
+	// HostnamePattern Regular expression the whole hostname of the peer has to match
+	HostnamePattern *string `json:"hostname_pattern,omitempty"`
+
+	// MaxPeersPerHostname Number of peers with the same hostname that can enroll with the key. The value of 0 indicates no limit.
+	MaxPeersPerHostname *int `json:"max_peers_per_hostname,omitempty"`
+}
+
+// SetupKeyRestrictionsAllowedOs defines model for SetupKeyRestrictions.AllowedOs.
+type SetupKeyRestrictionsAllowedOs string
+
 // SetupRequest Request to set up the initial admin user
 type SetupRequest struct {
 	// CreatePat If true and the server has setup-time PAT issuance enabled (NB_SETUP_PAT_ENABLED=true), create a Personal Access Token for the new owner user and return it in the response. Ignored when the server feature is disabled.
@@ -5430,6 +6427,24 @@ type SyncResult struct {
 	Result *string `json:"result,omitempty"`
 }
 
+// TOTPAuthConfig Time-based one-time password (RFC 6238) authentication. Each user signs in with their name and a code from an authenticator app.
+type TOTPAuthConfig struct {
+	// Enabled Whether TOTP auth is enabled
+	Enabled bool `json:"enabled"`
+
+	// Users Users enrolled for TOTP auth
+	Users *[]TOTPUser `json:"users,omitempty"`
+}
+
+// TOTPUser defines model for TOTPUser.
+type TOTPUser struct {
+	// Name User name entered on the login page
+	Name string `json:"name"`
+
+	// Secret Base32-encoded TOTP shared secret. Omit on update to keep the enrolled secret. Cleared in responses.
+	Secret *string `json:"secret,omitempty"`
+}
+
 // TenantGroupResponse defines model for TenantGroupResponse.
 type TenantGroupResponse struct {
 	// Id The Group ID
@@ -5762,6 +6777,30 @@ type UserRequest struct {
 	Role string `json:"role"`
 }
 
+// WebAuthnAuthConfig WebAuthn (passkey) authentication. Users register a passkey on the service's login page using a one-time enrollment code.
+type WebAuthnAuthConfig struct {
+	// Enabled Whether WebAuthn auth is enabled
+	Enabled bool `json:"enabled"`
+
+	// Users Users allowed to sign in with a passkey
+	Users *[]WebAuthnUser `json:"users,omitempty"`
+}
+
+// WebAuthnUser defines model for WebAuthnUser.
+type WebAuthnUser struct {
+	// CredentialCount Number of passkeys registered for this user
+	CredentialCount *int `json:"credential_count,omitempty"`
+
+	// EnrollmentCode One-time code that authorizes registering a new passkey for this user. Omit on update to keep the pending code. Cleared in responses.
+	EnrollmentCode *string `json:"enrollment_code,omitempty"`
+
+	// EnrollmentPending Whether an unused enrollment code is set for this user
+	EnrollmentPending *bool `json:"enrollment_pending,omitempty"`
+
+	// Name User name entered on the login page
+	Name string `json:"name"`
+}
+
 // WebhookTarget Target configuration for webhook notification channels.
 type WebhookTarget struct {
 	// Headers Custom HTTP headers sent with each webhook request.
@@ -5772,6 +6811,78 @@ type WebhookTarget struct {
 	Url string `json:"url"`
 }
 
+// WorkloadIdentityClaimRule defines model for WorkloadIdentityClaimRule.
+type WorkloadIdentityClaimRule struct {
+	// Claim Token claim to match. Nested claims are addressed with dots.
+	Claim string `json:"claim"`
+
+	// Values Accepted values of the claim. A '*' matches any sequence of characters.
+	Values []string `json:"values"`
+}
+
+// WorkloadIdentityProvider defines model for WorkloadIdentityProvider.
+type WorkloadIdentityProvider struct {
+	// Audiences Accepted audiences of the tokens
+	Audiences []string `json:"audiences"`
+
+	// AutoGroups List of group IDs to auto-assign to peers enrolled with the provider
+	AutoGroups []string `json:"auto_groups"`
+
+	// ClaimRules Rules the token claims have to match
+	ClaimRules []WorkloadIdentityClaimRule `json:"claim_rules"`
+
+	// CreatedAt Workload identity provider creation date
+	CreatedAt time.Time `json:"created_at"`
+
+	// Enabled Whether peers can enroll with tokens of the provider
+	Enabled bool `json:"enabled"`
+
+	// Ephemeral Indicate that the peers enrolled with the provider are ephemeral
+	Ephemeral bool `json:"ephemeral"`
+
+	// Id Workload identity provider ID, used by the peers to enroll with the provider
+	Id string `json:"id"`
+
+	// Issuer Issuer of the tokens, matched against the iss claim
+	Issuer string `json:"issuer"`
+
+	// JwksUrl URL of the issuer signing keys. Discovered from the issuer when not set.
+	JwksUrl *string `json:"jwks_url,omitempty"`
+
+	// Name Workload identity provider name
+	Name string `json:"name"`
+
+	// UpdatedAt Workload identity provider last update date
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WorkloadIdentityProviderRequest defines model for WorkloadIdentityProviderRequest.
+type WorkloadIdentityProviderRequest struct {
+	// Audiences Accepted audiences of the tokens
+	Audiences []string `json:"audiences"`
+
+	// AutoGroups List of group IDs to auto-assign to peers enrolled with the provider
+	AutoGroups *[]string `json:"auto_groups,omitempty"`
+
+	// ClaimRules Rules the token claims have to match. Every rule has to match, and a rule matches when the claim matches any of its values.
+	ClaimRules []WorkloadIdentityClaimRule `json:"claim_rules"`
+
+	// Enabled Whether peers can enroll with tokens of the provider. Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Ephemeral Indicate that the peers enrolled with the provider are ephemeral
+	Ephemeral *bool `json:"ephemeral,omitempty"`
+
+	// Issuer Issuer of the tokens, matched against the iss claim
+	Issuer string `json:"issuer"`
+
+	// JwksUrl URL of the issuer signing keys. When omitted, it is discovered from the OpenID configuration of the issuer.
+	JwksUrl *string `json:"jwks_url,omitempty"`
+
+	// Name Workload identity provider name
+	Name string `json:"name"`
+}
+
 // WorkloadRequest defines model for WorkloadRequest.
 type WorkloadRequest struct {
 	union json.RawMessage
@@ -5969,6 +7080,30 @@ type GetApiAgentNetworkUsageOverviewParams struct {
 // GetApiAgentNetworkUsageOverviewParamsGranularity defines parameters for GetApiAgentNetworkUsageOverview.
 type GetApiAgentNetworkUsageOverviewParamsGranularity string
 
+// GetApiEventsAuditParams defines parameters for GetApiEventsAudit.
+type GetApiEventsAuditParams struct {
+	// ActivityCode Filter by activity code, e.g. policy.update. Can be repeated to match any of several codes.
+	ActivityCode *[]string `form:"activity_code,omitempty" json:"activity_code,omitempty"`
+
+	// InitiatorId Filter by the ID of the user or object that initiated the event
+	InitiatorId *string `form:"initiator_id,omitempty" json:"initiator_id,omitempty"`
+
+	// TargetId Filter by the ID of the object affected by the event
+	TargetId *string `form:"target_id,omitempty" json:"target_id,omitempty"`
+
+	// StartDate Filter by timestamp >= start_date (RFC3339 format)
+	StartDate *time.Time `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Filter by timestamp <= end_date (RFC3339 format)
+	EndDate *time.Time `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Cursor Return only events older than the event with this ID
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of events to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetApiEventsNetworkTrafficParams defines parameters for GetApiEventsNetworkTraffic.
 type GetApiEventsNetworkTrafficParams struct {
 	// Page Page number
@@ -6156,6 +7291,9 @@ type GetApiPeersParams struct {
 
 	// Ip Filter peers by IP address
 	Ip *string `form:"ip,omitempty" json:"ip,omitempty"`
+
+	// Label Filter peers by label in the key=value form. When repeated, peers must have all of the labels.
+	Label *[]string `form:"label,omitempty" json:"label,omitempty"`
 }
 
 // GetApiPeersPeerIdIngressPortsParams defines parameters for GetApiPeersPeerIdIngressPorts.
@@ -6170,6 +7308,15 @@ type GetApiUsersParams struct {
 	ServiceUser *bool `form:"service_user,omitempty" json:"service_user,omitempty"`
 }
 
+// PostApiAccessRequestsJSONRequestBody defines body for PostApiAccessRequests for application/json ContentType.
+type PostApiAccessRequestsJSONRequestBody = AccessRequestCreate
+
+// PostApiAccessRequestsRequestIdApproveJSONRequestBody defines body for PostApiAccessRequestsRequestIdApprove for application/json ContentType.
+type PostApiAccessRequestsRequestIdApproveJSONRequestBody = AccessRequestReview
+
+// PostApiAccessRequestsRequestIdDenyJSONRequestBody defines body for PostApiAccessRequestsRequestIdDeny for application/json ContentType.
+type PostApiAccessRequestsRequestIdDenyJSONRequestBody = AccessRequestReview
+
 // PutApiAccountsAccountIdJSONRequestBody defines body for PutApiAccountsAccountId for application/json ContentType.
 type PutApiAccountsAccountIdJSONRequestBody = AccountRequest
 
@@ -6371,6 +7518,9 @@ type PostApiPeersPeerIdTemporaryAccessJSONRequestBody = PeerTemporaryAccessReque
 // PostApiPoliciesJSONRequestBody defines body for PostApiPolicies for application/json ContentType.
 type PostApiPoliciesJSONRequestBody = PolicyUpdate
 
+// PostApiPoliciesSimulateJSONRequestBody defines body for PostApiPoliciesSimulate for application/json ContentType.
+type PostApiPoliciesSimulateJSONRequestBody = PolicySimulationRequest
+
 // PutApiPoliciesPolicyIdJSONRequestBody defines body for PutApiPoliciesPolicyId for application/json ContentType.
 type PutApiPoliciesPolicyIdJSONRequestBody = PolicyCreate
 
@@ -6392,6 +7542,12 @@ type PostApiReverseProxiesServicesJSONRequestBody = ServiceRequest
 // PutApiReverseProxiesServicesServiceIdJSONRequestBody defines body for PutApiReverseProxiesServicesServiceId for application/json ContentType.
 type PutApiReverseProxiesServicesServiceIdJSONRequestBody = ServiceRequest
 
+// PostApiRolesJSONRequestBody defines body for PostApiRoles for application/json ContentType.
+type PostApiRolesJSONRequestBody = CustomRoleRequest
+
+// PutApiRolesRoleIdJSONRequestBody defines body for PutApiRolesRoleId for application/json ContentType.
+type PutApiRolesRoleIdJSONRequestBody = CustomRoleRequest
+
 // PostApiRoutesJSONRequestBody defines body for PostApiRoutes for application/json ContentType.
 type PostApiRoutesJSONRequestBody = RouteRequest
 
@@ -6428,6 +7584,12 @@ type PutApiUsersUserIdPasswordJSONRequestBody = PasswordChangeRequest
 // PostApiUsersUserIdTokensJSONRequestBody defines body for PostApiUsersUserIdTokens for application/json ContentType.
 type PostApiUsersUserIdTokensJSONRequestBody = PersonalAccessTokenRequest
 
+// PostApiWorkloadIdentityProvidersJSONRequestBody defines body for PostApiWorkloadIdentityProviders for application/json ContentType.
+type PostApiWorkloadIdentityProvidersJSONRequestBody = WorkloadIdentityProviderRequest
+
+// PutApiWorkloadIdentityProvidersProviderIdJSONRequestBody defines body for PutApiWorkloadIdentityProvidersProviderId for application/json ContentType.
+type PutApiWorkloadIdentityProvidersProviderIdJSONRequestBody = WorkloadIdentityProviderRequest
+
 // AsEmailTarget returns the union data inside the NotificationChannelRequest_Target as a EmailTarget
 func (t NotificationChannelRequest_Target) AsEmailTarget() (EmailTarget, error) {
 	var body EmailTarget
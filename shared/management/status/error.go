@@ -199,6 +199,11 @@ func NewPolicyNotFoundError(policyID string) error {
 	return Errorf(NotFound, "policy: %s not found", policyID)
 }
 
+// NewAccessRequestNotFoundError creates a new Error with NotFound type for a missing access request
+func NewAccessRequestNotFoundError(requestID string) error {
+	return Errorf(NotFound, "access request: %s not found", requestID)
+}
+
 // NewNameServerGroupNotFoundError creates a new Error with NotFound type for a missing name server group
 func NewNameServerGroupNotFoundError(nsGroupID string) error {
 	return Errorf(NotFound, "nameserver group: %s not found", nsGroupID)
@@ -292,6 +297,16 @@ func NewZoneNotFoundError(zoneID string) error {
 	return Errorf(NotFound, "zone: %s not found", zoneID)
 }
 
+// NewCustomRoleNotFoundError creates a new Error with NotFound type for a missing custom role.
+func NewCustomRoleNotFoundError(roleID string) error {
+	return Errorf(NotFound, "custom role: %s not found", roleID)
+}
+
+// NewWorkloadIdentityProviderNotFoundError creates a new Error with NotFound type for a missing workload identity provider.
+func NewWorkloadIdentityProviderNotFoundError(providerID string) error {
+	return Errorf(NotFound, "workload identity provider: %s not found", providerID)
+}
+
 // NewDNSRecordNotFoundError creates a new Error with NotFound type for a missing dns record.
 func NewDNSRecordNotFoundError(recordID string) error {
 	return Errorf(NotFound, "dns record: %s not found", recordID)
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AccessRequestTargetType is the kind of resource a just-in-time access request grants access to
+type AccessRequestTargetType string
+
+const (
+	// AccessRequestTargetGroup grants the requester's peers temporary membership in a group
+	AccessRequestTargetGroup AccessRequestTargetType = "group"
+	// AccessRequestTargetPolicy temporarily enables a disabled policy
+	AccessRequestTargetPolicy AccessRequestTargetType = "policy"
+)
+
+// AccessRequestStatus is the state of a just-in-time access request
+type AccessRequestStatus string
+
+const (
+	// AccessRequestStatusPending is a request waiting for an approver
+	AccessRequestStatusPending AccessRequestStatus = "pending"
+	// AccessRequestStatusApproved is a granted request that is in effect until it expires
+	AccessRequestStatusApproved AccessRequestStatus = "approved"
+	// AccessRequestStatusDenied is a request an approver rejected
+	AccessRequestStatusDenied AccessRequestStatus = "denied"
+	// AccessRequestStatusRevoked is a request withdrawn before approval or a grant ended before it expired
+	AccessRequestStatusRevoked AccessRequestStatus = "revoked"
+	// AccessRequestStatusExpired is a grant whose duration has elapsed
+	AccessRequestStatusExpired AccessRequestStatus = "expired"
+)
+
+// AccessRequestMaxDuration is the longest time an access request can be granted for
+const AccessRequestMaxDuration = 30 * 24 * time.Hour
+
+// AccessRequest is a user's request for temporary access to a group or a policy. Once approved it
+// is a grant that the network map treats like a regular group membership or enabled policy until
+// ExpiresAt.
+type AccessRequest struct {
+	// ID of the access request
+	ID string `gorm:"primaryKey"`
+
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+
+	// UserID is the user that requested access
+	UserID string `gorm:"index"`
+
+	// TargetType is the kind of the requested resource
+	TargetType AccessRequestTargetType
+
+	// TargetID is the ID of the requested group or policy
+	TargetID string
+
+	// Justification explains why the access is needed
+	Justification string
+
+	// Duration is how long the access is granted for once approved
+	Duration time.Duration
+
+	// Status of the request
+	Status AccessRequestStatus `gorm:"index"`
+
+	// CreatedAt is the time the request was made
+	CreatedAt time.Time
+
+	// ReviewedBy is the user that approved or denied the request
+	ReviewedBy string
+
+	// ReviewedAt is the time the request was approved or denied
+	ReviewedAt *time.Time
+
+	// ReviewComment is the approver's note on the decision
+	ReviewComment string
+
+	// ExpiresAt is the time an approved grant ends
+	ExpiresAt *time.Time
+
+	// RevokedBy is the user that withdrew the request or ended the grant early
+	RevokedBy string
+
+	// RevokedAt is the time the request was withdrawn or the grant was ended early
+	RevokedAt *time.Time
+}
+
+// Copy returns a copy of the access request
+func (r *AccessRequest) Copy() *AccessRequest {
+	c := *r
+	c.ReviewedAt = copyTimePtr(r.ReviewedAt)
+	c.ExpiresAt = copyTimePtr(r.ExpiresAt)
+	c.RevokedAt = copyTimePtr(r.RevokedAt)
+	return &c
+}
+
+func copyTimePtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
+
+// Validate checks that a new request is well-formed
+func (r *AccessRequest) Validate() error {
+	switch r.TargetType {
+	case AccessRequestTargetGroup, AccessRequestTargetPolicy:
+	default:
+		return fmt.Errorf("unsupported target type %q", r.TargetType)
+	}
+	if r.TargetID == "" {
+		return errors.New("target ID is required")
+	}
+	if strings.TrimSpace(r.Justification) == "" {
+		return errors.New("justification is required")
+	}
+	if r.Duration <= 0 {
+		return errors.New("duration must be positive")
+	}
+	if r.Duration > AccessRequestMaxDuration {
+		return fmt.Errorf("duration can't be longer than %s", AccessRequestMaxDuration)
+	}
+	return nil
+}
+
+// IsActive reports whether the request is an approved grant in effect at the given moment
+func (r *AccessRequest) IsActive(now time.Time) bool {
+	return r.Status == AccessRequestStatusApproved && r.ExpiresAt != nil && now.Before(*r.ExpiresAt)
+}
+
+// Approve grants the request for its duration starting now
+func (r *AccessRequest) Approve(userID, comment string, now time.Time) {
+	expiresAt := now.Add(r.Duration)
+	r.Status = AccessRequestStatusApproved
+	r.ReviewedBy = userID
+	r.ReviewedAt = &now
+	r.ReviewComment = comment
+	r.ExpiresAt = &expiresAt
+}
+
+// Deny rejects the request
+func (r *AccessRequest) Deny(userID, comment string, now time.Time) {
+	r.Status = AccessRequestStatusDenied
+	r.ReviewedBy = userID
+	r.ReviewedAt = &now
+	r.ReviewComment = comment
+}
+
+// Revoke withdraws a pending request or ends an approved grant before it expires
+func (r *AccessRequest) Revoke(userID string, now time.Time) {
+	r.Status = AccessRequestStatusRevoked
+	r.RevokedBy = userID
+	r.RevokedAt = &now
+}
+
+// EventMeta returns activity event meta related to the access request
+func (r *AccessRequest) EventMeta() map[string]any {
+	meta := map[string]any{
+		"target_type":   r.TargetType,
+		"target_id":     r.TargetID,
+		"justification": r.Justification,
+		"duration":      r.Duration.String(),
+		"requested_by":  r.UserID,
+	}
+	if r.ExpiresAt != nil {
+		meta["expires_at"] = r.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	if r.ReviewComment != "" {
+		meta["comment"] = r.ReviewComment
+	}
+	return meta
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessRequest_Validate(t *testing.T) {
+	valid := AccessRequest{TargetType: AccessRequestTargetGroup, TargetID: "group", Justification: "incident", Duration: time.Hour}
+	assert.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(r *AccessRequest)
+	}{
+		{name: "unknown target type", modify: func(r *AccessRequest) { r.TargetType = "user" }},
+		{name: "missing target", modify: func(r *AccessRequest) { r.TargetID = "" }},
+		{name: "blank justification", modify: func(r *AccessRequest) { r.Justification = "  " }},
+		{name: "no duration", modify: func(r *AccessRequest) { r.Duration = 0 }},
+		{name: "too long", modify: func(r *AccessRequest) { r.Duration = AccessRequestMaxDuration + time.Second }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := valid
+			tt.modify(&request)
+			assert.Error(t, request.Validate())
+		})
+	}
+}
+
+func TestAccessRequest_Lifecycle(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	request := &AccessRequest{Status: AccessRequestStatusPending, Duration: time.Hour}
+	assert.False(t, request.IsActive(now), "pending requests aren't in effect")
+
+	request.Approve("approver", "ok", now)
+	assert.Equal(t, AccessRequestStatusApproved, request.Status)
+	assert.Equal(t, now.Add(time.Hour), *request.ExpiresAt)
+	assert.True(t, request.IsActive(now))
+	assert.True(t, request.IsActive(now.Add(59*time.Minute)))
+	assert.False(t, request.IsActive(now.Add(time.Hour)), "the grant ends at its expiry")
+
+	c := request.Copy()
+	*c.ExpiresAt = now
+	assert.Equal(t, now.Add(time.Hour), *request.ExpiresAt, "copies don't share the timestamps")
+
+	request.Revoke("approver", now.Add(time.Minute))
+	assert.Equal(t, AccessRequestStatusRevoked, request.Status)
+	assert.False(t, request.IsActive(now.Add(2*time.Minute)))
+}
@@ -3,8 +3,10 @@ package types
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -75,6 +77,9 @@ type Policy struct {
 
 	// SourcePostureChecks are ID references to Posture checks for policy source groups
 	SourcePostureChecks []string `gorm:"serializer:json"`
+
+	// Schedule limits when an enabled policy is in effect, always when nil
+	Schedule *PolicySchedule `gorm:"serializer:json"`
 }
 
 // Copy returns a copy of the policy.
@@ -88,6 +93,7 @@ func (p *Policy) Copy() *Policy {
 		Enabled:             p.Enabled,
 		Rules:               make([]*PolicyRule, len(p.Rules)),
 		SourcePostureChecks: make([]string, len(p.SourcePostureChecks)),
+		Schedule:            p.Schedule.Copy(),
 	}
 	for i, r := range p.Rules {
 		c.Rules[i] = r.Copy()
@@ -113,6 +119,10 @@ func (p *Policy) Equal(other *Policy) bool {
 		return false
 	}
 
+	if !p.Schedule.Equal(other.Schedule) {
+		return false
+	}
+
 	if len(p.Rules) != len(other.Rules) {
 		return false
 	}
@@ -134,6 +144,11 @@ func (p *Policy) Equal(other *Policy) bool {
 	return true
 }
 
+// IsActive reports whether the policy is enabled and its schedule allows it at the given moment
+func (p *Policy) IsActive(now time.Time) bool {
+	return p.Enabled && (p.Schedule == nil || p.Schedule.IsActive(now))
+}
+
 // EventMeta returns activity event meta related to this policy
 func (p *Policy) EventMeta() map[string]any {
 	return map[string]any{"name": p.Name}
@@ -165,6 +180,11 @@ func (p *Policy) RuleGroups() []string {
 	return groups
 }
 
+// HasLabelSelectors reports whether any rule of the policy selects peers by their labels.
+func (p *Policy) HasLabelSelectors() bool {
+	return slices.ContainsFunc(p.Rules, (*PolicyRule).HasLabelSelectors)
+}
+
 // SourceGroups returns a slice of all unique source groups referenced in the policy's rules.
 func (p *Policy) SourceGroups() []string {
 	if len(p.Rules) == 1 {
@@ -0,0 +1,257 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const policyScheduleTimeLayout = "15:04"
+
+var policyScheduleWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// PolicySchedule limits when a policy is in effect. A policy with a schedule is only active
+// between StartDate and EndDate and, when windows are defined, inside one of the windows.
+type PolicySchedule struct {
+	// Timezone is the IANA name of the timezone the windows are evaluated in, UTC when empty
+	Timezone string `json:"timezone,omitempty"`
+	// Windows are the recurring weekly time windows the policy is active in
+	Windows []PolicyScheduleWindow `json:"windows,omitempty"`
+	// StartDate is the moment the policy becomes active
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// EndDate is the moment the policy stops being active
+	EndDate *time.Time `json:"end_date,omitempty"`
+}
+
+// PolicyScheduleWindow is a recurring time window on the selected weekdays. A window whose end is
+// not after its start spans midnight and ends on the following day.
+type PolicyScheduleWindow struct {
+	// Days are the weekdays (mon, tue, wed, thu, fri, sat, sun) the window starts on, every day when empty
+	Days []string `json:"days,omitempty"`
+	// Start is the time of day in HH:MM the window opens at
+	Start string `json:"start"`
+	// End is the time of day in HH:MM the window closes at
+	End string `json:"end"`
+}
+
+// Copy returns a copy of the schedule
+func (s *PolicySchedule) Copy() *PolicySchedule {
+	if s == nil {
+		return nil
+	}
+	c := &PolicySchedule{
+		Timezone: s.Timezone,
+		Windows:  make([]PolicyScheduleWindow, 0, len(s.Windows)),
+	}
+	for _, w := range s.Windows {
+		c.Windows = append(c.Windows, PolicyScheduleWindow{Days: slices.Clone(w.Days), Start: w.Start, End: w.End})
+	}
+	if s.StartDate != nil {
+		t := *s.StartDate
+		c.StartDate = &t
+	}
+	if s.EndDate != nil {
+		t := *s.EndDate
+		c.EndDate = &t
+	}
+	return c
+}
+
+// Equal reports whether both schedules are the same
+func (s *PolicySchedule) Equal(other *PolicySchedule) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if s.Timezone != other.Timezone || !timePtrEqual(s.StartDate, other.StartDate) || !timePtrEqual(s.EndDate, other.EndDate) {
+		return false
+	}
+	return slices.EqualFunc(s.Windows, other.Windows, func(a, b PolicyScheduleWindow) bool {
+		return a.Start == b.Start && a.End == b.End && slices.Equal(a.Days, b.Days)
+	})
+}
+
+func timePtrEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// Validate checks that the schedule is well-formed
+func (s *PolicySchedule) Validate() error {
+	if _, err := s.location(); err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", s.Timezone, err)
+	}
+	if len(s.Windows) == 0 && s.StartDate == nil && s.EndDate == nil {
+		return errors.New("schedule requires at least one window, a start date or an end date")
+	}
+	if s.StartDate != nil && s.EndDate != nil && !s.StartDate.Before(*s.EndDate) {
+		return errors.New("schedule start date must be before the end date")
+	}
+	for i, w := range s.Windows {
+		if err := w.validate(); err != nil {
+			return fmt.Errorf("window %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func (w PolicyScheduleWindow) validate() error {
+	for _, day := range w.Days {
+		if _, ok := policyScheduleWeekdays[day]; !ok {
+			return fmt.Errorf("invalid day %q", day)
+		}
+	}
+	start, err := parseTimeOfDay(w.Start)
+	if err != nil {
+		return fmt.Errorf("invalid start %q: %w", w.Start, err)
+	}
+	end, err := parseTimeOfDay(w.End)
+	if err != nil {
+		return fmt.Errorf("invalid end %q: %w", w.End, err)
+	}
+	if start == end {
+		return errors.New("start and end must differ")
+	}
+	return nil
+}
+
+// IsActive reports whether the schedule allows the policy at the given moment
+func (s *PolicySchedule) IsActive(now time.Time) bool {
+	if s.StartDate != nil && now.Before(*s.StartDate) {
+		return false
+	}
+	if s.EndDate != nil && !now.Before(*s.EndDate) {
+		return false
+	}
+	if len(s.Windows) == 0 {
+		return true
+	}
+
+	loc, err := s.location()
+	if err != nil {
+		return false
+	}
+	local := now.In(loc)
+	// a window that opened yesterday may still be open when it spans midnight
+	for _, dayOffset := range []int{0, -1} {
+		for _, w := range s.Windows {
+			start, end, ok := w.occurrence(local, dayOffset, loc)
+			if ok && !local.Before(start) && local.Before(end) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NextTransition returns the first moment after now at which the schedule switches between
+// active and inactive. It returns false when the state never changes again.
+func (s *PolicySchedule) NextTransition(now time.Time) (time.Time, bool) {
+	var candidates []time.Time
+	if s.StartDate != nil && s.StartDate.After(now) {
+		candidates = append(candidates, *s.StartDate)
+	}
+	if s.EndDate != nil && s.EndDate.After(now) {
+		candidates = append(candidates, *s.EndDate)
+	}
+
+	if loc, err := s.location(); err == nil {
+		local := now.In(loc)
+		// windows repeat weekly, so the boundaries of the coming week cover every change
+		for dayOffset := -1; dayOffset <= 8; dayOffset++ {
+			for _, w := range s.Windows {
+				start, end, ok := w.occurrence(local, dayOffset, loc)
+				if !ok {
+					continue
+				}
+				if start.After(now) {
+					candidates = append(candidates, start)
+				}
+				if end.After(now) {
+					candidates = append(candidates, end)
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b time.Time) int { return a.Compare(b) })
+
+	active := s.IsActive(now)
+	for _, candidate := range candidates {
+		if s.IsActive(candidate) != active {
+			return candidate, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// occurrence returns the window instance starting on the day dayOffset days away from local
+func (w PolicyScheduleWindow) occurrence(local time.Time, dayOffset int, loc *time.Location) (time.Time, time.Time, bool) {
+	startOfDay, err := parseTimeOfDay(w.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	endOfDay, err := parseTimeOfDay(w.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	day := time.Date(local.Year(), local.Month(), local.Day()+dayOffset, 0, 0, 0, 0, loc)
+	if len(w.Days) > 0 && !slices.ContainsFunc(w.Days, func(d string) bool {
+		return policyScheduleWeekdays[d] == day.Weekday()
+	}) {
+		return time.Time{}, time.Time{}, false
+	}
+
+	start := time.Date(day.Year(), day.Month(), day.Day(), startOfDay/60, startOfDay%60, 0, 0, loc)
+	endDay := day
+	if endOfDay <= startOfDay {
+		endDay = day.AddDate(0, 0, 1)
+	}
+	end := time.Date(endDay.Year(), endDay.Month(), endDay.Day(), endOfDay/60, endOfDay%60, 0, 0, loc)
+	return start, end, true
+}
+
+func (s *PolicySchedule) location() (*time.Location, error) {
+	if s.Timezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(s.Timezone)
+}
+
+// parseTimeOfDay returns the minutes since midnight of an HH:MM value
+func parseTimeOfDay(value string) (int, error) {
+	t, err := time.Parse(policyScheduleTimeLayout, value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// NextPolicyScheduleTransition returns the earliest moment after now at which one of the enabled
+// scheduled policies switches between active and inactive.
+func NextPolicyScheduleTransition(policies []*Policy, now time.Time) (time.Time, bool) {
+	var next time.Time
+	var found bool
+	for _, policy := range policies {
+		if policy == nil || !policy.Enabled || policy.Schedule == nil {
+			continue
+		}
+		transition, ok := policy.Schedule.NextTransition(now)
+		if ok && (!found || transition.Before(next)) {
+			next = transition
+			found = true
+		}
+	}
+	return next, found
+}
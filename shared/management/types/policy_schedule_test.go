@@ -0,0 +1,134 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestPolicySchedule_Validate(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		schedule PolicySchedule
+		valid    bool
+	}{
+		{name: "window", schedule: PolicySchedule{Windows: []PolicyScheduleWindow{{Days: []string{"mon"}, Start: "09:00", End: "17:00"}}}, valid: true},
+		{name: "overnight window", schedule: PolicySchedule{Timezone: "Europe/Berlin", Windows: []PolicyScheduleWindow{{Start: "22:00", End: "06:00"}}}, valid: true},
+		{name: "dates only", schedule: PolicySchedule{StartDate: &start, EndDate: &end}, valid: true},
+		{name: "empty", schedule: PolicySchedule{}},
+		{name: "unknown timezone", schedule: PolicySchedule{Timezone: "Mars/Olympus", StartDate: &start}},
+		{name: "start after end", schedule: PolicySchedule{StartDate: &end, EndDate: &start}},
+		{name: "invalid day", schedule: PolicySchedule{Windows: []PolicyScheduleWindow{{Days: []string{"monday"}, Start: "09:00", End: "17:00"}}}},
+		{name: "invalid time", schedule: PolicySchedule{Windows: []PolicyScheduleWindow{{Start: "25:00", End: "17:00"}}}},
+		{name: "empty window", schedule: PolicySchedule{Windows: []PolicyScheduleWindow{{Start: "09:00", End: "09:00"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPolicySchedule_IsActive(t *testing.T) {
+	businessHours := &PolicySchedule{
+		Timezone: "America/New_York",
+		Windows:  []PolicyScheduleWindow{{Days: []string{"mon", "tue", "wed", "thu", "fri"}, Start: "09:00", End: "17:00"}},
+	}
+	overnight := &PolicySchedule{
+		Windows: []PolicyScheduleWindow{{Days: []string{"fri"}, Start: "22:00", End: "02:00"}},
+	}
+	start := mustTime(t, "2026-03-02T00:00:00Z")
+	end := mustTime(t, "2026-03-09T00:00:00Z")
+	dated := &PolicySchedule{StartDate: &start, EndDate: &end}
+
+	tests := []struct {
+		name     string
+		schedule *PolicySchedule
+		now      string
+		active   bool
+	}{
+		{name: "inside business hours", schedule: businessHours, now: "2026-03-02T14:00:00Z", active: true},
+		{name: "before business hours in local time", schedule: businessHours, now: "2026-03-02T13:59:00Z", active: false},
+		{name: "end is exclusive", schedule: businessHours, now: "2026-03-02T22:00:00Z", active: false},
+		{name: "weekend", schedule: businessHours, now: "2026-03-07T15:00:00Z", active: false},
+		{name: "overnight before midnight", schedule: overnight, now: "2026-03-06T23:00:00Z", active: true},
+		{name: "overnight after midnight", schedule: overnight, now: "2026-03-07T01:30:00Z", active: true},
+		{name: "overnight closed", schedule: overnight, now: "2026-03-07T02:00:00Z", active: false},
+		{name: "overnight only starts on selected days", schedule: overnight, now: "2026-03-06T01:00:00Z", active: false},
+		{name: "before start date", schedule: dated, now: "2026-03-01T23:59:59Z", active: false},
+		{name: "between dates", schedule: dated, now: "2026-03-05T12:00:00Z", active: true},
+		{name: "at end date", schedule: dated, now: "2026-03-09T00:00:00Z", active: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.active, tt.schedule.IsActive(mustTime(t, tt.now)))
+		})
+	}
+}
+
+func TestPolicySchedule_NextTransition(t *testing.T) {
+	schedule := &PolicySchedule{
+		Timezone: "Europe/Berlin",
+		Windows:  []PolicyScheduleWindow{{Days: []string{"mon"}, Start: "09:00", End: "17:00"}},
+	}
+
+	// Saturday: the window opens on Monday at 09:00 Berlin time
+	next, ok := schedule.NextTransition(mustTime(t, "2026-03-07T12:00:00Z"))
+	require.True(t, ok)
+	assert.Equal(t, mustTime(t, "2026-03-09T08:00:00Z"), next.UTC())
+
+	next, ok = schedule.NextTransition(mustTime(t, "2026-03-09T10:00:00Z"))
+	require.True(t, ok)
+	assert.Equal(t, mustTime(t, "2026-03-09T16:00:00Z"), next.UTC())
+
+	// the clocks move forward on 2026-03-29, the window opens at 09:00 summer time
+	next, ok = schedule.NextTransition(mustTime(t, "2026-03-28T12:00:00Z"))
+	require.True(t, ok)
+	assert.Equal(t, mustTime(t, "2026-03-30T07:00:00Z"), next.UTC())
+
+	end := mustTime(t, "2026-03-09T12:00:00Z")
+	schedule.EndDate = &end
+	next, ok = schedule.NextTransition(mustTime(t, "2026-03-09T10:00:00Z"))
+	require.True(t, ok)
+	assert.Equal(t, end, next, "the end date closes the window early")
+
+	_, ok = schedule.NextTransition(mustTime(t, "2026-03-09T13:00:00Z"))
+	assert.False(t, ok, "an expired schedule never changes again")
+}
+
+func TestNextPolicyScheduleTransition(t *testing.T) {
+	now := mustTime(t, "2026-03-02T00:00:00Z")
+	soon := now.Add(time.Hour)
+	later := now.Add(2 * time.Hour)
+
+	policies := []*Policy{
+		{ID: "unscheduled", Enabled: true},
+		{ID: "disabled", Enabled: false, Schedule: &PolicySchedule{StartDate: &soon}},
+		{ID: "later", Enabled: true, Schedule: &PolicySchedule{StartDate: &later}},
+	}
+	next, ok := NextPolicyScheduleTransition(policies, now)
+	require.True(t, ok)
+	assert.Equal(t, later, next, "disabled policies are ignored")
+
+	_, ok = NextPolicyScheduleTransition(policies[:2], now)
+	assert.False(t, ok)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"maps"
 	"slices"
 
 	"github.com/netbirdio/netbird/shared/management/proto"
@@ -71,6 +72,12 @@ type PolicyRule struct {
 	// SourceResource policy source resource that the rule is applied to
 	SourceResource Resource `gorm:"serializer:json"`
 
+	// SourceLabels selects the source peers by their labels in addition to the source groups
+	SourceLabels map[string]string `gorm:"serializer:json"`
+
+	// DestinationLabels selects the destination peers by their labels in addition to the destination groups
+	DestinationLabels map[string]string `gorm:"serializer:json"`
+
 	// Bidirectional define if the rule is applicable in both directions, sources, and destinations
 	Bidirectional bool
 
@@ -109,6 +116,8 @@ func (pm *PolicyRule) Copy() *PolicyRule {
 		PortRanges:          make([]RulePortRange, len(pm.PortRanges)),
 		AuthorizedGroups:    make(map[string][]string, len(pm.AuthorizedGroups)),
 		AuthorizedUser:      pm.AuthorizedUser,
+		SourceLabels:        maps.Clone(pm.SourceLabels),
+		DestinationLabels:   maps.Clone(pm.DestinationLabels),
 	}
 	copy(rule.Destinations, pm.Destinations)
 	copy(rule.Sources, pm.Sources)
@@ -155,10 +164,18 @@ func (pm *PolicyRule) Equal(other *PolicyRule) bool {
 	if !authorizedGroupsEqual(pm.AuthorizedGroups, other.AuthorizedGroups) {
 		return false
 	}
+	if !maps.Equal(pm.SourceLabels, other.SourceLabels) || !maps.Equal(pm.DestinationLabels, other.DestinationLabels) {
+		return false
+	}
 
 	return true
 }
 
+// HasLabelSelectors reports whether the rule selects source or destination peers by their labels
+func (pm *PolicyRule) HasLabelSelectors() bool {
+	return len(pm.SourceLabels) > 0 || len(pm.DestinationLabels) > 0
+}
+
 func stringSlicesEqualUnordered(a, b []string) bool {
 	if len(a) != len(b) {
 		return false